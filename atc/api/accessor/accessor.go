@@ -11,8 +11,13 @@ type Access interface {
 	HasToken() bool
 	IsAuthenticated() bool
 	IsAuthorized(string) bool
+	IsAuthorizedInPipeline(string, string) bool
+	IsOwner(string) bool
+	HasRole(string, string) bool
+	IsKnownRole(string) bool
 	IsAdmin() bool
 	IsSystem() bool
+	IsAPIToken() bool
 	TeamNames() []string
 	CSRFToken() string
 	UserName() string
@@ -58,19 +63,69 @@ func (a *access) IsAuthorized(team string) bool {
 	return false
 }
 
+// IsAuthorizedInPipeline returns whether a role bound to the user in the
+// pipeline permits the action, regardless of their roles in the team.
+func (a *access) IsAuthorizedInPipeline(team string, pipeline string) bool {
+	for _, pipelineRole := range a.PipelineRoles()[team][pipeline] {
+		if a.hasPermission(pipelineRole) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOwner returns whether the team is owned by the user, regardless of the
+// role required by the action.
+func (a *access) IsOwner(team string) bool {
+	if a.IsAdmin() {
+		return true
+	}
+	for _, teamRole := range a.TeamRoles()[team] {
+		if teamRole == "owner" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRole returns whether the user has at least the permissions of the role
+// in the team, regardless of the role required by the action.
+func (a *access) HasRole(team string, role string) bool {
+	if a.IsAdmin() {
+		return true
+	}
+	for _, teamRole := range a.TeamRoles()[team] {
+		if a.includesRole(teamRole, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsKnownRole returns whether the role is one of the built-in roles or a
+// custom role defined by the operator.
+func (a *access) IsKnownRole(role string) bool {
+	return a.actionRoleMap.IsKnownRole(role)
+}
+
 func (a *access) hasPermission(role string) bool {
-	switch a.actionRoleMap.RoleOfAction(a.action) {
-	case "owner":
-		return role == "owner"
-	case "member":
-		return role == "owner" || role == "member"
-	case "pipeline-operator":
-		return role == "owner" || role == "member" || role == "pipeline-operator"
-	case "viewer":
-		return role == "owner" || role == "member" || role == "pipeline-operator" || role == "viewer"
-	default:
+	return a.actionRoleMap.RolePermits(role, a.action)
+}
+
+// includesRole returns whether the role permits every action which the
+// required role does.
+func (a *access) includesRole(role string, required string) bool {
+	if !a.actionRoleMap.IsKnownRole(role) || !a.actionRoleMap.IsKnownRole(required) {
 		return false
 	}
+
+	for action := range requiredRoles {
+		if a.actionRoleMap.RolePermits(required, action) && !a.actionRoleMap.RolePermits(role, action) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (a *access) IsAdmin() bool {
@@ -89,6 +144,16 @@ func (a *access) IsSystem() bool {
 	return false
 }
 
+// IsAPIToken reports whether the request was authenticated with an API token
+// rather than a token issued by skymarshal.
+func (a *access) IsAPIToken() bool {
+	if isAPITokenClaim, ok := a.Claims()["api_token"]; ok {
+		isAPIToken, ok := isAPITokenClaim.(bool)
+		return ok && isAPIToken
+	}
+	return false
+}
+
 func (a *access) TeamNames() []string {
 
 	teams := []string{}
@@ -119,6 +184,18 @@ func (a *access) TeamRoles() map[string][]string {
 	return teamRoles
 }
 
+// PipelineRoles returns the roles bound to the user in individual pipelines,
+// keyed by team and then by pipeline.
+func (a *access) PipelineRoles() map[string]map[string][]string {
+	pipelineRoles := map[string]map[string][]string{}
+
+	if pipelinesClaim, ok := a.Claims()["pipelines"]; ok {
+		_ = mapstructure.Decode(pipelinesClaim, &pipelineRoles)
+	}
+
+	return pipelineRoles
+}
+
 func (a *access) CSRFToken() string {
 	if csrfTokenClaim, ok := a.Claims()["csrf"]; ok {
 		if csrfToken, ok := csrfTokenClaim.(string); ok {
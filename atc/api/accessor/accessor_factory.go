@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	jwt "github.com/dgrijalva/jwt-go"
 )
 
@@ -16,19 +18,23 @@ type AccessFactory interface {
 	ActionRoleMapModifier
 	ActionRoleMap
 
-	Create(*http.Request, string) Access
+	Create(*http.Request, string) (Access, error)
 }
 
 type accessFactory struct {
-	publicKey      *rsa.PublicKey
-	rolesActionMap map[string]string
+	publicKey       *rsa.PublicKey
+	apiTokenFactory db.APITokenFactory
+	rolesActionMap  map[string]string
+	customRoles     map[string]map[string]bool
 }
 
-func NewAccessFactory(key *rsa.PublicKey) AccessFactory {
+func NewAccessFactory(key *rsa.PublicKey, apiTokenFactory db.APITokenFactory) AccessFactory {
 
 	factory := accessFactory{
-		publicKey:      key,
-		rolesActionMap: map[string]string{},
+		publicKey:       key,
+		apiTokenFactory: apiTokenFactory,
+		rolesActionMap:  map[string]string{},
+		customRoles:     map[string]map[string]bool{},
 	}
 
 	// Copy rolesActionMap
@@ -39,23 +45,73 @@ func NewAccessFactory(key *rsa.PublicKey) AccessFactory {
 	return &factory
 }
 
-func (a *accessFactory) Create(r *http.Request, action string) Access {
+func (a *accessFactory) Create(r *http.Request, action string) (Access, error) {
 
 	header := r.Header.Get("Authorization")
 	if header == "" {
-		return &access{nil, action, a}
+		return &access{nil, action, a}, nil
 	}
 
 	if len(header) < 7 || strings.ToUpper(header[0:6]) != "BEARER" {
-		return &access{&jwt.Token{}, action, a}
+		return &access{&jwt.Token{}, action, a}, nil
+	}
+
+	if strings.HasPrefix(header[7:], atc.APITokenPrefix) {
+		token, err := a.useAPIToken(header[7:])
+		if err != nil {
+			return nil, err
+		}
+
+		return &access{token, action, a}, nil
 	}
 
 	token, err := jwt.Parse(header[7:], a.validate)
 	if err != nil {
-		return &access{&jwt.Token{}, action, a}
+		return &access{&jwt.Token{}, action, a}, nil
+	}
+
+	return &access{token, action, a}, nil
+}
+
+// useAPIToken grants the role of the API token as if it had been issued by
+// skymarshal, acting as the user who created it or, for a service account, as
+// the account itself.
+func (a *accessFactory) useAPIToken(value string) (*jwt.Token, error) {
+	if a.apiTokenFactory == nil {
+		return &jwt.Token{}, nil
+	}
+
+	apiToken, found, err := a.apiTokenFactory.UseAPIToken(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &jwt.Token{}, nil
+	}
+
+	userName := apiToken.CreatedBy()
+	if apiToken.ServiceAccount() {
+		userName = ServiceAccountUserName(apiToken.TeamName(), apiToken.Name())
 	}
 
-	return &access{token, action, a}
+	return &jwt.Token{
+		Valid: true,
+		Claims: jwt.MapClaims{
+			"teams": map[string][]string{
+				apiToken.TeamName(): {apiToken.Role()},
+			},
+			"user_name": userName,
+			"api_token": true,
+		},
+	}, nil
+}
+
+// ServiceAccountUserName is the name which a service account acts as. It is
+// kept distinct from the names of users so that an account can't be mistaken
+// for one.
+func ServiceAccountUserName(teamName string, name string) string {
+	return "service-account:" + teamName + "/" + name
 }
 
 func (a *accessFactory) validate(token *jwt.Token) (interface{}, error) {
@@ -68,24 +124,21 @@ func (a *accessFactory) validate(token *jwt.Token) (interface{}, error) {
 }
 
 func (a *accessFactory) CustomizeActionRoleMap(logger lager.Logger, customMapping CustomActionRoleMap) error {
+	for role, actions := range customMapping {
+		if !isBuiltInRole(role) {
+			err := a.defineCustomRole(logger, role, actions)
+			if err != nil {
+				return err
+			}
 
-	// Get all validate role names
-	allKnownRoles := map[string]interface{}{}
-	for _, roleName := range a.rolesActionMap {
-		allKnownRoles[roleName] = nil
-	}
-
-	for newRole, actions := range customMapping {
-		// Check if the customized role name is valid
-		if _, ok := allKnownRoles[newRole]; !ok {
-			return fmt.Errorf("unknown role %s", newRole)
+			continue
 		}
 
 		// Update requiredRoles
 		for _, action := range actions {
 			if oldRole, ok := a.rolesActionMap[action]; ok {
-				a.rolesActionMap[action] = newRole
-				logger.Info("customize-role", lager.Data{"action": action, "oldRole": oldRole, "newRole": newRole})
+				a.rolesActionMap[action] = role
+				logger.Info("customize-role", lager.Data{"action": action, "oldRole": oldRole, "newRole": role})
 			} else {
 				return fmt.Errorf("unknown action %s", action)
 			}
@@ -95,6 +148,65 @@ func (a *accessFactory) CustomizeActionRoleMap(logger lager.Logger, customMappin
 	return nil
 }
 
+func (a *accessFactory) defineCustomRole(logger lager.Logger, role string, actions []string) error {
+	if len(actions) == 0 {
+		return fmt.Errorf("custom role %s must permit at least one action", role)
+	}
+
+	permitted := map[string]bool{}
+	for _, action := range actions {
+		if _, ok := a.rolesActionMap[action]; !ok {
+			return fmt.Errorf("unknown action %s", action)
+		}
+
+		permitted[action] = true
+	}
+
+	a.customRoles[role] = permitted
+
+	logger.Info("define-custom-role", lager.Data{"role": role, "actions": actions})
+
+	return nil
+}
+
 func (a *accessFactory) RoleOfAction(action string) string {
 	return a.rolesActionMap[action]
 }
+
+func (a *accessFactory) RolePermits(role string, action string) bool {
+	if permitted, ok := a.customRoles[role]; ok {
+		return permitted[action]
+	}
+
+	rank, ok := builtInRoleRank(role)
+	if !ok {
+		return false
+	}
+
+	requiredRank, ok := builtInRoleRank(a.rolesActionMap[action])
+	if !ok {
+		return false
+	}
+
+	return rank <= requiredRank
+}
+
+func (a *accessFactory) IsKnownRole(role string) bool {
+	_, isCustom := a.customRoles[role]
+	return isCustom || isBuiltInRole(role)
+}
+
+func isBuiltInRole(role string) bool {
+	_, ok := builtInRoleRank(role)
+	return ok
+}
+
+func builtInRoleRank(role string) (int, bool) {
+	for rank, builtInRole := range builtInRoles {
+		if builtInRole == role {
+			return rank, true
+		}
+	}
+
+	return 0, false
+}
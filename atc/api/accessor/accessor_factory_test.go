@@ -4,6 +4,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"crypto/rand"
 	"crypto/rsa"
+	"errors"
 	"fmt"
 	"github.com/dgrijalva/jwt-go"
 	"net/http"
@@ -13,13 +14,16 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 )
 
 var _ = Describe("AccessorFactory", func() {
 	var accessorFactory accessor.AccessFactory
 	var access accessor.Access
+	var createErr error
 	var key *rsa.PrivateKey
 	var req *http.Request
+	var fakeAPITokenFactory *dbfakes.FakeAPITokenFactory
 
 	Describe("Create", func() {
 		BeforeEach(func() {
@@ -31,13 +35,14 @@ var _ = Describe("AccessorFactory", func() {
 
 			publicKey := &key.PublicKey
 			//publicKey = rsa.GenerateKey(random, bits)
-			accessorFactory = accessor.NewAccessFactory(publicKey)
+			fakeAPITokenFactory = new(dbfakes.FakeAPITokenFactory)
+			accessorFactory = accessor.NewAccessFactory(publicKey, fakeAPITokenFactory)
 
 			req, err = http.NewRequest("GET", "localhost:8080", nil)
 			Expect(err).NotTo(HaveOccurred())
 		})
 		JustBeforeEach(func() {
-			access = accessorFactory.Create(req, "some-action")
+			access, createErr = accessorFactory.Create(req, "some-action")
 		})
 
 		Context("when request has jwt token set", func() {
@@ -49,6 +54,7 @@ var _ = Describe("AccessorFactory", func() {
 			})
 
 			It("creates valid access object", func() {
+				Expect(createErr).ToNot(HaveOccurred())
 				Expect(access).ToNot(BeNil())
 			})
 		})
@@ -65,6 +71,7 @@ var _ = Describe("AccessorFactory", func() {
 			})
 
 			It("creates valid access object", func() {
+				Expect(createErr).ToNot(HaveOccurred())
 				Expect(access).ToNot(BeNil())
 			})
 
@@ -74,6 +81,7 @@ var _ = Describe("AccessorFactory", func() {
 				req.Header.Add("Authorization", "")
 			})
 			It("creates valid access object", func() {
+				Expect(createErr).ToNot(HaveOccurred())
 				Expect(access).ToNot(BeNil())
 			})
 		})
@@ -83,9 +91,76 @@ var _ = Describe("AccessorFactory", func() {
 				req.Header.Add("Authorization", "blah-token")
 			})
 			It("creates valid access object", func() {
+				Expect(createErr).ToNot(HaveOccurred())
 				Expect(access).ToNot(BeNil())
 			})
 		})
+
+		Context("when request has an api token set", func() {
+			var fakeAPIToken *dbfakes.FakeAPIToken
+
+			BeforeEach(func() {
+				fakeAPIToken = new(dbfakes.FakeAPIToken)
+				fakeAPIToken.TeamNameReturns("some-team")
+				fakeAPIToken.NameReturns("some-token")
+				fakeAPIToken.RoleReturns("member")
+				fakeAPIToken.CreatedByReturns("some-user")
+
+				req.Header.Add("Authorization", "Bearer ct_some-token")
+			})
+
+			Context("when the token is found", func() {
+				BeforeEach(func() {
+					fakeAPITokenFactory.UseAPITokenReturns(fakeAPIToken, true, nil)
+				})
+
+				It("looks up the token", func() {
+					Expect(fakeAPITokenFactory.UseAPITokenCallCount()).To(Equal(1))
+					Expect(fakeAPITokenFactory.UseAPITokenArgsForCall(0)).To(Equal("ct_some-token"))
+				})
+
+				It("grants the role of the token as the user who created it", func() {
+					Expect(access.IsAuthenticated()).To(BeTrue())
+					Expect(access.HasRole("some-team", "member")).To(BeTrue())
+					Expect(access.HasRole("some-team", "owner")).To(BeFalse())
+					Expect(access.TeamNames()).To(ConsistOf("some-team"))
+					Expect(access.IsAdmin()).To(BeFalse())
+					Expect(access.UserName()).To(Equal("some-user"))
+					Expect(access.IsAPIToken()).To(BeTrue())
+				})
+
+				Context("when the token is for a service account", func() {
+					BeforeEach(func() {
+						fakeAPIToken.ServiceAccountReturns(true)
+					})
+
+					It("acts as the service account", func() {
+						Expect(access.UserName()).To(Equal("service-account:some-team/some-token"))
+					})
+				})
+			})
+
+			Context("when the token is not found", func() {
+				BeforeEach(func() {
+					fakeAPITokenFactory.UseAPITokenReturns(nil, false, nil)
+				})
+
+				It("is not authenticated", func() {
+					Expect(access.HasToken()).To(BeTrue())
+					Expect(access.IsAuthenticated()).To(BeFalse())
+				})
+			})
+
+			Context("when looking up the token fails", func() {
+				BeforeEach(func() {
+					fakeAPITokenFactory.UseAPITokenReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns the error", func() {
+					Expect(createErr).To(MatchError("nope"))
+				})
+			})
+		})
 	})
 
 	Describe("CustomizeRolesMapping", func() {
@@ -94,7 +169,7 @@ var _ = Describe("AccessorFactory", func() {
 		)
 
 		BeforeEach(func() {
-			accessorFactory = accessor.NewAccessFactory(&rsa.PublicKey{})
+			accessorFactory = accessor.NewAccessFactory(&rsa.PublicKey{}, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -120,4 +195,68 @@ var _ = Describe("AccessorFactory", func() {
 			Expect(accessorFactory.RoleOfAction(atc.GetCC)).To(Equal("viewer"))
 		})
 	})
+
+	Describe("custom roles", func() {
+		var (
+			accessorFactory accessor.AccessFactory
+			customData      accessor.CustomActionRoleMap
+			customizeErr    error
+		)
+
+		BeforeEach(func() {
+			accessorFactory = accessor.NewAccessFactory(&rsa.PublicKey{}, nil)
+
+			customData = accessor.CustomActionRoleMap{
+				"release-manager": []string{atc.PinResourceVersion, atc.CreateJobBuild, atc.AbortBuild},
+			}
+		})
+
+		JustBeforeEach(func() {
+			customizeErr = accessorFactory.CustomizeActionRoleMap(lager.NewLogger("test"), customData)
+		})
+
+		It("defines the role", func() {
+			Expect(customizeErr).NotTo(HaveOccurred())
+			Expect(accessorFactory.IsKnownRole("release-manager")).To(BeTrue())
+		})
+
+		It("permits exactly the listed actions", func() {
+			Expect(accessorFactory.RolePermits("release-manager", atc.PinResourceVersion)).To(BeTrue())
+			Expect(accessorFactory.RolePermits("release-manager", atc.CreateJobBuild)).To(BeTrue())
+			Expect(accessorFactory.RolePermits("release-manager", atc.AbortBuild)).To(BeTrue())
+
+			Expect(accessorFactory.RolePermits("release-manager", atc.SaveConfig)).To(BeFalse())
+			Expect(accessorFactory.RolePermits("release-manager", atc.GetPipeline)).To(BeFalse())
+		})
+
+		It("does not move the actions from the built-in roles", func() {
+			Expect(accessorFactory.RoleOfAction(atc.PinResourceVersion)).To(Equal("pipeline-operator"))
+			Expect(accessorFactory.RolePermits("pipeline-operator", atc.PinResourceVersion)).To(BeTrue())
+		})
+
+		It("does not know roles which were never defined", func() {
+			Expect(accessorFactory.IsKnownRole("some-role")).To(BeFalse())
+			Expect(accessorFactory.RolePermits("some-role", atc.GetPipeline)).To(BeFalse())
+		})
+
+		Context("when an action is unknown", func() {
+			BeforeEach(func() {
+				customData["release-manager"] = append(customData["release-manager"], "SetEverything")
+			})
+
+			It("errors", func() {
+				Expect(customizeErr).To(MatchError("unknown action SetEverything"))
+			})
+		})
+
+		Context("when the role permits no actions", func() {
+			BeforeEach(func() {
+				customData["release-manager"] = []string{}
+			})
+
+			It("errors", func() {
+				Expect(customizeErr).To(MatchError("custom role release-manager must permit at least one action"))
+			})
+		})
+	})
 })
@@ -37,7 +37,7 @@ var _ = Describe("Accessor", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		publicKey := &key.PublicKey
-		accessorFactory = accessor.NewAccessFactory(publicKey)
+		accessorFactory = accessor.NewAccessFactory(publicKey, nil)
 
 	})
 
@@ -47,7 +47,8 @@ var _ = Describe("Accessor", func() {
 			tokenString, err := token.SignedString(key)
 			Expect(err).NotTo(HaveOccurred())
 			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-			access = accessorFactory.Create(req, "some-action")
+			access, err = accessorFactory.Create(req, "some-action")
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		Context("when request has admin claim set", func() {
@@ -99,7 +100,8 @@ var _ = Describe("Accessor", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-			access = accessorFactory.Create(req, "some-action")
+			access, err = accessorFactory.Create(req, "some-action")
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		Context("when request has system claim set", func() {
@@ -148,10 +150,33 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Is API token", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access, err = accessorFactory.Create(req, "some-action")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when request has a token issued by skymarshal", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"owner"}}}
+			})
+			It("returns false", func() {
+				Expect(access.IsAPIToken()).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("has token", func() {
 
 		JustBeforeEach(func() {
-			access = accessorFactory.Create(req, "some-action")
+			var err error
+			access, err = accessorFactory.Create(req, "some-action")
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		Context("when request has token", func() {
@@ -204,7 +229,8 @@ var _ = Describe("Accessor", func() {
 			tokenString, err := token.SignedString(key)
 			Expect(err).NotTo(HaveOccurred())
 			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-			access = accessorFactory.Create(req, "some-action")
+			access, err = accessorFactory.Create(req, "some-action")
+			Expect(err).NotTo(HaveOccurred())
 		})
 		Context("when valid token is set", func() {
 			It("returns true", func() {
@@ -220,7 +246,8 @@ var _ = Describe("Accessor", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-			access = accessorFactory.Create(req, atc.SetTeam)
+			access, err = accessorFactory.Create(req, atc.SetTeam)
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		Context("when request has team name claim set for some-team as owner", func() {
@@ -278,13 +305,176 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Is Owner", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access, err = accessorFactory.Create(req, atc.ResolveVar)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when request has team name claim set for some-team as owner", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"owner"}}}
+			})
+			It("returns true", func() {
+				Expect(access.IsOwner("some-team")).To(BeTrue())
+			})
+		})
+
+		Context("when request has team name claim set for some-team as member", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"member"}}}
+			})
+			It("returns false even though the action is authorized", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeTrue())
+				Expect(access.IsOwner("some-team")).To(BeFalse())
+			})
+		})
+
+		Context("when request has team name claim set to other-team:owner", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"other-team": {"owner"}}}
+			})
+			It("returns false", func() {
+				Expect(access.IsOwner("some-team")).To(BeFalse())
+			})
+		})
+
+		Context("when request has admin claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"is_admin": true}
+			})
+			It("returns true", func() {
+				Expect(access.IsOwner("some-team")).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Has Role", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access, err = accessorFactory.Create(req, atc.GetPipeline)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when request has team name claim set for some-team as member", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"member"}}}
+			})
+			It("has the role and the roles below it", func() {
+				Expect(access.HasRole("some-team", "member")).To(BeTrue())
+				Expect(access.HasRole("some-team", "pipeline-operator")).To(BeTrue())
+				Expect(access.HasRole("some-team", "viewer")).To(BeTrue())
+			})
+			It("does not have the roles above it", func() {
+				Expect(access.HasRole("some-team", "owner")).To(BeFalse())
+			})
+			It("does not have unknown roles", func() {
+				Expect(access.HasRole("some-team", "bogus")).To(BeFalse())
+			})
+			It("does not have the role in other teams", func() {
+				Expect(access.HasRole("other-team", "viewer")).To(BeFalse())
+			})
+		})
+
+		Context("when request has admin claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"is_admin": true}
+			})
+			It("returns true", func() {
+				Expect(access.HasRole("some-team", "owner")).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Is Authorized in pipeline", func() {
+		var action string
+
+		BeforeEach(func() {
+			action = atc.PausePipeline
+		})
+
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access, err = accessorFactory.Create(req, action)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when request has a pipeline role which permits the action", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{
+					"teams": map[string][]string{"some-team": {"viewer"}},
+					"pipelines": map[string]map[string][]string{
+						"some-team": {"deploy-prod": {"member"}},
+					},
+				}
+			})
+			It("is authorized for that pipeline", func() {
+				Expect(access.IsAuthorizedInPipeline("some-team", "deploy-prod")).To(BeTrue())
+			})
+			It("is not authorized for other pipelines", func() {
+				Expect(access.IsAuthorizedInPipeline("some-team", "other-pipeline")).To(BeFalse())
+			})
+			It("is not authorized for the same pipeline in other teams", func() {
+				Expect(access.IsAuthorizedInPipeline("other-team", "deploy-prod")).To(BeFalse())
+			})
+			It("does not grant the role in the team as a whole", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeFalse())
+			})
+		})
+
+		Context("when request has a pipeline role which does not permit the action", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{
+					"pipelines": map[string]map[string][]string{
+						"some-team": {"deploy-prod": {"viewer"}},
+					},
+				}
+			})
+			It("returns false", func() {
+				Expect(access.IsAuthorizedInPipeline("some-team", "deploy-prod")).To(BeFalse())
+			})
+
+			Context("when the action only requires the pipeline role", func() {
+				BeforeEach(func() {
+					action = atc.GetPipeline
+				})
+				It("returns true", func() {
+					Expect(access.IsAuthorizedInPipeline("some-team", "deploy-prod")).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when request only has a team role which permits the action", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"member"}}}
+			})
+			It("returns false, as the role is not bound in the pipeline", func() {
+				Expect(access.IsAuthorizedInPipeline("some-team", "deploy-prod")).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Get CSRF Token", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
 			tokenString, err := token.SignedString(key)
 			Expect(err).NotTo(HaveOccurred())
 			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-			access = accessorFactory.Create(req, "some-action")
+			access, err = accessorFactory.Create(req, "some-action")
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		Context("when request has csrfToken claim set", func() {
@@ -329,7 +519,8 @@ var _ = Describe("Accessor", func() {
 			tokenString, err := token.SignedString(key)
 			Expect(err).NotTo(HaveOccurred())
 			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-			access = accessorFactory.Create(req, "some-action")
+			access, err = accessorFactory.Create(req, "some-action")
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		Context("when request has teams claim set to nil", func() {
@@ -383,7 +574,8 @@ var _ = Describe("Accessor", func() {
 			tokenString, err := token.SignedString(key)
 			Expect(err).NotTo(HaveOccurred())
 			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-			access = accessorFactory.Create(req, "some-action")
+			access, err = accessorFactory.Create(req, "some-action")
+			Expect(err).NotTo(HaveOccurred())
 		})
 
 		Context("when request has user_name claim set", func() {
@@ -445,7 +637,8 @@ var _ = Describe("Accessor", func() {
 			tokenString, err := token.SignedString(key)
 			Expect(err).NotTo(HaveOccurred())
 			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-			access := accessorFactory.Create(req, action)
+			access, err := accessorFactory.Create(req, action)
+			Expect(err).NotTo(HaveOccurred())
 
 			Expect(access.IsAuthorized("some-team")).To(Equal(authorized))
 		},
@@ -504,11 +697,21 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.AbortBuild, atc.AbortBuild, "pipeline-operator", true),
 		Entry("viewer :: "+atc.AbortBuild, atc.AbortBuild, "viewer", false),
 
+		Entry("owner :: "+atc.SetBuildComment, atc.SetBuildComment, "owner", true),
+		Entry("member :: "+atc.SetBuildComment, atc.SetBuildComment, "member", true),
+		Entry("pipeline-operator :: "+atc.SetBuildComment, atc.SetBuildComment, "pipeline-operator", false),
+		Entry("viewer :: "+atc.SetBuildComment, atc.SetBuildComment, "viewer", false),
+
 		Entry("owner :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "owner", true),
 		Entry("member :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "member", true),
 		Entry("pipeline-operator :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "pipeline-operator", true),
 		Entry("viewer :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "viewer", true),
 
+		Entry("owner :: "+atc.GetBuildQueue, atc.GetBuildQueue, "owner", true),
+		Entry("member :: "+atc.GetBuildQueue, atc.GetBuildQueue, "member", true),
+		Entry("pipeline-operator :: "+atc.GetBuildQueue, atc.GetBuildQueue, "pipeline-operator", true),
+		Entry("viewer :: "+atc.GetBuildQueue, atc.GetBuildQueue, "viewer", true),
+
 		Entry("owner :: "+atc.GetJob, atc.GetJob, "owner", true),
 		Entry("member :: "+atc.GetJob, atc.GetJob, "member", true),
 		Entry("pipeline-operator :: "+atc.GetJob, atc.GetJob, "pipeline-operator", true),
@@ -544,6 +747,11 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.ListJobInputs, atc.ListJobInputs, "pipeline-operator", true),
 		Entry("viewer :: "+atc.ListJobInputs, atc.ListJobInputs, "viewer", true),
 
+		Entry("owner :: "+atc.ListJobQueue, atc.ListJobQueue, "owner", true),
+		Entry("member :: "+atc.ListJobQueue, atc.ListJobQueue, "member", true),
+		Entry("pipeline-operator :: "+atc.ListJobQueue, atc.ListJobQueue, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListJobQueue, atc.ListJobQueue, "viewer", true),
+
 		Entry("owner :: "+atc.GetJobBuild, atc.GetJobBuild, "owner", true),
 		Entry("member :: "+atc.GetJobBuild, atc.GetJobBuild, "member", true),
 		Entry("pipeline-operator :: "+atc.GetJobBuild, atc.GetJobBuild, "pipeline-operator", true),
@@ -689,6 +897,11 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.UnpausePipeline, atc.UnpausePipeline, "pipeline-operator", true),
 		Entry("viewer :: "+atc.UnpausePipeline, atc.UnpausePipeline, "viewer", false),
 
+		Entry("owner :: "+atc.ArchivePipeline, atc.ArchivePipeline, "owner", true),
+		Entry("member :: "+atc.ArchivePipeline, atc.ArchivePipeline, "member", true),
+		Entry("pipeline-operator :: "+atc.ArchivePipeline, atc.ArchivePipeline, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ArchivePipeline, atc.ArchivePipeline, "viewer", false),
+
 		Entry("owner :: "+atc.ExposePipeline, atc.ExposePipeline, "owner", true),
 		Entry("member :: "+atc.ExposePipeline, atc.ExposePipeline, "member", true),
 		Entry("pipeline-operator :: "+atc.ExposePipeline, atc.ExposePipeline, "pipeline-operator", false),
@@ -719,6 +932,11 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.PipelineBadge, atc.PipelineBadge, "pipeline-operator", true),
 		Entry("viewer :: "+atc.PipelineBadge, atc.PipelineBadge, "viewer", true),
 
+		Entry("owner :: "+atc.ResolveVar, atc.ResolveVar, "owner", true),
+		Entry("member :: "+atc.ResolveVar, atc.ResolveVar, "member", true),
+		Entry("pipeline-operator :: "+atc.ResolveVar, atc.ResolveVar, "pipeline-operator", false),
+		Entry("viewer :: "+atc.ResolveVar, atc.ResolveVar, "viewer", false),
+
 		Entry("owner :: "+atc.RegisterWorker, atc.RegisterWorker, "owner", true),
 		Entry("member :: "+atc.RegisterWorker, atc.RegisterWorker, "member", true),
 		Entry("pipeline-operator :: "+atc.RegisterWorker, atc.RegisterWorker, "pipeline-operator", false),
@@ -779,6 +997,11 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.GetInfoCreds, atc.GetInfoCreds, "pipeline-operator", true),
 		Entry("viewer :: "+atc.GetInfoCreds, atc.GetInfoCreds, "viewer", true),
 
+		Entry("owner :: "+atc.GetEncryptionKeyRotation, atc.GetEncryptionKeyRotation, "owner", true),
+		Entry("member :: "+atc.GetEncryptionKeyRotation, atc.GetEncryptionKeyRotation, "member", true),
+		Entry("pipeline-operator :: "+atc.GetEncryptionKeyRotation, atc.GetEncryptionKeyRotation, "pipeline-operator", true),
+		Entry("viewer :: "+atc.GetEncryptionKeyRotation, atc.GetEncryptionKeyRotation, "viewer", true),
+
 		Entry("owner :: "+atc.ListContainers, atc.ListContainers, "owner", true),
 		Entry("member :: "+atc.ListContainers, atc.ListContainers, "member", true),
 		Entry("pipeline-operator :: "+atc.ListContainers, atc.ListContainers, "pipeline-operator", true),
@@ -843,6 +1066,36 @@ var _ = Describe("Accessor", func() {
 		Entry("pipeline-operator :: "+atc.DestroyTeam, atc.DestroyTeam, "pipeline-operator", false),
 		Entry("viewer :: "+atc.DestroyTeam, atc.DestroyTeam, "viewer", false),
 
+		Entry("owner :: "+atc.SetTeamWebhook, atc.SetTeamWebhook, "owner", true),
+		Entry("member :: "+atc.SetTeamWebhook, atc.SetTeamWebhook, "member", false),
+		Entry("pipeline-operator :: "+atc.SetTeamWebhook, atc.SetTeamWebhook, "pipeline-operator", false),
+		Entry("viewer :: "+atc.SetTeamWebhook, atc.SetTeamWebhook, "viewer", false),
+
+		Entry("owner :: "+atc.DestroyTeamWebhook, atc.DestroyTeamWebhook, "owner", true),
+		Entry("member :: "+atc.DestroyTeamWebhook, atc.DestroyTeamWebhook, "member", false),
+		Entry("pipeline-operator :: "+atc.DestroyTeamWebhook, atc.DestroyTeamWebhook, "pipeline-operator", false),
+		Entry("viewer :: "+atc.DestroyTeamWebhook, atc.DestroyTeamWebhook, "viewer", false),
+
+		Entry("owner :: "+atc.ReceiveTeamWebhook, atc.ReceiveTeamWebhook, "owner", true),
+		Entry("member :: "+atc.ReceiveTeamWebhook, atc.ReceiveTeamWebhook, "member", true),
+		Entry("pipeline-operator :: "+atc.ReceiveTeamWebhook, atc.ReceiveTeamWebhook, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ReceiveTeamWebhook, atc.ReceiveTeamWebhook, "viewer", false),
+
+		Entry("owner :: "+atc.CreateAPIToken, atc.CreateAPIToken, "owner", true),
+		Entry("member :: "+atc.CreateAPIToken, atc.CreateAPIToken, "member", true),
+		Entry("pipeline-operator :: "+atc.CreateAPIToken, atc.CreateAPIToken, "pipeline-operator", true),
+		Entry("viewer :: "+atc.CreateAPIToken, atc.CreateAPIToken, "viewer", true),
+
+		Entry("owner :: "+atc.ListAPITokens, atc.ListAPITokens, "owner", true),
+		Entry("member :: "+atc.ListAPITokens, atc.ListAPITokens, "member", true),
+		Entry("pipeline-operator :: "+atc.ListAPITokens, atc.ListAPITokens, "pipeline-operator", true),
+		Entry("viewer :: "+atc.ListAPITokens, atc.ListAPITokens, "viewer", true),
+
+		Entry("owner :: "+atc.RevokeAPIToken, atc.RevokeAPIToken, "owner", true),
+		Entry("member :: "+atc.RevokeAPIToken, atc.RevokeAPIToken, "member", true),
+		Entry("pipeline-operator :: "+atc.RevokeAPIToken, atc.RevokeAPIToken, "pipeline-operator", true),
+		Entry("viewer :: "+atc.RevokeAPIToken, atc.RevokeAPIToken, "viewer", true),
+
 		Entry("owner :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "owner", true),
 		Entry("member :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "member", true),
 		Entry("pipeline-operator :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "pipeline-operator", true),
@@ -887,7 +1140,8 @@ var _ = Describe("Accessor", func() {
 				tokenString, err := token.SignedString(key)
 				Expect(err).NotTo(HaveOccurred())
 				req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
-				access := accessorFactory.Create(req, action)
+				access, err := accessorFactory.Create(req, action)
+				Expect(err).NotTo(HaveOccurred())
 
 				Expect(access.IsAuthorized("some-team")).To(Equal(authorized))
 			},
@@ -908,4 +1162,63 @@ var _ = Describe("Accessor", func() {
 			Entry("viewer :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "viewer", true),
 		)
 	})
+
+	Describe("Custom roles", func() {
+		BeforeEach(func() {
+			customData := accessor.CustomActionRoleMap{
+				"release-manager": []string{atc.PinResourceVersion, atc.CreateJobBuild, atc.AbortBuild},
+			}
+
+			logger := lager.NewLogger("test")
+			err := accessorFactory.CustomizeActionRoleMap(logger, customData)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		createAccess := func(action string, role string) accessor.Access {
+			claims := &jwt.MapClaims{"teams": map[string][]string{"some-team": {role}}}
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access, err := accessorFactory.Create(req, action)
+			Expect(err).NotTo(HaveOccurred())
+			return access
+		}
+
+		DescribeTable("role actions",
+			func(action, role string, authorized bool) {
+				Expect(createAccess(action, role).IsAuthorized("some-team")).To(Equal(authorized))
+			},
+			Entry("release-manager :: "+atc.PinResourceVersion, atc.PinResourceVersion, "release-manager", true),
+			Entry("release-manager :: "+atc.CreateJobBuild, atc.CreateJobBuild, "release-manager", true),
+			Entry("release-manager :: "+atc.AbortBuild, atc.AbortBuild, "release-manager", true),
+			Entry("release-manager :: "+atc.SaveConfig, atc.SaveConfig, "release-manager", false),
+			Entry("release-manager :: "+atc.GetPipeline, atc.GetPipeline, "release-manager", false),
+			Entry("undefined-role :: "+atc.GetPipeline, atc.GetPipeline, "undefined-role", false),
+		)
+
+		It("knows the custom role", func() {
+			access := createAccess(atc.GetPipeline, "viewer")
+			Expect(access.IsKnownRole("release-manager")).To(BeTrue())
+			Expect(access.IsKnownRole("viewer")).To(BeTrue())
+			Expect(access.IsKnownRole("undefined-role")).To(BeFalse())
+		})
+
+		DescribeTable("roles including the custom role",
+			func(role string, included bool) {
+				Expect(createAccess(atc.GetPipeline, role).HasRole("some-team", "release-manager")).To(Equal(included))
+			},
+			Entry("owner", "owner", true),
+			Entry("member", "member", true),
+			Entry("pipeline-operator", "pipeline-operator", true),
+			Entry("viewer", "viewer", false),
+			Entry("release-manager", "release-manager", true),
+		)
+
+		It("does not include the built-in roles which permit other actions", func() {
+			access := createAccess(atc.GetPipeline, "release-manager")
+			Expect(access.HasRole("some-team", "viewer")).To(BeFalse())
+			Expect(access.HasRole("some-team", "pipeline-operator")).To(BeFalse())
+		})
+	})
 })
@@ -18,6 +18,18 @@ type FakeAccess struct {
 	cSRFTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	HasRoleStub        func(string, string) bool
+	hasRoleMutex       sync.RWMutex
+	hasRoleArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	hasRoleReturns struct {
+		result1 bool
+	}
+	hasRoleReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	HasTokenStub        func() bool
 	hasTokenMutex       sync.RWMutex
 	hasTokenArgsForCall []struct {
@@ -28,6 +40,16 @@ type FakeAccess struct {
 	hasTokenReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsAPITokenStub        func() bool
+	isAPITokenMutex       sync.RWMutex
+	isAPITokenArgsForCall []struct {
+	}
+	isAPITokenReturns struct {
+		result1 bool
+	}
+	isAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsAdminStub        func() bool
 	isAdminMutex       sync.RWMutex
 	isAdminArgsForCall []struct {
@@ -59,6 +81,40 @@ type FakeAccess struct {
 	isAuthorizedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsAuthorizedInPipelineStub        func(string, string) bool
+	isAuthorizedInPipelineMutex       sync.RWMutex
+	isAuthorizedInPipelineArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	isAuthorizedInPipelineReturns struct {
+		result1 bool
+	}
+	isAuthorizedInPipelineReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsKnownRoleStub        func(string) bool
+	isKnownRoleMutex       sync.RWMutex
+	isKnownRoleArgsForCall []struct {
+		arg1 string
+	}
+	isKnownRoleReturns struct {
+		result1 bool
+	}
+	isKnownRoleReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	IsOwnerStub        func(string) bool
+	isOwnerMutex       sync.RWMutex
+	isOwnerArgsForCall []struct {
+		arg1 string
+	}
+	isOwnerReturns struct {
+		result1 bool
+	}
+	isOwnerReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsSystemStub        func() bool
 	isSystemMutex       sync.RWMutex
 	isSystemArgsForCall []struct {
@@ -145,6 +201,67 @@ func (fake *FakeAccess) CSRFTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeAccess) HasRole(arg1 string, arg2 string) bool {
+	fake.hasRoleMutex.Lock()
+	ret, specificReturn := fake.hasRoleReturnsOnCall[len(fake.hasRoleArgsForCall)]
+	fake.hasRoleArgsForCall = append(fake.hasRoleArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("HasRole", []interface{}{arg1, arg2})
+	fake.hasRoleMutex.Unlock()
+	if fake.HasRoleStub != nil {
+		return fake.HasRoleStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.hasRoleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) HasRoleCallCount() int {
+	fake.hasRoleMutex.RLock()
+	defer fake.hasRoleMutex.RUnlock()
+	return len(fake.hasRoleArgsForCall)
+}
+
+func (fake *FakeAccess) HasRoleCalls(stub func(string, string) bool) {
+	fake.hasRoleMutex.Lock()
+	defer fake.hasRoleMutex.Unlock()
+	fake.HasRoleStub = stub
+}
+
+func (fake *FakeAccess) HasRoleArgsForCall(i int) (string, string) {
+	fake.hasRoleMutex.RLock()
+	defer fake.hasRoleMutex.RUnlock()
+	argsForCall := fake.hasRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccess) HasRoleReturns(result1 bool) {
+	fake.hasRoleMutex.Lock()
+	defer fake.hasRoleMutex.Unlock()
+	fake.HasRoleStub = nil
+	fake.hasRoleReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) HasRoleReturnsOnCall(i int, result1 bool) {
+	fake.hasRoleMutex.Lock()
+	defer fake.hasRoleMutex.Unlock()
+	fake.HasRoleStub = nil
+	if fake.hasRoleReturnsOnCall == nil {
+		fake.hasRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.hasRoleReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) HasToken() bool {
 	fake.hasTokenMutex.Lock()
 	ret, specificReturn := fake.hasTokenReturnsOnCall[len(fake.hasTokenArgsForCall)]
@@ -197,6 +314,58 @@ func (fake *FakeAccess) HasTokenReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsAPIToken() bool {
+	fake.isAPITokenMutex.Lock()
+	ret, specificReturn := fake.isAPITokenReturnsOnCall[len(fake.isAPITokenArgsForCall)]
+	fake.isAPITokenArgsForCall = append(fake.isAPITokenArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsAPIToken", []interface{}{})
+	fake.isAPITokenMutex.Unlock()
+	if fake.IsAPITokenStub != nil {
+		return fake.IsAPITokenStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isAPITokenReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsAPITokenCallCount() int {
+	fake.isAPITokenMutex.RLock()
+	defer fake.isAPITokenMutex.RUnlock()
+	return len(fake.isAPITokenArgsForCall)
+}
+
+func (fake *FakeAccess) IsAPITokenCalls(stub func() bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = stub
+}
+
+func (fake *FakeAccess) IsAPITokenReturns(result1 bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = nil
+	fake.isAPITokenReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsAPITokenReturnsOnCall(i int, result1 bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = nil
+	if fake.isAPITokenReturnsOnCall == nil {
+		fake.isAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsAdmin() bool {
 	fake.isAdminMutex.Lock()
 	ret, specificReturn := fake.isAdminReturnsOnCall[len(fake.isAdminArgsForCall)]
@@ -361,6 +530,187 @@ func (fake *FakeAccess) IsAuthorizedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsAuthorizedInPipeline(arg1 string, arg2 string) bool {
+	fake.isAuthorizedInPipelineMutex.Lock()
+	ret, specificReturn := fake.isAuthorizedInPipelineReturnsOnCall[len(fake.isAuthorizedInPipelineArgsForCall)]
+	fake.isAuthorizedInPipelineArgsForCall = append(fake.isAuthorizedInPipelineArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("IsAuthorizedInPipeline", []interface{}{arg1, arg2})
+	fake.isAuthorizedInPipelineMutex.Unlock()
+	if fake.IsAuthorizedInPipelineStub != nil {
+		return fake.IsAuthorizedInPipelineStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isAuthorizedInPipelineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsAuthorizedInPipelineCallCount() int {
+	fake.isAuthorizedInPipelineMutex.RLock()
+	defer fake.isAuthorizedInPipelineMutex.RUnlock()
+	return len(fake.isAuthorizedInPipelineArgsForCall)
+}
+
+func (fake *FakeAccess) IsAuthorizedInPipelineCalls(stub func(string, string) bool) {
+	fake.isAuthorizedInPipelineMutex.Lock()
+	defer fake.isAuthorizedInPipelineMutex.Unlock()
+	fake.IsAuthorizedInPipelineStub = stub
+}
+
+func (fake *FakeAccess) IsAuthorizedInPipelineArgsForCall(i int) (string, string) {
+	fake.isAuthorizedInPipelineMutex.RLock()
+	defer fake.isAuthorizedInPipelineMutex.RUnlock()
+	argsForCall := fake.isAuthorizedInPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccess) IsAuthorizedInPipelineReturns(result1 bool) {
+	fake.isAuthorizedInPipelineMutex.Lock()
+	defer fake.isAuthorizedInPipelineMutex.Unlock()
+	fake.IsAuthorizedInPipelineStub = nil
+	fake.isAuthorizedInPipelineReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsAuthorizedInPipelineReturnsOnCall(i int, result1 bool) {
+	fake.isAuthorizedInPipelineMutex.Lock()
+	defer fake.isAuthorizedInPipelineMutex.Unlock()
+	fake.IsAuthorizedInPipelineStub = nil
+	if fake.isAuthorizedInPipelineReturnsOnCall == nil {
+		fake.isAuthorizedInPipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isAuthorizedInPipelineReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsKnownRole(arg1 string) bool {
+	fake.isKnownRoleMutex.Lock()
+	ret, specificReturn := fake.isKnownRoleReturnsOnCall[len(fake.isKnownRoleArgsForCall)]
+	fake.isKnownRoleArgsForCall = append(fake.isKnownRoleArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("IsKnownRole", []interface{}{arg1})
+	fake.isKnownRoleMutex.Unlock()
+	if fake.IsKnownRoleStub != nil {
+		return fake.IsKnownRoleStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isKnownRoleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsKnownRoleCallCount() int {
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
+	return len(fake.isKnownRoleArgsForCall)
+}
+
+func (fake *FakeAccess) IsKnownRoleCalls(stub func(string) bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = stub
+}
+
+func (fake *FakeAccess) IsKnownRoleArgsForCall(i int) string {
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
+	argsForCall := fake.isKnownRoleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccess) IsKnownRoleReturns(result1 bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = nil
+	fake.isKnownRoleReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsKnownRoleReturnsOnCall(i int, result1 bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = nil
+	if fake.isKnownRoleReturnsOnCall == nil {
+		fake.isKnownRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isKnownRoleReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsOwner(arg1 string) bool {
+	fake.isOwnerMutex.Lock()
+	ret, specificReturn := fake.isOwnerReturnsOnCall[len(fake.isOwnerArgsForCall)]
+	fake.isOwnerArgsForCall = append(fake.isOwnerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("IsOwner", []interface{}{arg1})
+	fake.isOwnerMutex.Unlock()
+	if fake.IsOwnerStub != nil {
+		return fake.IsOwnerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isOwnerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsOwnerCallCount() int {
+	fake.isOwnerMutex.RLock()
+	defer fake.isOwnerMutex.RUnlock()
+	return len(fake.isOwnerArgsForCall)
+}
+
+func (fake *FakeAccess) IsOwnerCalls(stub func(string) bool) {
+	fake.isOwnerMutex.Lock()
+	defer fake.isOwnerMutex.Unlock()
+	fake.IsOwnerStub = stub
+}
+
+func (fake *FakeAccess) IsOwnerArgsForCall(i int) string {
+	fake.isOwnerMutex.RLock()
+	defer fake.isOwnerMutex.RUnlock()
+	argsForCall := fake.isOwnerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccess) IsOwnerReturns(result1 bool) {
+	fake.isOwnerMutex.Lock()
+	defer fake.isOwnerMutex.Unlock()
+	fake.IsOwnerStub = nil
+	fake.isOwnerReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsOwnerReturnsOnCall(i int, result1 bool) {
+	fake.isOwnerMutex.Lock()
+	defer fake.isOwnerMutex.Unlock()
+	fake.IsOwnerStub = nil
+	if fake.isOwnerReturnsOnCall == nil {
+		fake.isOwnerReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isOwnerReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsSystem() bool {
 	fake.isSystemMutex.Lock()
 	ret, specificReturn := fake.isSystemReturnsOnCall[len(fake.isSystemArgsForCall)]
@@ -522,14 +872,24 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.cSRFTokenMutex.RLock()
 	defer fake.cSRFTokenMutex.RUnlock()
+	fake.hasRoleMutex.RLock()
+	defer fake.hasRoleMutex.RUnlock()
 	fake.hasTokenMutex.RLock()
 	defer fake.hasTokenMutex.RUnlock()
+	fake.isAPITokenMutex.RLock()
+	defer fake.isAPITokenMutex.RUnlock()
 	fake.isAdminMutex.RLock()
 	defer fake.isAdminMutex.RUnlock()
 	fake.isAuthenticatedMutex.RLock()
 	defer fake.isAuthenticatedMutex.RUnlock()
 	fake.isAuthorizedMutex.RLock()
 	defer fake.isAuthorizedMutex.RUnlock()
+	fake.isAuthorizedInPipelineMutex.RLock()
+	defer fake.isAuthorizedInPipelineMutex.RUnlock()
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
+	fake.isOwnerMutex.RLock()
+	defer fake.isOwnerMutex.RUnlock()
 	fake.isSystemMutex.RLock()
 	defer fake.isSystemMutex.RUnlock()
 	fake.teamNamesMutex.RLock()
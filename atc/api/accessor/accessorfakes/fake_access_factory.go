@@ -10,7 +10,7 @@ import (
 )
 
 type FakeAccessFactory struct {
-	CreateStub        func(*http.Request, string) accessor.Access
+	CreateStub        func(*http.Request, string) (accessor.Access, error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
 		arg1 *http.Request
@@ -18,9 +18,11 @@ type FakeAccessFactory struct {
 	}
 	createReturns struct {
 		result1 accessor.Access
+		result2 error
 	}
 	createReturnsOnCall map[int]struct {
 		result1 accessor.Access
+		result2 error
 	}
 	CustomizeActionRoleMapStub        func(lager.Logger, accessor.CustomActionRoleMap) error
 	customizeActionRoleMapMutex       sync.RWMutex
@@ -34,6 +36,17 @@ type FakeAccessFactory struct {
 	customizeActionRoleMapReturnsOnCall map[int]struct {
 		result1 error
 	}
+	IsKnownRoleStub        func(string) bool
+	isKnownRoleMutex       sync.RWMutex
+	isKnownRoleArgsForCall []struct {
+		arg1 string
+	}
+	isKnownRoleReturns struct {
+		result1 bool
+	}
+	isKnownRoleReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	RoleOfActionStub        func(string) string
 	roleOfActionMutex       sync.RWMutex
 	roleOfActionArgsForCall []struct {
@@ -45,11 +58,23 @@ type FakeAccessFactory struct {
 	roleOfActionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RolePermitsStub        func(string, string) bool
+	rolePermitsMutex       sync.RWMutex
+	rolePermitsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	rolePermitsReturns struct {
+		result1 bool
+	}
+	rolePermitsReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAccessFactory) Create(arg1 *http.Request, arg2 string) accessor.Access {
+func (fake *FakeAccessFactory) Create(arg1 *http.Request, arg2 string) (accessor.Access, error) {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
@@ -62,10 +87,10 @@ func (fake *FakeAccessFactory) Create(arg1 *http.Request, arg2 string) accessor.
 		return fake.CreateStub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
 	fakeReturns := fake.createReturns
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
 func (fake *FakeAccessFactory) CreateCallCount() int {
@@ -74,7 +99,7 @@ func (fake *FakeAccessFactory) CreateCallCount() int {
 	return len(fake.createArgsForCall)
 }
 
-func (fake *FakeAccessFactory) CreateCalls(stub func(*http.Request, string) accessor.Access) {
+func (fake *FakeAccessFactory) CreateCalls(stub func(*http.Request, string) (accessor.Access, error)) {
 	fake.createMutex.Lock()
 	defer fake.createMutex.Unlock()
 	fake.CreateStub = stub
@@ -87,27 +112,30 @@ func (fake *FakeAccessFactory) CreateArgsForCall(i int) (*http.Request, string)
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeAccessFactory) CreateReturns(result1 accessor.Access) {
+func (fake *FakeAccessFactory) CreateReturns(result1 accessor.Access, result2 error) {
 	fake.createMutex.Lock()
 	defer fake.createMutex.Unlock()
 	fake.CreateStub = nil
 	fake.createReturns = struct {
 		result1 accessor.Access
-	}{result1}
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeAccessFactory) CreateReturnsOnCall(i int, result1 accessor.Access) {
+func (fake *FakeAccessFactory) CreateReturnsOnCall(i int, result1 accessor.Access, result2 error) {
 	fake.createMutex.Lock()
 	defer fake.createMutex.Unlock()
 	fake.CreateStub = nil
 	if fake.createReturnsOnCall == nil {
 		fake.createReturnsOnCall = make(map[int]struct {
 			result1 accessor.Access
+			result2 error
 		})
 	}
 	fake.createReturnsOnCall[i] = struct {
 		result1 accessor.Access
-	}{result1}
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeAccessFactory) CustomizeActionRoleMap(arg1 lager.Logger, arg2 accessor.CustomActionRoleMap) error {
@@ -171,6 +199,66 @@ func (fake *FakeAccessFactory) CustomizeActionRoleMapReturnsOnCall(i int, result
 	}{result1}
 }
 
+func (fake *FakeAccessFactory) IsKnownRole(arg1 string) bool {
+	fake.isKnownRoleMutex.Lock()
+	ret, specificReturn := fake.isKnownRoleReturnsOnCall[len(fake.isKnownRoleArgsForCall)]
+	fake.isKnownRoleArgsForCall = append(fake.isKnownRoleArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("IsKnownRole", []interface{}{arg1})
+	fake.isKnownRoleMutex.Unlock()
+	if fake.IsKnownRoleStub != nil {
+		return fake.IsKnownRoleStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isKnownRoleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccessFactory) IsKnownRoleCallCount() int {
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
+	return len(fake.isKnownRoleArgsForCall)
+}
+
+func (fake *FakeAccessFactory) IsKnownRoleCalls(stub func(string) bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = stub
+}
+
+func (fake *FakeAccessFactory) IsKnownRoleArgsForCall(i int) string {
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
+	argsForCall := fake.isKnownRoleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAccessFactory) IsKnownRoleReturns(result1 bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = nil
+	fake.isKnownRoleReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccessFactory) IsKnownRoleReturnsOnCall(i int, result1 bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = nil
+	if fake.isKnownRoleReturnsOnCall == nil {
+		fake.isKnownRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isKnownRoleReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccessFactory) RoleOfAction(arg1 string) string {
 	fake.roleOfActionMutex.Lock()
 	ret, specificReturn := fake.roleOfActionReturnsOnCall[len(fake.roleOfActionArgsForCall)]
@@ -231,6 +319,67 @@ func (fake *FakeAccessFactory) RoleOfActionReturnsOnCall(i int, result1 string)
 	}{result1}
 }
 
+func (fake *FakeAccessFactory) RolePermits(arg1 string, arg2 string) bool {
+	fake.rolePermitsMutex.Lock()
+	ret, specificReturn := fake.rolePermitsReturnsOnCall[len(fake.rolePermitsArgsForCall)]
+	fake.rolePermitsArgsForCall = append(fake.rolePermitsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RolePermits", []interface{}{arg1, arg2})
+	fake.rolePermitsMutex.Unlock()
+	if fake.RolePermitsStub != nil {
+		return fake.RolePermitsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rolePermitsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccessFactory) RolePermitsCallCount() int {
+	fake.rolePermitsMutex.RLock()
+	defer fake.rolePermitsMutex.RUnlock()
+	return len(fake.rolePermitsArgsForCall)
+}
+
+func (fake *FakeAccessFactory) RolePermitsCalls(stub func(string, string) bool) {
+	fake.rolePermitsMutex.Lock()
+	defer fake.rolePermitsMutex.Unlock()
+	fake.RolePermitsStub = stub
+}
+
+func (fake *FakeAccessFactory) RolePermitsArgsForCall(i int) (string, string) {
+	fake.rolePermitsMutex.RLock()
+	defer fake.rolePermitsMutex.RUnlock()
+	argsForCall := fake.rolePermitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccessFactory) RolePermitsReturns(result1 bool) {
+	fake.rolePermitsMutex.Lock()
+	defer fake.rolePermitsMutex.Unlock()
+	fake.RolePermitsStub = nil
+	fake.rolePermitsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccessFactory) RolePermitsReturnsOnCall(i int, result1 bool) {
+	fake.rolePermitsMutex.Lock()
+	defer fake.rolePermitsMutex.Unlock()
+	fake.RolePermitsStub = nil
+	if fake.rolePermitsReturnsOnCall == nil {
+		fake.rolePermitsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.rolePermitsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccessFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -238,8 +387,12 @@ func (fake *FakeAccessFactory) Invocations() map[string][][]interface{} {
 	defer fake.createMutex.RUnlock()
 	fake.customizeActionRoleMapMutex.RLock()
 	defer fake.customizeActionRoleMapMutex.RUnlock()
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
 	fake.roleOfActionMutex.RLock()
 	defer fake.roleOfActionMutex.RUnlock()
+	fake.rolePermitsMutex.RLock()
+	defer fake.rolePermitsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
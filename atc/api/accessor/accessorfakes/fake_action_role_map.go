@@ -8,6 +8,17 @@ import (
 )
 
 type FakeActionRoleMap struct {
+	IsKnownRoleStub        func(string) bool
+	isKnownRoleMutex       sync.RWMutex
+	isKnownRoleArgsForCall []struct {
+		arg1 string
+	}
+	isKnownRoleReturns struct {
+		result1 bool
+	}
+	isKnownRoleReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	RoleOfActionStub        func(string) string
 	roleOfActionMutex       sync.RWMutex
 	roleOfActionArgsForCall []struct {
@@ -19,10 +30,82 @@ type FakeActionRoleMap struct {
 	roleOfActionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RolePermitsStub        func(string, string) bool
+	rolePermitsMutex       sync.RWMutex
+	rolePermitsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	rolePermitsReturns struct {
+		result1 bool
+	}
+	rolePermitsReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeActionRoleMap) IsKnownRole(arg1 string) bool {
+	fake.isKnownRoleMutex.Lock()
+	ret, specificReturn := fake.isKnownRoleReturnsOnCall[len(fake.isKnownRoleArgsForCall)]
+	fake.isKnownRoleArgsForCall = append(fake.isKnownRoleArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("IsKnownRole", []interface{}{arg1})
+	fake.isKnownRoleMutex.Unlock()
+	if fake.IsKnownRoleStub != nil {
+		return fake.IsKnownRoleStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isKnownRoleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeActionRoleMap) IsKnownRoleCallCount() int {
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
+	return len(fake.isKnownRoleArgsForCall)
+}
+
+func (fake *FakeActionRoleMap) IsKnownRoleCalls(stub func(string) bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = stub
+}
+
+func (fake *FakeActionRoleMap) IsKnownRoleArgsForCall(i int) string {
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
+	argsForCall := fake.isKnownRoleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActionRoleMap) IsKnownRoleReturns(result1 bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = nil
+	fake.isKnownRoleReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeActionRoleMap) IsKnownRoleReturnsOnCall(i int, result1 bool) {
+	fake.isKnownRoleMutex.Lock()
+	defer fake.isKnownRoleMutex.Unlock()
+	fake.IsKnownRoleStub = nil
+	if fake.isKnownRoleReturnsOnCall == nil {
+		fake.isKnownRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isKnownRoleReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeActionRoleMap) RoleOfAction(arg1 string) string {
 	fake.roleOfActionMutex.Lock()
 	ret, specificReturn := fake.roleOfActionReturnsOnCall[len(fake.roleOfActionArgsForCall)]
@@ -83,11 +166,76 @@ func (fake *FakeActionRoleMap) RoleOfActionReturnsOnCall(i int, result1 string)
 	}{result1}
 }
 
+func (fake *FakeActionRoleMap) RolePermits(arg1 string, arg2 string) bool {
+	fake.rolePermitsMutex.Lock()
+	ret, specificReturn := fake.rolePermitsReturnsOnCall[len(fake.rolePermitsArgsForCall)]
+	fake.rolePermitsArgsForCall = append(fake.rolePermitsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RolePermits", []interface{}{arg1, arg2})
+	fake.rolePermitsMutex.Unlock()
+	if fake.RolePermitsStub != nil {
+		return fake.RolePermitsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rolePermitsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeActionRoleMap) RolePermitsCallCount() int {
+	fake.rolePermitsMutex.RLock()
+	defer fake.rolePermitsMutex.RUnlock()
+	return len(fake.rolePermitsArgsForCall)
+}
+
+func (fake *FakeActionRoleMap) RolePermitsCalls(stub func(string, string) bool) {
+	fake.rolePermitsMutex.Lock()
+	defer fake.rolePermitsMutex.Unlock()
+	fake.RolePermitsStub = stub
+}
+
+func (fake *FakeActionRoleMap) RolePermitsArgsForCall(i int) (string, string) {
+	fake.rolePermitsMutex.RLock()
+	defer fake.rolePermitsMutex.RUnlock()
+	argsForCall := fake.rolePermitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActionRoleMap) RolePermitsReturns(result1 bool) {
+	fake.rolePermitsMutex.Lock()
+	defer fake.rolePermitsMutex.Unlock()
+	fake.RolePermitsStub = nil
+	fake.rolePermitsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeActionRoleMap) RolePermitsReturnsOnCall(i int, result1 bool) {
+	fake.rolePermitsMutex.Lock()
+	defer fake.rolePermitsMutex.Unlock()
+	fake.RolePermitsStub = nil
+	if fake.rolePermitsReturnsOnCall == nil {
+		fake.rolePermitsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.rolePermitsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeActionRoleMap) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.isKnownRoleMutex.RLock()
+	defer fake.isKnownRoleMutex.RUnlock()
 	fake.roleOfActionMutex.RLock()
 	defer fake.roleOfActionMutex.RUnlock()
+	fake.rolePermitsMutex.RLock()
+	defer fake.rolePermitsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
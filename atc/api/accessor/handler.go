@@ -1,19 +1,27 @@
 package accessor
 
 import (
+	"bufio"
 	"context"
+	"io"
+	"net"
 	"net/http"
+	"sync"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/felixge/httpsnoop"
 )
 
 func NewHandler(
+	logger lager.Logger,
 	handler http.Handler,
 	accessFactory AccessFactory,
 	action string,
 	aud auditor.Auditor,
 ) http.Handler {
 	return accessorHandler{
+		logger:        logger,
 		handler:       handler,
 		accessFactory: accessFactory,
 		action:        action,
@@ -22,6 +30,7 @@ func NewHandler(
 }
 
 type accessorHandler struct {
+	logger        lager.Logger
 	handler       http.Handler
 	accessFactory AccessFactory
 	action        string
@@ -30,11 +39,60 @@ type accessorHandler struct {
 
 func (h accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
-	acc := h.accessFactory.Create(r, h.action)
+	acc, err := h.accessFactory.Create(r, h.action)
+	if err != nil {
+		h.logger.Error("failed-to-construct-accessor", err, lager.Data{"action": h.action})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
 	ctx := context.WithValue(r.Context(), "accessor", acc)
 
-	h.auditor.Audit(h.action, acc.UserName(), r)
+	if r.Header.Get(auditor.RequestIDHeader) == "" {
+		r.Header.Set(auditor.RequestIDHeader, auditor.NewRequestID())
+	}
+
+	w.Header().Set(auditor.RequestIDHeader, r.Header.Get(auditor.RequestIDHeader))
+
+	// the request is audited as soon as its status is known, rather than once
+	// it's been served, as streaming and hijacked requests can last for hours
+	var once sync.Once
+	audit := func(status int) {
+		once.Do(func() {
+			h.auditor.Audit(h.action, acc.UserName(), r, status)
+		})
+	}
+
+	w = httpsnoop.Wrap(w, httpsnoop.Hooks{
+		WriteHeader: func(next httpsnoop.WriteHeaderFunc) httpsnoop.WriteHeaderFunc {
+			return func(status int) {
+				audit(status)
+				next(status)
+			}
+		},
+		Write: func(next httpsnoop.WriteFunc) httpsnoop.WriteFunc {
+			return func(b []byte) (int, error) {
+				audit(http.StatusOK)
+				return next(b)
+			}
+		},
+		ReadFrom: func(next httpsnoop.ReadFromFunc) httpsnoop.ReadFromFunc {
+			return func(src io.Reader) (int64, error) {
+				audit(http.StatusOK)
+				return next(src)
+			}
+		},
+		Hijack: func(next httpsnoop.HijackFunc) httpsnoop.HijackFunc {
+			return func() (net.Conn, *bufio.ReadWriter, error) {
+				audit(http.StatusSwitchingProtocols)
+				return next()
+			}
+		},
+	})
+
 	h.handler.ServeHTTP(w, r.WithContext(ctx))
+
+	audit(http.StatusOK)
 }
 
 func GetAccessor(r *http.Request) Access {
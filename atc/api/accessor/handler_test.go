@@ -1,10 +1,14 @@
 package accessor_test
 
 import (
+	"errors"
 	"net/http"
+	"net/http/httptest"
 
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/auditor"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 
 	. "github.com/onsi/ginkgo"
@@ -16,14 +20,17 @@ var _ = Describe("Handler", func() {
 	var (
 		innerHandlerCalled bool
 		accessorFactory    *accessorfakes.FakeAccessFactory
+		fakeAuditor        *auditorfakes.FakeAuditor
 		dummyHandler       http.HandlerFunc
 		access             accessor.Access
 		fakeAccess         *accessorfakes.FakeAccess
 		accessorHandler    http.Handler
 		req                *http.Request
+		recorder           *httptest.ResponseRecorder
 	)
 	BeforeEach(func() {
 		accessorFactory = new(accessorfakes.FakeAccessFactory)
+		fakeAuditor = new(auditorfakes.FakeAuditor)
 
 		dummyHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			innerHandlerCalled = true
@@ -34,26 +41,106 @@ var _ = Describe("Handler", func() {
 		var err error
 		req, err = http.NewRequest("GET", "localhost:8080", nil)
 		Expect(err).NotTo(HaveOccurred())
+
+		recorder = httptest.NewRecorder()
 	})
 
 	JustBeforeEach(func() {
-		accessorHandler.ServeHTTP(nil, req)
+		accessorHandler = accessor.NewHandler(lagertest.NewTestLogger("test"), dummyHandler, accessorFactory, "some-action", fakeAuditor)
+		accessorHandler.ServeHTTP(recorder, req)
 	})
 
 	Describe("Accessor Handler", func() {
-		BeforeEach(func() {
-			accessorHandler = accessor.NewHandler(dummyHandler, accessorFactory, "some-action", new(auditorfakes.FakeAuditor))
-		})
-
 		Context("when access factory return valid access object", func() {
 			BeforeEach(func() {
 				fakeAccess = new(accessorfakes.FakeAccess)
-				accessorFactory.CreateReturns(fakeAccess)
+				fakeAccess.UserNameReturns("some-user")
+				accessorFactory.CreateReturns(fakeAccess, nil)
 			})
+
 			It("calls the inner handler", func() {
 				Expect(innerHandlerCalled).To(BeTrue())
 				Expect(access).To(Equal(fakeAccess))
 			})
+
+			It("audits the request once it has been served", func() {
+				Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
+
+				action, userName, _, status := fakeAuditor.AuditArgsForCall(0)
+				Expect(action).To(Equal("some-action"))
+				Expect(userName).To(Equal("some-user"))
+				Expect(status).To(Equal(http.StatusOK))
+			})
+
+			It("generates a request ID and responds with it", func() {
+				requestID := recorder.Header().Get(auditor.RequestIDHeader)
+				Expect(requestID).ToNot(BeEmpty())
+
+				_, _, r, _ := fakeAuditor.AuditArgsForCall(0)
+				Expect(r.Header.Get(auditor.RequestIDHeader)).To(Equal(requestID))
+			})
+
+			Context("when the request has an ID", func() {
+				BeforeEach(func() {
+					req.Header.Set(auditor.RequestIDHeader, "some-request-id")
+				})
+
+				It("responds with the same ID", func() {
+					Expect(recorder.Header().Get(auditor.RequestIDHeader)).To(Equal("some-request-id"))
+				})
+			})
+
+			Context("when the inner handler responds with a status", func() {
+				BeforeEach(func() {
+					dummyHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+						w.WriteHeader(http.StatusInternalServerError)
+					})
+				})
+
+				It("audits the request with the first status", func() {
+					Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
+
+					_, _, _, status := fakeAuditor.AuditArgsForCall(0)
+					Expect(status).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the inner handler streams a response", func() {
+				var auditedBeforeFinished bool
+
+				BeforeEach(func() {
+					auditedBeforeFinished = false
+
+					dummyHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+						w.Write([]byte("some-output"))
+						auditedBeforeFinished = fakeAuditor.AuditCallCount() == 1
+					})
+				})
+
+				It("audits the request as soon as the status is known", func() {
+					Expect(auditedBeforeFinished).To(BeTrue())
+					Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
+
+					_, _, _, status := fakeAuditor.AuditArgsForCall(0)
+					Expect(status).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when access factory fails to create the access object", func() {
+			BeforeEach(func() {
+				innerHandlerCalled = false
+				accessorFactory.CreateReturns(nil, errors.New("nope"))
+			})
+
+			It("responds with 500 Internal Server Error", func() {
+				Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+			})
+
+			It("does not call the inner handler", func() {
+				Expect(innerHandlerCalled).To(BeFalse())
+			})
 		})
 	})
 })
@@ -22,7 +22,9 @@ var requiredRoles = map[string]string{
 	atc.BuildEvents:                   "viewer",
 	atc.BuildResources:                "viewer",
 	atc.AbortBuild:                    "pipeline-operator",
+	atc.SetBuildComment:               "member",
 	atc.GetBuildPreparation:           "viewer",
+	atc.GetBuildQueue:                 "viewer",
 	atc.GetJob:                        "viewer",
 	atc.CreateJobBuild:                "pipeline-operator",
 	atc.RerunJobBuild:                 "pipeline-operator",
@@ -30,6 +32,7 @@ var requiredRoles = map[string]string{
 	atc.ListJobs:                      "viewer",
 	atc.ListJobBuilds:                 "viewer",
 	atc.ListJobInputs:                 "viewer",
+	atc.ListJobQueue:                  "viewer",
 	atc.GetJobBuild:                   "viewer",
 	atc.PauseJob:                      "pipeline-operator",
 	atc.UnpauseJob:                    "pipeline-operator",
@@ -62,12 +65,14 @@ var requiredRoles = map[string]string{
 	atc.OrderPipelines:                "member",
 	atc.PausePipeline:                 "pipeline-operator",
 	atc.UnpausePipeline:               "pipeline-operator",
+	atc.ArchivePipeline:               "member",
 	atc.ExposePipeline:                "member",
 	atc.HidePipeline:                  "member",
 	atc.RenamePipeline:                "member",
 	atc.ListPipelineBuilds:            "viewer",
 	atc.CreatePipelineBuild:           "member",
 	atc.PipelineBadge:                 "viewer",
+	atc.ResolveVar:                    "member",
 	atc.RegisterWorker:                "member",
 	atc.LandWorker:                    "member",
 	atc.RetireWorker:                  "member",
@@ -80,6 +85,7 @@ var requiredRoles = map[string]string{
 	atc.DownloadCLI:                   "viewer",
 	atc.GetInfo:                       "viewer",
 	atc.GetInfoCreds:                  "viewer",
+	atc.GetEncryptionKeyRotation:      "viewer",
 	atc.ListContainers:                "viewer",
 	atc.GetContainer:                  "viewer",
 	atc.HijackContainer:               "member",
@@ -93,6 +99,12 @@ var requiredRoles = map[string]string{
 	atc.SetTeam:                       "owner",
 	atc.RenameTeam:                    "owner",
 	atc.DestroyTeam:                   "owner",
+	atc.SetTeamWebhook:                "owner",
+	atc.DestroyTeamWebhook:            "owner",
+	atc.ReceiveTeamWebhook:            "pipeline-operator",
+	atc.CreateAPIToken:                "viewer",
+	atc.ListAPITokens:                 "viewer",
+	atc.RevokeAPIToken:                "viewer",
 	atc.ListTeamBuilds:                "viewer",
 	atc.CreateArtifact:                "member",
 	atc.GetArtifact:                   "member",
@@ -100,12 +112,26 @@ var requiredRoles = map[string]string{
 	atc.GetWall:                       "viewer",
 }
 
+// builtInRoles are ordered from the most to the least permissive, each role
+// permitting the actions of the roles after it.
+var builtInRoles = []string{"owner", "member", "pipeline-operator", "viewer"}
+
+// CustomActionRoleMap maps a role to actions. For a built-in role, the actions
+// are moved to the role; any other role is defined as a custom role which
+// permits exactly the listed actions.
 type CustomActionRoleMap map[string][]string
 
 //go:generate counterfeiter . ActionRoleMap
 
 type ActionRoleMap interface {
 	RoleOfAction(string) string
+
+	// RolePermits returns whether the role, built-in or custom, permits the
+	// action.
+	RolePermits(role string, action string) bool
+
+	// IsKnownRole returns whether the role is built-in or custom.
+	IsKnownRole(role string) bool
 }
 
 //go:generate counterfeiter . ActionRoleMapModifier
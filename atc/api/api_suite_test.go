@@ -49,9 +49,11 @@ var (
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbUserFactory           *dbfakes.FakeUserFactory
+	dbAuditEventRepository  *dbfakes.FakeAuditEventRepository
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	dbEncryptionKeyRotation *dbfakes.FakeEncryptionKeyRotation
 	fakeSecretManager       *credsfakes.FakeSecrets
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	credsManagers           creds.Managers
@@ -95,8 +97,10 @@ var _ = BeforeEach(func() {
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 	dbUserFactory = new(dbfakes.FakeUserFactory)
+	dbAuditEventRepository = new(dbfakes.FakeAuditEventRepository)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	dbEncryptionKeyRotation = new(dbfakes.FakeEncryptionKeyRotation)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -109,7 +113,7 @@ var _ = BeforeEach(func() {
 
 	fakeAccess = new(accessorfakes.FakeAccess)
 	fakeAccessor = new(accessorfakes.FakeAccessFactory)
-	fakeAccessor.CreateReturns(fakeAccess)
+	fakeAccessor.CreateReturns(fakeAccess, nil)
 
 	fakePipeline = new(dbfakes.FakePipeline)
 	dbTeam.PipelineReturns(fakePipeline, true, nil)
@@ -174,6 +178,7 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		dbAuditEventRepository,
 
 		constructedEventHandler.Construct,
 
@@ -191,10 +196,11 @@ var _ = BeforeEach(func() {
 		credsManagers,
 		interceptTimeoutFactory,
 		dbWall,
+		dbEncryptionKeyRotation,
 	)
 
 	Expect(err).NotTo(HaveOccurred())
-	accessorHandler := accessor.NewHandler(handler, fakeAccessor, "some-action", new(auditorfakes.FakeAuditor))
+	accessorHandler := accessor.NewHandler(lagertest.NewTestLogger("test"), handler, fakeAccessor, "some-action", new(auditorfakes.FakeAuditor))
 	handler = wrappa.LoggerHandler{
 		Logger:  logger,
 		Handler: accessorHandler,
@@ -208,7 +214,7 @@ var _ = BeforeEach(func() {
 })
 
 var _ = JustBeforeEach(func() {
-	fakeAccessor.CreateReturns(fakeAccess)
+	fakeAccessor.CreateReturns(fakeAccess, nil)
 })
 
 var _ = AfterEach(func() {
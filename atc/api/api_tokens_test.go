@@ -0,0 +1,444 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Tokens API", func() {
+	var response *http.Response
+
+	fakeAPIToken := func(name string, createdBy string) *dbfakes.FakeAPIToken {
+		apiToken := new(dbfakes.FakeAPIToken)
+		apiToken.NameReturns(name)
+		apiToken.TeamNameReturns("a-team")
+		apiToken.RoleReturns("member")
+		apiToken.CreatedByReturns(createdBy)
+		apiToken.CreatedAtReturns(time.Unix(100, 0))
+		return apiToken
+	}
+
+	BeforeEach(func() {
+		dbTeam.NameReturns("a-team")
+	})
+
+	Describe("POST /api/v1/teams/:team_name/tokens", func() {
+		var body string
+
+		BeforeEach(func() {
+			body = `{"name":"some-token","role":"member"}`
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/tokens", bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.HasRoleReturns(true)
+				fakeAccess.IsKnownRoleReturns(true)
+				fakeAccess.UserNameReturns("some-user")
+
+				dbTeam.CreateAPITokenReturns(fakeAPIToken("some-token", "some-user"), "ct_some-secret", nil)
+			})
+
+			It("returns 201 with the token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				var apiToken atc.APIToken
+				err := json.NewDecoder(response.Body).Decode(&apiToken)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(apiToken).To(Equal(atc.APIToken{
+					Name:      "some-token",
+					TeamName:  "a-team",
+					Role:      "member",
+					CreatedBy: "some-user",
+					CreatedAt: 100,
+					Token:     "ct_some-secret",
+				}))
+			})
+
+			It("creates the token on behalf of the user", func() {
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+				Expect(dbTeam.CreateAPITokenCallCount()).To(Equal(1))
+
+				name, role, serviceAccount, createdBy, expiresAt := dbTeam.CreateAPITokenArgsForCall(0)
+				Expect(name).To(Equal("some-token"))
+				Expect(role).To(Equal("member"))
+				Expect(serviceAccount).To(BeFalse())
+				Expect(createdBy).To(Equal("some-user"))
+				Expect(expiresAt).To(BeZero())
+
+				team, role := fakeAccess.HasRoleArgsForCall(0)
+				Expect(team).To(Equal("a-team"))
+				Expect(role).To(Equal("member"))
+			})
+
+			Context("when the token expires", func() {
+				var expiresAt time.Time
+
+				BeforeEach(func() {
+					expiresAt = time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+					body = `{"name":"some-token","role":"member","expires_at":` + strconv.FormatInt(expiresAt.Unix(), 10) + `}`
+				})
+
+				It("creates the token with the expiry", func() {
+					_, _, _, _, createdExpiresAt := dbTeam.CreateAPITokenArgsForCall(0)
+					Expect(createdExpiresAt).To(BeTemporally("==", expiresAt))
+				})
+			})
+
+			Context("when the token would already have expired", func() {
+				BeforeEach(func() {
+					body = `{"name":"some-token","role":"member","expires_at":100}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbTeam.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the name is missing", func() {
+				BeforeEach(func() {
+					body = `{"role":"member"}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the role is unknown", func() {
+				BeforeEach(func() {
+					body = `{"name":"some-token","role":"superuser"}`
+					fakeAccess.IsKnownRoleReturns(false)
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeAccess.IsKnownRoleArgsForCall(0)).To(Equal("superuser"))
+
+					msg, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(msg)).To(Equal("unknown role 'superuser'"))
+				})
+			})
+
+			Context("when the body is malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the user does not have the role", func() {
+				BeforeEach(func() {
+					fakeAccess.HasRoleReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbTeam.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the request is authenticated with an API token", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAPITokenReturns(true)
+				})
+
+				It("returns 403 for a token that never expires", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbTeam.CreateAPITokenCallCount()).To(BeZero())
+
+					msg, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(msg)).To(Equal("API tokens can't be used to create other API tokens"))
+				})
+
+				Context("when creating a token which expires", func() {
+					BeforeEach(func() {
+						body = `{"name":"some-token","role":"member","expires_at":` + strconv.FormatInt(time.Now().Add(time.Minute).Unix(), 10) + `}`
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(dbTeam.CreateAPITokenCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when creating a service account", func() {
+					BeforeEach(func() {
+						body = `{"name":"some-bot","role":"viewer","service_account":true}`
+						fakeAccess.IsOwnerReturns(true)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(dbTeam.CreateAPITokenCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when creating a service account", func() {
+				BeforeEach(func() {
+					body = `{"name":"some-bot","role":"viewer","service_account":true}`
+				})
+
+				Context("when the user owns the team", func() {
+					BeforeEach(func() {
+						fakeAccess.IsOwnerReturns(true)
+					})
+
+					It("creates the service account", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+						_, _, serviceAccount, _, _ := dbTeam.CreateAPITokenArgsForCall(0)
+						Expect(serviceAccount).To(BeTrue())
+					})
+				})
+
+				Context("when the user does not own the team", func() {
+					BeforeEach(func() {
+						fakeAccess.IsOwnerReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(dbTeam.CreateAPITokenCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the token already exists", func() {
+				BeforeEach(func() {
+					dbTeam.CreateAPITokenReturns(nil, "", db.ErrAPITokenExists)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when creating the token fails", func() {
+				BeforeEach(func() {
+					dbTeam.CreateAPITokenReturns(nil, "", errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/tokens", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/tokens")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.UserNameReturns("some-user")
+
+				usedToken := fakeAPIToken("some-token", "some-user")
+				usedToken.LastUsedAtReturns(time.Unix(200, 0))
+
+				dbTeam.APITokensReturns([]db.APIToken{
+					usedToken,
+					fakeAPIToken("other-token", "other-user"),
+				}, nil)
+			})
+
+			Context("when the user owns the team", func() {
+				BeforeEach(func() {
+					fakeAccess.IsOwnerReturns(true)
+				})
+
+				It("returns all of the tokens", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					var apiTokens []atc.APIToken
+					err := json.NewDecoder(response.Body).Decode(&apiTokens)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(apiTokens).To(Equal([]atc.APIToken{
+						{
+							Name:       "some-token",
+							TeamName:   "a-team",
+							Role:       "member",
+							CreatedBy:  "some-user",
+							CreatedAt:  100,
+							LastUsedAt: 200,
+						},
+						{
+							Name:      "other-token",
+							TeamName:  "a-team",
+							Role:      "member",
+							CreatedBy: "other-user",
+							CreatedAt: 100,
+						},
+					}))
+				})
+			})
+
+			Context("when the user does not own the team", func() {
+				BeforeEach(func() {
+					fakeAccess.IsOwnerReturns(false)
+				})
+
+				It("returns only the user's own tokens", func() {
+					var apiTokens []atc.APIToken
+					err := json.NewDecoder(response.Body).Decode(&apiTokens)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(apiTokens).To(HaveLen(1))
+					Expect(apiTokens[0].Name).To(Equal("some-token"))
+				})
+			})
+
+			Context("when getting the tokens fails", func() {
+				BeforeEach(func() {
+					dbTeam.APITokensReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/tokens/:token_name", func() {
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/tokens/other-token", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.UserNameReturns("some-user")
+
+				dbTeam.APITokensReturns([]db.APIToken{
+					fakeAPIToken("other-token", "other-user"),
+				}, nil)
+				dbTeam.RevokeAPITokenReturns(true, nil)
+			})
+
+			Context("when the user owns the team", func() {
+				BeforeEach(func() {
+					fakeAccess.IsOwnerReturns(true)
+				})
+
+				It("revokes the token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(dbTeam.RevokeAPITokenCallCount()).To(Equal(1))
+					Expect(dbTeam.RevokeAPITokenArgsForCall(0)).To(Equal("other-token"))
+				})
+
+				Context("when the token does not exist", func() {
+					BeforeEach(func() {
+						dbTeam.APITokensReturns(nil, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						Expect(dbTeam.RevokeAPITokenCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when revoking the token fails", func() {
+					BeforeEach(func() {
+						dbTeam.RevokeAPITokenReturns(false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the token belongs to another user", func() {
+				BeforeEach(func() {
+					fakeAccess.IsOwnerReturns(false)
+				})
+
+				It("returns 404 without revoking it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					Expect(dbTeam.RevokeAPITokenCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+})
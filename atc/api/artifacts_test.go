@@ -28,7 +28,7 @@ var _ = Describe("ArtifactRepository API", func() {
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 	})
 
 	Describe("POST /api/v1/teams/:team_name/artifacts", func() {
@@ -39,7 +39,7 @@ var _ = Describe("ArtifactRepository API", func() {
 			fakeaccess = new(accessorfakes.FakeAccess)
 			fakeaccess.IsAuthenticatedReturns(true)
 
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -208,7 +208,7 @@ var _ = Describe("ArtifactRepository API", func() {
 			fakeaccess = new(accessorfakes.FakeAccess)
 			fakeaccess.IsAuthenticatedReturns(true)
 
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 		})
 
 		JustBeforeEach(func() {
@@ -0,0 +1,176 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Events API", func() {
+	var (
+		response   *http.Response
+		fakeaccess *accessorfakes.FakeAccess
+		query      url.Values
+	)
+
+	BeforeEach(func() {
+		fakeaccess = new(accessorfakes.FakeAccess)
+		query = url.Values{}
+	})
+
+	Context("GET /api/v1/audit_events", func() {
+		JustBeforeEach(func() {
+			fakeAccessor.CreateReturns(fakeaccess, nil)
+
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/audit_events", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.URL.RawQuery = query.Encode()
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when not an admin", func() {
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when an admin", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAdminReturns(true)
+
+					dbAuditEventRepository.AuditEventsReturns([]atc.AuditEvent{
+						{
+							ID:        42,
+							Time:      10,
+							UserName:  "some-user",
+							TeamName:  "some-team",
+							Action:    atc.SetTeam,
+							Target:    "/api/v1/teams/some-team",
+							RequestID: "some-request-id",
+							SourceIP:  "1.2.3.4",
+							Status:    http.StatusCreated,
+						},
+					}, db.Pagination{}, nil)
+				})
+
+				It("returns 200 with the events as JSON", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[{
+						"id": 42,
+						"time": 10,
+						"user_name": "some-user",
+						"team_name": "some-team",
+						"action": "SetTeam",
+						"target": "/api/v1/teams/some-team",
+						"request_id": "some-request-id",
+						"source_ip": "1.2.3.4",
+						"status": 201
+					}]`))
+				})
+
+				It("lists the first page of all events", func() {
+					Expect(dbAuditEventRepository.AuditEventsCallCount()).To(Equal(1))
+
+					filter, page := dbAuditEventRepository.AuditEventsArgsForCall(0)
+					Expect(filter).To(Equal(db.AuditEventFilter{}))
+					Expect(page).To(Equal(db.Page{Limit: 100}))
+				})
+
+				Context("when filters and a page are given", func() {
+					BeforeEach(func() {
+						query.Set("user", "some-user")
+						query.Set("team", "some-team")
+						query.Set("action", atc.SetTeam)
+						query.Set("from", "10")
+						query.Set("to", "20")
+						query.Set("since", "50")
+						query.Set("limit", "2")
+					})
+
+					It("lists the events matching the filters", func() {
+						filter, page := dbAuditEventRepository.AuditEventsArgsForCall(0)
+						Expect(filter).To(Equal(db.AuditEventFilter{
+							UserName: "some-user",
+							TeamName: "some-team",
+							Action:   atc.SetTeam,
+							From:     time.Unix(10, 0),
+							To:       time.Unix(20, 0),
+						}))
+						Expect(page).To(Equal(db.Page{Since: 50, Limit: 2}))
+					})
+				})
+
+				Context("when a time is malformed", func() {
+					BeforeEach(func() {
+						query.Set("from", "yesterday")
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(dbAuditEventRepository.AuditEventsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when there are more pages", func() {
+					BeforeEach(func() {
+						query.Set("user", "some-user")
+
+						dbAuditEventRepository.AuditEventsReturns([]atc.AuditEvent{}, db.Pagination{
+							Previous: &db.Page{Until: 4, Limit: 2},
+							Next:     &db.Page{Since: 2, Limit: 2},
+						}, nil)
+					})
+
+					It("links to them, keeping the filters", func() {
+						Expect(response.Header["Link"]).To(ConsistOf([]string{
+							`<https://example.com/api/v1/audit_events?limit=2&since=2&user=some-user>; rel="next"`,
+							`<https://example.com/api/v1/audit_events?limit=2&until=4&user=some-user>; rel="previous"`,
+						}))
+					})
+				})
+
+				Context("when listing the events fails", func() {
+					BeforeEach(func() {
+						dbAuditEventRepository.AuditEventsReturns(nil, db.Pagination{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,109 @@
+package auditserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-audit-events")
+
+	filter := db.AuditEventFilter{
+		UserName: r.FormValue("user"),
+		TeamName: r.FormValue("team"),
+		Action:   r.FormValue("action"),
+	}
+
+	var err error
+	filter.From, err = parseTimestamp(r.FormValue(atc.PaginationQueryFrom))
+	if err != nil {
+		logger.Info("malformed-from", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	filter.To, err = parseTimestamp(r.FormValue(atc.PaginationQueryTo))
+	if err != nil {
+		logger.Info("malformed-to", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	since, _ := strconv.Atoi(r.FormValue(atc.PaginationQuerySince))
+	until, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryUntil))
+
+	limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+	if limit == 0 {
+		limit = atc.PaginationAPIDefaultLimit
+	}
+
+	events, pagination, err := s.auditEventRepository.AuditEvents(filter, db.Page{
+		Since: since,
+		Until: until,
+		Limit: limit,
+	})
+	if err != nil {
+		logger.Error("failed-to-get-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if pagination.Next != nil {
+		s.addLink(w, r, atc.PaginationQuerySince, pagination.Next.Since, pagination.Next.Limit, atc.LinkRelNext)
+	}
+
+	if pagination.Previous != nil {
+		s.addLink(w, r, atc.PaginationQueryUntil, pagination.Previous.Until, pagination.Previous.Limit, atc.LinkRelPrevious)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(events)
+	if err != nil {
+		logger.Error("failed-to-encode-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// addLink links to another page of the events, keeping the filters of the
+// request.
+func (s *Server) addLink(w http.ResponseWriter, r *http.Request, param string, id int, limit int, rel string) {
+	query := url.Values{}
+	for _, filter := range []string{"user", "team", "action", atc.PaginationQueryFrom, atc.PaginationQueryTo} {
+		if value := r.FormValue(filter); value != "" {
+			query.Set(filter, value)
+		}
+	}
+
+	query.Set(param, strconv.Itoa(id))
+	query.Set(atc.PaginationQueryLimit, strconv.Itoa(limit))
+
+	w.Header().Add("Link", fmt.Sprintf(
+		`<%s/api/v1/audit_events?%s>; rel="%s"`,
+		s.externalURL,
+		query.Encode(),
+		rel,
+	))
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(seconds, 0), nil
+}
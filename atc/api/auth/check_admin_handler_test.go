@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
@@ -48,7 +49,7 @@ var _ = Describe("CheckAdminHandler", func() {
 			http.Error(w, "still nope", http.StatusForbidden)
 		}
 
-		server = httptest.NewServer(accessor.NewHandler(auth.CheckAdminHandler(
+		server = httptest.NewServer(accessor.NewHandler(lagertest.NewTestLogger("test"), auth.CheckAdminHandler(
 			simpleHandler,
 			fakeRejector,
 		), fakeAccessor,
@@ -62,7 +63,7 @@ var _ = Describe("CheckAdminHandler", func() {
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 	})
 
 	Context("when a request is made", func() {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
@@ -46,13 +47,13 @@ var _ = Describe("AuthenticationHandler", func() {
 		fakeRejector = new(authfakes.FakeRejector)
 		fakeAuditor = new(auditorfakes.FakeAuditor)
 
-		fakeAccessor.CreateReturns(fakeAccess)
+		fakeAccessor.CreateReturns(fakeAccess, nil)
 
 		fakeRejector.UnauthorizedStub = func(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "nope", http.StatusUnauthorized)
 		}
 
-		server = httptest.NewServer(accessor.NewHandler(auth.CheckAuthenticationHandler(
+		server = httptest.NewServer(accessor.NewHandler(lagertest.NewTestLogger("test"), auth.CheckAuthenticationHandler(
 			simpleHandler,
 			fakeRejector,
 		), fakeAccessor,
@@ -71,7 +72,7 @@ var _ = Describe("AuthenticationHandler", func() {
 	Describe("CheckAuthenticationHandler", func() {
 
 		BeforeEach(func() {
-			server = httptest.NewServer(accessor.NewHandler(auth.CheckAuthenticationHandler(
+			server = httptest.NewServer(accessor.NewHandler(lagertest.NewTestLogger("test"), auth.CheckAuthenticationHandler(
 				simpleHandler,
 				fakeRejector,
 			), fakeAccessor,
@@ -123,7 +124,7 @@ var _ = Describe("AuthenticationHandler", func() {
 	Describe("CheckAuthenticationIfProvidedHandler", func() {
 
 		BeforeEach(func() {
-			server = httptest.NewServer(accessor.NewHandler(auth.CheckAuthenticationIfProvidedHandler(
+			server = httptest.NewServer(accessor.NewHandler(lagertest.NewTestLogger("test"), auth.CheckAuthenticationIfProvidedHandler(
 				simpleHandler,
 				fakeRejector,
 			), fakeAccessor,
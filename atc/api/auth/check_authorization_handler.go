@@ -30,8 +30,15 @@ func (h checkAuthorizationHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 	}
 
 	teamName := r.URL.Query().Get(":team_name")
+	pipelineName := r.URL.Query().Get(":pipeline_name")
 
-	if !acc.IsAuthorized(teamName) {
+	// roles bound in a pipeline only grant access to the pipeline's routes
+	authorized := acc.IsAuthorized(teamName)
+	if !authorized && pipelineName != "" {
+		authorized = acc.IsAuthorizedInPipeline(teamName, pipelineName)
+	}
+
+	if !authorized {
 		h.rejector.Forbidden(w, r)
 		return
 	}
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
@@ -48,7 +49,7 @@ var _ = Describe("CheckAuthorizationHandler", func() {
 			http.Error(w, "nope", http.StatusForbidden)
 		}
 
-		server = httptest.NewServer(accessor.NewHandler(auth.CheckAuthorizationHandler(
+		server = httptest.NewServer(accessor.NewHandler(lagertest.NewTestLogger("test"), auth.CheckAuthorizationHandler(
 			simpleHandler,
 			fakeRejector,
 		), fakeAccessor,
@@ -62,7 +63,7 @@ var _ = Describe("CheckAuthorizationHandler", func() {
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 	})
 
 	Context("when a request is made", func() {
@@ -119,6 +120,58 @@ var _ = Describe("CheckAuthorizationHandler", func() {
 			})
 		})
 
+		Context("when the request is for a pipeline", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+
+				urlValues := url.Values{
+					":team_name":     []string{"some-team"},
+					":pipeline_name": []string{"some-pipeline"},
+				}
+				request.URL.RawQuery = urlValues.Encode()
+			})
+
+			Context("when the user has a role in the pipeline which permits the action", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedInPipelineReturns(true)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("checks access to the pipeline of the team", func() {
+					teamName, pipelineName := fakeaccess.IsAuthorizedInPipelineArgsForCall(0)
+					Expect(teamName).To(Equal("some-team"))
+					Expect(pipelineName).To(Equal("some-pipeline"))
+				})
+			})
+
+			Context("when the user has no role in the pipeline which permits the action", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedInPipelineReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when the request is not for a pipeline", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+				fakeaccess.IsAuthorizedInPipelineReturns(true)
+			})
+
+			It("does not consider roles in pipelines", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeaccess.IsAuthorizedInPipelineCallCount()).To(BeZero())
+			})
+		})
+
 		Context("when the request is not authenticated", func() {
 			BeforeEach(func() {
 				fakeaccess.IsAuthenticatedReturns(false)
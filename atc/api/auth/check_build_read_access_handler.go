@@ -78,7 +78,7 @@ func (h checkBuildReadAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 
 	acc := accessor.GetAccessor(r)
 
-	if !acc.IsAuthenticated() || !acc.IsAuthorized(build.TeamName()) {
+	if !acc.IsAuthenticated() || !isAuthorizedForBuild(acc, build) {
 		pipeline, found, err := build.Pipeline()
 		if err != nil {
 			w.WriteHeader(http.StatusInternalServerError)
@@ -127,3 +127,13 @@ func (h checkBuildReadAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 	ctx := context.WithValue(r.Context(), BuildContextKey, build)
 	h.delegateHandler.ServeHTTP(w, r.WithContext(ctx))
 }
+
+// isAuthorizedForBuild returns whether the user may perform the action on the
+// build, which for builds of a pipeline includes any role bound in it.
+func isAuthorizedForBuild(acc accessor.Access, build db.Build) bool {
+	if acc.IsAuthorized(build.TeamName()) {
+		return true
+	}
+
+	return build.PipelineName() != "" && acc.IsAuthorizedInPipeline(build.TeamName(), build.PipelineName())
+}
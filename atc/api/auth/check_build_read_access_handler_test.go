@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
@@ -45,7 +46,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 		server = httptest.NewServer(handler)
 
 		request, err := http.NewRequest("POST", server.URL+"?:build_id=55", nil)
@@ -104,6 +105,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 		BeforeEach(func() {
 			checkBuildReadAccessHandler := handlerFactory.AnyJobHandler(delegate, auth.UnauthorizedRejector{})
 			handler = accessor.NewHandler(
+				lagertest.NewTestLogger("test"),
 				checkBuildReadAccessHandler,
 				fakeAccessor,
 				"some-action",
@@ -217,6 +219,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 			fakeJob = new(dbfakes.FakeJob)
 			checkBuildReadAccessHandler := handlerFactory.CheckIfPrivateJobHandler(delegate, auth.UnauthorizedRejector{})
 			handler = accessor.NewHandler(
+				lagertest.NewTestLogger("test"),
 				checkBuildReadAccessHandler,
 				fakeAccessor,
 				"some-action",
@@ -67,7 +67,7 @@ func (h checkBuildWriteAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if !acc.IsAuthorized(build.TeamName()) {
+	if !isAuthorizedForBuild(acc, build) {
 		h.rejector.Forbidden(w, r)
 		return
 	}
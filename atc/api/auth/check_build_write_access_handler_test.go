@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
@@ -44,11 +45,11 @@ var _ = Describe("CheckBuildWriteAccessHandler", func() {
 		build.JobNameReturns("some-job")
 
 		checkBuildWriteAccessHandler := handlerFactory.HandlerFor(delegate, auth.UnauthorizedRejector{})
-		handler = accessor.NewHandler(checkBuildWriteAccessHandler, fakeAccessor, "some-action", new(auditorfakes.FakeAuditor))
+		handler = accessor.NewHandler(lagertest.NewTestLogger("test"), checkBuildWriteAccessHandler, fakeAccessor, "some-action", new(auditorfakes.FakeAuditor))
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 		server = httptest.NewServer(handler)
 
 		request, err := http.NewRequest("POST", server.URL+"?:team_name=some-team&:build_id=55", nil)
@@ -116,6 +117,40 @@ var _ = Describe("CheckBuildWriteAccessHandler", func() {
 		})
 	})
 
+	Context("when authenticated and accessing a build of a pipeline", func() {
+		BeforeEach(func() {
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(false)
+			build.TeamNameReturns("some-team")
+			build.PipelineNameReturns("some-pipeline")
+			buildFactory.BuildReturns(build, true, nil)
+		})
+
+		Context("when the user has a role in the pipeline which permits the action", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedInPipelineReturns(true)
+			})
+
+			It("returns 200 ok", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				teamName, pipelineName := fakeaccess.IsAuthorizedInPipelineArgsForCall(0)
+				Expect(teamName).To(Equal("some-team"))
+				Expect(pipelineName).To(Equal("some-pipeline"))
+			})
+		})
+
+		Context("when the user has no role in the pipeline which permits the action", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedInPipelineReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
 	Context("when not authenticated", func() {
 		BeforeEach(func() {
 			fakeaccess.IsAuthenticatedReturns(false)
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
@@ -43,11 +44,11 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 
 		delegate = &pipelineDelegateHandler{}
 		checkPipelineAccessHandler := handlerFactory.HandlerFor(delegate, auth.UnauthorizedRejector{})
-		handler = accessor.NewHandler(checkPipelineAccessHandler, fakeAccessor, "some-action", new(auditorfakes.FakeAuditor))
+		handler = accessor.NewHandler(lagertest.NewTestLogger("test"), checkPipelineAccessHandler, fakeAccessor, "some-action", new(auditorfakes.FakeAuditor))
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 		server = httptest.NewServer(handler)
 
 		request, err := http.NewRequest("POST", server.URL+"?:team_name=some-team&:pipeline_name=some-pipeline", nil)
@@ -123,6 +124,27 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 				})
 			})
 
+			Context("and authorized through a role in the pipeline", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(true)
+					fakeaccess.IsAuthorizedReturns(false)
+					fakeaccess.IsAuthorizedInPipelineReturns(true)
+				})
+
+				It("checks the roles bound in the pipeline", func() {
+					Expect(fakeaccess.IsAuthorizedInPipelineCallCount()).To(Equal(1))
+
+					teamName, pipelineName := fakeaccess.IsAuthorizedInPipelineArgsForCall(0)
+					Expect(teamName).To(Equal("some-team"))
+					Expect(pipelineName).To(Equal("some-pipeline"))
+				})
+
+				It("calls pipelineScopedHandler with pipelineDB in context", func() {
+					Expect(delegate.IsCalled).To(BeTrue())
+					Expect(delegate.ContextPipelineDB).To(BeIdenticalTo(pipeline))
+				})
+			})
+
 			Context("and unauthorized", func() {
 				BeforeEach(func() {
 					fakeaccess.IsAuthorizedReturns(false)
@@ -53,7 +53,7 @@ var _ = Describe("CsrfValidationHandler", func() {
 		isCSRFRequired = false
 		logger = lagertest.NewTestLogger("csrf-validation-test")
 
-		csrfValidationHandler = accessor.NewHandler(auth.CSRFValidationHandler(
+		csrfValidationHandler = accessor.NewHandler(lagertest.NewTestLogger("test"), auth.CSRFValidationHandler(
 			simpleHandler,
 			auth.UnauthorizedRejector{},
 		), fakeAccessor,
@@ -74,7 +74,7 @@ var _ = Describe("CsrfValidationHandler", func() {
 
 	Context("when request does not require CSRF validation", func() {
 		JustBeforeEach(func() {
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 
 			var err error
 			response, err = http.DefaultClient.Do(request)
@@ -94,7 +94,7 @@ var _ = Describe("CsrfValidationHandler", func() {
 
 	Context("when request requires CSRF validation", func() {
 		JustBeforeEach(func() {
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 
 			var err error
 			response, err = http.DefaultClient.Do(request)
@@ -441,7 +441,7 @@ var _ = Describe("Builds API", func() {
 
 		Context("when parsing the build_id fails", func() {
 			BeforeEach(func() {
-				fakeAccessor.CreateReturns(fakeAccess)
+				fakeAccessor.CreateReturns(fakeAccess, nil)
 				var err error
 
 				response, err = client.Get(server.URL + "/api/v1/builds/nope")
@@ -585,6 +585,20 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 						})
+
+						Context("when the build has a comment", func() {
+							BeforeEach(func() {
+								build.CommentReturns("flaky, see infra ticket")
+							})
+
+							It("includes the comment", func() {
+								var atcBuild atc.Build
+								err := json.NewDecoder(response.Body).Decode(&atcBuild)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(atcBuild.Comment).To(Equal("flaky, see infra ticket"))
+							})
+						})
 					})
 				})
 			})
@@ -1056,6 +1070,124 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/builds/:build_id/comment", func() {
+		var (
+			response *http.Response
+			body     string
+		)
+
+		BeforeEach(func() {
+			body = `{"comment":"flaky, see infra ticket"}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/comment", bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the build is found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not set the comment", func() {
+						Expect(build.SetCommentCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+					})
+
+					It("checks authorization against the team of the build", func() {
+						Expect(fakeAccess.IsAuthorizedCallCount()).To(Equal(1))
+						Expect(fakeAccess.IsAuthorizedArgsForCall(0)).To(Equal("some-team"))
+					})
+
+					Context("when setting the comment succeeds", func() {
+						BeforeEach(func() {
+							build.SetCommentReturns(nil)
+						})
+
+						It("returns 200", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+						})
+
+						It("sets the comment on the build", func() {
+							Expect(build.SetCommentCallCount()).To(Equal(1))
+							Expect(build.SetCommentArgsForCall(0)).To(Equal("flaky, see infra ticket"))
+						})
+					})
+
+					Context("when setting the comment fails", func() {
+						BeforeEach(func() {
+							build.SetCommentReturns(errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when the request body is malformed", func() {
+						BeforeEach(func() {
+							body = `{`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+
+						It("does not set the comment", func() {
+							Expect(build.SetCommentCallCount()).To(BeZero())
+						})
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
@@ -1264,6 +1396,91 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/queue", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/queue")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(build, true, nil)
+				build.JobNameReturns("job1")
+				build.TeamNameReturns("some-team")
+				build.QueuePositionReturns(atc.BuildQueuePosition{
+					BuildID:   42,
+					BuildName: "3",
+					JobName:   "job1",
+					Priority:  10,
+					Position:  2,
+				}, true, nil)
+			})
+
+			Context("when not authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(false)
+					build.PipelineReturns(fakePipeline, true, nil)
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				It("returns OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("returns the queue position of the build", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"build_id": 42,
+						"build_name": "3",
+						"job_name": "job1",
+						"priority": 10,
+						"position": 2
+					}`))
+				})
+
+				Context("when the build is not queued", func() {
+					BeforeEach(func() {
+						build.QueuePositionReturns(atc.BuildQueuePosition{}, false, nil)
+					})
+
+					It("returns Not Found", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when looking up the queue position fails", func() {
+					BeforeEach(func() {
+						build.QueuePositionReturns(atc.BuildQueuePosition{}, false, errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/plan", func() {
 		var plan *json.RawMessage
 
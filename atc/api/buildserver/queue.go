@@ -0,0 +1,34 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildQueue(build db.Build) http.Handler {
+	logger := s.logger.Session("build-queue", lager.Data{"build-id": build.ID()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		position, found, err := build.QueuePosition()
+		if err != nil {
+			logger.Error("failed-to-get-queue-position", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(position)
+		if err != nil {
+			logger.Error("failed-to-encode-queue-position", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
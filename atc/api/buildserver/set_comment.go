@@ -0,0 +1,35 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SetComment(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-comment", lager.Data{
+			"build": build.ID(),
+		})
+
+		var reqBody atc.SetBuildCommentRequestBody
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = build.SetComment(reqBody.Comment)
+		if err != nil {
+			logger.Error("failed-to-set-comment", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -29,7 +29,7 @@ var _ = Describe("cc.xml", func() {
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 	})
 
 	Describe("GET /api/v1/teams/:team_name/cc.xml", func() {
@@ -108,7 +108,7 @@ var _ = Describe("Config API", func() {
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 	})
 
 	Describe("GET /api/v1/teams/:team_name/pipelines/:name/config", func() {
@@ -374,8 +374,8 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							pipelineRef, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+							Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
@@ -466,8 +466,8 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							pipelineRef, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+							Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
@@ -510,8 +510,8 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								pipelineRef, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+								Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
 										{
@@ -780,8 +780,8 @@ jobs:
 									It("passes validation and saves it un-interpolated", func() {
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-										name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-										Expect(name).To(Equal("a-pipeline"))
+										pipelineRef, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+										Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 										Expect(id).To(Equal(db.ConfigVersion(42)))
 										Expect(initiallyPaused).To(BeTrue())
@@ -940,8 +940,8 @@ jobs:
 					It("saves it", func() {
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-						name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-						Expect(name).To(Equal("a-pipeline"))
+						pipelineRef, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+						Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
 								{
@@ -80,7 +80,7 @@ var _ = Describe("Containers API", func() {
 		})
 	})
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 	})
 
 	Describe("GET /api/v1/teams/a-team/containers", func() {
@@ -362,8 +362,8 @@ var _ = Describe("Containers API", func() {
 					_, err := client.Do(req)
 					Expect(err).NotTo(HaveOccurred())
 
-					_, pipelineName, resourceName, secretManager, varSourcePool := dbTeam.FindCheckContainersArgsForCall(0)
-					Expect(pipelineName).To(Equal("some-pipeline"))
+					_, pipelineRef, resourceName, secretManager, varSourcePool := dbTeam.FindCheckContainersArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(resourceName).To(Equal("some-resource"))
 					Expect(secretManager).To(Equal(fakeSecretManager))
 					Expect(varSourcePool).To(Equal(fakeVarSourcePool))
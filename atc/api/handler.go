@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/artifactserver"
+	"github.com/concourse/concourse/atc/api/auditserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/checkserver"
@@ -20,9 +21,12 @@ import (
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
+	"github.com/concourse/concourse/atc/api/tokenserver"
 	"github.com/concourse/concourse/atc/api/usersserver"
+	"github.com/concourse/concourse/atc/api/varserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/wallserver"
+	"github.com/concourse/concourse/atc/api/webhookserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -53,6 +57,7 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbAuditEventRepository db.AuditEventRepository,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 
@@ -70,6 +75,7 @@ func NewHandler(
 	credsManagers creds.Managers,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	dbWall db.Wall,
+	dbEncryptionKeyRotation db.EncryptionKeyRotation,
 ) (http.Handler, error) {
 
 	absCLIDownloadsDir, err := filepath.Abs(cliDownloadsDir)
@@ -96,10 +102,14 @@ func NewHandler(
 	containerServer := containerserver.NewServer(logger, workerClient, secretManager, varSourcePool, interceptTimeoutFactory, containerRepository, destroyer)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
-	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
+	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers, dbEncryptionKeyRotation)
 	artifactServer := artifactserver.NewServer(logger, workerClient)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
+	auditServer := auditserver.NewServer(logger, externalURL, dbAuditEventRepository)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	webhookServer := webhookserver.NewServer(logger, dbTeamFactory)
+	tokenServer := tokenserver.NewServer(logger)
+	varServer := varserver.NewServer(logger, secretManager, varSourcePool)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -116,6 +126,8 @@ func NewHandler(
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
+		atc.SetBuildComment:     buildHandlerFactory.HandlerFor(buildServer.SetComment),
+		atc.GetBuildQueue:       buildHandlerFactory.HandlerFor(buildServer.GetBuildQueue),
 
 		atc.GetCheck: http.HandlerFunc(checkServer.GetCheck),
 
@@ -124,6 +136,7 @@ func NewHandler(
 		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
 		atc.ListJobBuilds:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
 		atc.ListJobInputs:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobInputs),
+		atc.ListJobQueue:   pipelineHandlerFactory.HandlerFor(jobServer.ListJobQueue),
 		atc.GetJobBuild:    pipelineHandlerFactory.HandlerFor(jobServer.GetJobBuild),
 		atc.CreateJobBuild: pipelineHandlerFactory.HandlerFor(jobServer.CreateJobBuild),
 		atc.RerunJobBuild:  pipelineHandlerFactory.HandlerFor(jobServer.RerunJobBuild),
@@ -145,6 +158,7 @@ func NewHandler(
 		atc.OrderPipelines:      http.HandlerFunc(pipelineServer.OrderPipelines),
 		atc.PausePipeline:       pipelineHandlerFactory.HandlerFor(pipelineServer.PausePipeline),
 		atc.UnpausePipeline:     pipelineHandlerFactory.HandlerFor(pipelineServer.UnpausePipeline),
+		atc.ArchivePipeline:     pipelineHandlerFactory.HandlerFor(pipelineServer.ArchivePipeline),
 		atc.ExposePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.ExposePipeline),
 		atc.HidePipeline:        pipelineHandlerFactory.HandlerFor(pipelineServer.HidePipeline),
 		atc.GetVersionsDB:       pipelineHandlerFactory.HandlerFor(pipelineServer.GetVersionsDB),
@@ -153,6 +167,8 @@ func NewHandler(
 		atc.CreatePipelineBuild: pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:       pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
 
+		atc.ResolveVar: pipelineHandlerFactory.HandlerFor(varServer.ResolveVar),
+
 		atc.ListAllResources:        http.HandlerFunc(resourceServer.ListAllResources),
 		atc.ListResources:           pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
 		atc.ListResourceTypes:       pipelineHandlerFactory.HandlerFor(resourceServer.ListVersionedResourceTypes),
@@ -187,8 +203,12 @@ func NewHandler(
 		atc.GetInfo:      http.HandlerFunc(infoServer.Info),
 		atc.GetInfoCreds: http.HandlerFunc(infoServer.Creds),
 
+		atc.GetEncryptionKeyRotation: http.HandlerFunc(infoServer.EncryptionKeyRotation),
+
 		atc.ListActiveUsersSince: http.HandlerFunc(usersServer.GetUsersSince),
 
+		atc.ListAuditEvents: http.HandlerFunc(auditServer.ListAuditEvents),
+
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
@@ -206,6 +226,14 @@ func NewHandler(
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
 
+		atc.SetTeamWebhook:     teamHandlerFactory.HandlerFor(webhookServer.SetWebhook),
+		atc.DestroyTeamWebhook: teamHandlerFactory.HandlerFor(webhookServer.DestroyWebhook),
+		atc.ReceiveTeamWebhook: http.HandlerFunc(webhookServer.ReceiveWebhook),
+
+		atc.CreateAPIToken: teamHandlerFactory.HandlerFor(tokenServer.CreateAPIToken),
+		atc.ListAPITokens:  teamHandlerFactory.HandlerFor(tokenServer.ListAPITokens),
+		atc.RevokeAPIToken: teamHandlerFactory.HandlerFor(tokenServer.RevokeAPIToken),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	awsssm "github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds/credhub"
 	"github.com/concourse/concourse/atc/creds/secretsmanager"
 	"github.com/concourse/concourse/atc/creds/ssm"
@@ -188,6 +190,8 @@ var _ = Describe("Pipelines API", func() {
 					PathPrefix: "testpath",
 					TLS:        tls,
 					Auth:       authConfig,
+
+					LeaseExpiryWarning: 5 * time.Minute,
 				}
 
 				err := vaultManager.Init(lager.NewLogger("test"))
@@ -257,6 +261,7 @@ var _ = Describe("Pipelines API", func() {
             "path_prefix": "testpath",
 			"shared_path": "",
 			"namespace": "testnamespace",
+			"lease_expiry_warning": 300000000000,
             "ca_cert": "",
             "server_name": "server-name",
 						"auth_backend": "backend-server",
@@ -462,4 +467,75 @@ var _ = Describe("Pipelines API", func() {
 
 		})
 	})
+
+	Describe("GET /api/v1/info/encryption", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/info/encryption")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when a rotation is running", func() {
+				BeforeEach(func() {
+					dbEncryptionKeyRotation.StatusReturns(atc.EncryptionKeyRotation{
+						State:     atc.EncryptionKeyRotationRunning,
+						StartedAt: 42,
+						LastError: "disaster",
+						Columns: []atc.EncryptionKeyRotationColumn{
+							{Table: "teams", Column: "legacy_auth", RowsTotal: 2, RowsProcessed: 2, RowsRotated: 1, Done: true},
+							{Table: "builds", Column: "private_plan", RowsTotal: 1000, RowsProcessed: 500, RowsRotated: 500},
+						},
+					}, nil)
+				})
+
+				It("returns 200 with the progress of the rotation", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"state": "running",
+						"started_at": 42,
+						"last_error": "disaster",
+						"columns": [
+							{"table": "teams", "column": "legacy_auth", "rows_total": 2, "rows_processed": 2, "rows_rotated": 1, "done": true},
+							{"table": "builds", "column": "private_plan", "rows_total": 1000, "rows_processed": 500, "rows_rotated": 500, "done": false}
+						]
+					}`))
+				})
+			})
+
+			Context("when getting the status fails", func() {
+				BeforeEach(func() {
+					dbEncryptionKeyRotation.StatusReturns(atc.EncryptionKeyRotation{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
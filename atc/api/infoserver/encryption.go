@@ -0,0 +1,28 @@
+package infoserver
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// EncryptionKeyRotation returns the progress of the most recent rotation of
+// the database encryption key. If the key has never been rotated the
+// response will be empty.
+func (s *Server) EncryptionKeyRotation(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("encryption-key-rotation")
+
+	rotation, err := s.keyRotation.Status()
+	if err != nil {
+		logger.Error("failed-to-get-status", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(rotation)
+	if err != nil {
+		logger.Error("failed-to-encode-rotation", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -3,6 +3,7 @@ package infoserver
 import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
 )
 
 type Server struct {
@@ -12,6 +13,7 @@ type Server struct {
 	externalURL   string
 	clusterName   string
 	credsManagers creds.Managers
+	keyRotation   db.EncryptionKeyRotation
 }
 
 func NewServer(
@@ -21,6 +23,7 @@ func NewServer(
 	externalURL string,
 	clusterName string,
 	credsManagers creds.Managers,
+	keyRotation db.EncryptionKeyRotation,
 ) *Server {
 	return &Server{
 		logger:        logger,
@@ -29,5 +32,6 @@ func NewServer(
 		externalURL:   externalURL,
 		clusterName:   clusterName,
 		credsManagers: credsManagers,
+		keyRotation:   keyRotation,
 	}
 }
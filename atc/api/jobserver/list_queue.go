@@ -0,0 +1,41 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListJobQueue(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-job-queue")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		queue, err := job.QueuedBuilds()
+		if err != nil {
+			logger.Error("failed-to-get-queued-builds", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(queue)
+		if err != nil {
+			logger.Error("failed-to-encode-queued-builds", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
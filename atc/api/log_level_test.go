@@ -27,7 +27,7 @@ var _ = Describe("Log Level API", func() {
 		})
 
 		JustBeforeEach(func() {
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 			req, err := http.NewRequest("PUT", server.URL+"/api/v1/log-level", bytes.NewBufferString(logLevelPayload))
 			Expect(err).NotTo(HaveOccurred())
 
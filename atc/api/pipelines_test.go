@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -87,7 +88,7 @@ var _ = Describe("Pipelines API", func() {
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 	})
 
 	Describe("GET /api/v1/pipelines", func() {
@@ -378,8 +379,11 @@ var _ = Describe("Pipelines API", func() {
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name", func() {
 		var response *http.Response
 		var fakePipeline *dbfakes.FakePipeline
+		var query url.Values
 
 		BeforeEach(func() {
+			query = url.Values{}
+
 			fakePipeline = new(dbfakes.FakePipeline)
 			fakePipeline.IDReturns(4)
 			fakePipeline.NameReturns("some-specific-pipeline")
@@ -401,7 +405,7 @@ var _ = Describe("Pipelines API", func() {
 		})
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/some-specific-pipeline", nil)
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/some-specific-pipeline?"+query.Encode(), nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Set("Content-Type", "application/json")
@@ -460,6 +464,41 @@ var _ = Describe("Pipelines API", func() {
 						]
 					}`))
 			})
+
+			Context("when requesting an instance of the pipeline", func() {
+				BeforeEach(func() {
+					query = atc.PipelineRef{
+						Name:         "some-specific-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}.QueryParams()
+
+					fakePipeline.InstanceVarsReturns(atc.InstanceVars{"branch": "feature"})
+				})
+
+				It("looks up the pipeline by its instance vars", func() {
+					Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{
+						Name:         "some-specific-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}))
+				})
+
+				It("includes the instance vars in the pipeline JSON", func() {
+					var pipeline atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipeline)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(pipeline.InstanceVars).To(Equal(atc.InstanceVars{"branch": "feature"}))
+				})
+			})
+
+			Context("when the instance vars are malformed", func() {
+				BeforeEach(func() {
+					query = url.Values{atc.InstanceVarsQueryParam: []string{"{nope"}}
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
 		})
 
 		Context("when authenticated as another team", func() {
@@ -822,8 +861,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline-name"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline-name"}))
 				})
 
 				It("deletes the named pipeline from the database", func() {
@@ -895,8 +934,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when pausing the pipeline succeeds", func() {
@@ -975,8 +1014,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when unpausing the pipeline succeeds", func() {
@@ -1004,6 +1043,99 @@ var _ = Describe("Pipelines API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
+
+				Context("when the pipeline is archived", func() {
+					BeforeEach(func() {
+						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						dbPipeline.ArchivedReturns(true)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not unpause the pipeline", func() {
+						Expect(dbPipeline.UnpauseCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/archive", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/archive", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+				})
+
+				It("injects the proper pipelineDB", func() {
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+				})
+
+				Context("when archiving the pipeline succeeds", func() {
+					BeforeEach(func() {
+						dbPipeline.ArchiveReturns(nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("archives the pipeline", func() {
+						Expect(dbPipeline.ArchiveCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when archiving the pipeline fails", func() {
+					BeforeEach(func() {
+						dbPipeline.ArchiveReturns(errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
 			})
 
 			Context("when requester does not belong to the team", func() {
@@ -1059,8 +1191,8 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when exposing the pipeline succeeds", func() {
@@ -1137,8 +1269,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipeline", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when hiding the pipeline succeeds", func() {
@@ -1525,8 +1657,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipeline", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				It("returns 204", func() {
@@ -1862,6 +1994,171 @@ var _ = Describe("Pipelines API", func() {
 								"reap_time": 200
 						}`))
 					})
+
+					Context("when the plan gets inputs from remote sources", func() {
+						var fakeGitResource *dbfakes.FakeResource
+						var fakeResourceType *dbfakes.FakeResourceType
+
+						BeforeEach(func() {
+							fakeGitResource = new(dbfakes.FakeResource)
+							fakeGitResource.NameReturns("some-repo")
+							fakeGitResource.TypeReturns("git")
+							fakeGitResource.SourceReturns(atc.Source{
+								"uri":         "git@example.com:some/repo.git",
+								"branch":      "master",
+								"private_key": "((private-key))",
+							})
+							fakeGitResource.TagsReturns(atc.Tags{"some-tag"})
+
+							fakeOtherResource := new(dbfakes.FakeResource)
+							fakeOtherResource.NameReturns("some-image")
+							fakeOtherResource.TypeReturns("registry-image")
+							fakeOtherResource.SourceReturns(atc.Source{"repository": "some/image"})
+
+							dbPipeline.ResourcesReturns(db.Resources{fakeOtherResource, fakeGitResource}, nil)
+
+							fakeResourceType = new(dbfakes.FakeResourceType)
+							fakeResourceType.NameReturns("registry-image")
+							fakeResourceType.TypeReturns("docker-image")
+							fakeResourceType.SourceReturns(atc.Source{"repository": "concourse/registry-image-resource"})
+							fakeResourceType.VersionReturns(atc.Version{"digest": "some-digest"})
+
+							dbPipeline.ResourceTypesReturns(db.ResourceTypes{fakeResourceType}, nil)
+
+							plan = atc.Plan{
+								Do: &atc.DoPlan{
+									{
+										Aggregate: &atc.AggregatePlan{
+											{
+												Get: &atc.GetPlan{
+													Name:     "image",
+													Resource: "some-image",
+													Version:  &atc.Version{"digest": "some-other-digest"},
+												},
+											},
+											{
+												Get: &atc.GetPlan{
+													Name:    "repo",
+													Type:    "git",
+													Source:  atc.Source{"uri": "git@example.com:some/repo.git"},
+													Version: &atc.Version{"ref": "some-ref"},
+												},
+											},
+											{
+												Get: &atc.GetPlan{
+													Name:    "other-repo",
+													Type:    "git",
+													Source:  atc.Source{"uri": "https://example.com/other/repo.git"},
+													Version: &atc.Version{"ref": "some-ref"},
+												},
+											},
+										},
+									},
+									plan,
+								},
+							}
+						})
+
+						It("creates a started build with the pipeline's resource configuration", func() {
+							versionedResourceTypes := atc.VersionedResourceTypes{
+								{
+									ResourceType: atc.ResourceType{
+										Name:   "registry-image",
+										Type:   "docker-image",
+										Source: atc.Source{"repository": "concourse/registry-image-resource"},
+									},
+									Version: atc.Version{"digest": "some-digest"},
+								},
+							}
+
+							Expect(dbPipeline.CreateStartedBuildCallCount()).To(Equal(1))
+
+							gets := *dbPipeline.CreateStartedBuildArgsForCall(0).Do
+							Expect((*gets[0].Aggregate)[0].Get).To(Equal(&atc.GetPlan{
+								Name:                   "image",
+								Resource:               "some-image",
+								Type:                   "registry-image",
+								Source:                 atc.Source{"repository": "some/image"},
+								Version:                &atc.Version{"digest": "some-other-digest"},
+								VersionedResourceTypes: versionedResourceTypes,
+							}))
+
+							Expect((*gets[0].Aggregate)[1].Get).To(Equal(&atc.GetPlan{
+								Name: "repo",
+								Type: "git",
+								Source: atc.Source{
+									"uri":         "git@example.com:some/repo.git",
+									"private_key": "((private-key))",
+								},
+								Tags:                   atc.Tags{"some-tag"},
+								Version:                &atc.Version{"ref": "some-ref"},
+								VersionedResourceTypes: versionedResourceTypes,
+							}))
+
+							Expect((*gets[0].Aggregate)[2].Get).To(Equal(&atc.GetPlan{
+								Name:                   "other-repo",
+								Type:                   "git",
+								Source:                 atc.Source{"uri": "https://example.com/other/repo.git"},
+								Version:                &atc.Version{"ref": "some-ref"},
+								VersionedResourceTypes: versionedResourceTypes,
+							}))
+						})
+
+						Context("when a git input gives the branch containing its ref", func() {
+							BeforeEach(func() {
+								(*(*plan.Do)[0].Aggregate)[1].Get.Source["branch"] = "some-branch"
+							})
+
+							It("clones that branch rather than the resource's", func() {
+								Expect(dbPipeline.CreateStartedBuildCallCount()).To(Equal(1))
+
+								gets := *dbPipeline.CreateStartedBuildArgsForCall(0).Do
+								Expect((*gets[0].Aggregate)[1].Get.Source).To(Equal(atc.Source{
+									"uri":         "git@example.com:some/repo.git",
+									"branch":      "some-branch",
+									"private_key": "((private-key))",
+								}))
+							})
+						})
+
+						Context("when a resource is not in the pipeline", func() {
+							BeforeEach(func() {
+								(*(*plan.Do)[0].Aggregate)[0].Get.Resource = "bogus-resource"
+							})
+
+							It("returns 400 Bad Request", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(string(body)).To(Equal("unknown resource: bogus-resource"))
+							})
+
+							It("does not create a build", func() {
+								Expect(dbPipeline.CreateStartedBuildCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when getting the resources fails", func() {
+							BeforeEach(func() {
+								dbPipeline.ResourcesReturns(nil, errors.New("nope"))
+							})
+
+							It("returns 500 Internal Server Error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+
+						Context("when getting the resource types fails", func() {
+							BeforeEach(func() {
+								dbPipeline.ResourceTypesReturns(nil, errors.New("nope"))
+							})
+
+							It("returns 500 Internal Server Error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+					})
 				})
 			})
 		})
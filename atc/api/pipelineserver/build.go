@@ -2,6 +2,7 @@ package pipelineserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -21,6 +22,20 @@ func (s *Server) CreateBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		err = resolveGetPlans(pipeline, &plan)
+		if err != nil {
+			if _, ok := err.(unknownResourceError); ok {
+				logger.Info("unknown-resource", lager.Data{"error": err.Error()})
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "%s", err)
+				return
+			}
+
+			logger.Error("failed-to-resolve-get-plans", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		build, err := pipeline.CreateStartedBuild(plan)
 		if err != nil {
 			logger.Error("failed-to-create-one-off-build", err)
@@ -38,3 +53,97 @@ func (s *Server) CreateBuild(pipeline db.Pipeline) http.Handler {
 		}
 	})
 }
+
+type unknownResourceError struct {
+	resourceName string
+}
+
+func (err unknownResourceError) Error() string {
+	return fmt.Sprintf("unknown resource: %s", err.resourceName)
+}
+
+// resolveGetPlans completes the get steps of a one-off build which only name
+// a resource of the pipeline, and lends the source of the pipeline's git
+// resources to the get steps fetching the same repository so that they're
+// authenticated the same way.
+//
+// Any ((vars)) in the sources are left as they are, to be resolved by the
+// build in the pipeline's scope.
+func resolveGetPlans(pipeline db.Pipeline, plan *atc.Plan) error {
+	var gets []*atc.GetPlan
+	plan.Each(func(p *atc.Plan) {
+		if p.Get == nil {
+			return
+		}
+
+		if (p.Get.Type == "" && p.Get.Resource != "") || (p.Get.Type == "git" && p.Get.Resource == "") {
+			gets = append(gets, p.Get)
+		}
+	})
+
+	if len(gets) == 0 {
+		return nil
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return err
+	}
+
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return err
+	}
+
+	for _, get := range gets {
+		if get.Type == "" {
+			resource, found := resources.Lookup(get.Resource)
+			if !found {
+				return unknownResourceError{get.Resource}
+			}
+
+			get.Type = resource.Type()
+			get.Source = resource.Source()
+
+			if len(get.Tags) == 0 {
+				get.Tags = resource.Tags()
+			}
+		} else {
+			resource, found := gitResourceForURI(resources, get.Source["uri"])
+			if found {
+				source := atc.Source{}
+				for key, value := range resource.Source() {
+					// the resource's branch would limit the clone to it; the
+					// branch containing the ref, if any, comes with the input
+					if key != "branch" {
+						source[key] = value
+					}
+				}
+
+				for key, value := range get.Source {
+					source[key] = value
+				}
+
+				get.Source = source
+
+				if len(get.Tags) == 0 {
+					get.Tags = resource.Tags()
+				}
+			}
+		}
+
+		get.VersionedResourceTypes = resourceTypes.Deserialize()
+	}
+
+	return nil
+}
+
+func gitResourceForURI(resources db.Resources, uri interface{}) (db.Resource, bool) {
+	for _, resource := range resources {
+		if resource.Type() == "git" && resource.Source()["uri"] == uri {
+			return resource, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,27 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func APIToken(apiToken db.APIToken) atc.APIToken {
+	presented := atc.APIToken{
+		Name:           apiToken.Name(),
+		TeamName:       apiToken.TeamName(),
+		Role:           apiToken.Role(),
+		ServiceAccount: apiToken.ServiceAccount(),
+		CreatedBy:      apiToken.CreatedBy(),
+		CreatedAt:      apiToken.CreatedAt().Unix(),
+	}
+
+	if !apiToken.ExpiresAt().IsZero() {
+		presented.ExpiresAt = apiToken.ExpiresAt().Unix()
+	}
+
+	if !apiToken.LastUsedAt().IsZero() {
+		presented.LastUsedAt = apiToken.LastUsedAt().Unix()
+	}
+
+	return presented
+}
@@ -1,6 +1,10 @@
 package present
 
 import (
+	"net/url"
+	"sort"
+	"strings"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -10,5 +14,121 @@ func Team(team db.Team) atc.Team {
 		ID:   team.ID(),
 		Name: team.Name(),
 		Auth: team.Auth(),
+
+		PipelineAuth:         pipelineAuth(team.PipelineAuth()),
+		DefaultBuildPriority: team.DefaultBuildPriority(),
+		MaxRunningBuilds:     team.MaxRunningBuilds(),
+		VarSources:           redactedVarSources(team.VarSources()),
+		SyslogDrains:         redactedSyslogDrains(team.SyslogDrains()),
+	}
+}
+
+// publicVarSourceKeys are the keys of a var source's config whose values are
+// presented as-is; every other value may be a secret, e.g. a client token.
+var publicVarSourceKeys = map[string]bool{
+	"url":                  true,
+	"path_prefix":          true,
+	"shared_path":          true,
+	"namespace":            true,
+	"server_name":          true,
+	"auth_backend":         true,
+	"insecure_skip_verify": true,
+}
+
+func pipelineAuth(auth atc.PipelineAuth) *atc.PipelineAuth {
+	if len(auth) == 0 {
+		return nil
+	}
+
+	return &auth
+}
+
+const redacted = "((redacted))"
+
+func redactedVarSources(varSources atc.VarSourceConfigs) *atc.VarSourceConfigs {
+	if len(varSources) == 0 {
+		return nil
+	}
+
+	presented := atc.VarSourceConfigs{}
+	for _, vs := range varSources {
+		config, ok := vs.Config.(map[string]interface{})
+		if !ok {
+			vs.Config = redactValue(vs.Config)
+			presented = append(presented, vs)
+			continue
+		}
+
+		redactedConfig := map[string]interface{}{}
+		for key, value := range config {
+			if _, isMap := value.(map[string]interface{}); publicVarSourceKeys[key] && !isMap {
+				redactedConfig[key] = value
+			} else {
+				redactedConfig[key] = redactValue(value)
+			}
+		}
+
+		vs.Config = redactedConfig
+		presented = append(presented, vs)
 	}
+
+	return &presented
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		redactedMap := map[string]interface{}{}
+		for key, value := range v {
+			redactedMap[key] = redactValue(value)
+		}
+		return redactedMap
+	case []interface{}:
+		redactedList := []interface{}{}
+		for _, value := range v {
+			redactedList = append(redactedList, redactValue(value))
+		}
+		return redactedList
+	default:
+		return redacted
+	}
+}
+
+// redactedURLValue replaces the credentials within a URL, where the
+// parentheses of redacted would have to be escaped.
+const redactedURLValue = "redacted"
+
+// redactedSyslogDrains hides the credentials a drain's URL may carry, i.e. its
+// password and the values of its query parameters.
+func redactedSyslogDrains(drains []atc.SyslogDrainConfig) *[]atc.SyslogDrainConfig {
+	if len(drains) == 0 {
+		return nil
+	}
+
+	presented := []atc.SyslogDrainConfig{}
+	for _, drain := range drains {
+		u, err := url.Parse(drain.URL)
+		if err != nil {
+			presented = append(presented, atc.SyslogDrainConfig{URL: redacted})
+			continue
+		}
+
+		if _, hasPassword := u.User.Password(); hasPassword {
+			u.User = url.UserPassword(u.User.Username(), redactedURLValue)
+		}
+
+		if u.RawQuery != "" {
+			var params []string
+			for key := range u.Query() {
+				params = append(params, url.QueryEscape(key)+"="+redactedURLValue)
+			}
+			sort.Strings(params)
+
+			u.RawQuery = strings.Join(params, "&")
+		}
+
+		presented = append(presented, atc.SyslogDrainConfig{URL: u.String()})
+	}
+
+	return &presented
 }
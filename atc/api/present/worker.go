@@ -32,6 +32,8 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		ActiveContainers: workerInfo.ActiveContainers(),
 		ActiveVolumes:    workerInfo.ActiveVolumes(),
 		ActiveTasks:      activeTasks,
+		CPULoad:          workerInfo.CPULoad(),
+		MemoryLoad:       workerInfo.MemoryLoad(),
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
 		Tags:             workerInfo.Tags(),
@@ -44,11 +44,11 @@ var _ = Describe("TeamScopedHandlerFactory", func() {
 		handlerFactory := api.NewTeamScopedHandlerFactory(logger, fakeTeamFactory)
 		innerHandler := handlerFactory.HandlerFor(delegate.GetHandler)
 
-		handler = accessor.NewHandler(innerHandler, fakeAccessor, "some-action", new(auditorfakes.FakeAuditor))
+		handler = accessor.NewHandler(lagertest.NewTestLogger("test"), innerHandler, fakeAccessor, "some-action", new(auditorfakes.FakeAuditor))
 	})
 
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 		server = httptest.NewServer(handler)
 
 		fullUrl := fmt.Sprintf("%s?:team_name=some-team", server.URL)
@@ -2,6 +2,7 @@ package teamserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -9,6 +10,8 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/configvalidate"
+	"github.com/concourse/concourse/atc/syslog"
 )
 
 func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
@@ -34,6 +37,38 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if atcTeam.VarSources != nil {
+		err = configvalidate.ValidateVarSources(*atcTeam.VarSources)
+		if err != nil {
+			hLog.Info("invalid-var-sources", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid var sources: %s", err)
+			return
+		}
+	}
+
+	if atcTeam.PipelineAuth != nil {
+		err = validatePipelineAuth(acc, *atcTeam.PipelineAuth)
+		if err != nil {
+			hLog.Info("invalid-pipeline-auth", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid pipeline auth: %s", err)
+			return
+		}
+	}
+
+	if atcTeam.SyslogDrains != nil {
+		for _, drain := range *atcTeam.SyslogDrains {
+			err = syslog.ValidateTeamDrain(drain.URL)
+			if err != nil {
+				hLog.Info("invalid-syslog-drains", lager.Data{"error": err.Error()})
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintf(w, "invalid syslog drains: %s", err)
+				return
+			}
+		}
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
@@ -42,8 +77,8 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if found {
-		hLog.Debug("updating-credentials")
-		err = team.UpdateProviderAuth(atcTeam.Auth)
+		hLog.Debug("updating-team")
+		err = team.Update(atcTeam)
 		if err != nil {
 			hLog.Error("failed-to-update-team", err, lager.Data{"teamName": teamName})
 			w.WriteHeader(http.StatusInternalServerError)
@@ -74,3 +109,21 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// validatePipelineAuth checks that only known roles are bound in pipelines,
+// other than owner, as ownership always applies to the team as a whole.
+func validatePipelineAuth(acc accessor.Access, pipelineAuth atc.PipelineAuth) error {
+	for pipeline, auth := range pipelineAuth {
+		for role, config := range auth {
+			if role == "owner" || !acc.IsKnownRole(role) {
+				return fmt.Errorf("role '%s' cannot be bound in pipeline '%s'", role, pipeline)
+			}
+
+			if len(config["users"]) == 0 && len(config["groups"]) == 0 {
+				return fmt.Errorf("role '%s' in pipeline '%s' must be bound to at least one user or group", role, pipeline)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package tokenserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) CreateAPIToken(team db.Team) http.Handler {
+	logger := s.logger.Session("create-api-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body atc.CreateAPITokenRequestBody
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if body.Name == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "token name must be specified")
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		// tokens minted by a token would outlive its expiry and revocation
+		if acc.IsAPIToken() {
+			logger.Info("created-by-api-token")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, "API tokens can't be used to create other API tokens")
+			return
+		}
+
+		if !acc.IsKnownRole(body.Role) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "unknown role '%s'", body.Role)
+			return
+		}
+
+		var expiresAt time.Time
+		if body.ExpiresAt != 0 {
+			expiresAt = time.Unix(body.ExpiresAt, 0)
+
+			if !expiresAt.After(time.Now()) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, "token must expire in the future")
+				return
+			}
+		}
+
+		// a token can't grant more than its creator could do themselves, and
+		// only owners can act as anyone other than themselves
+		if !acc.HasRole(team.Name(), body.Role) || (body.ServiceAccount && !acc.IsOwner(team.Name())) {
+			logger.Info("not-permitted", lager.Data{"role": body.Role, "service-account": body.ServiceAccount})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		apiToken, token, err := team.CreateAPIToken(body.Name, body.Role, body.ServiceAccount, acc.UserName(), expiresAt)
+		if err != nil {
+			if err == db.ErrAPITokenExists {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprintf(w, "token '%s' already exists", body.Name)
+				return
+			}
+
+			logger.Error("failed-to-create-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logger.Info("created", lager.Data{
+			"name":            apiToken.Name(),
+			"role":            apiToken.Role(),
+			"service-account": apiToken.ServiceAccount(),
+			"created-by":      apiToken.CreatedBy(),
+		})
+
+		presented := present.APIToken(apiToken)
+		presented.Token = token
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-api-token", err)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package tokenserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListAPITokens(team db.Team) http.Handler {
+	logger := s.logger.Session("list-api-tokens")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiTokens, err := team.APITokens()
+		if err != nil {
+			logger.Error("failed-to-get-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		presented := []atc.APIToken{}
+		for _, apiToken := range apiTokens {
+			if canManage(acc, apiToken) {
+				presented = append(presented, present.APIToken(apiToken))
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package tokenserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) RevokeAPIToken(team db.Team) http.Handler {
+	logger := s.logger.Session("revoke-api-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenName := rata.Param(r, "token_name")
+
+		apiTokens, err := team.APITokens()
+		if err != nil {
+			logger.Error("failed-to-get-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		var apiToken db.APIToken
+		for _, t := range apiTokens {
+			if t.Name() == tokenName {
+				apiToken = t
+				break
+			}
+		}
+
+		// don't reveal the tokens of other users
+		if apiToken == nil || !canManage(acc, apiToken) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		found, err := team.RevokeAPIToken(tokenName)
+		if err != nil {
+			logger.Error("failed-to-revoke-api-token", err, lager.Data{"name": tokenName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		logger.Info("revoked", lager.Data{"name": tokenName, "revoked-by": acc.UserName()})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,23 @@
+package tokenserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger lager.Logger
+}
+
+func NewServer(logger lager.Logger) *Server {
+	return &Server{
+		logger: logger,
+	}
+}
+
+// canManage returns whether the user can see and revoke the token. Owners
+// can manage all of their team's tokens; everyone else only their own.
+func canManage(acc accessor.Access, apiToken db.APIToken) bool {
+	return acc.IsOwner(apiToken.TeamName()) || apiToken.CreatedBy() == acc.UserName()
+}
@@ -30,7 +30,7 @@ var _ = Describe("Users API", func() {
 	Context("GET /api/v1/users", func() {
 
 		JustBeforeEach(func() {
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 
 			req, err := http.NewRequest("GET", server.URL+"/api/v1/users", nil)
 			Expect(err).NotTo(HaveOccurred())
@@ -148,7 +148,7 @@ var _ = Describe("Users API", func() {
 		})
 
 		JustBeforeEach(func() {
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 
 			req, err := http.NewRequest("GET", server.URL+"/api/v1/users?since="+date, nil)
 			Expect(err).NotTo(HaveOccurred())
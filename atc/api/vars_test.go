@@ -0,0 +1,242 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/atc/creds/dummy"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Vars API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/vars/resolve", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = "name=some-var"
+
+			fakePipeline.TeamNameReturns("a-team")
+
+			globalSecrets := dummy.NewSecretsFactory([]dummy.VarFlag{
+				{Name: "a-team/some-var", Value: "team-value"},
+			}).NewSecrets()
+
+			sourceSecrets := dummy.NewSecretsFactory([]dummy.VarFlag{
+				{Name: "a-team/a-pipeline/some-var", Value: "source-value"},
+			}).NewSecrets()
+
+			fakePipeline.VariablesReturns(vars.NewMultiVars([]vars.Variables{
+				creds.NewVariables(globalSecrets, "a-team", "a-pipeline", false),
+				vars.NamedVariables{
+					"some-source": creds.NewVariables(sourceSecrets, "a-team", "a-pipeline", true),
+				},
+			}), nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/vars/resolve?"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("creates the vars of the pipeline", func() {
+				Expect(fakePipeline.VariablesCallCount()).To(Equal(1))
+				_, globalSecrets, varSourcePool := fakePipeline.VariablesArgsForCall(0)
+				Expect(globalSecrets).To(Equal(fakeSecretManager))
+				Expect(varSourcePool).To(Equal(fakeVarSourcePool))
+			})
+
+			It("returns every path the var is looked up at without the value", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"name": "some-var",
+					"lookups": [
+						{"path": "a-team/a-pipeline/some-var", "found": false, "chosen": false},
+						{"path": "a-team/some-var", "found": true, "chosen": true},
+						{"path": "some-var", "found": false, "chosen": false}
+					]
+				}`))
+			})
+
+			Context("when the var is from a var source", func() {
+				BeforeEach(func() {
+					query = "name=some-source:some-var"
+				})
+
+				It("returns the lookups made in the var source", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"name": "some-source:some-var",
+						"lookups": [
+							{"path": "a-team/a-pipeline/some-source:some-var", "found": false, "chosen": false},
+							{"path": "a-team/some-source:some-var", "found": false, "chosen": false},
+							{"path": "some-source:some-var", "found": false, "chosen": false},
+							{"source": "some-source", "path": "a-team/a-pipeline/some-var", "found": true, "chosen": true},
+							{"source": "some-source", "path": "a-team/some-var", "found": false, "chosen": false},
+							{"source": "some-source", "path": "some-var", "found": false, "chosen": false}
+						]
+					}`))
+				})
+			})
+
+			Context("when the var source is unknown", func() {
+				BeforeEach(func() {
+					query = "name=bogus:some-var"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("failed to trace var: unknown var source: bogus"))
+				})
+			})
+
+			Context("when looking up a path fails", func() {
+				BeforeEach(func() {
+					fakeSecrets := new(credsfakes.FakeSecrets)
+					fakeSecrets.NewSecretLookupPathsReturns([]creds.SecretLookupPath{
+						creds.NewSecretLookupWithPrefix("first/"),
+						creds.NewSecretLookupWithPrefix("second/"),
+					})
+					fakeSecrets.GetReturnsOnCall(0, nil, nil, false, errors.New("disaster"))
+					fakeSecrets.GetReturnsOnCall(1, "some-value", nil, true, nil)
+
+					fakePipeline.VariablesReturns(creds.NewVariables(fakeSecrets, "a-team", "a-pipeline", false), nil)
+				})
+
+				It("reports the error and chooses no lookup", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"name": "some-var",
+						"lookups": [
+							{"path": "first/some-var", "found": false, "chosen": false, "error": "disaster"},
+							{"path": "second/some-var", "found": true, "chosen": false}
+						]
+					}`))
+				})
+			})
+
+			Context("when the var is a local var", func() {
+				BeforeEach(func() {
+					query = "name=.:some-var"
+				})
+
+				It("reports it as local without looking it up", func() {
+					Expect(fakePipeline.VariablesCallCount()).To(BeZero())
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{"name": ".:some-var", "local": true, "lookups": []}`))
+				})
+			})
+
+			Context("when the var name is missing", func() {
+				BeforeEach(func() {
+					query = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when creating the vars fails", func() {
+				BeforeEach(func() {
+					fakePipeline.VariablesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when revealing the value", func() {
+				BeforeEach(func() {
+					query = "name=some-var&reveal=true"
+				})
+
+				Context("as a team owner", func() {
+					BeforeEach(func() {
+						fakeAccess.IsOwnerReturns(true)
+					})
+
+					It("returns the value of the chosen lookup", func() {
+						Expect(fakeAccess.IsOwnerArgsForCall(0)).To(Equal("a-team"))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"name": "some-var",
+							"value": "team-value",
+							"lookups": [
+								{"path": "a-team/a-pipeline/some-var", "found": false, "chosen": false},
+								{"path": "a-team/some-var", "found": true, "chosen": true},
+								{"path": "some-var", "found": false, "chosen": false}
+							]
+						}`))
+					})
+				})
+
+				Context("as a team member", func() {
+					BeforeEach(func() {
+						fakeAccess.IsOwnerReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(fakePipeline.VariablesCallCount()).To(BeZero())
+					})
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,99 @@
+package varserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/vars"
+)
+
+// ResolveVar reports every path a var of the pipeline is looked up at, in
+// the order they are tried when the pipeline runs, and which one the value is
+// taken from. Only team owners can have the value revealed.
+func (s *Server) ResolveVar(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("resolve-var")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		varName := r.URL.Query().Get(atc.ResolveVarQueryName)
+		if varName == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "missing var name")
+			return
+		}
+
+		reveal := r.URL.Query().Get(atc.ResolveVarQueryReveal) == "true"
+		if reveal && !accessor.GetAccessor(r).IsOwner(pipeline.TeamName()) {
+			logger.Debug("not-allowed-to-reveal")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, "only team owners can reveal the value of a var")
+			return
+		}
+
+		trace := atc.VarTrace{
+			Name:    varName,
+			Lookups: []atc.VarLookup{},
+		}
+
+		if strings.HasPrefix(varName, ".:") {
+			trace.Local = true
+			s.respond(logger, w, trace)
+			return
+		}
+
+		variables, err := pipeline.Variables(logger, s.secretManager, s.varSourcePool)
+		if err != nil {
+			logger.Error("failed-to-create-var-sources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, "failed to create var sources: %s", err)
+			return
+		}
+
+		lookups, err := vars.Trace(variables, vars.VariableDefinition{Name: varName})
+		if err != nil {
+			logger.Info("failed-to-trace-var", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "failed to trace var: %s", err)
+			return
+		}
+
+		chosen := vars.ChosenLookup(lookups)
+
+		for i, lookup := range lookups {
+			varLookup := atc.VarLookup{
+				Source: lookup.Source,
+				Path:   lookup.Path,
+				Found:  lookup.Found,
+				Chosen: i == chosen && lookup.Err == nil,
+			}
+
+			if lookup.Err != nil {
+				varLookup.Error = lookup.Err.Error()
+			}
+
+			if varLookup.Chosen && reveal {
+				trace.Value = lookup.Value
+			}
+
+			trace.Lookups = append(trace.Lookups, varLookup)
+		}
+
+		s.respond(logger, w, trace)
+	})
+}
+
+func (s *Server) respond(logger lager.Logger, w http.ResponseWriter, trace atc.VarTrace) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err := json.NewEncoder(w).Encode(trace)
+	if err != nil {
+		logger.Error("failed-to-encode-var-trace", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,24 @@
+package varserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type Server struct {
+	logger        lager.Logger
+	secretManager creds.Secrets
+	varSourcePool creds.VarSourcePool
+}
+
+func NewServer(
+	logger lager.Logger,
+	secretManager creds.Secrets,
+	varSourcePool creds.VarSourcePool,
+) *Server {
+	return &Server{
+		logger:        logger,
+		secretManager: secretManager,
+		varSourcePool: varSourcePool,
+	}
+}
@@ -32,7 +32,7 @@ var _ = Describe("Volumes API", func() {
 		var response *http.Response
 
 		JustBeforeEach(func() {
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 
 			var err error
 			response, err = client.Get(server.URL + "/api/v1/teams/a-team/volumes")
@@ -303,7 +303,7 @@ var _ = Describe("Volumes API", func() {
 
 		JustBeforeEach(func() {
 			var err error
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 
 			response, err = client.Do(req)
 			Expect(err).NotTo(HaveOccurred())
@@ -411,7 +411,7 @@ var _ = Describe("Volumes API", func() {
 			`)
 		})
 		JustBeforeEach(func() {
-			fakeAccessor.CreateReturns(fakeaccess)
+			fakeAccessor.CreateReturns(fakeaccess, nil)
 			req, err = http.NewRequest("PUT", server.URL+"/api/v1/volumes/report", body)
 			Expect(err).NotTo(HaveOccurred())
 			req.Header.Set("Content-Type", "application/json")
@@ -0,0 +1,401 @@
+package api_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhooks API", func() {
+	var response *http.Response
+
+	Describe("PUT /api/v1/teams/:team_name/webhooks/:provider", func() {
+		var provider, body string
+
+		BeforeEach(func() {
+			provider = "github"
+			body = `{"secret":"some-secret"}`
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/webhooks/"+provider, bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("sets the secret of the team's webhook", func() {
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+				Expect(dbTeam.SetWebhookSecretCallCount()).To(Equal(1))
+
+				provider, secret := dbTeam.SetWebhookSecretArgsForCall(0)
+				Expect(provider).To(Equal("github"))
+				Expect(secret).To(Equal("some-secret"))
+			})
+
+			Context("when the provider is unknown", func() {
+				BeforeEach(func() {
+					provider = "svn"
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the secret is missing", func() {
+				BeforeEach(func() {
+					body = `{}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when setting the secret fails", func() {
+				BeforeEach(func() {
+					dbTeam.SetWebhookSecretReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/webhooks/:provider", func() {
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/webhooks/github", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the webhook exists", func() {
+				BeforeEach(func() {
+					dbTeam.DeleteWebhookReturns(true, nil)
+				})
+
+				It("returns 204", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				It("deletes the webhook", func() {
+					Expect(dbTeam.DeleteWebhookCallCount()).To(Equal(1))
+					Expect(dbTeam.DeleteWebhookArgsForCall(0)).To(Equal("github"))
+				})
+			})
+
+			Context("when the webhook does not exist", func() {
+				BeforeEach(func() {
+					dbTeam.DeleteWebhookReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when deleting the webhook fails", func() {
+				BeforeEach(func() {
+					dbTeam.DeleteWebhookReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/webhooks/:provider", func() {
+		var (
+			provider string
+			header   http.Header
+			payload  string
+
+			fakePipeline                              *dbfakes.FakePipeline
+			matchingResource, otherMatchingResource   *dbfakes.FakeResource
+			otherResource, interpolatedSourceResource *dbfakes.FakeResource
+		)
+
+		sign := func(secret, payload string) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(payload))
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+
+		BeforeEach(func() {
+			provider = "github"
+			payload = `{
+				"repository": {
+					"clone_url": "https://github.com/org/repo.git",
+					"ssh_url": "git@github.com:org/repo.git",
+					"html_url": "https://github.com/org/repo"
+				}
+			}`
+			header = http.Header{
+				"X-Github-Event":      {"push"},
+				"X-Hub-Signature-256": {sign("some-secret", payload)},
+			}
+
+			dbTeam.WebhookSecretReturns("some-secret", true, nil)
+
+			matchingResource = new(dbfakes.FakeResource)
+			matchingResource.NameReturns("repo")
+			matchingResource.SourceReturns(atc.Source{"uri": "https://github.com/org/repo.git"})
+
+			otherMatchingResource = new(dbfakes.FakeResource)
+			otherMatchingResource.NameReturns("repo-ssh")
+			otherMatchingResource.SourceReturns(atc.Source{"uri": "git@github.com:Org/repo"})
+
+			otherResource = new(dbfakes.FakeResource)
+			otherResource.NameReturns("other-repo")
+			otherResource.SourceReturns(atc.Source{"uri": "https://github.com/org/other-repo.git"})
+
+			interpolatedSourceResource = new(dbfakes.FakeResource)
+			interpolatedSourceResource.NameReturns("no-uri")
+			interpolatedSourceResource.SourceReturns(atc.Source{"repository": "org/repo"})
+
+			fakePipeline = new(dbfakes.FakePipeline)
+			fakePipeline.NameReturns("some-pipeline")
+			fakePipeline.ResourcesReturns(db.Resources{
+				matchingResource,
+				otherResource,
+				otherMatchingResource,
+				interpolatedSourceResource,
+			}, nil)
+
+			dbTeam.PipelinesReturns([]db.Pipeline{fakePipeline}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/webhooks/"+provider, bytes.NewBufferString(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			request.Header = header
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the push event is signed with the team's secret", func() {
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("looks up the secret of the team's webhook", func() {
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+				Expect(dbTeam.WebhookSecretArgsForCall(0)).To(Equal("github"))
+			})
+
+			It("notifies every resource of the pushed repository", func() {
+				Expect(matchingResource.NotifyScanCallCount()).To(Equal(1))
+				Expect(otherMatchingResource.NotifyScanCallCount()).To(Equal(1))
+				Expect(otherResource.NotifyScanCallCount()).To(Equal(0))
+				Expect(interpolatedSourceResource.NotifyScanCallCount()).To(Equal(0))
+			})
+
+			It("returns the checked resources", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{"pipeline_name": "some-pipeline", "resource_name": "repo"},
+					{"pipeline_name": "some-pipeline", "resource_name": "repo-ssh"}
+				]`))
+			})
+
+			Context("when notifying a resource fails", func() {
+				BeforeEach(func() {
+					matchingResource.NotifyScanReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when the event is not a push event", func() {
+			BeforeEach(func() {
+				header.Set("X-GitHub-Event", "ping")
+			})
+
+			It("returns 200 without notifying any resource", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(matchingResource.NotifyScanCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the event is signed with another secret", func() {
+			BeforeEach(func() {
+				header.Set("X-Hub-Signature-256", sign("other-secret", payload))
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(matchingResource.NotifyScanCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the event is not signed", func() {
+			BeforeEach(func() {
+				header.Del("X-Hub-Signature-256")
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when receiving a gitlab push event", func() {
+			BeforeEach(func() {
+				provider = "gitlab"
+				payload = `{
+					"project": {
+						"git_http_url": "https://gitlab.com/org/repo.git",
+						"git_ssh_url": "git@gitlab.com:org/repo.git"
+					}
+				}`
+				header = http.Header{
+					"X-Gitlab-Event": {"Push Hook"},
+					"X-Gitlab-Token": {"some-secret"},
+				}
+
+				matchingResource.SourceReturns(atc.Source{"uri": "ssh://git@gitlab.com:22/org/repo.git"})
+			})
+
+			It("notifies the resources of the pushed repository", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(matchingResource.NotifyScanCallCount()).To(Equal(1))
+				Expect(otherMatchingResource.NotifyScanCallCount()).To(Equal(0))
+			})
+
+			Context("when the token is wrong", func() {
+				BeforeEach(func() {
+					header.Set("X-Gitlab-Token", "other-secret")
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+		})
+
+		Context("when receiving a bitbucket push event", func() {
+			BeforeEach(func() {
+				provider = "bitbucket"
+				payload = `{
+					"repository": {
+						"links": {"html": {"href": "https://bitbucket.org/org/repo"}}
+					}
+				}`
+				header = http.Header{
+					"X-Event-Key":     {"repo:push"},
+					"X-Hub-Signature": {sign("some-secret", payload)},
+				}
+
+				matchingResource.SourceReturns(atc.Source{"uri": "git@bitbucket.org:org/repo.git"})
+			})
+
+			It("notifies the resources of the pushed repository", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(matchingResource.NotifyScanCallCount()).To(Equal(1))
+				Expect(otherMatchingResource.NotifyScanCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the provider is unknown", func() {
+			BeforeEach(func() {
+				provider = "svn"
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the team has no webhook for the provider", func() {
+			BeforeEach(func() {
+				dbTeam.WebhookSecretReturns("", false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the team does not exist", func() {
+			BeforeEach(func() {
+				dbTeamFactory.FindTeamReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package webhookserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) DestroyWebhook(team db.Team) http.Handler {
+	logger := s.logger.Session("destroy-webhook")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		providerName := rata.Param(r, "provider")
+
+		found, err := team.DeleteWebhook(providerName)
+		if err != nil {
+			logger.Error("failed-to-delete-webhook", err, lager.Data{"provider": providerName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,178 @@
+package webhookserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// A provider verifies and parses the push events sent by a code hosting
+// service.
+type provider interface {
+	// Verify returns true if the event was signed with the given secret.
+	Verify(r *http.Request, body []byte, secret string) bool
+
+	// PushedRepositories returns the URIs of the repository which was pushed
+	// to. It returns false if the event is not a push event.
+	PushedRepositories(r *http.Request, body []byte) ([]string, bool, error)
+}
+
+var providers = map[string]provider{
+	atc.WebhookProviderGitHub:    gitHub{},
+	atc.WebhookProviderGitLab:    gitLab{},
+	atc.WebhookProviderBitbucket: bitbucket{},
+}
+
+type gitHub struct{}
+
+func (gitHub) Verify(r *http.Request, body []byte, secret string) bool {
+	if signature := r.Header.Get("X-Hub-Signature-256"); signature != "" {
+		return validSignature(sha256.New, "sha256=", signature, body, secret)
+	}
+
+	return validSignature(sha1.New, "sha1=", r.Header.Get("X-Hub-Signature"), body, secret)
+}
+
+func (gitHub) PushedRepositories(r *http.Request, body []byte) ([]string, bool, error) {
+	if r.Header.Get("X-GitHub-Event") != "push" {
+		return nil, false, nil
+	}
+
+	var payload struct {
+		Repository struct {
+			CloneURL string `json:"clone_url"`
+			SSHURL   string `json:"ssh_url"`
+			GitURL   string `json:"git_url"`
+			HTMLURL  string `json:"html_url"`
+		} `json:"repository"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return []string{
+		payload.Repository.CloneURL,
+		payload.Repository.SSHURL,
+		payload.Repository.GitURL,
+		payload.Repository.HTMLURL,
+	}, true, nil
+}
+
+// gitLab does not sign its events; the secret is sent as a token instead.
+type gitLab struct{}
+
+func (gitLab) Verify(r *http.Request, body []byte, secret string) bool {
+	return hmac.Equal([]byte(r.Header.Get("X-Gitlab-Token")), []byte(secret))
+}
+
+func (gitLab) PushedRepositories(r *http.Request, body []byte) ([]string, bool, error) {
+	switch r.Header.Get("X-Gitlab-Event") {
+	case "Push Hook", "Tag Push Hook":
+	default:
+		return nil, false, nil
+	}
+
+	var payload struct {
+		Project struct {
+			GitHTTPURL string `json:"git_http_url"`
+			GitSSHURL  string `json:"git_ssh_url"`
+			WebURL     string `json:"web_url"`
+		} `json:"project"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return []string{
+		payload.Project.GitHTTPURL,
+		payload.Project.GitSSHURL,
+		payload.Project.WebURL,
+	}, true, nil
+}
+
+type bitbucket struct{}
+
+func (bitbucket) Verify(r *http.Request, body []byte, secret string) bool {
+	return validSignature(sha256.New, "sha256=", r.Header.Get("X-Hub-Signature"), body, secret)
+}
+
+func (bitbucket) PushedRepositories(r *http.Request, body []byte) ([]string, bool, error) {
+	if r.Header.Get("X-Event-Key") != "repo:push" {
+		return nil, false, nil
+	}
+
+	var payload struct {
+		Repository struct {
+			Links struct {
+				HTML struct {
+					Href string `json:"href"`
+				} `json:"html"`
+			} `json:"links"`
+		} `json:"repository"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return []string{payload.Repository.Links.HTML.Href}, true, nil
+}
+
+func validSignature(h func() hash.Hash, prefix string, signature string, body []byte, secret string) bool {
+	if !strings.HasPrefix(signature, prefix) {
+		return false
+	}
+
+	actual, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(h, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(actual, mac.Sum(nil))
+}
+
+// normalizeRepositoryURI reduces the different URIs of a repository to the
+// same form, so that e.g. https://github.com/org/repo.git and
+// git@github.com:org/repo.git are considered equal.
+func normalizeRepositoryURI(uri string) string {
+	uri = strings.TrimSpace(uri)
+
+	if i := strings.Index(uri, "://"); i != -1 {
+		uri = uri[i+len("://"):]
+	} else if i := strings.Index(uri, ":"); i != -1 {
+		// scp-like syntax, e.g. git@github.com:org/repo.git
+		uri = uri[:i] + "/" + uri[i+1:]
+	}
+
+	host, path := uri, ""
+	if i := strings.Index(uri, "/"); i != -1 {
+		host, path = uri[:i], uri[i+1:]
+	}
+
+	if i := strings.LastIndex(host, "@"); i != -1 {
+		host = host[i+1:]
+	}
+
+	if i := strings.Index(host, ":"); i != -1 {
+		host = host[:i]
+	}
+
+	path = strings.TrimSuffix(strings.TrimSuffix(path, "/"), ".git")
+
+	return strings.ToLower(host + "/" + path)
+}
@@ -0,0 +1,155 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+// maxPayloadSize is the largest push event which is accepted, matching the
+// limit GitHub places on the events it sends.
+const maxPayloadSize = 25 * 1024 * 1024
+
+// ReceiveWebhook checks every resource of the team whose source.uri is the
+// repository that was pushed to, after verifying the event was sent by the
+// provider with the team's secret.
+func (s *Server) ReceiveWebhook(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("receive-webhook")
+
+	teamName := rata.Param(r, "team_name")
+	providerName := rata.Param(r, "provider")
+
+	provider, found := providers[providerName]
+	if !found {
+		logger.Info("unknown-provider", lager.Data{"provider": providerName})
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-find-team", err, lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	secret, found, err := team.WebhookSecret(providerName)
+	if err != nil {
+		logger.Error("failed-to-get-webhook-secret", err, lager.Data{"team": teamName, "provider": providerName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("webhook-not-configured", lager.Data{"team": teamName, "provider": providerName})
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		logger.Error("failed-to-read-body", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !provider.Verify(r, body, secret) {
+		logger.Info("invalid-signature", lager.Data{"team": teamName, "provider": providerName})
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	uris, isPush, err := provider.PushedRepositories(r, body)
+	if err != nil {
+		logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	checked := []atc.WebhookCheckedResource{}
+
+	if isPush {
+		resources, err := s.resourcesWithURI(team, uris)
+		if err != nil {
+			logger.Error("failed-to-find-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		for _, pushed := range resources {
+			err = pushed.resource.NotifyScan()
+			if err != nil {
+				logger.Error("failed-to-notify-scan", err, lager.Data{"resource": pushed.resource.Name()})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			checked = append(checked, atc.WebhookCheckedResource{
+				PipelineName:         pushed.pipeline.Name(),
+				PipelineInstanceVars: pushed.pipeline.InstanceVars(),
+				ResourceName:         pushed.resource.Name(),
+			})
+		}
+
+		logger.Debug("notified-resources", lager.Data{"resources": len(checked)})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(checked)
+	if err != nil {
+		logger.Error("failed-to-encode-checked-resources", err)
+	}
+}
+
+type pushedResource struct {
+	pipeline db.Pipeline
+	resource db.Resource
+}
+
+func (s *Server) resourcesWithURI(team db.Team, uris []string) ([]pushedResource, error) {
+	pushed := map[string]bool{}
+	for _, uri := range uris {
+		if uri != "" {
+			pushed[normalizeRepositoryURI(uri)] = true
+		}
+	}
+
+	pipelines, err := team.Pipelines()
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []pushedResource
+	for _, pipeline := range pipelines {
+		resources, err := pipeline.Resources()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range resources {
+			uri, ok := resource.Source()["uri"].(string)
+			if !ok || uri == "" {
+				continue
+			}
+
+			if pushed[normalizeRepositoryURI(uri)] {
+				matching = append(matching, pushedResource{pipeline, resource})
+			}
+		}
+	}
+
+	return matching, nil
+}
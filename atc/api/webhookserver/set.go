@@ -0,0 +1,48 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) SetWebhook(team db.Team) http.Handler {
+	logger := s.logger.Session("set-webhook")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		providerName := rata.Param(r, "provider")
+
+		if _, found := providers[providerName]; !found {
+			logger.Info("unknown-provider", lager.Data{"provider": providerName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body atc.SetTeamWebhookRequestBody
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if body.Secret == "" {
+			logger.Info("missing-secret")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = team.SetWebhookSecret(providerName, body.Secret)
+		if err != nil {
+			logger.Error("failed-to-set-webhook-secret", err, lager.Data{"provider": providerName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -24,7 +24,7 @@ var _ = Describe("Workers API", func() {
 		fakeaccess = new(accessorfakes.FakeAccess)
 	})
 	JustBeforeEach(func() {
-		fakeAccessor.CreateReturns(fakeaccess)
+		fakeAccessor.CreateReturns(fakeaccess, nil)
 	})
 
 	Describe("GET /api/v1/workers", func() {
@@ -0,0 +1,27 @@
+package atc
+
+// APITokenPrefix starts every API token, telling them apart from the tokens
+// issued by skymarshal.
+const APITokenPrefix = "ct_"
+
+// APIToken is a long-lived, revocable token granting a role in a team. The
+// token itself is only returned when it is created.
+type APIToken struct {
+	Name           string `json:"name"`
+	TeamName       string `json:"team_name"`
+	Role           string `json:"role"`
+	ServiceAccount bool   `json:"service_account,omitempty"`
+	CreatedBy      string `json:"created_by"`
+	CreatedAt      int64  `json:"created_at"`
+	ExpiresAt      int64  `json:"expires_at,omitempty"`
+	LastUsedAt     int64  `json:"last_used_at,omitempty"`
+
+	Token string `json:"token,omitempty"`
+}
+
+type CreateAPITokenRequestBody struct {
+	Name           string `json:"name"`
+	Role           string `json:"role"`
+	ServiceAccount bool   `json:"service_account,omitempty"`
+	ExpiresAt      int64  `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,77 @@
+package archive
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type archiver struct {
+	buildFactory db.BuildFactory
+	archiveAfter time.Duration
+	batchSize    int
+	clock        clock.Clock
+}
+
+// NewArchiver returns a component which moves the events of builds that
+// completed more than archiveAfter ago from the database to the event archive
+// configured on the database connection, and deletes the archived events of
+// builds once they have been reaped.
+func NewArchiver(
+	buildFactory db.BuildFactory,
+	archiveAfter time.Duration,
+	batchSize int,
+	clock clock.Clock,
+) *archiver {
+	return &archiver{
+		buildFactory: buildFactory,
+		archiveAfter: archiveAfter,
+		batchSize:    batchSize,
+		clock:        clock,
+	}
+}
+
+func (a *archiver) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("archive")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	reapedBuilds, err := a.buildFactory.GetReapedArchivedBuilds(a.batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-reaped-archived-builds", err)
+		return err
+	}
+
+	for _, build := range reapedBuilds {
+		err := build.DeleteArchivedEvents(ctx)
+		if err != nil {
+			logger.Error("failed-to-delete-archived-events", err, lager.Data{"build": build.ID()})
+			continue
+		}
+	}
+
+	builds, err := a.buildFactory.GetArchivableBuilds(a.clock.Now().Add(-a.archiveAfter), a.batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-archivable-builds", err)
+		return err
+	}
+
+	for _, build := range builds {
+		err := build.ArchiveEvents(ctx)
+		if err != nil {
+			logger.Error("failed-to-archive-events", err, lager.Data{"build": build.ID()})
+			continue
+		}
+	}
+
+	if len(builds) > 0 {
+		logger.Debug("archived", lager.Data{"builds": len(builds)})
+	}
+
+	return nil
+}
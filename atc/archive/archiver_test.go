@@ -0,0 +1,114 @@
+package archive_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/archive"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archiver", func() {
+	var (
+		fakeBuildFactory *dbfakes.FakeBuildFactory
+		fakeClock        *fakeclock.FakeClock
+
+		fakeBuild1, fakeBuild2 *dbfakes.FakeBuild
+		fakeReapedBuild        *dbfakes.FakeBuild
+
+		ctx    context.Context
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1585000000, 0))
+
+		fakeBuild1 = new(dbfakes.FakeBuild)
+		fakeBuild1.IDReturns(1)
+		fakeBuild2 = new(dbfakes.FakeBuild)
+		fakeBuild2.IDReturns(2)
+		fakeBuildFactory.GetArchivableBuildsReturns([]db.Build{fakeBuild1, fakeBuild2}, nil)
+
+		fakeReapedBuild = new(dbfakes.FakeBuild)
+		fakeReapedBuild.IDReturns(3)
+		fakeBuildFactory.GetReapedArchivedBuildsReturns([]db.Build{fakeReapedBuild}, nil)
+
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+	})
+
+	JustBeforeEach(func() {
+		runErr = archive.NewArchiver(fakeBuildFactory, time.Hour, 50, fakeClock).Run(ctx)
+	})
+
+	It("archives the events of builds which completed before the grace period", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+
+		Expect(fakeBuildFactory.GetArchivableBuildsCallCount()).To(Equal(1))
+		completedBefore, limit := fakeBuildFactory.GetArchivableBuildsArgsForCall(0)
+		Expect(completedBefore).To(Equal(fakeClock.Now().Add(-time.Hour)))
+		Expect(limit).To(Equal(50))
+
+		Expect(fakeBuild1.ArchiveEventsCallCount()).To(Equal(1))
+		Expect(fakeBuild2.ArchiveEventsCallCount()).To(Equal(1))
+	})
+
+	It("deletes the archived events of reaped builds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+
+		Expect(fakeBuildFactory.GetReapedArchivedBuildsCallCount()).To(Equal(1))
+		Expect(fakeBuildFactory.GetReapedArchivedBuildsArgsForCall(0)).To(Equal(50))
+
+		Expect(fakeReapedBuild.DeleteArchivedEventsCallCount()).To(Equal(1))
+	})
+
+	Context("when archiving a build fails", func() {
+		BeforeEach(func() {
+			fakeBuild1.ArchiveEventsReturns(errors.New("nope"))
+		})
+
+		It("still archives the other builds", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeBuild2.ArchiveEventsCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when deleting archived events fails", func() {
+		BeforeEach(func() {
+			fakeReapedBuild.DeleteArchivedEventsReturns(errors.New("nope"))
+		})
+
+		It("still archives builds", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeBuild1.ArchiveEventsCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when getting the archivable builds fails", func() {
+		BeforeEach(func() {
+			fakeBuildFactory.GetArchivableBuildsReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+
+	Context("when getting the reaped builds fails", func() {
+		BeforeEach(func() {
+			fakeBuildFactory.GetReapedArchivedBuildsReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+			Expect(fakeBuildFactory.GetArchivableBuildsCallCount()).To(BeZero())
+		})
+	})
+})
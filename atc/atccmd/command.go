@@ -23,7 +23,9 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/archive"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/noop"
@@ -34,6 +36,7 @@ import (
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/keyrotation"
 	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/lockrunner"
 	"github.com/concourse/concourse/atc/metric"
@@ -70,6 +73,8 @@ import (
 	_ "github.com/concourse/concourse/atc/creds/conjur"
 	_ "github.com/concourse/concourse/atc/creds/credhub"
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/env"
+	_ "github.com/concourse/concourse/atc/creds/file"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
@@ -94,6 +99,7 @@ type RunCommand struct {
 	Logger flag.Lager
 
 	varSourcePool creds.VarSourcePool
+	newKey        encryption.Strategy
 
 	BindIP   flag.IP `long:"bind-ip"   default:"0.0.0.0" description:"IP address on which to listen for web traffic."`
 	BindPort uint16  `long:"bind-port" default:"8080"    description:"Port on which to listen for HTTP traffic."`
@@ -118,7 +124,12 @@ type RunCommand struct {
 	CredentialManagers   creds.Managers
 
 	EncryptionKey    flag.Cipher `long:"encryption-key"     description:"A 16 or 32 length key used to encrypt sensitive information before storing it in the database."`
-	OldEncryptionKey flag.Cipher `long:"old-encryption-key" description:"Encryption key previously used for encrypting sensitive information. If provided without a new key, data is encrypted. If provided with a new key, data is re-encrypted."`
+	OldEncryptionKey flag.Cipher `long:"old-encryption-key" description:"Encryption key previously used for encrypting sensitive information. If provided without a new key, data is decrypted. If provided with a new key, data is re-encrypted. Either happens in the background, and data encrypted with either key can be read in the meantime."`
+
+	EnvelopeEncryption struct {
+		VaultTransit encryption.VaultTransit
+		LocalKeys    encryption.LocalKeys
+	} `group:"Envelope Encryption" namespace:"encryption"`
 
 	DebugBindIP   flag.IP `long:"debug-bind-ip"   default:"127.0.0.1" description:"IP address on which to listen for the pprof debugger endpoints."`
 	DebugBindPort uint16  `long:"debug-bind-port" default:"8079"      description:"Port on which to listen for the pprof debugger endpoints."`
@@ -133,7 +144,7 @@ type RunCommand struct {
 	GlobalResourceCheckTimeout   time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" description:"Method by which a worker is selected during container placement."`
+	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" description:"Method by which a worker is selected during container placement. Multiple comma-separated methods are applied in order, each narrowing down the workers chosen by the previous one. Methods: volume-locality, build-locality, fewest-build-containers, limit-active-tasks, least-cpu-load, least-memory-load, random."`
 	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 
@@ -157,6 +168,7 @@ type RunCommand struct {
 	Tracing struct {
 		Jaeger      tracing.Jaeger
 		Stackdriver tracing.Stackdriver
+		OTLP        tracing.OTLP
 	} `group:"Tracing" namespace:"tracing"`
 
 	Server struct {
@@ -175,7 +187,16 @@ type RunCommand struct {
 		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
+	BuildEventArchive struct {
+		FileSystem blobstore.FileSystem
+		S3         blobstore.S3
+
+		After    time.Duration `long:"after"    default:"24h" description:"Period after the completion of a build after which its events are moved from the database to the archive."`
+		Interval time.Duration `long:"interval" default:"1m"  description:"Interval on which to archive the events of builds."`
+	} `group:"Build Event Archive" namespace:"build-event-archive"`
+
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
+	DefaultBuildTimeout  time.Duration `long:"default-build-timeout" description:"Default duration after which a build is aborted as timed out. A job's timeout takes precedence. 0 means no timeout."`
 
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
@@ -200,6 +221,11 @@ type RunCommand struct {
 		EnableTeamAuditLog      bool `long:"enable-team-auditing" description:"Enable auditing for all api requests connected to teams."`
 		EnableWorkerAuditLog    bool `long:"enable-worker-auditing" description:"Enable auditing for all api requests connected to workers."`
 		EnableVolumeAuditLog    bool `long:"enable-volume-auditing" description:"Enable auditing for all api requests connected to volumes."`
+
+		RecordAuditEvents   bool          `long:"record-audit-events" description:"Record every api request as an audit event in the database, regardless of which groups of requests are logged."`
+		AuditEventRetention time.Duration `long:"audit-event-retention" default:"720h" description:"Period after which audit events recorded in the database are removed. 0 keeps them forever."`
+		EmitAuditEvents     bool          `long:"emit-audit-events" description:"Forward audit events to the configured metric emitters."`
+		TrustForwardedFor   bool          `long:"audit-trust-forwarded-for" description:"Record the client address of audit events from the X-Forwarded-For header, as set by a trusted load balancer in front of the web nodes, rather than the address of the load balancer."`
 	}
 
 	Syslog struct {
@@ -208,6 +234,10 @@ type RunCommand struct {
 		Transport     string        `long:"syslog-transport" description:"Transport protocol for syslog messages (Currently supporting tcp, udp & tls)."`
 		DrainInterval time.Duration `long:"syslog-drain-interval" description:"Interval over which checking is done for new build logs to send to syslog server (duration measurement units are s/m/h; eg. 30s/30m/1h)" default:"30s"`
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
+
+		JSONFile        string `long:"syslog-json-file" description:"Path of a local file to append build events to as JSON lines."`
+		JSONURL         string `long:"syslog-json-url" description:"HTTP(S) endpoint to post build events to as JSON lines."`
+		AllowTeamDrains bool   `long:"syslog-allow-team-drains" description:"Allow teams to configure their own destinations for the events of their builds."`
 	} ` group:"Syslog Drainer Configuration"`
 
 	Auth struct {
@@ -217,7 +247,7 @@ type RunCommand struct {
 
 	EnableRedactSecrets bool `long:"enable-redact-secrets" description:"Enable redacting secrets in build logs."`
 
-	ConfigRBAC string `long:"config-rbac" description:"Customize RBAC role-action mapping."`
+	ConfigRBAC string `long:"config-rbac" description:"Customize RBAC role-action mapping, and define custom roles which permit only the listed actions."`
 }
 
 type Migration struct {
@@ -433,6 +463,13 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		}
 
 		tracing.ConfigureTracer(exp)
+	case cmd.Tracing.OTLP.IsConfigured():
+		exp, err := cmd.Tracing.OTLP.Exporter()
+		if err != nil {
+			return nil, err
+		}
+
+		tracing.ConfigureBatchTracer(exp)
 	}
 
 	http.HandleFunc("/debug/connections", func(w http.ResponseWriter, r *http.Request) {
@@ -452,6 +489,11 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 
 	lockFactory := lock.NewLockFactory(lockConn, metric.LogLockAcquired, metric.LogLockReleased)
 
+	cmd.newKey, err = cmd.newKeyStrategy()
+	if err != nil {
+		return nil, err
+	}
+
 	apiConn, err := cmd.constructDBConn(retryingDriverName, logger, cmd.APIMaxOpenConnections, "api", lockFactory)
 	if err != nil {
 		return nil, err
@@ -631,7 +673,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	)
 
 	pool := worker.NewPool(workerProvider)
-	workerClient := worker.NewClient(pool, workerProvider)
+	workerClient := worker.NewClient(pool, workerProvider, db.NewWorkerTaskQueue(dbConn))
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -643,8 +685,10 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, secretManager, cmd.varSourcePool, cmd.GlobalResourceCheckTimeout)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbAuditEventRepository := db.NewAuditEventRepository(dbConn)
+	dbEncryptionKeyRotation := db.NewEncryptionKeyRotation(dbConn, cmd.newKey, cmd.oldKey())
 
-	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
+	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey(), db.NewAPITokenFactory(dbConn))
 	customActionRoleMap := accessor.CustomActionRoleMap{}
 	err = accessor.ParseCustomActionRoleMap(cmd.ConfigRBAC, &customActionRoleMap)
 	if err != nil {
@@ -670,11 +714,13 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		dbAuditEventRepository,
 		workerClient,
 		secretManager,
 		credsManagers,
 		accessFactory,
 		dbWall,
+		dbEncryptionKeyRotation,
 	)
 
 	if err != nil {
@@ -754,6 +800,26 @@ func (cmd *RunCommand) constructAPIMembers(
 	return members, nil
 }
 
+// syslogDestinations returns the globally configured destinations for build
+// events in the form understood by syslog.Open.
+func (cmd *RunCommand) syslogDestinations() []string {
+	var destinations []string
+
+	if cmd.Syslog.Address != "" {
+		destinations = append(destinations, cmd.Syslog.Transport+"://"+cmd.Syslog.Address)
+	}
+
+	if cmd.Syslog.JSONFile != "" {
+		destinations = append(destinations, "file://"+cmd.Syslog.JSONFile)
+	}
+
+	if cmd.Syslog.JSONURL != "" {
+		destinations = append(destinations, cmd.Syslog.JSONURL)
+	}
+
+	return destinations
+}
+
 func (cmd *RunCommand) constructBackendMembers(
 	logger lager.Logger,
 	dbConn db.Conn,
@@ -765,12 +831,15 @@ func (cmd *RunCommand) constructBackendMembers(
 		return nil, fmt.Errorf("syslog Drainer is misconfigured, cannot configure a drainer without a transport")
 	}
 
-	syslogDrainConfigured := true
-	if cmd.Syslog.Address == "" {
-		syslogDrainConfigured = false
-	}
+	syslogDestinations := cmd.syslogDestinations()
+	syslogDrainConfigured := len(syslogDestinations) > 0 || cmd.Syslog.AllowTeamDrains
+
+	var syslogTeamFactory db.TeamFactory
 
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
+	if cmd.Syslog.AllowTeamDrains {
+		syslogTeamFactory = teamFactory
+	}
 
 	resourceFactory := resource.NewResourceFactory()
 	dbResourceCacheFactory := db.NewResourceCacheFactory(dbConn, lockFactory)
@@ -813,14 +882,14 @@ func (cmd *RunCommand) constructBackendMembers(
 	)
 
 	pool := worker.NewPool(workerProvider)
-	workerClient := worker.NewClient(pool, workerProvider)
+	workerClient := worker.NewClient(pool, workerProvider, db.NewWorkerTaskQueue(dbConn))
 
 	defaultLimits, err := cmd.parseDefaultLimits()
 	if err != nil {
 		return nil, err
 	}
 
-	buildContainerStrategy, err := cmd.chooseBuildContainerStrategy()
+	buildContainerStrategy, err := cmd.chooseBuildContainerStrategy(dbWorkerFactory)
 	if err != nil {
 		return nil, err
 	}
@@ -888,7 +957,9 @@ func (cmd *RunCommand) constructBackendMembers(
 						factory.NewBuildFactory(
 							atc.NewPlanFactory(time.Now().Unix()),
 						),
-						alg),
+						alg,
+						db.NewWorkerTaskQueue(dbConn),
+					),
 				},
 				cmd.JobSchedulingMaxInFlight,
 			),
@@ -910,7 +981,7 @@ func (cmd *RunCommand) constructBackendMembers(
 		// run separately so as to not preempt critical GC
 		{Name: atc.ComponentBuildReaper, Runner: lockrunner.NewRunner(
 			logger.Session(atc.ComponentBuildReaper),
-			gc.NewBuildLogCollector(
+			gc.NewTracedTask(atc.ComponentBuildReaper, gc.NewBuildLogCollector(
 				dbPipelineFactory,
 				500,
 				gc.NewBuildLogRetentionCalculator(
@@ -920,7 +991,7 @@ func (cmd *RunCommand) constructBackendMembers(
 					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
-			),
+			)),
 			atc.ComponentBuildReaper,
 			lockFactory,
 			componentFactory,
@@ -934,11 +1005,11 @@ func (cmd *RunCommand) constructBackendMembers(
 			Name: atc.ComponentSyslogDrainer, Runner: lockrunner.NewRunner(
 				logger.Session(atc.ComponentSyslogDrainer),
 				syslog.NewDrainer(
-					cmd.Syslog.Transport,
-					cmd.Syslog.Address,
 					cmd.Syslog.Hostname,
+					syslogDestinations,
 					cmd.Syslog.CACerts,
 					dbBuildFactory,
+					syslogTeamFactory,
 				),
 				atc.ComponentSyslogDrainer,
 				lockFactory,
@@ -948,6 +1019,42 @@ func (cmd *RunCommand) constructBackendMembers(
 			)},
 		)
 	}
+	if cmd.BuildEventArchive.FileSystem.IsConfigured() || cmd.BuildEventArchive.S3.IsConfigured() {
+		members = append(members, grouper.Member{
+			Name: atc.ComponentBuildEventArchiver, Runner: lockrunner.NewRunner(
+				logger.Session(atc.ComponentBuildEventArchiver),
+				gc.NewTracedTask(atc.ComponentBuildEventArchiver, archive.NewArchiver(
+					dbBuildFactory,
+					cmd.BuildEventArchive.After,
+					50,
+					clock.NewClock(),
+				)),
+				atc.ComponentBuildEventArchiver,
+				lockFactory,
+				componentFactory,
+				clock.NewClock(),
+				runnerInterval,
+			)},
+		)
+	}
+
+	if cmd.OldEncryptionKey.AEAD != nil {
+		members = append(members, grouper.Member{
+			Name: atc.ComponentEncryptionKeyRotator, Runner: lockrunner.NewRunner(
+				logger.Session(atc.ComponentEncryptionKeyRotator),
+				keyrotation.NewRotator(
+					db.NewEncryptionKeyRotation(dbConn, cmd.newKey, cmd.oldKey()),
+					500,
+					time.Minute,
+				),
+				atc.ComponentEncryptionKeyRotator,
+				lockFactory,
+				componentFactory,
+				clock.NewClock(),
+				runnerInterval,
+			)},
+		)
+	}
 	if cmd.Worker.GardenURL.URL != nil {
 		members = cmd.appendStaticWorker(logger, dbWorkerFactory, members)
 	}
@@ -967,6 +1074,7 @@ func (cmd *RunCommand) constructGCMember(
 	dbContainerRepository := db.NewContainerRepository(gcConn)
 	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
+	dbAuditEventRepository := db.NewAuditEventRepository(gcConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
 	resourceFactory := resource.NewResourceFactory()
@@ -1028,13 +1136,14 @@ func (cmd *RunCommand) constructGCMember(
 		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, jobRunner, cmd.GC.MissingGracePeriod),
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
 		atc.ComponentCollectorVarSources:        gc.NewCollectorTask(cmd.varSourcePool.(gc.Collector)),
+		atc.ComponentCollectorAuditEvents:       gc.NewAuditEventCollector(dbAuditEventRepository, cmd.Auditor.AuditEventRetention),
 	}
 
 	for collectorName, collector := range collectors {
 		members = append(members, grouper.Member{
 			Name: collectorName, Runner: lockrunner.NewRunner(
 				logger.Session(collectorName),
-				collector,
+				gc.NewTracedTask(collectorName, collector),
 				collectorName,
 				lockFactory,
 				componentFactory,
@@ -1083,6 +1192,12 @@ func (cmd *RunCommand) secretManager(logger lager.Logger) (creds.Secrets, error)
 	}
 
 	result := secretsFactory.NewSecrets()
+	if leased, ok := result.(creds.LeasedSecrets); ok {
+		leased.OnRotation(func(secretPath string) {
+			logger.Info("secret-rotated", lager.Data{"path": secretPath})
+		})
+	}
+
 	result = creds.NewRetryableSecrets(result, cmd.CredentialManagement.RetryConfig)
 	if cmd.CredentialManagement.CacheConfig.Enabled {
 		result = creds.NewCachedSecrets(result, cmd.CredentialManagement.CacheConfig)
@@ -1090,20 +1205,52 @@ func (cmd *RunCommand) secretManager(logger lager.Logger) (creds.Secrets, error)
 	return result, nil
 }
 
-func (cmd *RunCommand) newKey() *encryption.Key {
-	var newKey *encryption.Key
-	if cmd.EncryptionKey.AEAD != nil {
-		newKey = encryption.NewKey(cmd.EncryptionKey.AEAD)
+// newKeyStrategy constructs the strategy new values are encrypted with. It
+// is built once and shared by all connections so that an envelope's data key
+// and cache aren't duplicated for each of them.
+func (cmd *RunCommand) newKeyStrategy() (encryption.Strategy, error) {
+	switch {
+	case cmd.EnvelopeEncryption.VaultTransit.IsConfigured():
+		provider, err := cmd.EnvelopeEncryption.VaultTransit.KeyProvider()
+		if err != nil {
+			return nil, err
+		}
+
+		return encryption.NewEnvelope(provider), nil
+	case cmd.EnvelopeEncryption.LocalKeys.IsConfigured():
+		provider, err := cmd.EnvelopeEncryption.LocalKeys.KeyProvider()
+		if err != nil {
+			return nil, err
+		}
+
+		return encryption.NewEnvelope(provider), nil
+	case cmd.EncryptionKey.AEAD != nil:
+		return encryption.NewKey(cmd.EncryptionKey.AEAD), nil
+	}
+
+	return nil, nil
+}
+
+func (cmd *RunCommand) eventArchive() (blobstore.Store, error) {
+	if cmd.BuildEventArchive.FileSystem.IsConfigured() && cmd.BuildEventArchive.S3.IsConfigured() {
+		return nil, errors.New("build events can only be archived to either a directory or an S3 bucket")
+	}
+
+	switch {
+	case cmd.BuildEventArchive.FileSystem.IsConfigured():
+		return cmd.BuildEventArchive.FileSystem.Store()
+	case cmd.BuildEventArchive.S3.IsConfigured():
+		return cmd.BuildEventArchive.S3.Store()
 	}
-	return newKey
+
+	return nil, nil
 }
 
-func (cmd *RunCommand) oldKey() *encryption.Key {
-	var oldKey *encryption.Key
+func (cmd *RunCommand) oldKey() encryption.Strategy {
 	if cmd.OldEncryptionKey.AEAD != nil {
-		oldKey = encryption.NewKey(cmd.OldEncryptionKey.AEAD)
+		return encryption.NewKey(cmd.OldEncryptionKey.AEAD)
 	}
-	return oldKey
+	return nil
 }
 
 func webHandler(logger lager.Logger) (http.Handler, error) {
@@ -1285,6 +1432,24 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	keySources := 0
+	for _, configured := range []bool{
+		cmd.EncryptionKey.AEAD != nil,
+		cmd.EnvelopeEncryption.VaultTransit.IsConfigured(),
+		cmd.EnvelopeEncryption.LocalKeys.IsConfigured(),
+	} {
+		if configured {
+			keySources++
+		}
+	}
+
+	if keySources > 1 {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify only one of --encryption-key, --encryption-vault-transit-url, or --encryption-local-keys-dir"),
+		)
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1316,7 +1481,12 @@ func (cmd *RunCommand) constructDBConn(
 	connectionName string,
 	lockFactory lock.LockFactory,
 ) (db.Conn, error) {
-	dbConn, err := db.Open(logger.Session("db"), driverName, cmd.Postgres.ConnectionString(), cmd.newKey(), cmd.oldKey(), connectionName, lockFactory)
+	eventArchive, err := cmd.eventArchive()
+	if err != nil {
+		return nil, err
+	}
+
+	dbConn, err := db.Open(logger.Session("db"), driverName, cmd.Postgres.ConnectionString(), cmd.newKey, cmd.oldKey(), connectionName, lockFactory, eventArchive)
 	if err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %s", err)
 	}
@@ -1354,26 +1524,42 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 	return dbConn, nil
 }
 
-func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacementStrategy, error) {
-	var strategy worker.ContainerPlacementStrategy
-	if cmd.ContainerPlacementStrategy != "limit-active-tasks" && cmd.MaxActiveTasksPerWorker != 0 {
-		return nil, errors.New("max-active-tasks-per-worker has only effect with limit-active-tasks strategy")
-	}
+func (cmd *RunCommand) chooseBuildContainerStrategy(workerFactory db.WorkerFactory) (worker.ContainerPlacementStrategy, error) {
 	if cmd.MaxActiveTasksPerWorker < 0 {
 		return nil, errors.New("max-active-tasks-per-worker must be greater or equal than 0")
 	}
-	switch cmd.ContainerPlacementStrategy {
-	case "random":
-		strategy = worker.NewRandomPlacementStrategy()
-	case "fewest-build-containers":
-		strategy = worker.NewFewestBuildContainersPlacementStrategy()
-	case "limit-active-tasks":
-		strategy = worker.NewLimitActiveTasksPlacementStrategy(cmd.MaxActiveTasksPerWorker)
-	default:
-		strategy = worker.NewVolumeLocalityPlacementStrategy()
+
+	var nodes []worker.ContainerPlacementStrategyChainNode
+	limitsActiveTasks := false
+	for _, name := range strings.Split(cmd.ContainerPlacementStrategy, ",") {
+		name = strings.TrimSpace(name)
+
+		switch name {
+		case "volume-locality":
+			nodes = append(nodes, worker.NewVolumeLocalityPlacementStrategy())
+		case "build-locality":
+			nodes = append(nodes, worker.NewBuildLocalityPlacementStrategy(workerFactory))
+		case "fewest-build-containers":
+			nodes = append(nodes, worker.NewFewestBuildContainersPlacementStrategy())
+		case "limit-active-tasks":
+			nodes = append(nodes, worker.NewLimitActiveTasksPlacementStrategy(cmd.MaxActiveTasksPerWorker))
+			limitsActiveTasks = true
+		case "least-cpu-load":
+			nodes = append(nodes, worker.NewLeastCPULoadPlacementStrategy())
+		case "least-memory-load":
+			nodes = append(nodes, worker.NewLeastMemoryLoadPlacementStrategy())
+		case "random":
+			nodes = append(nodes, worker.NewRandomPlacementStrategy())
+		default:
+			return nil, fmt.Errorf("unknown container placement strategy: '%s'", name)
+		}
+	}
+
+	if !limitsActiveTasks && cmd.MaxActiveTasksPerWorker != 0 {
+		return nil, errors.New("max-active-tasks-per-worker has only effect with limit-active-tasks strategy")
 	}
 
-	return strategy, nil
+	return worker.NewChainedPlacementStrategy(nodes...), nil
 }
 
 func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) error {
@@ -1423,6 +1609,9 @@ func (cmd *RunCommand) configureComponentIntervals(componentFactory db.Component
 			}, {
 				Name:     atc.ComponentCollectorArtifacts,
 				Interval: cmd.GC.Interval,
+			}, {
+				Name:     atc.ComponentCollectorAuditEvents,
+				Interval: cmd.GC.Interval,
 			}, {
 				Name:     atc.ComponentCollectorBuilds,
 				Interval: cmd.GC.Interval,
@@ -1453,6 +1642,12 @@ func (cmd *RunCommand) configureComponentIntervals(componentFactory db.Component
 			}, {
 				Name:     atc.ComponentCollectorVarSources,
 				Interval: 60 * time.Second,
+			}, {
+				Name:     atc.ComponentBuildEventArchiver,
+				Interval: cmd.BuildEventArchive.Interval,
+			}, {
+				Name:     atc.ComponentEncryptionKeyRotator,
+				Interval: 10 * time.Second,
 			},
 		})
 }
@@ -1491,7 +1686,7 @@ func (cmd *RunCommand) constructEngine(
 		cmd.EnableRedactSecrets,
 	)
 
-	return engine.NewEngine(stepBuilder)
+	return engine.NewEngine(stepBuilder, cmd.DefaultBuildTimeout)
 }
 
 func (cmd *RunCommand) constructHTTPHandler(
@@ -1540,11 +1735,13 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbAuditEventRepository db.AuditEventRepository,
 	workerClient worker.Client,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	dbEncryptionKeyRotation db.EncryptionKeyRotation,
 ) (http.Handler, error) {
 
 	checkPipelineAccessHandlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
@@ -1562,6 +1759,10 @@ func (cmd *RunCommand) constructAPIHandler(
 		cmd.Auditor.EnableTeamAuditLog,
 		cmd.Auditor.EnableWorkerAuditLog,
 		cmd.Auditor.EnableVolumeAuditLog,
+		dbAuditEventRepository,
+		cmd.Auditor.RecordAuditEvents,
+		cmd.Auditor.EmitAuditEvents,
+		cmd.Auditor.TrustForwardedFor,
 		logger,
 	)
 	apiWrapper := wrappa.MultiWrappa{
@@ -1573,7 +1774,7 @@ func (cmd *RunCommand) constructAPIHandler(
 			checkWorkerTeamAccessHandlerFactory,
 		),
 		wrappa.NewConcourseVersionWrappa(concourse.Version),
-		wrappa.NewAccessorWrappa(accessFactory, aud),
+		wrappa.NewAccessorWrappa(logger, accessFactory, aud),
 		wrappa.NewCompressionWrappa(logger),
 	}
 
@@ -1595,6 +1796,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		dbAuditEventRepository,
 
 		buildserver.NewEventHandler,
 
@@ -1612,6 +1814,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		dbWall,
+		dbEncryptionKeyRotation,
 	)
 }
 
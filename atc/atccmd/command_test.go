@@ -27,6 +27,26 @@ func (s *CommandSuite) TestLetsEncryptDefaultIsUpToDate() {
 	s.Equal(opt.Default, []string{autocert.DefaultACMEDirectory})
 }
 
+func (s *CommandSuite) TestEnvelopeEncryptionFlags() {
+	cmd := &atccmd.ATCCommand{}
+
+	parser := flags.NewParser(cmd, flags.Default)
+	parser.NamespaceDelimiter = "-"
+
+	for _, name := range []string{
+		"encryption-vault-transit-url",
+		"encryption-vault-transit-key",
+		"encryption-local-keys-dir",
+		"encryption-local-key-id",
+	} {
+		s.NotNil(parser.Find("run").FindOptionByLongName(name), name)
+	}
+
+	opt := parser.Find("run").FindOptionByLongName("encryption-vault-transit-mount")
+	s.NotNil(opt)
+	s.Equal(opt.Default, []string{"transit"})
+}
+
 func TestSuite(t *testing.T) {
 	suite.Run(t, &CommandSuite{
 		Assertions: require.New(t),
@@ -0,0 +1,15 @@
+package atc
+
+// AuditEvent records a request made against the API by a user, and how it
+// turned out.
+type AuditEvent struct {
+	ID        int    `json:"id"`
+	Time      int64  `json:"time"`
+	UserName  string `json:"user_name"`
+	TeamName  string `json:"team_name,omitempty"`
+	Action    string `json:"action"`
+	Target    string `json:"target"`
+	RequestID string `json:"request_id,omitempty"`
+	SourceIP  string `json:"source_ip,omitempty"`
+	Status    int    `json:"status"`
+}
@@ -1,13 +1,31 @@
 package auditor
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 )
 
+// RequestIDHeader carries the ID of a request, which is recorded along with
+// its audit event. A new ID is generated for requests which lack one.
+const RequestIDHeader = "X-Request-Id"
+
+// auditEventQueueSize is the number of audit events which may be waiting to
+// be saved. Once it's reached, further events are dropped rather than holding
+// up the requests they were made for.
+const auditEventQueueSize = 1024
+
+var errAuditEventQueueFull = errors.New("too many audit events waiting to be saved")
+
 //go:generate counterfeiter . Auditor
 
 func NewAuditor(
@@ -20,9 +38,13 @@ func NewAuditor(
 	EnableTeamAuditLog bool,
 	EnableWorkerAuditLog bool,
 	EnableVolumeAuditLog bool,
+	auditEventRepository db.AuditEventRepository,
+	recordAuditEvents bool,
+	emitAuditEvents bool,
+	trustForwardedFor bool,
 	logger lager.Logger,
 ) *auditor {
-	return &auditor{
+	a := &auditor{
 		EnableBuildAuditLog:     EnableBuildAuditLog,
 		EnableContainerAuditLog: EnableContainerAuditLog,
 		EnableJobAuditLog:       EnableJobAuditLog,
@@ -32,12 +54,27 @@ func NewAuditor(
 		EnableTeamAuditLog:      EnableTeamAuditLog,
 		EnableWorkerAuditLog:    EnableWorkerAuditLog,
 		EnableVolumeAuditLog:    EnableVolumeAuditLog,
+		auditEventRepository:    auditEventRepository,
+		recordAuditEvents:       recordAuditEvents,
+		emitAuditEvents:         emitAuditEvents,
+		trustForwardedFor:       trustForwardedFor,
 		logger:                  logger,
 	}
+
+	if recordAuditEvents {
+		a.events = make(chan atc.AuditEvent, auditEventQueueSize)
+		go a.saveEvents()
+	}
+
+	return a
 }
 
 type Auditor interface {
-	Audit(action string, userName string, r *http.Request)
+	// Audit logs that the user made the request, and the status it was
+	// responded to with, if auditing is enabled for the action. When audit
+	// events are recorded, every request is saved to the database in the
+	// background, whichever actions are logged.
+	Audit(action string, userName string, r *http.Request, status int)
 }
 
 type auditor struct {
@@ -50,7 +87,13 @@ type auditor struct {
 	EnableTeamAuditLog      bool
 	EnableWorkerAuditLog    bool
 	EnableVolumeAuditLog    bool
+	auditEventRepository    db.AuditEventRepository
+	recordAuditEvents       bool
+	emitAuditEvents         bool
+	trustForwardedFor       bool
 	logger                  lager.Logger
+
+	events chan atc.AuditEvent
 }
 
 func (a *auditor) ValidateAction(action string) bool {
@@ -63,7 +106,9 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildEvents,
 		atc.BuildResources,
 		atc.AbortBuild,
+		atc.SetBuildComment,
 		atc.GetBuildPreparation,
+		atc.GetBuildQueue,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
@@ -82,6 +127,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListJobs,
 		atc.ListJobBuilds,
 		atc.ListJobInputs,
+		atc.ListJobQueue,
 		atc.GetJobBuild,
 		atc.PauseJob,
 		atc.UnpauseJob,
@@ -96,12 +142,14 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.OrderPipelines,
 		atc.PausePipeline,
 		atc.UnpausePipeline,
+		atc.ArchivePipeline,
 		atc.ExposePipeline,
 		atc.HidePipeline,
 		atc.RenamePipeline,
 		atc.ListPipelineBuilds,
 		atc.CreatePipelineBuild,
-		atc.PipelineBadge:
+		atc.PipelineBadge,
+		atc.ResolveVar:
 		return a.EnablePipelineAuditLog
 	case atc.ListAllResources,
 		atc.ListResources,
@@ -131,7 +179,9 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.DownloadCLI,
 		atc.GetInfo,
 		atc.GetInfoCreds,
+		atc.GetEncryptionKeyRotation,
 		atc.ListActiveUsersSince,
+		atc.ListAuditEvents,
 		atc.GetWall,
 		atc.SetWall,
 		atc.ClearWall:
@@ -141,7 +191,13 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
-		atc.GetTeam:
+		atc.GetTeam,
+		atc.SetTeamWebhook,
+		atc.DestroyTeamWebhook,
+		atc.ReceiveTeamWebhook,
+		atc.CreateAPIToken,
+		atc.ListAPITokens,
+		atc.RevokeAPIToken:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
 		atc.LandWorker,
@@ -160,9 +216,92 @@ func (a *auditor) ValidateAction(action string) bool {
 	}
 }
 
-func (a *auditor) Audit(action string, userName string, r *http.Request) {
-	err := r.ParseForm()
-	if err == nil && a.ValidateAction(action) {
-		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+func (a *auditor) Audit(action string, userName string, r *http.Request, status int) {
+	logged := a.ValidateAction(action)
+	if !logged && !a.recordAuditEvents {
+		return
+	}
+
+	event := atc.AuditEvent{
+		UserName:  userName,
+		TeamName:  r.URL.Query().Get(":team_name"),
+		Action:    action,
+		Target:    r.URL.Path,
+		RequestID: r.Header.Get(RequestIDHeader),
+		SourceIP:  a.sourceIP(r),
+		Status:    status,
+	}
+
+	if logged {
+		// only the query is logged, as the handler may not have read the
+		// request's body yet
+		a.logger.Info("audit", lager.Data{
+			"action":     action,
+			"user":       userName,
+			"status":     status,
+			"parameters": r.URL.Query(),
+		})
+
+		if a.emitAuditEvents {
+			metric.AuditEvent{
+				TeamName: event.TeamName,
+				Action:   event.Action,
+				Status:   event.Status,
+			}.Emit(a.logger)
+		}
+	}
+
+	if a.recordAuditEvents {
+		select {
+		case a.events <- event:
+		default:
+			a.logger.Error("failed-to-save-audit-event", errAuditEventQueueFull, lager.Data{"action": action})
+		}
 	}
 }
+
+// saveEvents saves audit events to the database as they're queued, so that a
+// slow database doesn't hold up responses.
+func (a *auditor) saveEvents() {
+	for event := range a.events {
+		err := a.auditEventRepository.SaveAuditEvent(event)
+		if err != nil {
+			a.logger.Error("failed-to-save-audit-event", err)
+		}
+	}
+}
+
+// NewRequestID generates a random ID for a request.
+func NewRequestID() string {
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(id)
+}
+
+// sourceIP returns the address of the client which made the request. Behind
+// a trusted load balancer, that's the last address in the X-Forwarded-For
+// header, which the load balancer appended; any before it could have been
+// made up by the client.
+func (a *auditor) sourceIP(r *http.Request) string {
+	if a.trustForwardedFor {
+		forwardedFor := r.Header["X-Forwarded-For"]
+		if len(forwardedFor) != 0 {
+			addresses := strings.Split(forwardedFor[len(forwardedFor)-1], ",")
+			address := strings.TrimSpace(addresses[len(addresses)-1])
+			if address != "" {
+				return address
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
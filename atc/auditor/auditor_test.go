@@ -1,12 +1,16 @@
 package auditor_test
 
 import (
+	"errors"
+	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -15,6 +19,7 @@ import (
 var _ = Describe("Audit", func() {
 	var (
 		aud                     auditor.Auditor
+		fakeAuditEventRepo      *dbfakes.FakeAuditEventRepository
 		dummyAction             string
 		userName                string
 		logger                  *lagertest.TestLogger
@@ -28,10 +33,13 @@ var _ = Describe("Audit", func() {
 		EnableTeamAuditLog      bool
 		EnableWorkerAuditLog    bool
 		EnableVolumeAuditLog    bool
+		recordAuditEvents       bool
+		trustForwardedFor       bool
 	)
 
 	BeforeEach(func() {
 		userName = "test"
+		fakeAuditEventRepo = new(dbfakes.FakeAuditEventRepository)
 
 		var err error
 		req, err = http.NewRequest("GET", "localhost:8080", nil)
@@ -51,6 +59,10 @@ var _ = Describe("Audit", func() {
 			EnableTeamAuditLog,
 			EnableWorkerAuditLog,
 			EnableVolumeAuditLog,
+			fakeAuditEventRepo,
+			recordAuditEvents,
+			false,
+			trustForwardedFor,
 			logger,
 		)
 	})
@@ -80,13 +92,146 @@ var _ = Describe("Audit", func() {
 		})
 		It("all routes are handled and does not panic", func() {
 			for _, route := range atc.Routes {
-				aud.Audit(route.Name, userName, req)
+				aud.Audit(route.Name, userName, req, http.StatusOK)
 			}
 			logs := logger.Logs()
 			Expect(len(logs)).ToNot(Equal(0))
 		})
 	})
 
+	Describe("recording audit events", func() {
+		BeforeEach(func() {
+			recordAuditEvents = true
+			EnableTeamAuditLog = true
+
+			var err error
+			req, err = http.NewRequest("PUT", "http://localhost:8080/api/v1/teams/some-team?:team_name=some-team", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.RemoteAddr = "1.2.3.4:5678"
+			req.Header.Set(auditor.RequestIDHeader, "some-request-id")
+		})
+
+		AfterEach(func() {
+			recordAuditEvents = false
+		})
+
+		It("saves the event for an audited action", func() {
+			aud.Audit(atc.SetTeam, userName, req, http.StatusCreated)
+
+			Eventually(fakeAuditEventRepo.SaveAuditEventCallCount).Should(Equal(1))
+			Expect(fakeAuditEventRepo.SaveAuditEventArgsForCall(0)).To(Equal(atc.AuditEvent{
+				UserName:  userName,
+				TeamName:  "some-team",
+				Action:    atc.SetTeam,
+				Target:    "/api/v1/teams/some-team",
+				RequestID: "some-request-id",
+				SourceIP:  "1.2.3.4",
+				Status:    http.StatusCreated,
+			}))
+
+			logs := logger.Logs()
+			Expect(logs[0].Data["status"]).To(BeNumerically("==", http.StatusCreated))
+		})
+
+		Context("when the request was forwarded by a load balancer", func() {
+			BeforeEach(func() {
+				req.Header.Add("X-Forwarded-For", "9.9.9.9")
+				req.Header.Add("X-Forwarded-For", "10.0.0.1, 5.6.7.8")
+			})
+
+			It("records the address of the load balancer", func() {
+				aud.Audit(atc.SetTeam, userName, req, http.StatusCreated)
+				Eventually(fakeAuditEventRepo.SaveAuditEventCallCount).Should(Equal(1))
+				Expect(fakeAuditEventRepo.SaveAuditEventArgsForCall(0).SourceIP).To(Equal("1.2.3.4"))
+			})
+
+			Context("when the load balancer is trusted", func() {
+				BeforeEach(func() {
+					trustForwardedFor = true
+				})
+
+				AfterEach(func() {
+					trustForwardedFor = false
+				})
+
+				It("records the address of the client it appended", func() {
+					aud.Audit(atc.SetTeam, userName, req, http.StatusCreated)
+					Eventually(fakeAuditEventRepo.SaveAuditEventCallCount).Should(Equal(1))
+					Expect(fakeAuditEventRepo.SaveAuditEventArgsForCall(0).SourceIP).To(Equal("5.6.7.8"))
+				})
+			})
+		})
+
+		It("saves the event for an action which isn't logged", func() {
+			aud.Audit(atc.SaveConfig, userName, req, http.StatusOK)
+
+			Eventually(fakeAuditEventRepo.SaveAuditEventCallCount).Should(Equal(1))
+			Expect(fakeAuditEventRepo.SaveAuditEventArgsForCall(0).Action).To(Equal(atc.SaveConfig))
+			Expect(logger.Logs()).To(BeEmpty())
+		})
+
+		It("does not read the request's body", func() {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			req.Body = ioutil.NopCloser(strings.NewReader("some=form"))
+
+			aud.Audit(atc.SetTeam, userName, req, http.StatusCreated)
+
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("some=form"))
+		})
+
+		Context("when saving the event is slow", func() {
+			var saved chan struct{}
+
+			BeforeEach(func() {
+				saved = make(chan struct{})
+				fakeAuditEventRepo.SaveAuditEventStub = func(atc.AuditEvent) error {
+					<-saved
+					return nil
+				}
+			})
+
+			AfterEach(func() {
+				close(saved)
+			})
+
+			It("does not wait for the event to be saved", func() {
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					aud.Audit(atc.SetTeam, userName, req, http.StatusCreated)
+					aud.Audit(atc.SetTeam, userName, req, http.StatusCreated)
+				}()
+
+				Eventually(done).Should(BeClosed())
+			})
+		})
+
+		Context("when saving the event fails", func() {
+			BeforeEach(func() {
+				fakeAuditEventRepo.SaveAuditEventReturns(errors.New("disaster"))
+			})
+
+			It("logs the error", func() {
+				aud.Audit(atc.SetTeam, userName, req, http.StatusCreated)
+				Eventually(logger.LogMessages).Should(ContainElement("access_handler.failed-to-save-audit-event"))
+			})
+		})
+
+		Context("when recording audit events is disabled", func() {
+			BeforeEach(func() {
+				recordAuditEvents = false
+			})
+
+			It("does not save the event", func() {
+				aud.Audit(atc.SetTeam, userName, req, http.StatusCreated)
+				Consistently(fakeAuditEventRepo.SaveAuditEventCallCount).Should(BeZero())
+			})
+		})
+	})
+
 	Describe("EnableBuildAuditLog", func() {
 
 		Context("When EnableBuildAudit is false with a Build action", func() {
@@ -96,7 +241,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -110,7 +255,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -123,7 +268,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -136,7 +281,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -152,7 +297,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -166,7 +311,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -179,7 +324,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -192,7 +337,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -208,7 +353,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -222,7 +367,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -235,7 +380,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -248,7 +393,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -264,7 +409,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -278,7 +423,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -291,7 +436,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -304,7 +449,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -320,7 +465,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -334,7 +479,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -347,7 +492,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -360,7 +505,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -376,7 +521,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -390,7 +535,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -403,7 +548,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -416,7 +561,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -432,7 +577,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -446,7 +591,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -459,7 +604,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -472,7 +617,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -488,7 +633,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -502,7 +647,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -515,7 +660,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -528,7 +673,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -544,7 +689,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -558,7 +703,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -571,7 +716,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -584,7 +729,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req, http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -0,0 +1,114 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// FileSystem configures a store of blobs in a local directory, e.g. a mounted
+// network volume shared by the web nodes.
+type FileSystem struct {
+	Dir string `long:"dir" description:"Directory to store blobs in. It must be shared by all web nodes."`
+}
+
+func (f FileSystem) IsConfigured() bool {
+	return f.Dir != ""
+}
+
+func (f FileSystem) Store() (Store, error) {
+	err := os.MkdirAll(f.Dir, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create blob store directory: %w", err)
+	}
+
+	return NewFileSystemStore(f.Dir), nil
+}
+
+type fileSystemStore struct {
+	dir string
+}
+
+func NewFileSystemStore(dir string) Store {
+	return &fileSystemStore{dir: dir}
+}
+
+func (s *fileSystemStore) Put(ctx context.Context, key string, content io.Reader) error {
+	blobPath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(blobPath), 0700)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a partially written blob is
+	// never read
+	tmp, err := ioutil.TempFile(filepath.Dir(blobPath), ".tmp-")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, content)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), blobPath)
+}
+
+func (s *fileSystemStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	blobPath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(blobPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *fileSystemStore) Delete(ctx context.Context, key string) error {
+	blobPath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(blobPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *fileSystemStore) path(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" || strings.HasSuffix(key, "/") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(cleaned)), nil
+}
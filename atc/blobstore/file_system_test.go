@@ -0,0 +1,87 @@
+package blobstore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileSystemStore", func() {
+	var (
+		dir   string
+		store blobstore.Store
+		ctx   context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "blobstore")
+		Expect(err).ToNot(HaveOccurred())
+
+		store = blobstore.NewFileSystemStore(dir)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("reads back the blobs it stores", func() {
+		err := store.Put(ctx, "builds/1/events", strings.NewReader("some-content"))
+		Expect(err).ToNot(HaveOccurred())
+
+		blob, err := store.Get(ctx, "builds/1/events")
+		Expect(err).ToNot(HaveOccurred())
+
+		defer blob.Close()
+
+		content, err := ioutil.ReadAll(blob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("some-content"))
+	})
+
+	It("replaces existing blobs", func() {
+		err := store.Put(ctx, "some-key", strings.NewReader("old-content"))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = store.Put(ctx, "some-key", strings.NewReader("new-content"))
+		Expect(err).ToNot(HaveOccurred())
+
+		content, err := ioutil.ReadFile(filepath.Join(dir, "some-key"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("new-content"))
+	})
+
+	It("keeps blobs within its directory", func() {
+		err := store.Put(ctx, "../../escaped", strings.NewReader("some-content"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(filepath.Join(dir, "escaped")).To(BeAnExistingFile())
+	})
+
+	It("returns ErrNotFound for missing blobs", func() {
+		_, err := store.Get(ctx, "missing")
+		Expect(err).To(Equal(blobstore.ErrNotFound))
+	})
+
+	It("deletes blobs", func() {
+		err := store.Put(ctx, "some-key", strings.NewReader("some-content"))
+		Expect(err).ToNot(HaveOccurred())
+
+		err = store.Delete(ctx, "some-key")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = store.Get(ctx, "some-key")
+		Expect(err).To(Equal(blobstore.ErrNotFound))
+
+		By("not failing when the blob is already gone")
+		err = store.Delete(ctx, "some-key")
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,113 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// S3 configures a store of blobs in an S3 bucket. Other S3-compatible object
+// stores, e.g. MinIO, are supported by configuring their endpoint.
+type S3 struct {
+	Bucket          string `long:"s3-bucket"            description:"Name of the S3 bucket to store blobs in."`
+	Prefix          string `long:"s3-prefix"            description:"Prefix of the keys of the blobs within the bucket."`
+	Region          string `long:"s3-region"            description:"AWS region of the bucket."`
+	Endpoint        string `long:"s3-endpoint"          description:"URL of an S3-compatible object store to use instead of AWS."`
+	ForcePathStyle  bool   `long:"s3-force-path-style"  description:"Address the bucket as part of the path rather than the host, as required by most S3-compatible object stores."`
+	AccessKeyID     string `long:"s3-access-key-id"     description:"AWS access key ID. The default credential chain is used if not specified."`
+	SecretAccessKey string `long:"s3-secret-access-key" description:"AWS secret access key."`
+	SessionToken    string `long:"s3-session-token"     description:"AWS session token."`
+}
+
+func (c S3) IsConfigured() bool {
+	return c.Bucket != ""
+}
+
+func (c S3) Store() (Store, error) {
+	config := &aws.Config{
+		S3ForcePathStyle: aws.Bool(c.ForcePathStyle),
+	}
+
+	if c.Region != "" {
+		config.Region = aws.String(c.Region)
+	}
+
+	if c.Endpoint != "" {
+		config.Endpoint = aws.String(c.Endpoint)
+	}
+
+	if c.AccessKeyID != "" {
+		config.Credentials = credentials.NewStaticCredentials(c.AccessKeyID, c.SecretAccessKey, c.SessionToken)
+	}
+
+	session, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aws session: %w", err)
+	}
+
+	return NewS3Store(s3.New(session), c.Bucket, c.Prefix), nil
+}
+
+type s3Store struct {
+	client   s3iface.S3API
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+func NewS3Store(client s3iface.S3API, bucket string, prefix string) Store {
+	return &s3Store{
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+		bucket:   bucket,
+		prefix:   prefix,
+	}
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, content io.Reader) error {
+	// the uploader splits large blobs into multiple parts, so that blobs of
+	// unknown size can be streamed
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+		Body:   content,
+	})
+	return err
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	return err
+}
+
+func (s *s3Store) key(key string) string {
+	return path.Join(s.prefix, key)
+}
@@ -0,0 +1,104 @@
+package blobstore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("S3", func() {
+	var (
+		server *ghttp.Server
+		store  blobstore.Store
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		var err error
+		store, err = blobstore.S3{
+			Bucket:          "some-bucket",
+			Prefix:          "concourse",
+			Region:          "us-east-1",
+			Endpoint:        server.URL(),
+			ForcePathStyle:  true,
+			AccessKeyID:     "some-access-key-id",
+			SecretAccessKey: "some-secret-access-key",
+		}.Store()
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("puts blobs under the prefix", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/some-bucket/concourse/builds/1/events"),
+				func(w http.ResponseWriter, r *http.Request) {
+					body, err := ioutil.ReadAll(r.Body)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(body)).To(Equal("some-content"))
+				},
+			),
+		)
+
+		err := store.Put(ctx, "builds/1/events", strings.NewReader("some-content"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("gets blobs", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/some-bucket/concourse/builds/1/events"),
+				ghttp.RespondWith(http.StatusOK, "some-content"),
+			),
+		)
+
+		blob, err := store.Get(ctx, "builds/1/events")
+		Expect(err).ToNot(HaveOccurred())
+
+		defer blob.Close()
+
+		content, err := ioutil.ReadAll(blob)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("some-content"))
+	})
+
+	It("returns ErrNotFound for missing blobs", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/some-bucket/concourse/missing"),
+				ghttp.RespondWith(http.StatusNotFound, `<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`),
+			),
+		)
+
+		_, err := store.Get(ctx, "missing")
+		Expect(err).To(Equal(blobstore.ErrNotFound))
+	})
+
+	It("deletes blobs", func() {
+		server.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("DELETE", "/some-bucket/concourse/builds/1/events"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			),
+		)
+
+		err := store.Delete(ctx, "builds/1/events")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+	})
+})
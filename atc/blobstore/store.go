@@ -0,0 +1,23 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when getting a blob which does not exist.
+var ErrNotFound = errors.New("blob not found")
+
+//go:generate counterfeiter . Store
+
+// Store keeps blobs of data outside of the database, e.g. the archived events
+// of builds. Keys are slash-separated paths.
+type Store interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the blob. Deleting a blob which does not exist is not an
+	// error.
+	Delete(ctx context.Context, key string) error
+}
@@ -24,6 +24,7 @@ type Build struct {
 	ReapTime     int64         `json:"reap_time,omitempty"`
 	RerunNumber  int           `json:"rerun_number,omitempty"`
 	RerunOf      *RerunOfBuild `json:"rerun_of,omitempty"`
+	Comment      string        `json:"comment,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -67,3 +68,13 @@ type BuildPreparation struct {
 	InputsSatisfied     BuildPreparationStatus            `json:"inputs_satisfied"`
 	MissingInputReasons MissingInputReasons               `json:"missing_input_reasons"`
 }
+
+// BuildQueuePosition is the position of a pending build in the queue of
+// builds waiting to be started, which is ordered by priority and then by age.
+type BuildQueuePosition struct {
+	BuildID   int    `json:"build_id"`
+	BuildName string `json:"build_name"`
+	JobName   string `json:"job_name,omitempty"`
+	Priority  int    `json:"priority"`
+	Position  int    `json:"position"`
+}
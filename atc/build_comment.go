@@ -0,0 +1,5 @@
+package atc
+
+type SetBuildCommentRequestBody struct {
+	Comment string `json:"comment"`
+}
@@ -10,6 +10,7 @@ const (
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
 	ComponentCollectorArtifacts         = "collector_artifacts"
+	ComponentCollectorAuditEvents       = "collector_audit_events"
 	ComponentCollectorBuilds            = "collector_builds"
 	ComponentCollectorCheckSessions     = "collector_check_sessions"
 	ComponentCollectorChecks            = "collector_checks"
@@ -20,6 +21,8 @@ const (
 	ComponentCollectorVolumes           = "collector_volumes"
 	ComponentCollectorWorkers           = "collector_workers"
 	ComponentCollectorVarSources        = "collector_var_sources"
+	ComponentEncryptionKeyRotator       = "encryption_key_rotator"
+	ComponentBuildEventArchiver         = "build_event_archiver"
 )
 
 type Component struct {
@@ -379,6 +379,59 @@ func (c *InParallelConfig) UnmarshalJSON(payload []byte) error {
 	return nil
 }
 
+// An AcrossVarConfig configures a var that a step is run across. Values are
+// either a static list or a var reference (e.g. "((.:versions))") which is
+// resolved to a list when the step runs, e.g. after a load_var step.
+type AcrossVarConfig struct {
+	Var         string
+	Values      []interface{}
+	ValuesFrom  string
+	MaxInFlight int
+}
+
+type acrossVarConfigJSON struct {
+	Var         string      `json:"var"`
+	Values      interface{} `json:"values,omitempty"`
+	MaxInFlight int         `json:"max_in_flight,omitempty"`
+}
+
+func (c *AcrossVarConfig) UnmarshalJSON(payload []byte) error {
+	var data acrossVarConfigJSON
+	err := json.Unmarshal(payload, &data)
+	if err != nil {
+		return err
+	}
+
+	c.Var, c.MaxInFlight = data.Var, data.MaxInFlight
+
+	switch actual := data.Values.(type) {
+	case nil:
+	case []interface{}:
+		c.Values = actual
+	case string:
+		c.ValuesFrom = actual
+	default:
+		return fmt.Errorf("wrong type for across values: %v", actual)
+	}
+
+	return nil
+}
+
+func (c AcrossVarConfig) MarshalJSON() ([]byte, error) {
+	data := acrossVarConfigJSON{
+		Var:         c.Var,
+		MaxInFlight: c.MaxInFlight,
+	}
+
+	if c.ValuesFrom != "" {
+		data.Values = c.ValuesFrom
+	} else if c.Values != nil {
+		data.Values = c.Values
+	}
+
+	return json.Marshal(data)
+}
+
 // A PlanConfig is a flattened set of configuration corresponding to
 // a particular Plan, where Source and Version are populated lazily.
 type PlanConfig struct {
@@ -481,11 +534,21 @@ type PlanConfig struct {
 	// name of 'load_var' step
 	LoadVar string `json:"load_var,omitempty"`
 
+	// name of the 'get' step whose fetched version and metadata are loaded
+	// by a 'load_var' step, instead of a file
+	From string `json:"from,omitempty"`
+
 	// format of input file.
 	Format string `json:"format,omitempty"`
 
 	// if true, then it will not be redacted.
 	Reveal bool `json:"reveal,omitempty"`
+
+	// used on any step to run it once for each combination of var values
+	Across []AcrossVarConfig `json:"across,omitempty"`
+
+	// used with across to abort the remaining combinations once one fails
+	FailFast bool `json:"fail_fast,omitempty"`
 }
 
 func (config PlanConfig) Name() string {
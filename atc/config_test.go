@@ -41,6 +41,55 @@ var _ = Describe("Config", func() {
 		})
 	})
 
+	Describe("AcrossVarConfig", func() {
+		Context("when the values are a list", func() {
+			It("unmarshals them as static values", func() {
+				var config AcrossVarConfig
+				err := json.Unmarshal([]byte(`{"var":"v","values":["a",1.13],"max_in_flight":2}`), &config)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(config).To(Equal(AcrossVarConfig{
+					Var:         "v",
+					Values:      []interface{}{"a", 1.13},
+					MaxInFlight: 2,
+				}))
+			})
+
+			It("round-trips through JSON", func() {
+				payload, err := json.Marshal(AcrossVarConfig{Var: "v", Values: []interface{}{"a"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(payload).To(MatchJSON(`{"var":"v","values":["a"]}`))
+			})
+		})
+
+		Context("when the values are a var reference", func() {
+			It("unmarshals them as values to resolve at runtime", func() {
+				var config AcrossVarConfig
+				err := json.Unmarshal([]byte(`{"var":"v","values":"((.:list))"}`), &config)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(config).To(Equal(AcrossVarConfig{
+					Var:        "v",
+					ValuesFrom: "((.:list))",
+				}))
+			})
+
+			It("round-trips through JSON", func() {
+				payload, err := json.Marshal(AcrossVarConfig{Var: "v", ValuesFrom: "((.:list))"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(payload).To(MatchJSON(`{"var":"v","values":"((.:list))"}`))
+			})
+		})
+
+		Context("when the values are neither", func() {
+			It("produces an error", func() {
+				var config AcrossVarConfig
+				err := json.Unmarshal([]byte(`{"var":"v","values":{"a":"b"}}`), &config)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("VarSourceConfigs.OrderByDependency", func() {
 		var (
 			varSources VarSourceConfigs
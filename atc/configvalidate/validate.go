@@ -276,6 +276,36 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		if job.Timeout != "" {
+			_, err := time.ParseDuration(job.Timeout)
+			if err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has a timeout that could not be parsed ('%s')", job.Timeout),
+				)
+			}
+		}
+
+		teamSerialGroups := map[string]bool{}
+		for _, group := range job.TeamSerialGroups {
+			if strings.TrimSpace(group) == "" {
+				errorMessages = append(
+					errorMessages,
+					identifier+" has a team_serial_groups entry with no name",
+				)
+				continue
+			}
+
+			if teamSerialGroups[group] {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has the same team serial group more than once ('%s')", group),
+				)
+			}
+
+			teamSerialGroups[group] = true
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -327,6 +357,26 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		// A "load_var" step loading a version has to load it from a get step,
+		// or the implicit get of a put step, within the job.
+		artifacts := map[string]bool{}
+		for _, input := range job.Inputs() {
+			artifacts[input.Name] = true
+		}
+
+		for _, output := range job.Outputs() {
+			artifacts[output.Name] = true
+		}
+
+		for _, plan := range job.Plans() {
+			if plan.LoadVar != "" && plan.From != "" && !artifacts[plan.From] {
+				errorMessages = append(
+					errorMessages,
+					fmt.Sprintf("%s has a load_var step loading from an unknown get step: %s", identifier, plan.From),
+				)
+			}
+		}
+
 		// Within a job, each "load_var" step should have a unique name.
 		loadVarStepNames := map[string]interface{}{}
 		for _, plan := range job.Plan {
@@ -602,10 +652,14 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 	case plan.LoadVar != "":
 		identifier = fmt.Sprintf("%s.load_var.%s", identifier, plan.LoadVar)
 
-		if plan.File == "" {
+		if plan.File == "" && plan.From == "" {
 			errorMessages = append(errorMessages, identifier+" does not specify any file")
 		}
 
+		if plan.File != "" && plan.From != "" {
+			errorMessages = append(errorMessages, identifier+" specifies both a file and a get step to load from")
+		}
+
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
 		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
@@ -661,9 +715,42 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if len(plan.Across) > 0 {
+		errorMessages = append(errorMessages, validateAcross(identifier, plan.Across)...)
+	} else if plan.FailFast {
+		subIdentifier := fmt.Sprintf("%s.fail_fast", identifier)
+		errorMessages = append(errorMessages, subIdentifier+" is only applicable to steps run across vars")
+	}
+
 	return warnings, errorMessages
 }
 
+func validateAcross(identifier string, across []AcrossVarConfig) []string {
+	var errorMessages []string
+
+	names := map[string]bool{}
+	for i, v := range across {
+		subIdentifier := fmt.Sprintf("%s.across[%d]", identifier, i)
+
+		if v.Var == "" {
+			errorMessages = append(errorMessages, subIdentifier+" is missing a var name")
+		} else if names[v.Var] {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" repeats the var '%s'", v.Var))
+		}
+		names[v.Var] = true
+
+		if v.ValuesFrom != "" && !(strings.HasPrefix(v.ValuesFrom, "((") && strings.HasSuffix(v.ValuesFrom, "))")) {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(".values must be a list or a var reference ('%s')", v.ValuesFrom))
+		}
+
+		if v.MaxInFlight < 0 {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(".max_in_flight has an invalid limit (%d)", v.MaxInFlight))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	var errorMessages []string
 	var foundInapplicableFields []string
@@ -720,9 +807,15 @@ func compositeErr(errorMessages []string) error {
 }
 
 func validateVarSources(c Config) error {
+	return ValidateVarSources(c.VarSources)
+}
+
+// ValidateVarSources validates var sources, whether they are declared by a
+// pipeline or shared by a team's pipelines.
+func ValidateVarSources(varSources VarSourceConfigs) error {
 	names := map[string]interface{}{}
 
-	for _, cm := range c.VarSources {
+	for _, cm := range varSources {
 		factory := creds.ManagerFactories()[cm.Type]
 		if factory == nil {
 			return fmt.Errorf("unknown credential manager type: %s", cm.Type)
@@ -751,7 +844,7 @@ func validateVarSources(c Config) error {
 		}
 	}
 
-	if _, err := c.VarSources.OrderByDependency(); err != nil {
+	if _, err := varSources.OrderByDependency(); err != nil {
 		return err
 	}
 
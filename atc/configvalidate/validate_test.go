@@ -6,8 +6,10 @@ import (
 
 	. "github.com/concourse/concourse/atc"
 
-	// load dummy credential manager
+	// load credential managers
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/env"
+	_ "github.com/concourse/concourse/atc/creds/file"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -209,6 +211,25 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		for _, hostType := range []string{"env", "file"} {
+			hostType := hostType
+
+			Context("when a var source reads from the ATC's host with "+hostType, func() {
+				BeforeEach(func() {
+					config.VarSources = append(config.VarSources, VarSourceConfig{
+						Name:   "some",
+						Type:   hostType,
+						Config: map[string]interface{}{"prefix": "CONCOURSE_", "dir": "/"},
+					})
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("credential manager type " + hostType + " is not supported in pipeline"))
+				})
+			})
+		}
+
 		Context("when config is invalid", func() {
 			BeforeEach(func() {
 				config.VarSources = append(config.VarSources, VarSourceConfig{
@@ -658,6 +679,67 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has a timeout that cannot be parsed", func() {
+			BeforeEach(func() {
+				job.Timeout = "nope"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has a timeout that could not be parsed ('nope')"))
+			})
+		})
+
+		Context("when a job has a team serial group with no name", func() {
+			BeforeEach(func() {
+				job.TeamSerialGroups = []string{"some-group", " "}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has a team_serial_groups entry with no name"))
+			})
+		})
+
+		Context("when a job has the same team serial group more than once", func() {
+			BeforeEach(func() {
+				job.TeamSerialGroups = []string{"some-group", "some-group"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has the same team serial group more than once ('some-group')"))
+			})
+		})
+
+		Context("when a job has valid team serial groups", func() {
+			BeforeEach(func() {
+				job.TeamSerialGroups = []string{"some-group", "some-other-group"}
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has a valid timeout", func() {
+			BeforeEach(func() {
+				job.Timeout = "1h30m"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.Plan = append(job.Plan, PlanConfig{
@@ -1344,6 +1426,64 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a step is run across valid vars", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Across: []AcrossVarConfig{
+							{Var: "v1", Values: []interface{}{"a", "b"}, MaxInFlight: 2},
+							{Var: "v2", ValuesFrom: "((.:some-list))"},
+						},
+						FailFast: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a step is run across invalid vars", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Across: []AcrossVarConfig{
+							{Values: []interface{}{"a"}},
+							{Var: "v", ValuesFrom: "not-a-var", MaxInFlight: -1},
+							{Var: "v", Values: []interface{}{"a"}},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[0] is missing a var name"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[1].values must be a list or a var reference ('not-a-var')"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[1].max_in_flight has an invalid limit (-1)"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[2] repeats the var 'v'"))
+				})
+			})
+
+			Context("when a step sets fail_fast without across", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						FailFast: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.fail_fast is only applicable to steps run across vars"))
+				})
+			})
+
 			Context("when a put plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1473,6 +1613,84 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a load_var loads from a get step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+					}, PlanConfig{
+						LoadVar: "a-var",
+						From:    "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a load_var loads from the implicit get of a put step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+					}, PlanConfig{
+						LoadVar: "a-var",
+						From:    "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a load_var loads from an unknown get step", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+					}, PlanConfig{
+						InParallel: &InParallelConfig{
+							Steps: PlanSequence{
+								{
+									LoadVar: "a-var",
+									From:    "some-resorce",
+								},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has a load_var step loading from an unknown get step: some-resorce"))
+				})
+			})
+
+			Context("when a load_var has defined both 'File' and 'From'", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get: "some-resource",
+					}, PlanConfig{
+						LoadVar: "a-var",
+						File:    "some-resource/file",
+						From:    "some-resource",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[1].load_var.a-var specifies both a file and a get step to load from"))
+					Expect(errorMessages[0]).ToNot(ContainSubstring("unknown get step"))
+				})
+			})
+
 			Context("when two load_var steps have same name", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -17,6 +17,10 @@ type CachedSecrets struct {
 	secrets     Secrets
 	cacheConfig SecretCacheConfig
 	cache       *cache.Cache
+
+	// leased entries outlive their cache entry, so that their lease can be
+	// renewed instead of reading a new secret, but not their lease
+	leased *cache.Cache
 }
 
 type CacheEntry struct {
@@ -33,6 +37,7 @@ func NewCachedSecrets(secrets Secrets, cacheConfig SecretCacheConfig) *CachedSec
 		secrets:     secrets,
 		cacheConfig: cacheConfig,
 		cache:       cache.New(cacheConfig.Duration, cacheConfig.PurgeInterval),
+		leased:      cache.New(cache.NoExpiration, cacheConfig.PurgeInterval),
 	}
 }
 
@@ -44,6 +49,31 @@ func (cs *CachedSecrets) Get(secretPath string) (interface{}, *time.Time, bool,
 		return result.value, result.expiration, result.found, nil
 	}
 
+	// if the secret is leased, try to renew its lease before reading it again
+	leased, isLeased := cs.secrets.(LeasedSecrets)
+	if isLeased {
+		if previous, found := cs.leased.Get(secretPath); found {
+			cs.leased.Delete(secretPath)
+
+			expiration, renewed, err := leased.RenewLease(secretPath)
+			if err == nil && renewed {
+				result := previous.(CacheEntry)
+				previousExpiration := result.expiration
+				result.expiration = expiration
+
+				// once the lease reaches its max TTL, renewing it no longer
+				// extends it, and the secret has to be read again when it
+				// expires
+				if expiration != nil && expiration.After(*previousExpiration) {
+					cs.setLeased(secretPath, result)
+				}
+
+				cs.cache.Set(secretPath, result, cs.duration(expiration))
+				return result.value, result.expiration, true, nil
+			}
+		}
+	}
+
 	// otherwise, let's make a request to the underlying secret manager
 	value, expiration, found, err := cs.secrets.Get(secretPath)
 
@@ -57,16 +87,11 @@ func (cs *CachedSecrets) Get(secretPath string) (interface{}, *time.Time, bool,
 	entry = CacheEntry{value: value, expiration: expiration, found: found}
 
 	if found {
-		// take default cache ttl
-		duration := cs.cacheConfig.Duration
-		if expiration != nil {
-			// if secret lease time expires sooner, make duration smaller than default duration
-			itemDuration := expiration.Sub(time.Now())
-			if itemDuration < duration {
-				duration = itemDuration
-			}
+		cs.cache.Set(secretPath, entry, cs.duration(expiration))
+
+		if isLeased && expiration != nil {
+			cs.setLeased(secretPath, entry.(CacheEntry))
 		}
-		cs.cache.Set(secretPath, entry, duration)
 	} else {
 		cs.cache.Set(secretPath, entry, cs.cacheConfig.DurationNotFound)
 	}
@@ -74,6 +99,45 @@ func (cs *CachedSecrets) Get(secretPath string) (interface{}, *time.Time, bool,
 	return value, expiration, found, nil
 }
 
+// setLeased keeps the leased entry until its lease expires, after which it
+// can no longer be renewed.
+func (cs *CachedSecrets) setLeased(secretPath string, entry CacheEntry) {
+	ttl := time.Until(*entry.expiration)
+	if ttl <= 0 {
+		return
+	}
+
+	cs.leased.Set(secretPath, entry, ttl)
+}
+
+func (cs *CachedSecrets) duration(expiration *time.Time) time.Duration {
+	// take default cache ttl
+	duration := cs.cacheConfig.Duration
+	if expiration != nil {
+		// if secret lease time expires sooner, make duration smaller than default duration
+		itemDuration := expiration.Sub(time.Now())
+		if itemDuration < duration {
+			duration = itemDuration
+		}
+	}
+	return duration
+}
+
+func (cs *CachedSecrets) RenewLease(secretPath string) (*time.Time, bool, error) {
+	leased, ok := cs.secrets.(LeasedSecrets)
+	if !ok {
+		return nil, false, nil
+	}
+
+	return leased.RenewLease(secretPath)
+}
+
+func (cs *CachedSecrets) OnRotation(hook RotationHook) {
+	if leased, ok := cs.secrets.(LeasedSecrets); ok {
+		leased.OnRotation(hook)
+	}
+}
+
 func (cs *CachedSecrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
 	return cs.secrets.NewSecretLookupPaths(teamName, pipelineName, allowRootPath)
 }
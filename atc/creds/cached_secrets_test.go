@@ -162,4 +162,87 @@ var _ = Describe("Caching of secrets", func() {
 		Expect(underlyingMisses).To(BeIdenticalTo(4))
 	})
 
+	Context("when the underlying secrets are leased", func() {
+		var leasedSecretManager *credsfakes.FakeLeasedSecrets
+
+		var leaseExpiration time.Time
+
+		BeforeEach(func() {
+			cacheConfig.Duration = 100 * time.Millisecond
+
+			leasedSecretManager = new(credsfakes.FakeLeasedSecrets)
+			cachedSecretManager = creds.NewCachedSecrets(leasedSecretManager, cacheConfig)
+
+			leaseExpiration = time.Now().Add(500 * time.Millisecond)
+			leasedSecretManager.GetStub = makeGetStub("foo", "value", &leaseExpiration, true, nil, &underlyingReads, &underlyingMisses)
+		})
+
+		It("renews the lease of an expired secret instead of reading it again", func() {
+			renewedExpiration := time.Now().Add(time.Hour)
+			leasedSecretManager.RenewLeaseReturns(&renewedExpiration, true, nil)
+
+			_, _, _, _ = cachedSecretManager.Get("foo")
+			Expect(underlyingReads).To(BeIdenticalTo(1))
+
+			time.Sleep(150 * time.Millisecond)
+
+			value, expiration, found, err := cachedSecretManager.Get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("value"))
+			Expect(expiration).To(Equal(&renewedExpiration))
+
+			Expect(underlyingReads).To(BeIdenticalTo(1))
+			Expect(leasedSecretManager.RenewLeaseCallCount()).To(Equal(1))
+			Expect(leasedSecretManager.RenewLeaseArgsForCall(0)).To(Equal("foo"))
+		})
+
+		It("reads the secret again when its lease cannot be renewed", func() {
+			leasedSecretManager.RenewLeaseReturns(nil, false, nil)
+
+			_, _, _, _ = cachedSecretManager.Get("foo")
+
+			time.Sleep(150 * time.Millisecond)
+
+			_, _, _, _ = cachedSecretManager.Get("foo")
+			Expect(leasedSecretManager.RenewLeaseCallCount()).To(Equal(1))
+			Expect(underlyingReads).To(BeIdenticalTo(2))
+		})
+
+		It("reads the secret again once its lease has expired", func() {
+			_, _, _, _ = cachedSecretManager.Get("foo")
+
+			time.Sleep(600 * time.Millisecond)
+
+			_, _, _, _ = cachedSecretManager.Get("foo")
+			Expect(leasedSecretManager.RenewLeaseCallCount()).To(BeZero())
+			Expect(underlyingReads).To(BeIdenticalTo(2))
+		})
+
+		It("stops renewing the lease once it reaches its max TTL", func() {
+			leasedSecretManager.RenewLeaseReturns(&leaseExpiration, true, nil)
+
+			_, _, _, _ = cachedSecretManager.Get("foo")
+
+			time.Sleep(150 * time.Millisecond)
+
+			value, expiration, found, err := cachedSecretManager.Get("foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("value"))
+			Expect(expiration).To(Equal(&leaseExpiration))
+			Expect(leasedSecretManager.RenewLeaseCallCount()).To(Equal(1))
+
+			time.Sleep(150 * time.Millisecond)
+
+			_, _, _, _ = cachedSecretManager.Get("foo")
+			Expect(leasedSecretManager.RenewLeaseCallCount()).To(Equal(1))
+			Expect(underlyingReads).To(BeIdenticalTo(2))
+		})
+
+		It("registers rotation hooks with the underlying secrets", func() {
+			cachedSecretManager.OnRotation(func(string) {})
+			Expect(leasedSecretManager.OnRotationCallCount()).To(Equal(1))
+		})
+	})
 })
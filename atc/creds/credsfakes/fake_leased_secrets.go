@@ -0,0 +1,324 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package credsfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type FakeLeasedSecrets struct {
+	GetStub        func(string) (interface{}, *time.Time, bool, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		arg1 string
+	}
+	getReturns struct {
+		result1 interface{}
+		result2 *time.Time
+		result3 bool
+		result4 error
+	}
+	getReturnsOnCall map[int]struct {
+		result1 interface{}
+		result2 *time.Time
+		result3 bool
+		result4 error
+	}
+	NewSecretLookupPathsStub        func(string, string, bool) []creds.SecretLookupPath
+	newSecretLookupPathsMutex       sync.RWMutex
+	newSecretLookupPathsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	newSecretLookupPathsReturns struct {
+		result1 []creds.SecretLookupPath
+	}
+	newSecretLookupPathsReturnsOnCall map[int]struct {
+		result1 []creds.SecretLookupPath
+	}
+	OnRotationStub        func(creds.RotationHook)
+	onRotationMutex       sync.RWMutex
+	onRotationArgsForCall []struct {
+		arg1 creds.RotationHook
+	}
+	RenewLeaseStub        func(string) (*time.Time, bool, error)
+	renewLeaseMutex       sync.RWMutex
+	renewLeaseArgsForCall []struct {
+		arg1 string
+	}
+	renewLeaseReturns struct {
+		result1 *time.Time
+		result2 bool
+		result3 error
+	}
+	renewLeaseReturnsOnCall map[int]struct {
+		result1 *time.Time
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLeasedSecrets) Get(arg1 string) (interface{}, *time.Time, bool, error) {
+	fake.getMutex.Lock()
+	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Get", []interface{}{arg1})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeLeasedSecrets) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeLeasedSecrets) GetCalls(stub func(string) (interface{}, *time.Time, bool, error)) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = stub
+}
+
+func (fake *FakeLeasedSecrets) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	argsForCall := fake.getArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLeasedSecrets) GetReturns(result1 interface{}, result2 *time.Time, result3 bool, result4 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 interface{}
+		result2 *time.Time
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLeasedSecrets) GetReturnsOnCall(i int, result1 interface{}, result2 *time.Time, result3 bool, result4 error) {
+	fake.getMutex.Lock()
+	defer fake.getMutex.Unlock()
+	fake.GetStub = nil
+	if fake.getReturnsOnCall == nil {
+		fake.getReturnsOnCall = make(map[int]struct {
+			result1 interface{}
+			result2 *time.Time
+			result3 bool
+			result4 error
+		})
+	}
+	fake.getReturnsOnCall[i] = struct {
+		result1 interface{}
+		result2 *time.Time
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLeasedSecrets) NewSecretLookupPaths(arg1 string, arg2 string, arg3 bool) []creds.SecretLookupPath {
+	fake.newSecretLookupPathsMutex.Lock()
+	ret, specificReturn := fake.newSecretLookupPathsReturnsOnCall[len(fake.newSecretLookupPathsArgsForCall)]
+	fake.newSecretLookupPathsArgsForCall = append(fake.newSecretLookupPathsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("NewSecretLookupPaths", []interface{}{arg1, arg2, arg3})
+	fake.newSecretLookupPathsMutex.Unlock()
+	if fake.NewSecretLookupPathsStub != nil {
+		return fake.NewSecretLookupPathsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.newSecretLookupPathsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeLeasedSecrets) NewSecretLookupPathsCallCount() int {
+	fake.newSecretLookupPathsMutex.RLock()
+	defer fake.newSecretLookupPathsMutex.RUnlock()
+	return len(fake.newSecretLookupPathsArgsForCall)
+}
+
+func (fake *FakeLeasedSecrets) NewSecretLookupPathsCalls(stub func(string, string, bool) []creds.SecretLookupPath) {
+	fake.newSecretLookupPathsMutex.Lock()
+	defer fake.newSecretLookupPathsMutex.Unlock()
+	fake.NewSecretLookupPathsStub = stub
+}
+
+func (fake *FakeLeasedSecrets) NewSecretLookupPathsArgsForCall(i int) (string, string, bool) {
+	fake.newSecretLookupPathsMutex.RLock()
+	defer fake.newSecretLookupPathsMutex.RUnlock()
+	argsForCall := fake.newSecretLookupPathsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLeasedSecrets) NewSecretLookupPathsReturns(result1 []creds.SecretLookupPath) {
+	fake.newSecretLookupPathsMutex.Lock()
+	defer fake.newSecretLookupPathsMutex.Unlock()
+	fake.NewSecretLookupPathsStub = nil
+	fake.newSecretLookupPathsReturns = struct {
+		result1 []creds.SecretLookupPath
+	}{result1}
+}
+
+func (fake *FakeLeasedSecrets) NewSecretLookupPathsReturnsOnCall(i int, result1 []creds.SecretLookupPath) {
+	fake.newSecretLookupPathsMutex.Lock()
+	defer fake.newSecretLookupPathsMutex.Unlock()
+	fake.NewSecretLookupPathsStub = nil
+	if fake.newSecretLookupPathsReturnsOnCall == nil {
+		fake.newSecretLookupPathsReturnsOnCall = make(map[int]struct {
+			result1 []creds.SecretLookupPath
+		})
+	}
+	fake.newSecretLookupPathsReturnsOnCall[i] = struct {
+		result1 []creds.SecretLookupPath
+	}{result1}
+}
+
+func (fake *FakeLeasedSecrets) OnRotation(arg1 creds.RotationHook) {
+	fake.onRotationMutex.Lock()
+	fake.onRotationArgsForCall = append(fake.onRotationArgsForCall, struct {
+		arg1 creds.RotationHook
+	}{arg1})
+	fake.recordInvocation("OnRotation", []interface{}{arg1})
+	fake.onRotationMutex.Unlock()
+	if fake.OnRotationStub != nil {
+		fake.OnRotationStub(arg1)
+	}
+}
+
+func (fake *FakeLeasedSecrets) OnRotationCallCount() int {
+	fake.onRotationMutex.RLock()
+	defer fake.onRotationMutex.RUnlock()
+	return len(fake.onRotationArgsForCall)
+}
+
+func (fake *FakeLeasedSecrets) OnRotationCalls(stub func(creds.RotationHook)) {
+	fake.onRotationMutex.Lock()
+	defer fake.onRotationMutex.Unlock()
+	fake.OnRotationStub = stub
+}
+
+func (fake *FakeLeasedSecrets) OnRotationArgsForCall(i int) creds.RotationHook {
+	fake.onRotationMutex.RLock()
+	defer fake.onRotationMutex.RUnlock()
+	argsForCall := fake.onRotationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLeasedSecrets) RenewLease(arg1 string) (*time.Time, bool, error) {
+	fake.renewLeaseMutex.Lock()
+	ret, specificReturn := fake.renewLeaseReturnsOnCall[len(fake.renewLeaseArgsForCall)]
+	fake.renewLeaseArgsForCall = append(fake.renewLeaseArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RenewLease", []interface{}{arg1})
+	fake.renewLeaseMutex.Unlock()
+	if fake.RenewLeaseStub != nil {
+		return fake.RenewLeaseStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.renewLeaseReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLeasedSecrets) RenewLeaseCallCount() int {
+	fake.renewLeaseMutex.RLock()
+	defer fake.renewLeaseMutex.RUnlock()
+	return len(fake.renewLeaseArgsForCall)
+}
+
+func (fake *FakeLeasedSecrets) RenewLeaseCalls(stub func(string) (*time.Time, bool, error)) {
+	fake.renewLeaseMutex.Lock()
+	defer fake.renewLeaseMutex.Unlock()
+	fake.RenewLeaseStub = stub
+}
+
+func (fake *FakeLeasedSecrets) RenewLeaseArgsForCall(i int) string {
+	fake.renewLeaseMutex.RLock()
+	defer fake.renewLeaseMutex.RUnlock()
+	argsForCall := fake.renewLeaseArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLeasedSecrets) RenewLeaseReturns(result1 *time.Time, result2 bool, result3 error) {
+	fake.renewLeaseMutex.Lock()
+	defer fake.renewLeaseMutex.Unlock()
+	fake.RenewLeaseStub = nil
+	fake.renewLeaseReturns = struct {
+		result1 *time.Time
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLeasedSecrets) RenewLeaseReturnsOnCall(i int, result1 *time.Time, result2 bool, result3 error) {
+	fake.renewLeaseMutex.Lock()
+	defer fake.renewLeaseMutex.Unlock()
+	fake.RenewLeaseStub = nil
+	if fake.renewLeaseReturnsOnCall == nil {
+		fake.renewLeaseReturnsOnCall = make(map[int]struct {
+			result1 *time.Time
+			result2 bool
+			result3 error
+		})
+	}
+	fake.renewLeaseReturnsOnCall[i] = struct {
+		result1 *time.Time
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLeasedSecrets) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	fake.newSecretLookupPathsMutex.RLock()
+	defer fake.newSecretLookupPathsMutex.RUnlock()
+	fake.onRotationMutex.RLock()
+	defer fake.onRotationMutex.RUnlock()
+	fake.renewLeaseMutex.RLock()
+	defer fake.renewLeaseMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeLeasedSecrets) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ creds.LeasedSecrets = new(FakeLeasedSecrets)
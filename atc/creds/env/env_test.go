@@ -0,0 +1,76 @@
+package env_test
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/env"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Env", func() {
+	var (
+		envVars map[string]string
+		vs      vars.Variables
+	)
+
+	get := func(name string) (interface{}, bool) {
+		val, found, err := vs.Get(vars.VariableDefinition{Name: name})
+		Expect(err).ToNot(HaveOccurred())
+		return val, found
+	}
+
+	BeforeEach(func() {
+		envVars = map[string]string{
+			"SHARED_VAR":                             "root-value",
+			"SHARED__SHARED_VAR":                     "shared-value",
+			"SOME_TEAM__TEAM_VAR":                    "team-value",
+			"SOME_TEAM__SHARED_VAR":                  "team-value",
+			"SOME_TEAM__SOME_PIPELINE__PIPELINE_VAR": "pipeline-value",
+			"SOME_TEAM__SOME_PIPELINE__TEAM_VAR":     "pipeline-value",
+			"OTHER_TEAM__OTHER_VAR":                  "other-value",
+		}
+
+		factory := env.NewSecretsFactory(envVars, "shared")
+		vs = creds.NewVariables(factory.NewSecrets(), "some-team", "some-pipeline", false)
+	})
+
+	It("looks up vars under the pipeline, then the team, then the shared path", func() {
+		val, found := get("pipeline-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("pipeline-value"))
+
+		val, found = get("team-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("pipeline-value"))
+
+		val, found = get("shared-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("team-value"))
+
+		_, found = get("other-var")
+		Expect(found).To(BeFalse())
+	})
+
+	It("falls back to the shared path", func() {
+		vs = creds.NewVariables(env.NewSecretsFactory(envVars, "shared").NewSecrets(), "other-team", "", false)
+
+		val, found := get("shared-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("shared-value"))
+	})
+
+	It("only looks up vars at the root when allowed", func() {
+		vs = creds.NewVariables(env.NewSecretsFactory(envVars, "").NewSecrets(), "other-team", "", false)
+
+		_, found := get("shared-var")
+		Expect(found).To(BeFalse())
+
+		vs = creds.NewVariables(env.NewSecretsFactory(envVars, "").NewSecrets(), "other-team", "", true)
+
+		val, found := get("shared-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("root-value"))
+	})
+})
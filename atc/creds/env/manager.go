@@ -0,0 +1,82 @@
+package env
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type Manager struct {
+	Prefix     string `long:"prefix" description:"Prefix of the ATC's environment variables to read credentials from, named PREFIX[TEAM__[PIPELINE__]]VAR in upper case with dashes replaced by underscores."`
+	SharedPath string `long:"shared-path" description:"Path in which to lookup shared credentials, e.g. shared for PREFIXSHARED__VAR."`
+
+	Vars map[string]string
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	manager.Vars = map[string]string{}
+
+	for _, env := range os.Environ() {
+		name, value := splitEnv(env)
+		if strings.HasPrefix(name, manager.Prefix) {
+			manager.Vars[strings.TrimPrefix(name, manager.Prefix)] = value
+		}
+	}
+
+	return nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"prefix":      manager.Prefix,
+		"shared_path": manager.SharedPath,
+		"health":      health,
+	})
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Prefix != ""
+}
+
+func (manager *Manager) Validate() error {
+	if manager.Prefix == "" {
+		return errors.New("prefix must be a non-empty string")
+	}
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	return &creds.HealthResponse{
+		Method: "env",
+		Response: map[string]interface{}{
+			"secrets": len(manager.Vars),
+		},
+	}, nil
+}
+
+func (manager *Manager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
+	return NewSecretsFactory(manager.Vars, manager.SharedPath), nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+}
+
+func splitEnv(env string) (string, string) {
+	segs := strings.SplitN(env, "=", 2)
+	if len(segs) != 2 {
+		return segs[0], ""
+	}
+
+	return segs[0], segs[1]
+}
@@ -0,0 +1,98 @@
+package env_test
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds/env"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var manager *env.Manager
+
+	BeforeEach(func() {
+		manager = &env.Manager{}
+		_, err := flags.ParseArgs(manager, []string{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("IsConfigured()", func() {
+		It("fails on empty Manager", func() {
+			Expect(manager.IsConfigured()).To(BeFalse())
+		})
+
+		It("passes if Prefix is set", func() {
+			manager.Prefix = "CREDS_"
+			Expect(manager.IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Describe("Validate()", func() {
+		It("passes if Prefix is set", func() {
+			manager.Prefix = "CREDS_"
+			Expect(manager.Validate()).To(Succeed())
+		})
+
+		It("fails on empty Prefix", func() {
+			Expect(manager.Validate()).To(MatchError("prefix must be a non-empty string"))
+		})
+	})
+
+	Describe("Init()", func() {
+		var logger *lagertest.TestLogger
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+
+			manager.Prefix = "ENV_CREDS_TEST_"
+
+			os.Setenv("ENV_CREDS_TEST_SOME_TEAM__SOME_VAR", "some=value")
+			os.Setenv("OTHER_ENV_CREDS_TEST_SOME_TEAM__OTHER_VAR", "other-value")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("ENV_CREDS_TEST_SOME_TEAM__SOME_VAR")
+			os.Unsetenv("OTHER_ENV_CREDS_TEST_SOME_TEAM__OTHER_VAR")
+		})
+
+		It("reads the vars with the prefix from the environment", func() {
+			Expect(manager.Init(logger)).To(Succeed())
+
+			factory, err := manager.NewSecretsFactory(logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			secrets := factory.NewSecrets()
+
+			val, _, found, err := secrets.Get("some-team/some-var")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("some=value"))
+
+			_, _, found, err = secrets.Get("some-team/other-var")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("reports the secrets in its health", func() {
+			Expect(manager.Init(logger)).To(Succeed())
+
+			health, err := manager.Health()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health.Method).To(Equal("env"))
+			Expect(health.Response).To(HaveKeyWithValue("secrets", 1))
+		})
+	})
+
+	Describe("NewInstance()", func() {
+		It("refuses to create a manager for a var source", func() {
+			_, err := env.NewManagerFactory().NewInstance(map[string]interface{}{
+				"prefix": "CONCOURSE_",
+			})
+			Expect(err).To(MatchError("the env credential manager can only be configured by the ATC's flags"))
+		})
+	})
+})
@@ -0,0 +1,49 @@
+package env
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+var nameReplacer = strings.NewReplacer("/", "__", "-", "_")
+
+type Secrets struct {
+	vars       map[string]string
+	sharedPath string
+}
+
+// NewSecretLookupPaths defines how variables will be searched in the underlying secret manager
+func (secrets *Secrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+	if len(pipelineName) > 0 {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(path.Join(teamName, pipelineName)+"/"))
+	}
+	lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(teamName+"/"))
+	if secrets.sharedPath != "" {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(secrets.sharedPath+"/"))
+	}
+	if allowRootPath {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(""))
+	}
+	return lookupPaths
+}
+
+// Get retrieves the value of an individual secret from the environment
+// variable named after its path, e.g. SOME_TEAM__SOME_VAR for
+// some-team/some-var. The environment is read once when the ATC starts, so
+// the values never expire.
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	val, found := secrets.vars[envName(secretPath)]
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	return val, nil, true, nil
+}
+
+func envName(secretPath string) string {
+	return strings.ToUpper(nameReplacer.Replace(secretPath))
+}
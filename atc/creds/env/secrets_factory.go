@@ -0,0 +1,24 @@
+package env
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type SecretsFactory struct {
+	vars       map[string]string
+	sharedPath string
+}
+
+func NewSecretsFactory(vars map[string]string, sharedPath string) *SecretsFactory {
+	return &SecretsFactory{
+		vars:       vars,
+		sharedPath: sharedPath,
+	}
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return &Secrets{
+		vars:       factory.vars,
+		sharedPath: factory.sharedPath,
+	}
+}
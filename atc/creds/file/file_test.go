@@ -0,0 +1,188 @@
+package file_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/file"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("File", func() {
+	var (
+		dir   string
+		store *file.Store
+		vs    vars.Variables
+	)
+
+	writeVar := func(path string, content string) {
+		fullPath := filepath.Join(dir, path)
+
+		err := os.MkdirAll(filepath.Dir(fullPath), 0755)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = ioutil.WriteFile(fullPath, []byte(content), 0644)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	get := func(name string) (interface{}, bool) {
+		val, found, err := vs.Get(vars.VariableDefinition{Name: name})
+		Expect(err).ToNot(HaveOccurred())
+		return val, found
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "file-creds")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeVar("shared-var.yml", "root-value")
+		writeVar("shared/shared-var.yml", "shared-value")
+		writeVar("some-team/team-var.yml", "team-value")
+		writeVar("some-team/shared-var.yml", "team-value")
+		writeVar("some-team/some-pipeline/pipeline-var.yaml", "pipeline-value")
+		writeVar("some-team/some-pipeline/team-var.yml", "pipeline-value")
+		writeVar("some-team/some-pipeline/some-map.yml", "username: some-user\npassword: some-password\n")
+		writeVar("other-team/other-var.yml", "other-value")
+
+		store = file.NewStore(dir)
+		reloaded, err := store.Reload()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reloaded).To(BeTrue())
+
+		factory := file.NewSecretsFactory(store, "shared")
+		vs = creds.NewVariables(factory.NewSecrets(), "some-team", "some-pipeline", false)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("looks up vars under the pipeline, then the team, then the shared path", func() {
+		val, found := get("pipeline-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("pipeline-value"))
+
+		val, found = get("team-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("pipeline-value"))
+
+		val, found = get("shared-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("team-value"))
+
+		_, found = get("other-var")
+		Expect(found).To(BeFalse())
+	})
+
+	It("falls back to the shared path", func() {
+		vs = creds.NewVariables(file.NewSecretsFactory(store, "shared").NewSecrets(), "other-team", "", false)
+
+		val, found := get("shared-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("shared-value"))
+	})
+
+	It("only looks up vars at the root when allowed", func() {
+		vs = creds.NewVariables(file.NewSecretsFactory(store, "").NewSecrets(), "other-team", "", false)
+
+		_, found := get("shared-var")
+		Expect(found).To(BeFalse())
+
+		vs = creds.NewVariables(file.NewSecretsFactory(store, "").NewSecrets(), "other-team", "", true)
+
+		val, found := get("shared-var")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("root-value"))
+	})
+
+	It("parses the files as YAML", func() {
+		val, found := get("some-map")
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal(map[string]interface{}{
+			"username": "some-user",
+			"password": "some-password",
+		}))
+
+		val, err := creds.NewString(vs, "((some-map.username))").Evaluate()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(val).To(Equal("some-user"))
+	})
+
+	It("ignores hidden files and files without a YAML extension", func() {
+		writeVar("some-team/.hidden-var.yml", "hidden-value")
+		writeVar("some-team/..data/hidden-var.yml", "hidden-value")
+		writeVar("some-team/text-var.txt", "text-value")
+
+		_, err := store.Reload()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, found := get(".hidden-var")
+		Expect(found).To(BeFalse())
+
+		_, found = get("text-var.txt")
+		Expect(found).To(BeFalse())
+
+		secrets, _, _ := store.Status()
+		Expect(secrets).To(Equal(8))
+	})
+
+	Describe("reloading", func() {
+		It("does not re-read the directory if nothing changed", func() {
+			reloaded, err := store.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reloaded).To(BeFalse())
+		})
+
+		It("picks up added, modified and removed files", func() {
+			writeVar("some-team/new-var.yml", "new-value")
+			writeVar("some-team/team-var.yml", "modified-team-value")
+			Expect(os.Remove(filepath.Join(dir, "some-team/some-pipeline/team-var.yml"))).To(Succeed())
+
+			reloaded, err := store.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reloaded).To(BeTrue())
+
+			val, found := get("new-var")
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("new-value"))
+
+			val, found = get("team-var")
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("modified-team-value"))
+		})
+
+		It("keeps the previous secrets if a file cannot be parsed", func() {
+			writeVar("some-team/shared-var.yml", "{ not yaml")
+
+			_, err := store.Reload()
+			Expect(err).To(HaveOccurred())
+
+			val, found := get("shared-var")
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("team-value"))
+
+			_, _, loadErr := store.Status()
+			Expect(loadErr).To(HaveOccurred())
+
+			By("recovering once the file is fixed")
+			writeVar("some-team/shared-var.yml", "fixed-value")
+
+			reloaded, err := store.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reloaded).To(BeTrue())
+
+			_, _, loadErr = store.Status()
+			Expect(loadErr).ToNot(HaveOccurred())
+
+			val, found = get("shared-var")
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("fixed-value"))
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package file
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type Manager struct {
+	Dir            string        `long:"dir" description:"Directory of YAML files to read credentials from, laid out as TEAM/[PIPELINE/]VAR.yml."`
+	SharedPath     string        `long:"shared-path" description:"Path under the directory in which to lookup shared credentials."`
+	ReloadInterval time.Duration `long:"reload-interval" default:"10s" description:"Interval on which to check the directory for changes."`
+
+	Store *Store
+
+	stop chan struct{}
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	manager.Store = NewStore(manager.Dir)
+
+	_, err := manager.Store.Reload()
+	if err != nil {
+		log.Error("failed-to-load-credentials", err)
+		return err
+	}
+
+	manager.stop = make(chan struct{})
+	go manager.reload(log.Session("reload"), manager.stop)
+
+	return nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"dir":             manager.Dir,
+		"shared_path":     manager.SharedPath,
+		"reload_interval": manager.ReloadInterval,
+		"health":          health,
+	})
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Dir != ""
+}
+
+func (manager *Manager) Validate() error {
+	if manager.Dir == "" {
+		return errors.New("dir must be a non-empty string")
+	}
+
+	info, err := os.Stat(manager.Dir)
+	if err != nil {
+		return fmt.Errorf("invalid dir: %s", err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("invalid dir: %s is not a directory", manager.Dir)
+	}
+
+	if manager.ReloadInterval <= 0 {
+		return errors.New("reload interval must be positive")
+	}
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "file",
+	}
+
+	if manager.Store == nil {
+		return health, nil
+	}
+
+	secrets, loadedAt, err := manager.Store.Status()
+	if err != nil {
+		health.Error = err.Error()
+	}
+
+	health.Response = map[string]interface{}{
+		"secrets":   secrets,
+		"loaded_at": loadedAt,
+	}
+
+	return health, nil
+}
+
+func (manager *Manager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
+	return NewSecretsFactory(manager.Store, manager.SharedPath), nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+	if manager.stop != nil {
+		close(manager.stop)
+		manager.stop = nil
+	}
+}
+
+func (manager *Manager) reload(logger lager.Logger, stop <-chan struct{}) {
+	ticker := time.NewTicker(manager.ReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			reloaded, err := manager.Store.Reload()
+			if err != nil {
+				logger.Error("failed-to-reload-credentials", err)
+				continue
+			}
+
+			if reloaded {
+				logger.Info("reloaded-credentials")
+			}
+
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package file
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type managerFactory struct{}
+
+func init() {
+	creds.Register("file", NewManagerFactory())
+}
+
+func NewManagerFactory() creds.ManagerFactory {
+	return &managerFactory{}
+}
+
+func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &Manager{}
+
+	subGroup, err := group.AddGroup("File Credential Management", "", manager)
+	if err != nil {
+		panic(err)
+	}
+
+	subGroup.Namespace = "file-creds"
+
+	return manager
+}
+
+// NewInstance refuses to create file credential managers for var sources:
+// they read from the ATC's own host, so they may only be configured with the
+// ATC's flags.
+func (factory *managerFactory) NewInstance(interface{}) (creds.Manager, error) {
+	return nil, errors.New("the file credential manager can only be configured by the ATC's flags")
+}
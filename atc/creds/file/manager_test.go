@@ -0,0 +1,134 @@
+package file_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds/file"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		dir     string
+		manager *file.Manager
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "file-creds")
+		Expect(err).ToNot(HaveOccurred())
+
+		manager = &file.Manager{}
+		_, err = flags.ParseArgs(manager, []string{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("IsConfigured()", func() {
+		It("fails on empty Manager", func() {
+			Expect(manager.IsConfigured()).To(BeFalse())
+		})
+
+		It("passes if Dir is set", func() {
+			manager.Dir = dir
+			Expect(manager.IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Describe("Validate()", func() {
+		It("passes on an existing directory", func() {
+			manager.Dir = dir
+			Expect(manager.Validate()).To(Succeed())
+		})
+
+		It("fails if the directory does not exist", func() {
+			manager.Dir = filepath.Join(dir, "missing")
+			Expect(manager.Validate()).To(MatchError(ContainSubstring("invalid dir")))
+		})
+
+		It("fails if the directory is a file", func() {
+			manager.Dir = filepath.Join(dir, "some-file")
+			Expect(ioutil.WriteFile(manager.Dir, []byte{}, 0644)).To(Succeed())
+			Expect(manager.Validate()).To(MatchError(ContainSubstring("is not a directory")))
+		})
+
+		It("fails if the reload interval is not positive", func() {
+			manager.Dir = dir
+			manager.ReloadInterval = 0
+			Expect(manager.Validate()).To(MatchError("reload interval must be positive"))
+		})
+	})
+
+	Describe("Init()", func() {
+		var logger *lagertest.TestLogger
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+
+			manager.Dir = dir
+			manager.ReloadInterval = 10 * time.Millisecond
+
+			Expect(os.MkdirAll(filepath.Join(dir, "some-team"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(dir, "some-team", "some-var.yml"), []byte("some-value"), 0644)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			manager.Close(logger)
+		})
+
+		It("loads the directory and reloads it on change", func() {
+			Expect(manager.Init(logger)).To(Succeed())
+
+			factory, err := manager.NewSecretsFactory(logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			secrets := factory.NewSecrets()
+
+			val, _, found, err := secrets.Get("some-team/some-var")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("some-value"))
+
+			Expect(ioutil.WriteFile(filepath.Join(dir, "some-team", "some-var.yml"), []byte("some-other-value"), 0644)).To(Succeed())
+
+			Eventually(func() interface{} {
+				val, _, _, _ := secrets.Get("some-team/some-var")
+				return val
+			}).Should(Equal("some-other-value"))
+		})
+
+		It("reports the secrets in its health", func() {
+			Expect(manager.Init(logger)).To(Succeed())
+
+			health, err := manager.Health()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health.Method).To(Equal("file"))
+			Expect(health.Error).To(BeEmpty())
+			Expect(health.Response).To(HaveKeyWithValue("secrets", 1))
+		})
+
+		It("fails if the directory cannot be read", func() {
+			manager.Dir = filepath.Join(dir, "missing")
+			Expect(manager.Init(logger)).ToNot(Succeed())
+		})
+	})
+
+	Describe("NewInstance()", func() {
+		It("refuses to create a manager for a var source", func() {
+			_, err := file.NewManagerFactory().NewInstance(map[string]interface{}{
+				"dir": "/",
+			})
+			Expect(err).To(MatchError("the file credential manager can only be configured by the ATC's flags"))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package file
+
+import (
+	"path"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type Secrets struct {
+	store      *Store
+	sharedPath string
+}
+
+// NewSecretLookupPaths defines how variables will be searched in the underlying secret manager
+func (secrets *Secrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+	if len(pipelineName) > 0 {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(path.Join(teamName, pipelineName)+"/"))
+	}
+	lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(teamName+"/"))
+	if secrets.sharedPath != "" {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(secrets.sharedPath+"/"))
+	}
+	if allowRootPath {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(""))
+	}
+	return lookupPaths
+}
+
+// Get retrieves the value of an individual secret. Secrets read from files
+// never expire; changes are picked up when the directory is reloaded.
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	val, found := secrets.store.Get(secretPath)
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	return val, nil, true, nil
+}
@@ -0,0 +1,24 @@
+package file
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type SecretsFactory struct {
+	store      *Store
+	sharedPath string
+}
+
+func NewSecretsFactory(store *Store, sharedPath string) *SecretsFactory {
+	return &SecretsFactory{
+		store:      store,
+		sharedPath: sharedPath,
+	}
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return &Secrets{
+		store:      factory.store,
+		sharedPath: factory.sharedPath,
+	}
+}
@@ -0,0 +1,189 @@
+package file
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+var extensions = []string{".yml", ".yaml"}
+
+// Store holds the secrets read from a directory of YAML files, keyed by their
+// path relative to the directory without the file extension, e.g. the value
+// of main/some-pipeline/some-var.yml is stored as main/some-pipeline/some-var.
+type Store struct {
+	dir string
+
+	lock        sync.RWMutex
+	secrets     map[string]interface{}
+	fingerprint map[string]fileInfo
+	loadedAt    time.Time
+	loadErr     error
+}
+
+type fileInfo struct {
+	size    int64
+	modTime time.Time
+}
+
+func NewStore(dir string) *Store {
+	return &Store{
+		dir:     dir,
+		secrets: map[string]interface{}{},
+	}
+}
+
+// Get returns the secret stored at the given path.
+func (store *Store) Get(secretPath string) (interface{}, bool) {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	val, found := store.secrets[secretPath]
+	return val, found
+}
+
+// Reload re-reads the directory if any of its files have been added, removed
+// or modified since it was last read, and returns whether it was re-read. If
+// reading fails, the previously read secrets are kept.
+func (store *Store) Reload() (bool, error) {
+	fingerprint, err := store.scan()
+	if err != nil {
+		store.failed(err)
+		return false, err
+	}
+
+	store.lock.RLock()
+	unchanged := store.loadErr == nil && store.fingerprint != nil && sameFiles(store.fingerprint, fingerprint)
+	store.lock.RUnlock()
+
+	if unchanged {
+		return false, nil
+	}
+
+	secrets := map[string]interface{}{}
+	for file := range fingerprint {
+		payload, err := ioutil.ReadFile(filepath.Join(store.dir, file))
+		if err != nil {
+			store.failed(err)
+			return false, err
+		}
+
+		var val interface{}
+		err = yaml.Unmarshal(payload, &val)
+		if err != nil {
+			err = fmt.Errorf("failed to parse %s: %s", file, err)
+			store.failed(err)
+			return false, err
+		}
+
+		secrets[secretPath(file)] = val
+	}
+
+	store.lock.Lock()
+	store.secrets = secrets
+	store.fingerprint = fingerprint
+	store.loadedAt = time.Now()
+	store.loadErr = nil
+	store.lock.Unlock()
+
+	return true, nil
+}
+
+// Status returns the number of secrets, when they were last read and the
+// error of the last attempt to read them, if it failed.
+func (store *Store) Status() (int, time.Time, error) {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	return len(store.secrets), store.loadedAt, store.loadErr
+}
+
+func (store *Store) failed(err error) {
+	store.lock.Lock()
+	store.loadErr = err
+	store.lock.Unlock()
+}
+
+// scan walks the directory and returns the size and modification time of
+// every secret file. Hidden files and directories are skipped, and symlinks
+// are followed so that e.g. mounted Kubernetes secrets can be used.
+func (store *Store) scan() (map[string]fileInfo, error) {
+	files := map[string]fileInfo{}
+
+	err := filepath.Walk(store.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != store.dir && strings.HasPrefix(info.Name(), ".") {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			info, err = os.Stat(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !info.Mode().IsRegular() || !hasExtension(path) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(store.dir, path)
+		if err != nil {
+			return err
+		}
+
+		files[rel] = fileInfo{
+			size:    info.Size(),
+			modTime: info.ModTime(),
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func sameFiles(a, b map[string]fileInfo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for file, info := range a {
+		other, found := b[file]
+		if !found || other.size != info.size || !other.modTime.Equal(info.modTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasExtension(path string) bool {
+	for _, ext := range extensions {
+		if filepath.Ext(path) == ext {
+			return true
+		}
+	}
+
+	return false
+}
+
+func secretPath(file string) string {
+	return strings.TrimSuffix(filepath.ToSlash(file), filepath.Ext(file))
+}
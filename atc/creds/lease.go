@@ -0,0 +1,109 @@
+package creds
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+//go:generate counterfeiter . LeasedSecrets
+
+// LeasedSecrets is implemented by secret managers whose secrets are only
+// valid for the duration of a lease, e.g. Vault dynamic secrets.
+type LeasedSecrets interface {
+	Secrets
+
+	// RenewLease extends the lease of the secret last read from the given
+	// path and returns its new expiration. It returns false if the lease
+	// cannot be renewed, in which case the secret has to be read again.
+	RenewLease(string) (*time.Time, bool, error)
+
+	// OnRotation registers a hook which is called with the path of a secret
+	// whose lease has been replaced by a new one, i.e. the secret has been
+	// rotated.
+	OnRotation(RotationHook)
+}
+
+// A RotationHook is called with the path of a secret which has been rotated.
+type RotationHook func(secretPath string)
+
+// A Lease is the lease of a secret read from a secret manager.
+type Lease struct {
+	ID         string    `json:"id"`
+	Path       string    `json:"path"`
+	Expiration time.Time `json:"expiration"`
+	Renewable  bool      `json:"renewable"`
+}
+
+// A LeaseTracker keeps track of the leases of the secrets read by a secret
+// manager, so that they can be renewed and reported on, and calls the
+// rotation hooks when the lease of a secret is replaced.
+type LeaseTracker struct {
+	lock   sync.RWMutex
+	leases map[string]Lease
+	hooks  []RotationHook
+}
+
+func NewLeaseTracker() *LeaseTracker {
+	return &LeaseTracker{
+		leases: map[string]Lease{},
+	}
+}
+
+// Track records the lease of the secret read from the given path. If another
+// lease was recorded for the path, the secret is considered rotated.
+func (t *LeaseTracker) Track(lease Lease) {
+	t.lock.Lock()
+	previous, found := t.leases[lease.Path]
+	t.leases[lease.Path] = lease
+	hooks := t.hooks
+	t.lock.Unlock()
+
+	if found && previous.ID != lease.ID {
+		for _, hook := range hooks {
+			hook(lease.Path)
+		}
+	}
+}
+
+// Lease returns the lease last recorded for the given path.
+func (t *LeaseTracker) Lease(secretPath string) (Lease, bool) {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	lease, found := t.leases[secretPath]
+	return lease, found
+}
+
+func (t *LeaseTracker) OnRotation(hook RotationHook) {
+	t.lock.Lock()
+	t.hooks = append(t.hooks, hook)
+	t.lock.Unlock()
+}
+
+// ExpiringWithin returns the leases which expire within the given duration,
+// soonest first. Leases which have already expired are forgotten.
+func (t *LeaseTracker) ExpiringWithin(duration time.Duration) []Lease {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+
+	expiring := []Lease{}
+	for path, lease := range t.leases {
+		if lease.Expiration.Before(now) {
+			delete(t.leases, path)
+			continue
+		}
+
+		if lease.Expiration.Before(now.Add(duration)) {
+			expiring = append(expiring, lease)
+		}
+	}
+
+	sort.Slice(expiring, func(i, j int) bool {
+		return expiring[i].Expiration.Before(expiring[j].Expiration)
+	})
+
+	return expiring
+}
@@ -0,0 +1,66 @@
+package creds_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LeaseTracker", func() {
+	var (
+		tracker *creds.LeaseTracker
+		rotated []string
+	)
+
+	BeforeEach(func() {
+		tracker = creds.NewLeaseTracker()
+
+		rotated = nil
+		tracker.OnRotation(func(secretPath string) {
+			rotated = append(rotated, secretPath)
+		})
+	})
+
+	It("returns the lease tracked for a path", func() {
+		lease := creds.Lease{ID: "lease-1", Path: "/concourse/main/foo", Expiration: time.Now().Add(time.Hour)}
+		tracker.Track(lease)
+
+		found, ok := tracker.Lease("/concourse/main/foo")
+		Expect(ok).To(BeTrue())
+		Expect(found).To(Equal(lease))
+
+		_, ok = tracker.Lease("/concourse/main/bar")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("calls the rotation hooks when the lease of a path is replaced", func() {
+		tracker.Track(creds.Lease{ID: "lease-1", Path: "/concourse/main/foo", Expiration: time.Now().Add(time.Hour)})
+		Expect(rotated).To(BeEmpty())
+
+		By("renewing the same lease")
+		tracker.Track(creds.Lease{ID: "lease-1", Path: "/concourse/main/foo", Expiration: time.Now().Add(2 * time.Hour)})
+		Expect(rotated).To(BeEmpty())
+
+		By("reading a new lease")
+		tracker.Track(creds.Lease{ID: "lease-2", Path: "/concourse/main/foo", Expiration: time.Now().Add(time.Hour)})
+		Expect(rotated).To(Equal([]string{"/concourse/main/foo"}))
+	})
+
+	It("returns the leases expiring soon, soonest first", func() {
+		tracker.Track(creds.Lease{ID: "later", Path: "later", Expiration: time.Now().Add(4 * time.Minute)})
+		tracker.Track(creds.Lease{ID: "sooner", Path: "sooner", Expiration: time.Now().Add(time.Minute)})
+		tracker.Track(creds.Lease{ID: "not-soon", Path: "not-soon", Expiration: time.Now().Add(time.Hour)})
+		tracker.Track(creds.Lease{ID: "expired", Path: "expired", Expiration: time.Now().Add(-time.Minute)})
+
+		expiring := tracker.ExpiringWithin(5 * time.Minute)
+		Expect(expiring).To(HaveLen(2))
+		Expect(expiring[0].ID).To(Equal("sooner"))
+		Expect(expiring[1].ID).To(Equal("later"))
+
+		_, found := tracker.Lease("expired")
+		Expect(found).To(BeFalse())
+	})
+})
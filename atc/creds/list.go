@@ -0,0 +1,27 @@
+package creds
+
+import "github.com/concourse/concourse/vars"
+
+type List struct {
+	variablesResolver vars.Variables
+	rawList           interface{}
+}
+
+// NewList wraps a list, or a var reference resolving to a list, to be
+// evaluated against the given variables.
+func NewList(variables vars.Variables, list interface{}) List {
+	return List{
+		variablesResolver: variables,
+		rawList:           list,
+	}
+}
+
+func (l List) Evaluate() ([]interface{}, error) {
+	var list []interface{}
+	err := evaluate(l.variablesResolver, l.rawList, &list)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
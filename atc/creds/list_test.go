@@ -0,0 +1,39 @@
+package creds_test
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("List", func() {
+	var variables vars.StaticVariables
+
+	BeforeEach(func() {
+		variables = vars.StaticVariables{
+			"some-list":   []interface{}{"a", "b"},
+			"some-string": "lol",
+		}
+	})
+
+	Describe("Evaluate", func() {
+		It("resolves a var reference to a list", func() {
+			result, err := creds.NewList(variables, "((some-list))").Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]interface{}{"a", "b"}))
+		})
+
+		It("interpolates the values of a list", func() {
+			result, err := creds.NewList(variables, []interface{}{"((some-string))", "c"}).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]interface{}{"lol", "c"}))
+		})
+
+		It("errors if the var is not a list", func() {
+			_, err := creds.NewList(variables, "((some-string))").Evaluate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -31,6 +31,9 @@ type HealthResponse struct {
 	Response interface{} `json:"response,omitempty"`
 	Error    string      `json:"error,omitempty"`
 	Method   string      `json:"method,omitempty"`
+
+	// ExpiringLeases are the leases of secrets which are about to expire.
+	ExpiringLeases []Lease `json:"expiring_leases,omitempty"`
 }
 
 var managerFactories = map[string]ManagerFactory{}
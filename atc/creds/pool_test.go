@@ -8,8 +8,10 @@ import (
 	"github.com/concourse/concourse/atc/gc"
 	"time"
 
-	// load dummy credential manager
+	// load credential managers
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/env"
+	_ "github.com/concourse/concourse/atc/creds/file"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -44,6 +46,20 @@ var _ = Context("pool", func() {
 			varSourcePool = creds.NewVarSourcePool(5*time.Minute, fakeClock)
 		})
 
+		Context("when the var source reads from the ATC's host", func() {
+			It("refuses an env var source", func() {
+				_, err := varSourcePool.FindOrCreate(logger, map[string]interface{}{"prefix": "CONCOURSE_"}, creds.ManagerFactories()["env"])
+				Expect(err).To(HaveOccurred())
+				Expect(varSourcePool.Size()).To(Equal(0))
+			})
+
+			It("refuses a file var source", func() {
+				_, err := varSourcePool.FindOrCreate(logger, map[string]interface{}{"dir": "/"}, creds.ManagerFactories()["file"])
+				Expect(err).To(HaveOccurred())
+				Expect(varSourcePool.Size()).To(Equal(0))
+			})
+		})
+
 		Context("add 1 config", func() {
 			var (
 				secrets creds.Secrets
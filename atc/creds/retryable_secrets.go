@@ -43,3 +43,22 @@ func (rs RetryableSecrets) Get(secretPath string) (interface{}, *time.Time, bool
 func (rs RetryableSecrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
 	return rs.secrets.NewSecretLookupPaths(teamName, pipelineName, allowRootPath)
 }
+
+// RenewLease renews the lease of a secret, if the underlying secret manager
+// leases its secrets
+func (rs RetryableSecrets) RenewLease(secretPath string) (*time.Time, bool, error) {
+	leased, ok := rs.secrets.(LeasedSecrets)
+	if !ok {
+		return nil, false, nil
+	}
+
+	return leased.RenewLease(secretPath)
+}
+
+// OnRotation registers the hook with the underlying secret manager, if it
+// leases its secrets
+func (rs RetryableSecrets) OnRotation(hook RotationHook) {
+	if leased, ok := rs.secrets.(LeasedSecrets); ok {
+		leased.OnRotation(hook)
+	}
+}
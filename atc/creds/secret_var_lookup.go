@@ -1,6 +1,8 @@
 package creds
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/vars"
 )
 
@@ -17,28 +19,59 @@ func NewVariables(secrets Secrets, teamName string, pipelineName string, allowRo
 }
 
 func (sl VariableLookupFromSecrets) Get(varDef vars.VariableDefinition) (interface{}, bool, error) {
+	result, _, found, err := sl.GetWithExpiration(varDef)
+	return result, found, err
+}
+
+func (sl VariableLookupFromSecrets) GetWithExpiration(varDef vars.VariableDefinition) (interface{}, *time.Time, bool, error) {
 	// try to find a secret according to our var->secret lookup paths
 	if len(sl.LookupPaths) > 0 {
 		for _, rule := range sl.LookupPaths {
 			secretId, err := rule.VariableToSecretPath(varDef.Name)
 			if err != nil {
-				return nil, false, err
+				return nil, nil, false, err
 			}
-			result, _, found, err := sl.Secrets.Get(secretId)
+			result, expiration, found, err := sl.Secrets.Get(secretId)
 			if err != nil {
-				return nil, false, err
+				return nil, nil, false, err
 			}
 			if !found {
 				continue
 			}
-			return result, true, nil
+			return result, expiration, true, nil
 		}
-		return nil, false, nil
+		return nil, nil, false, nil
 	} else {
 		// if no paths are specified (i.e. for fake & noop secret managers), then try 1-to-1 var->secret mapping
+		return sl.Secrets.Get(varDef.Name)
+	}
+}
+
+// Trace reports every secret path the var is looked up at, without stopping
+// at the first one which is found.
+func (sl VariableLookupFromSecrets) Trace(varDef vars.VariableDefinition) ([]vars.VariableLookup, error) {
+	if len(sl.LookupPaths) == 0 {
 		result, _, found, err := sl.Secrets.Get(varDef.Name)
-		return result, found, err
+		return []vars.VariableLookup{{Path: varDef.Name, Found: found, Value: result, Err: err}}, nil
 	}
+
+	lookups := []vars.VariableLookup{}
+	for _, rule := range sl.LookupPaths {
+		secretId, err := rule.VariableToSecretPath(varDef.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		result, _, found, err := sl.Secrets.Get(secretId)
+		lookups = append(lookups, vars.VariableLookup{
+			Path:  secretId,
+			Found: found,
+			Value: result,
+			Err:   err,
+		})
+	}
+
+	return lookups, nil
 }
 
 func (sl VariableLookupFromSecrets) List() ([]vars.VariableDefinition, error) {
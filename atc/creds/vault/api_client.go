@@ -61,6 +61,11 @@ func (ac *APIClient) Read(path string) (*vaultapi.Secret, error) {
 	return ac.client().Logical().Read(path)
 }
 
+// RenewLease renews the lease of a dynamic secret for its original TTL.
+func (ac *APIClient) RenewLease(leaseID string) (*vaultapi.Secret, error) {
+	return ac.client().Sys().Renew(leaseID, 0)
+}
+
 func (ac *APIClient) loginParams() map[string]interface{} {
 	loginParams := make(map[string]interface{})
 	for k, v := range ac.authConfig.Params {
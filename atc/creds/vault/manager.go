@@ -20,7 +20,9 @@ type VaultManager struct {
 	SharedPath string `mapstructure:"shared_path" long:"shared-path" description:"Path under which to lookup shared credentials."`
 	Namespace  string `mapstructure:"namespace" long:"namespace"   description:"Vault namespace to use for authentication and secret lookup."`
 
-	TLS TLSConfig  `mapstructure:",squash"`
+	LeaseExpiryWarning time.Duration `mapstructure:"lease_expiry_warning" long:"lease-expiry-warning" default:"5m" description:"Report the leases of dynamic secrets expiring within this duration in the health of the credential manager."`
+
+	TLS  TLSConfig  `mapstructure:",squash"`
 	Auth AuthConfig `mapstructure:",squash"`
 
 	Client        *APIClient
@@ -72,23 +74,25 @@ func (manager *VaultManager) MarshalJSON() ([]byte, error) {
 	}
 
 	return json.Marshal(&map[string]interface{}{
-		"url":                manager.URL,
-		"path_prefix":        manager.PathPrefix,
-		"shared_path":        manager.SharedPath,
-		"namespace":          manager.Namespace,
-		"ca_cert":            manager.TLS.CACert,
-		"server_name":        manager.TLS.ServerName,
-		"auth_backend":       manager.Auth.Backend,
-		"auth_max_ttl":       manager.Auth.BackendMaxTTL,
-		"auth_retry_max":     manager.Auth.RetryMax,
-		"auth_retry_initial": manager.Auth.RetryInitial,
-		"health":             health,
+		"url":                  manager.URL,
+		"path_prefix":          manager.PathPrefix,
+		"shared_path":          manager.SharedPath,
+		"namespace":            manager.Namespace,
+		"lease_expiry_warning": manager.LeaseExpiryWarning,
+		"ca_cert":              manager.TLS.CACert,
+		"server_name":          manager.TLS.ServerName,
+		"auth_backend":         manager.Auth.Backend,
+		"auth_max_ttl":         manager.Auth.BackendMaxTTL,
+		"auth_retry_max":       manager.Auth.RetryMax,
+		"auth_retry_initial":   manager.Auth.RetryInitial,
+		"health":               health,
 	})
 }
 
 func (manager *VaultManager) Config(config map[string]interface{}) error {
 	// apply defaults
 	manager.PathPrefix = "/concourse"
+	manager.LeaseExpiryWarning = 5 * time.Minute
 	manager.Auth.RetryMax = 5 * time.Minute
 	manager.Auth.RetryInitial = time.Second
 
@@ -139,6 +143,10 @@ func (manager VaultManager) Health() (*creds.HealthResponse, error) {
 		Method: "/v1/sys/health",
 	}
 
+	if manager.SecretFactory != nil {
+		health.ExpiringLeases = manager.SecretFactory.leases.ExpiringWithin(manager.LeaseExpiryWarning)
+	}
+
 	response, err := manager.Client.health()
 	if err != nil {
 		health.Error = err.Error()
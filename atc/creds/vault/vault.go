@@ -15,12 +15,21 @@ type SecretReader interface {
 	Read(path string) (*vaultapi.Secret, error)
 }
 
+// A LeaseRenewer renews the lease of a vault secret. It should be
+// thread safe!
+type LeaseRenewer interface {
+	RenewLease(leaseID string) (*vaultapi.Secret, error)
+}
+
 // Vault converts a vault secret to our completely untyped secret
 // data.
 type Vault struct {
 	SecretReader SecretReader
 	Prefix       string
 	SharedPath   string
+
+	// Leases keeps track of the leases of dynamic secrets, if set.
+	Leases *creds.LeaseTracker
 }
 
 // NewSecretLookupPaths defines how variables will be searched in the underlying secret manager
@@ -64,6 +73,8 @@ func (v Vault) findSecret(path string) (*vaultapi.Secret, *time.Time, bool, erro
 	}
 
 	if secret != nil {
+		v.trackLease(path, secret)
+
 		// The lease duration is TTL: the time in seconds for which the lease is valid
 		// A consumer of this secret must renew the lease within that time.
 		duration := time.Duration(secret.LeaseDuration) * time.Second / 2
@@ -73,3 +84,57 @@ func (v Vault) findSecret(path string) (*vaultapi.Secret, *time.Time, bool, erro
 
 	return nil, nil, false, nil
 }
+
+// RenewLease renews the lease of the dynamic secret last read from the
+// given path. Static secrets have no lease, so they are never renewed.
+func (v Vault) RenewLease(secretPath string) (*time.Time, bool, error) {
+	if v.Leases == nil {
+		return nil, false, nil
+	}
+
+	lease, found := v.Leases.Lease(secretPath)
+	if !found || !lease.Renewable || lease.Expiration.Before(time.Now()) {
+		return nil, false, nil
+	}
+
+	renewer, ok := v.SecretReader.(LeaseRenewer)
+	if !ok {
+		return nil, false, nil
+	}
+
+	secret, err := renewer.RenewLease(lease.ID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if secret == nil || secret.LeaseDuration == 0 {
+		return nil, false, nil
+	}
+
+	v.trackLease(secretPath, secret)
+
+	duration := time.Duration(secret.LeaseDuration) * time.Second / 2
+	expiration := time.Now().Add(duration)
+	return &expiration, true, nil
+}
+
+// OnRotation registers a hook which is called when a dynamic secret is read
+// again with a new lease.
+func (v Vault) OnRotation(hook creds.RotationHook) {
+	if v.Leases != nil {
+		v.Leases.OnRotation(hook)
+	}
+}
+
+func (v Vault) trackLease(path string, secret *vaultapi.Secret) {
+	if v.Leases == nil || secret.LeaseID == "" {
+		return
+	}
+
+	v.Leases.Track(creds.Lease{
+		ID:         secret.LeaseID,
+		Path:       path,
+		Expiration: time.Now().Add(time.Duration(secret.LeaseDuration) * time.Second),
+		Renewable:  secret.Renewable,
+	})
+}
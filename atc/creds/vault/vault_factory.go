@@ -12,6 +12,7 @@ type vaultFactory struct {
 	prefix     string
 	sharedPath string
 	loggedIn   <-chan struct{}
+	leases     *creds.LeaseTracker
 }
 
 func NewVaultFactory(sr SecretReader, loggedIn <-chan struct{}, prefix string, sharedPath string) *vaultFactory {
@@ -20,6 +21,7 @@ func NewVaultFactory(sr SecretReader, loggedIn <-chan struct{}, prefix string, s
 		prefix:     prefix,
 		sharedPath: sharedPath,
 		loggedIn:   loggedIn,
+		leases:     creds.NewLeaseTracker(),
 	}
 
 	return factory
@@ -36,5 +38,6 @@ func (factory *vaultFactory) NewSecrets() creds.Secrets {
 		SecretReader: factory.sr,
 		Prefix:       factory.prefix,
 		SharedPath:   factory.sharedPath,
+		Leases:       factory.leases,
 	}
 }
@@ -1,6 +1,9 @@
 package vault_test
 
 import (
+	"errors"
+	"time"
+
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/vault"
 	"github.com/concourse/concourse/vars"
@@ -30,6 +33,19 @@ func (msr *MockSecretReader) Read(lookupPath string) (*vaultapi.Secret, error) {
 	return nil, nil
 }
 
+type MockLeaseRenewer struct {
+	MockSecretReader
+
+	renewed []string
+	secret  *vaultapi.Secret
+	err     error
+}
+
+func (mlr *MockLeaseRenewer) RenewLease(leaseID string) (*vaultapi.Secret, error) {
+	mlr.renewed = append(mlr.renewed, leaseID)
+	return mlr.secret, mlr.err
+}
+
 var _ = Describe("Vault", func() {
 
 	var v *vault.Vault
@@ -185,4 +201,104 @@ var _ = Describe("Vault", func() {
 			})
 		})
 	})
+	Describe("leases", func() {
+		var (
+			renewer *MockLeaseRenewer
+			rotated []string
+		)
+
+		BeforeEach(func() {
+			renewer = &MockLeaseRenewer{
+				MockSecretReader: MockSecretReader{&[]MockSecret{
+					{
+						path: "/concourse/team/creds",
+						secret: &vaultapi.Secret{
+							LeaseID:       "database/creds/lease-1",
+							LeaseDuration: 60,
+							Renewable:     true,
+							Data:          map[string]interface{}{"username": "user-1"},
+						},
+					}},
+				},
+				secret: &vaultapi.Secret{
+					LeaseID:       "database/creds/lease-1",
+					LeaseDuration: 3600,
+					Renewable:     true,
+				},
+			}
+
+			v.SecretReader = renewer
+			v.Leases = creds.NewLeaseTracker()
+
+			rotated = nil
+			v.OnRotation(func(secretPath string) {
+				rotated = append(rotated, secretPath)
+			})
+		})
+
+		It("tracks the lease of dynamic secrets", func() {
+			_, _, found, err := v.Get("/concourse/team/creds")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			lease, found := v.Leases.Lease("/concourse/team/creds")
+			Expect(found).To(BeTrue())
+			Expect(lease.ID).To(Equal("database/creds/lease-1"))
+			Expect(lease.Renewable).To(BeTrue())
+			Expect(lease.Expiration).To(BeTemporally("~", time.Now().Add(time.Minute), time.Second))
+		})
+
+		It("does not track static secrets", func() {
+			_, _, found, err := v.Get("/concourse/team")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found = v.Leases.Lease("/concourse/team")
+			Expect(found).To(BeFalse())
+		})
+
+		It("renews the lease of a dynamic secret", func() {
+			_, _, _, err := v.Get("/concourse/team/creds")
+			Expect(err).ToNot(HaveOccurred())
+
+			expiration, renewed, err := v.RenewLease("/concourse/team/creds")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(renewed).To(BeTrue())
+			Expect(*expiration).To(BeTemporally("~", time.Now().Add(30*time.Minute), time.Second))
+			Expect(renewer.renewed).To(Equal([]string{"database/creds/lease-1"}))
+
+			lease, _ := v.Leases.Lease("/concourse/team/creds")
+			Expect(lease.Expiration).To(BeTemporally("~", time.Now().Add(time.Hour), time.Second))
+			Expect(rotated).To(BeEmpty())
+		})
+
+		It("does not renew secrets which have not been read", func() {
+			_, renewed, err := v.RenewLease("/concourse/team/other")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(renewed).To(BeFalse())
+			Expect(renewer.renewed).To(BeEmpty())
+		})
+
+		It("returns an error when renewing fails", func() {
+			renewer.err = errors.New("lease expired")
+
+			_, _, _, err := v.Get("/concourse/team/creds")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, renewed, err := v.RenewLease("/concourse/team/creds")
+			Expect(err).To(MatchError("lease expired"))
+			Expect(renewed).To(BeFalse())
+		})
+
+		It("calls the rotation hooks when a secret is read with a new lease", func() {
+			_, _, _, err := v.Get("/concourse/team/creds")
+			Expect(err).ToNot(HaveOccurred())
+
+			(*renewer.secrets)[0].secret.LeaseID = "database/creds/lease-2"
+
+			_, _, _, err = v.Get("/concourse/team/creds")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rotated).To(Equal([]string{"/concourse/team/creds"}))
+		})
+	})
 })
@@ -0,0 +1,179 @@
+package db
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+var ErrAPITokenExists = errors.New("an api token with the same name already exists for the team")
+
+// APITokenUseInterval is how often the use of a token is recorded, so that a
+// busy client doesn't write to the database on every request.
+const APITokenUseInterval = time.Minute
+
+//go:generate counterfeiter . APIToken
+
+// An APIToken is a long-lived token granting a role in a team, either on
+// behalf of the user who created it or, for a service account, on behalf of
+// the token itself. Only its hash is stored.
+type APIToken interface {
+	ID() int
+	TeamID() int
+	TeamName() string
+	Name() string
+	Role() string
+	ServiceAccount() bool
+	CreatedBy() string
+	CreatedAt() time.Time
+	ExpiresAt() time.Time
+	LastUsedAt() time.Time
+}
+
+type apiToken struct {
+	id             int
+	teamID         int
+	teamName       string
+	name           string
+	role           string
+	serviceAccount bool
+	createdBy      string
+	createdAt      time.Time
+	expiresAt      time.Time
+	lastUsedAt     time.Time
+}
+
+func (t *apiToken) ID() int               { return t.id }
+func (t *apiToken) TeamID() int           { return t.teamID }
+func (t *apiToken) TeamName() string      { return t.teamName }
+func (t *apiToken) Name() string          { return t.name }
+func (t *apiToken) Role() string          { return t.role }
+func (t *apiToken) ServiceAccount() bool  { return t.serviceAccount }
+func (t *apiToken) CreatedBy() string     { return t.createdBy }
+func (t *apiToken) CreatedAt() time.Time  { return t.createdAt }
+func (t *apiToken) ExpiresAt() time.Time  { return t.expiresAt }
+func (t *apiToken) LastUsedAt() time.Time { return t.lastUsedAt }
+
+var apiTokensQuery = psql.Select(
+	"a.id",
+	"a.team_id",
+	"t.name",
+	"a.name",
+	"a.role",
+	"a.service_account",
+	"a.created_by",
+	"a.created_at",
+	"a.expires_at",
+	"a.last_used_at",
+).
+	From("api_tokens a").
+	Join("teams t ON t.id = a.team_id")
+
+func scanAPIToken(t *apiToken, row scannable) error {
+	var expiresAt, lastUsedAt pq.NullTime
+
+	err := row.Scan(
+		&t.id,
+		&t.teamID,
+		&t.teamName,
+		&t.name,
+		&t.role,
+		&t.serviceAccount,
+		&t.createdBy,
+		&t.createdAt,
+		&expiresAt,
+		&lastUsedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	t.expiresAt = expiresAt.Time
+	t.lastUsedAt = lastUsedAt.Time
+
+	return nil
+}
+
+// generateAPIToken returns a new random token along with the hash to store.
+func generateAPIToken() (string, string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	token := atc.APITokenPrefix + hex.EncodeToString(secret)
+
+	return token, hashAPIToken(token), nil
+}
+
+func hashAPIToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+//go:generate counterfeiter . APITokenFactory
+
+type APITokenFactory interface {
+	// UseAPIToken returns the unexpired token matching the given one,
+	// recording that it was used unless that was done within the last
+	// APITokenUseInterval.
+	UseAPIToken(token string) (APIToken, bool, error)
+}
+
+type apiTokenFactory struct {
+	conn Conn
+}
+
+func NewAPITokenFactory(conn Conn) APITokenFactory {
+	return &apiTokenFactory{
+		conn: conn,
+	}
+}
+
+func (f *apiTokenFactory) UseAPIToken(token string) (APIToken, bool, error) {
+	t := &apiToken{}
+	err := scanAPIToken(t, apiTokensQuery.
+		Where(sq.And{
+			sq.Eq{"a.token_hash": hashAPIToken(token)},
+			sq.Or{
+				sq.Eq{"a.expires_at": nil},
+				sq.Expr("a.expires_at > now()"),
+			},
+		}).
+		RunWith(f.conn).
+		QueryRow())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if time.Since(t.lastUsedAt) < APITokenUseInterval {
+		return t, true, nil
+	}
+
+	err = psql.Update("api_tokens").
+		Set("last_used_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": t.id}).
+		Suffix("RETURNING last_used_at").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&t.lastUsedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return t, true, nil
+}
@@ -0,0 +1,96 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APITokenFactory", func() {
+	var apiTokenFactory db.APITokenFactory
+
+	BeforeEach(func() {
+		apiTokenFactory = db.NewAPITokenFactory(dbConn)
+	})
+
+	Describe("UseAPIToken", func() {
+		var (
+			created db.APIToken
+			token   string
+		)
+
+		BeforeEach(func() {
+			var err error
+			created, token, err = defaultTeam.CreateAPIToken("some-bot", "pipeline-operator", true, "some-user", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("finds the token and records its use", func() {
+			used, found, err := apiTokenFactory.UseAPIToken(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(used.ID()).To(Equal(created.ID()))
+			Expect(used.TeamName()).To(Equal(defaultTeam.Name()))
+			Expect(used.Role()).To(Equal("pipeline-operator"))
+			Expect(used.ServiceAccount()).To(BeTrue())
+			Expect(used.LastUsedAt()).To(BeTemporally("~", time.Now(), time.Minute))
+
+			tokens, err := defaultTeam.APITokens()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens[0].LastUsedAt()).To(Equal(used.LastUsedAt()))
+		})
+
+		It("does not record its use again within a minute", func() {
+			first, found, err := apiTokenFactory.UseAPIToken(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			second, found, err := apiTokenFactory.UseAPIToken(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(second.LastUsedAt()).To(Equal(first.LastUsedAt()))
+		})
+
+		It("records its use again once a minute has passed", func() {
+			_, err := dbConn.Exec(`UPDATE api_tokens SET last_used_at = now() - interval '2 minutes' WHERE id = $1`, created.ID())
+			Expect(err).ToNot(HaveOccurred())
+
+			used, found, err := apiTokenFactory.UseAPIToken(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(used.LastUsedAt()).To(BeTemporally("~", time.Now(), 30*time.Second))
+		})
+
+		It("does not find unknown tokens", func() {
+			_, found, err := apiTokenFactory.UseAPIToken(atc.APITokenPrefix + "bogus")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not find revoked tokens", func() {
+			_, err := defaultTeam.RevokeAPIToken("some-bot")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := apiTokenFactory.UseAPIToken(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the token has expired", func() {
+			BeforeEach(func() {
+				var err error
+				_, token, err = defaultTeam.CreateAPIToken("expired-bot", "viewer", true, "some-user", time.Now().Add(-time.Minute))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not find the token", func() {
+				_, found, err := apiTokenFactory.UseAPIToken(token)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,247 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// AuditEventFilter narrows down the audit events to list. Empty fields match
+// every event.
+type AuditEventFilter struct {
+	UserName string
+	TeamName string
+	Action   string
+
+	From time.Time // inclusive
+	To   time.Time // inclusive
+}
+
+func (filter AuditEventFilter) where() sq.And {
+	where := sq.And{}
+
+	if filter.UserName != "" {
+		where = append(where, sq.Eq{"user_name": filter.UserName})
+	}
+
+	if filter.TeamName != "" {
+		where = append(where, sq.Eq{"team_name": filter.TeamName})
+	}
+
+	if filter.Action != "" {
+		where = append(where, sq.Eq{"action": filter.Action})
+	}
+
+	if !filter.From.IsZero() {
+		where = append(where, sq.GtOrEq{"time": filter.From})
+	}
+
+	if !filter.To.IsZero() {
+		where = append(where, sq.LtOrEq{"time": filter.To})
+	}
+
+	return where
+}
+
+//go:generate counterfeiter . AuditEventRepository
+
+type AuditEventRepository interface {
+	SaveAuditEvent(atc.AuditEvent) error
+
+	// AuditEvents returns the events matching the filter, newest first.
+	AuditEvents(AuditEventFilter, Page) ([]atc.AuditEvent, Pagination, error)
+
+	// RemoveExpiredAuditEvents removes the events recorded longer ago than
+	// the retention period, returning how many were removed.
+	RemoveExpiredAuditEvents(retention time.Duration) (int, error)
+}
+
+type auditEventRepository struct {
+	conn Conn
+}
+
+func NewAuditEventRepository(conn Conn) AuditEventRepository {
+	return &auditEventRepository{
+		conn: conn,
+	}
+}
+
+var auditEventsQuery = psql.Select(
+	"id",
+	"time",
+	"user_name",
+	"team_name",
+	"action",
+	"target",
+	"request_id",
+	"source_ip",
+	"status",
+).
+	From("audit_events")
+
+func (repository *auditEventRepository) SaveAuditEvent(event atc.AuditEvent) error {
+	_, err := psql.Insert("audit_events").
+		Columns(
+			"user_name",
+			"team_name",
+			"action",
+			"target",
+			"request_id",
+			"source_ip",
+			"status",
+		).
+		Values(
+			event.UserName,
+			event.TeamName,
+			event.Action,
+			event.Target,
+			event.RequestID,
+			event.SourceIP,
+			event.Status,
+		).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+func (repository *auditEventRepository) AuditEvents(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error) {
+	tx, err := repository.conn.Begin()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	defer Rollback(tx)
+
+	where := filter.where()
+
+	query := auditEventsQuery.
+		Where(where).
+		Limit(uint64(page.Limit))
+
+	var reverse bool
+	if page.Since == 0 && page.Until == 0 { // none
+		query = query.OrderBy("id DESC")
+	} else if page.Until != 0 && page.Since == 0 { // only until
+		query = query.
+			Where(sq.Gt{"id": page.Until}).
+			OrderBy("id ASC")
+		reverse = true
+	} else if page.Since != 0 && page.Until == 0 { // only since
+		query = query.
+			Where(sq.Lt{"id": page.Since}).
+			OrderBy("id DESC")
+	} else { // both
+		if page.Until > page.Since {
+			return nil, Pagination{}, fmt.Errorf("Invalid range boundaries")
+		}
+
+		query = query.
+			Where(sq.And{
+				sq.Gt{"id": page.Until},
+				sq.Lt{"id": page.Since},
+			}).
+			OrderBy("id ASC")
+		reverse = true
+	}
+
+	rows, err := query.RunWith(tx).Query()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	defer Close(rows)
+
+	events := []atc.AuditEvent{}
+	for rows.Next() {
+		var (
+			event     atc.AuditEvent
+			eventTime time.Time
+		)
+
+		err = rows.Scan(
+			&event.ID,
+			&eventTime,
+			&event.UserName,
+			&event.TeamName,
+			&event.Action,
+			&event.Target,
+			&event.RequestID,
+			&event.SourceIP,
+			&event.Status,
+		)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		event.Time = eventTime.Unix()
+
+		events = append(events, event)
+	}
+
+	if reverse {
+		for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+			events[i], events[j] = events[j], events[i]
+		}
+	}
+
+	if len(events) == 0 {
+		return events, Pagination{}, nil
+	}
+
+	var minID, maxID int
+	err = psql.Select("COALESCE(MAX(id), 0)", "COALESCE(MIN(id), 0)").
+		From("audit_events").
+		Where(where).
+		RunWith(tx).
+		QueryRow().
+		Scan(&maxID, &minID)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	first := events[0]
+	last := events[len(events)-1]
+
+	var pagination Pagination
+	if first.ID < maxID {
+		pagination.Previous = &Page{
+			Until: first.ID,
+			Limit: page.Limit,
+		}
+	}
+
+	if last.ID > minID {
+		pagination.Next = &Page{
+			Since: last.ID,
+			Limit: page.Limit,
+		}
+	}
+
+	return events, pagination, nil
+}
+
+func (repository *auditEventRepository) RemoveExpiredAuditEvents(retention time.Duration) (int, error) {
+	result, err := psql.Delete("audit_events").
+		Where(sq.Gt{
+			"now() - time": fmt.Sprintf("%.0f seconds", retention.Seconds()),
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(removed), nil
+}
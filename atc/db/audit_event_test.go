@@ -0,0 +1,115 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventRepository", func() {
+	var repository db.AuditEventRepository
+
+	BeforeEach(func() {
+		repository = db.NewAuditEventRepository(dbConn)
+	})
+
+	saveEvent := func(userName, teamName, action string) {
+		err := repository.SaveAuditEvent(atc.AuditEvent{
+			UserName:  userName,
+			TeamName:  teamName,
+			Action:    action,
+			Target:    "/api/v1/teams/" + teamName,
+			RequestID: "some-request-id",
+			SourceIP:  "1.2.3.4",
+			Status:    200,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	Describe("AuditEvents", func() {
+		BeforeEach(func() {
+			saveEvent("some-user", "some-team", atc.SetTeam)
+			saveEvent("other-user", "some-team", atc.SaveConfig)
+			saveEvent("some-user", "other-team", atc.SaveConfig)
+		})
+
+		It("returns the events newest first", func() {
+			events, pagination, err := repository.AuditEvents(db.AuditEventFilter{}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pagination).To(Equal(db.Pagination{}))
+
+			Expect(events).To(HaveLen(3))
+			Expect(events[0].UserName).To(Equal("some-user"))
+			Expect(events[0].TeamName).To(Equal("other-team"))
+			Expect(events[2].Action).To(Equal(atc.SetTeam))
+
+			Expect(events[2].Target).To(Equal("/api/v1/teams/some-team"))
+			Expect(events[2].RequestID).To(Equal("some-request-id"))
+			Expect(events[2].SourceIP).To(Equal("1.2.3.4"))
+			Expect(events[2].Status).To(Equal(200))
+			Expect(time.Unix(events[2].Time, 0)).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("filters the events", func() {
+			events, _, err := repository.AuditEvents(db.AuditEventFilter{UserName: "some-user"}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+
+			events, _, err = repository.AuditEvents(db.AuditEventFilter{TeamName: "some-team", Action: atc.SaveConfig}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].UserName).To(Equal("other-user"))
+
+			events, _, err = repository.AuditEvents(db.AuditEventFilter{From: time.Now().Add(time.Hour)}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(BeEmpty())
+
+			events, _, err = repository.AuditEvents(db.AuditEventFilter{To: time.Now().Add(time.Hour)}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(3))
+		})
+
+		It("paginates the events", func() {
+			events, pagination, err := repository.AuditEvents(db.AuditEventFilter{}, db.Page{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(2))
+			Expect(pagination.Previous).To(BeNil())
+			Expect(pagination.Next).To(Equal(&db.Page{Since: events[1].ID, Limit: 2}))
+
+			next, pagination, err := repository.AuditEvents(db.AuditEventFilter{}, *pagination.Next)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(HaveLen(1))
+			Expect(next[0].ID).To(BeNumerically("<", events[1].ID))
+			Expect(pagination.Previous).To(Equal(&db.Page{Until: next[0].ID, Limit: 2}))
+			Expect(pagination.Next).To(BeNil())
+
+			previous, _, err := repository.AuditEvents(db.AuditEventFilter{}, *pagination.Previous)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(previous).To(Equal(events))
+		})
+	})
+
+	Describe("RemoveExpiredAuditEvents", func() {
+		BeforeEach(func() {
+			saveEvent("some-user", "some-team", atc.SetTeam)
+			saveEvent("some-user", "some-team", atc.SaveConfig)
+
+			_, err := dbConn.Exec("UPDATE audit_events SET time = now() - '25 hours'::interval WHERE action = $1", atc.SetTeam)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes the events older than the retention period", func() {
+			removed, err := repository.RemoveExpiredAuditEvents(24 * time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			events, _, err := repository.AuditEvents(db.AuditEventFilter{}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Action).To(Equal(atc.SaveConfig))
+		})
+	})
+})
@@ -1,10 +1,12 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 	"time"
 
@@ -68,12 +70,15 @@ var buildsQuery = psql.Select(`
 		t.name,
 		b.nonce,
 		b.drained,
+		b.events_archived,
 		b.aborted,
 		b.completed,
 		b.inputs_ready,
 		b.rerun_of,
 		r.name,
-		b.rerun_number
+		b.rerun_number,
+		b.comment,
+		COALESCE(j.priority, t.default_build_priority, 0)
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -104,6 +109,7 @@ type Build interface {
 	PublicPlan() *json.RawMessage
 	HasPlan() bool
 	Status() BuildStatus
+	CreateTime() time.Time
 	StartTime() time.Time
 	IsNewerThanLastCheckOf(input Resource) bool
 	EndTime() time.Time
@@ -116,6 +122,8 @@ type Build interface {
 	RerunOf() int
 	RerunOfName() string
 	RerunNumber() int
+	Comment() string
+	Priority() int
 
 	Reload() (bool, error)
 
@@ -123,11 +131,13 @@ type Build interface {
 
 	Interceptible() (bool, error)
 	Preparation() (BuildPreparation, bool, error)
+	QueuePosition() (atc.BuildQueuePosition, bool, error)
 
 	Start(atc.Plan) (bool, error)
 	Finish(BuildStatus) error
 
 	SetInterceptible(bool) error
+	SetComment(string) error
 
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
@@ -149,6 +159,10 @@ type Build interface {
 
 	IsDrained() bool
 	SetDrained(bool) error
+
+	EventsArchived() bool
+	ArchiveEvents(context.Context) error
+	DeleteArchivedEvents(context.Context) error
 }
 
 type build struct {
@@ -172,6 +186,10 @@ type build struct {
 	rerunOfName string
 	rerunNumber int
 
+	comment string
+
+	priority int
+
 	schema      string
 	privatePlan atc.Plan
 	publicPlan  *json.RawMessage
@@ -181,9 +199,10 @@ type build struct {
 	endTime    time.Time
 	reapTime   time.Time
 
-	drained   bool
-	aborted   bool
-	completed bool
+	drained        bool
+	eventsArchived bool
+	aborted        bool
+	completed      bool
 }
 
 func newEmptyBuild(conn Conn, lockFactory lock.LockFactory) *build {
@@ -214,6 +233,7 @@ func (b *build) Schema() string               { return b.schema }
 func (b *build) PrivatePlan() atc.Plan        { return b.privatePlan }
 func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
 func (b *build) HasPlan() bool                { return string(*b.publicPlan) != "{}" }
+func (b *build) CreateTime() time.Time        { return b.createTime }
 func (b *build) IsNewerThanLastCheckOf(input Resource) bool {
 	return b.createTime.After(input.LastCheckEndTime())
 }
@@ -223,6 +243,7 @@ func (b *build) ReapTime() time.Time  { return b.reapTime }
 func (b *build) Status() BuildStatus  { return b.status }
 func (b *build) IsScheduled() bool    { return b.scheduled }
 func (b *build) IsDrained() bool      { return b.drained }
+func (b *build) EventsArchived() bool { return b.eventsArchived }
 func (b *build) IsRunning() bool      { return !b.completed }
 func (b *build) IsAborted() bool      { return b.aborted }
 func (b *build) IsCompleted() bool    { return b.completed }
@@ -230,6 +251,8 @@ func (b *build) InputsReady() bool    { return b.inputsReady }
 func (b *build) RerunOf() int         { return b.rerunOf }
 func (b *build) RerunOfName() string  { return b.rerunOfName }
 func (b *build) RerunNumber() int     { return b.rerunNumber }
+func (b *build) Comment() string      { return b.comment }
+func (b *build) Priority() int        { return b.priority }
 
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
@@ -411,6 +434,16 @@ func (b *build) Finish(status BuildStatus) error {
 			return err
 		}
 
+		// pipelines set by an earlier build of the job but not by this one
+		// have been removed from the job's set_pipeline steps
+		err = archivePipelines(tx, sq.And{
+			sq.Eq{"parent_job_id": b.jobID},
+			sq.Lt{"parent_build_id": b.id},
+		})
+		if err != nil {
+			return err
+		}
+
 		rows, err := psql.Select("o.resource_id", "o.version_md5").
 			From("build_resource_config_version_outputs o").
 			Where(sq.Eq{
@@ -538,6 +571,29 @@ func (b *build) Finish(status BuildStatus) error {
 	return nil
 }
 
+func (b *build) SetComment(comment string) error {
+	result, err := psql.Update("builds").
+		Set("comment", comment).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrBuildDisappeared
+	}
+
+	b.comment = comment
+	return nil
+}
+
 func (b *build) SetDrained(drained bool) error {
 	_, err := psql.Update("builds").
 		Set("drained", drained).
@@ -645,6 +701,26 @@ func (b *build) AcquireTrackingLock(logger lager.Logger, interval time.Duration)
 	return lock, true, nil
 }
 
+func (b *build) QueuePosition() (atc.BuildQueuePosition, bool, error) {
+	rows, err := b.conn.Query(buildQueueQuery+`
+		WHERE q.id = $1
+	`, b.id)
+	if err != nil {
+		return atc.BuildQueuePosition{}, false, err
+	}
+
+	positions, err := scanBuildQueuePositions(rows)
+	if err != nil {
+		return atc.BuildQueuePosition{}, false, err
+	}
+
+	if len(positions) == 0 {
+		return atc.BuildQueuePosition{}, false, nil
+	}
+
+	return positions[0], true, nil
+}
+
 func (b *build) Preparation() (BuildPreparation, bool, error) {
 	if b.jobID == 0 || b.status != BuildStatusPending {
 		return BuildPreparation{
@@ -695,17 +771,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
-	tf := NewTeamFactory(b.conn, b.lockFactory)
-	t, found, err := tf.FindTeam(b.teamName)
-	if err != nil {
-		return BuildPreparation{}, false, err
-	}
-
-	if !found {
-		return BuildPreparation{}, false, nil
-	}
-
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := b.Pipeline()
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -805,6 +871,12 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 }
 
 func (b *build) Events(from uint) (EventSource, error) {
+	// the events of reaped builds have been deleted, even if they had been
+	// archived
+	if b.eventsArchived && b.reapTime.IsZero() {
+		return newArchivedEventSource(b.conn.EventArchive(), b.id, from)
+	}
+
 	notifier, err := newConditionNotifier(b.conn.Bus(), buildEventsChannel(b.id), func() (bool, error) {
 		return true, nil
 	})
@@ -826,6 +898,109 @@ func (b *build) Events(from uint) (EventSource, error) {
 	), nil
 }
 
+// ArchiveEvents moves the events of the completed build from the database to
+// the event archive.
+func (b *build) ArchiveEvents(ctx context.Context) error {
+	store := b.conn.EventArchive()
+	if store == nil {
+		return ErrNoEventArchive
+	}
+
+	if !b.completed {
+		return fmt.Errorf("build %d has not completed", b.id)
+	}
+
+	if b.eventsArchived {
+		return nil
+	}
+
+	events, err := b.Events(0)
+	if err != nil {
+		return err
+	}
+
+	// ignore any errors coming from events.Close()
+	defer Close(events)
+
+	reader, writer := io.Pipe()
+
+	written := make(chan struct{})
+	go func() {
+		defer close(written)
+		writer.CloseWithError(writeArchivedEvents(writer, events))
+	}()
+
+	err = store.Put(ctx, buildEventsKey(b.id), reader)
+
+	// unblock the writer if the store gave up before reading everything
+	reader.CloseWithError(io.ErrClosedPipe)
+	<-written
+
+	if err != nil {
+		return err
+	}
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("build_events").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("builds").
+		Set("events_archived", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	b.eventsArchived = true
+
+	return nil
+}
+
+// DeleteArchivedEvents removes the events of the build from the event
+// archive, e.g. once the build has been reaped.
+func (b *build) DeleteArchivedEvents(ctx context.Context) error {
+	store := b.conn.EventArchive()
+	if store == nil {
+		return ErrNoEventArchive
+	}
+
+	err := store.Delete(ctx, buildEventsKey(b.id))
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("builds").
+		Set("events_archived", false).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	b.eventsArchived = false
+
+	return nil
+}
+
 func (b *build) SaveEvent(event atc.Event) error {
 	tx, err := b.conn.Begin()
 	if err != nil {
@@ -1422,9 +1597,10 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	var (
 		jobID, pipelineID, rerunOf, rerunNumber                             sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan, rerunOfName sql.NullString
+		comment                                                             sql.NullString
 		createTime, startTime, endTime, reapTime                            pq.NullTime
 		nonce                                                               sql.NullString
-		drained, aborted, completed                                         bool
+		drained, eventsArchived, aborted, completed                         bool
 		status                                                              string
 	)
 
@@ -1449,12 +1625,15 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&b.teamName,
 		&nonce,
 		&drained,
+		&eventsArchived,
 		&aborted,
 		&completed,
 		&b.inputsReady,
 		&rerunOf,
 		&rerunOfName,
 		&rerunNumber,
+		&comment,
+		&b.priority,
 	)
 	if err != nil {
 		return err
@@ -1471,11 +1650,13 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
 	b.drained = drained
+	b.eventsArchived = eventsArchived
 	b.aborted = aborted
 	b.completed = completed
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.comment = comment.String
 
 	var (
 		noncense      *string
@@ -0,0 +1,131 @@
+package db
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/event"
+)
+
+var ErrNoEventArchive = errors.New("build events are archived but no event archive is configured")
+
+// buildEventsKey is the key of the blob which the events of a build are
+// archived to, as gzipped JSON lines of event envelopes.
+func buildEventsKey(buildID int) string {
+	return fmt.Sprintf("builds/%d/events.ndjson.gz", buildID)
+}
+
+// writeArchivedEvents writes all of the events of the source to w in the
+// format read by archivedEventSource.
+func writeArchivedEvents(w io.Writer, events EventSource) error {
+	gz := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gz)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == ErrEndOfBuildEventStream {
+				break
+			}
+
+			return err
+		}
+
+		err = encoder.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return gz.Close()
+}
+
+// archivedEventSource reads the events of a completed build back from the
+// event archive. As the build has completed, the stream simply ends with the
+// last archived event.
+type archivedEventSource struct {
+	blob    io.ReadCloser
+	gz      *gzip.Reader
+	decoder *json.Decoder
+
+	closed bool
+	mu     sync.Mutex
+}
+
+func newArchivedEventSource(store blobstore.Store, buildID int, from uint) (*archivedEventSource, error) {
+	if store == nil {
+		return nil, ErrNoEventArchive
+	}
+
+	blob, err := store.Get(context.Background(), buildEventsKey(buildID))
+	if err != nil {
+		return nil, err
+	}
+
+	gz, err := gzip.NewReader(blob)
+	if err != nil {
+		_ = blob.Close()
+		return nil, err
+	}
+
+	source := &archivedEventSource{
+		blob:    blob,
+		gz:      gz,
+		decoder: json.NewDecoder(gz),
+	}
+
+	for i := uint(0); i < from; i++ {
+		_, err := source.Next()
+		if err != nil {
+			if err == ErrEndOfBuildEventStream {
+				break
+			}
+
+			_ = source.Close()
+			return nil, err
+		}
+	}
+
+	return source, nil
+}
+
+func (source *archivedEventSource) Next() (event.Envelope, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if source.closed {
+		return event.Envelope{}, ErrBuildEventStreamClosed
+	}
+
+	var ev event.Envelope
+	err := source.decoder.Decode(&ev)
+	if err != nil {
+		if err == io.EOF {
+			return event.Envelope{}, ErrEndOfBuildEventStream
+		}
+
+		return event.Envelope{}, err
+	}
+
+	return ev, nil
+}
+
+func (source *archivedEventSource) Close() error {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if source.closed {
+		return nil
+	}
+
+	source.closed = true
+
+	_ = source.gz.Close()
+	return source.blob.Close()
+}
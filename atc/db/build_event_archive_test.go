@@ -0,0 +1,204 @@
+package db_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build event archive", func() {
+	var (
+		archiveDir   string
+		archive      blobstore.Store
+		archiveConn  db.Conn
+		archiveBuild db.Build
+
+		build db.Build
+		ctx   context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		archiveDir, err = ioutil.TempDir("", "event-archive")
+		Expect(err).ToNot(HaveOccurred())
+
+		archive = blobstore.NewFileSystemStore(archiveDir)
+
+		archiveConn, err = db.Open(
+			lagertest.NewTestLogger("archive"),
+			"postgres",
+			postgresRunner.DataSourceName(),
+			nil,
+			nil,
+			"archive",
+			nil,
+			archive,
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+
+		build, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = build.Start(atc.Plan{})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.SaveEvent(event.Log{Payload: "some "})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.SaveEvent(event.Log{Payload: "log"})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = build.Finish(db.BuildStatusSucceeded)
+		Expect(err).ToNot(HaveOccurred())
+
+		var found bool
+		archiveBuild, found, err = db.NewBuildFactory(archiveConn, lockFactory, 5*time.Minute).Build(build.ID())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		Expect(archiveConn.Close()).To(Succeed())
+		Expect(os.RemoveAll(archiveDir)).To(Succeed())
+	})
+
+	Describe("ArchiveEvents", func() {
+		var originalEvents []event.Envelope
+
+		BeforeEach(func() {
+			originalEvents = readEvents(archiveBuild, 0)
+			Expect(originalEvents).To(HaveLen(4))
+
+			err := archiveBuild.ArchiveEvents(ctx)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("moves the events out of the database", func() {
+			Expect(archiveBuild.EventsArchived()).To(BeTrue())
+
+			var count int
+			err := psql.Select("COUNT(*)").
+				From("build_events").
+				Where(sq.Eq{"build_id": build.ID()}).
+				RunWith(dbConn).
+				QueryRow().
+				Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		It("reads the events back from the archive", func() {
+			found, err := build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.EventsArchived()).To(BeTrue())
+
+			_, err = archiveBuild.Reload()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(readEvents(archiveBuild, 0)).To(Equal(originalEvents))
+
+			By("allowing you to read from an offset")
+			Expect(readEvents(archiveBuild, 2)).To(Equal(originalEvents[2:]))
+		})
+
+		It("fails to read the events without an archive", func() {
+			_, err := build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = build.Events(0)
+			Expect(err).To(Equal(db.ErrNoEventArchive))
+		})
+
+		It("is no longer archivable", func() {
+			builds, err := buildFactory.GetArchivableBuilds(time.Now().Add(time.Hour), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(BeEmpty())
+		})
+
+		Context("when the build is reaped", func() {
+			BeforeEach(func() {
+				_, err := psql.Update("builds").
+					Set("reap_time", sq.Expr("now()")).
+					Where(sq.Eq{"id": build.ID()}).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the archived events", func() {
+				builds, err := db.NewBuildFactory(archiveConn, lockFactory, 5*time.Minute).GetReapedArchivedBuilds(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(builds).To(HaveLen(1))
+				Expect(builds[0].ID()).To(Equal(build.ID()))
+
+				By("not reading the events of the reaped build from the archive")
+				Expect(readEvents(builds[0], 0)).To(BeEmpty())
+
+				err = builds[0].DeleteArchivedEvents(ctx)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = archive.Get(ctx, "builds/"+strconv.Itoa(build.ID())+"/events.ndjson.gz")
+				Expect(err).To(Equal(blobstore.ErrNotFound))
+
+				builds, err = buildFactory.GetReapedArchivedBuilds(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(builds).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("GetArchivableBuilds", func() {
+		It("returns the builds which completed before the given time", func() {
+			builds, err := buildFactory.GetArchivableBuilds(time.Now().Add(time.Hour), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+			Expect(builds[0].ID()).To(Equal(build.ID()))
+
+			builds, err = buildFactory.GetArchivableBuilds(time.Now().Add(-time.Hour), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(BeEmpty())
+		})
+
+		It("does not return running builds", func() {
+			_, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			builds, err := buildFactory.GetArchivableBuilds(time.Now().Add(time.Hour), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+		})
+	})
+})
+
+func readEvents(build db.Build, from uint) []event.Envelope {
+	events, err := build.Events(from)
+	Expect(err).ToNot(HaveOccurred())
+
+	defer db.Close(events)
+
+	envelopes := []event.Envelope{}
+	for {
+		ev, err := events.Next()
+		if err == db.ErrEndOfBuildEventStream {
+			return envelopes
+		}
+
+		Expect(err).ToNot(HaveOccurred())
+
+		envelopes = append(envelopes, ev)
+	}
+}
@@ -19,6 +19,8 @@ type BuildFactory interface {
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	GetArchivableBuilds(completedBefore time.Time, limit int) ([]Build, error)
+	GetReapedArchivedBuilds(limit int) ([]Build, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
 }
@@ -114,6 +116,36 @@ func (f *buildFactory) GetDrainableBuilds() ([]Build, error) {
 	return getBuilds(query, f.conn, f.lockFactory)
 }
 
+// GetArchivableBuilds returns the builds which completed before the given time
+// and whose events are still in the database.
+func (f *buildFactory) GetArchivableBuilds(completedBefore time.Time, limit int) ([]Build, error) {
+	query := buildsQuery.Where(sq.And{
+		sq.Eq{
+			"b.completed":       true,
+			"b.events_archived": false,
+			"b.reap_time":       nil,
+		},
+		sq.Lt{"b.end_time": completedBefore},
+	}).
+		OrderBy("b.end_time ASC").
+		Limit(uint64(limit))
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
+// GetReapedArchivedBuilds returns the builds which have been reaped after
+// their events were archived, i.e. whose archived events are to be deleted.
+func (f *buildFactory) GetReapedArchivedBuilds(limit int) ([]Build, error) {
+	query := buildsQuery.Where(sq.And{
+		sq.Eq{"b.events_archived": true},
+		sq.NotEq{"b.reap_time": nil},
+	}).
+		OrderBy("b.id ASC").
+		Limit(uint64(limit))
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
 func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	query := buildsQuery.Where(sq.Eq{
 		"b.status": BuildStatusStarted,
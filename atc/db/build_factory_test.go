@@ -116,7 +116,7 @@ var _ = Describe("BuildFactory", func() {
 				err = build2.Finish(db.BuildStatusErrored)
 				Expect(err).NotTo(HaveOccurred())
 
-				p, _, err := defaultTeam.SavePipeline("other-pipeline", atc.Config{
+				p, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-other-job",
@@ -224,7 +224,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -234,7 +234,7 @@ var _ = Describe("BuildFactory", func() {
 			build2, err = privateJob.CreateBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -283,7 +283,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -293,7 +293,7 @@ var _ = Describe("BuildFactory", func() {
 			build2, err = privateJob.CreateBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -329,7 +329,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -339,7 +339,7 @@ var _ = Describe("BuildFactory", func() {
 			_, err = privateJob.CreateBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -365,7 +365,7 @@ var _ = Describe("BuildFactory", func() {
 		var build2DB, build3DB, build4DB db.Build
 
 		BeforeEach(func() {
-			pipeline, _, err := team.SavePipeline("other-pipeline", atc.Config{
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -420,7 +420,7 @@ var _ = Describe("BuildFactory", func() {
 		var build2DB db.Build
 
 		BeforeEach(func() {
-			pipeline, _, err := team.SavePipeline("other-pipeline", atc.Config{
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -469,7 +469,7 @@ var _ = Describe("BuildFactory", func() {
 		var build2DB db.Build
 
 		BeforeEach(func() {
-			pipeline, _, err := team.SavePipeline("other-pipeline", atc.Config{
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
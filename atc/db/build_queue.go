@@ -0,0 +1,52 @@
+package db
+
+import (
+	"database/sql"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// buildQueueQuery ranks the pending builds of every job in the order in which
+// they are preferred to be started: by the effective priority of their job,
+// falling back to the team's default build priority, and then by age.
+const buildQueueQuery = `
+	SELECT q.id, q.name, q.job_name, q.priority, q.position
+	FROM (
+		SELECT
+			b.id,
+			b.name,
+			b.job_id,
+			j.name AS job_name,
+			COALESCE(j.priority, t.default_build_priority, 0) AS priority,
+			row_number() OVER (
+				ORDER BY COALESCE(j.priority, t.default_build_priority, 0) DESC, b.id ASC
+			) AS position
+		FROM builds b
+		JOIN jobs j ON j.id = b.job_id
+		JOIN teams t ON t.id = b.team_id
+		WHERE b.status = 'pending'
+	) q
+`
+
+func scanBuildQueuePositions(rows *sql.Rows) ([]atc.BuildQueuePosition, error) {
+	defer Close(rows)
+
+	positions := []atc.BuildQueuePosition{}
+	for rows.Next() {
+		var position atc.BuildQueuePosition
+		err := rows.Scan(
+			&position.BuildID,
+			&position.BuildName,
+			&position.JobName,
+			&position.Priority,
+			&position.Position,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		positions = append(positions, position)
+	}
+
+	return positions, nil
+}
@@ -82,6 +82,38 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("SetComment", func() {
+		It("has no comment in the beginning", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Comment()).To(BeEmpty())
+		})
+
+		It("has the comment set after setting it and a reload", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetComment("flaky, see infra ticket")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Comment()).To(Equal("flaky, see infra ticket"))
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.Comment()).To(Equal("flaky, see infra ticket"))
+		})
+
+		It("returns ErrBuildDisappeared if the build is gone", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build.Delete()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetComment("too late")
+			Expect(err).To(Equal(db.ErrBuildDisappeared))
+		})
+	})
+
 	Describe("Start", func() {
 		var err error
 		var started bool
@@ -241,7 +273,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -724,7 +756,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -808,7 +840,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -908,7 +940,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -1038,7 +1070,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -1251,7 +1283,7 @@ var _ = Describe("Build", func() {
 		Context("when a job build", func() {
 			BeforeEach(func() {
 				var err error
-				createdPipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				createdPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -1349,7 +1381,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Resources: atc.ResourceConfigs{
 						{
 							Name: "some-resource",
@@ -1544,7 +1576,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(scheduled).To(BeTrue())
 
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1605,7 +1637,7 @@ var _ = Describe("Build", func() {
 					Context("when max running builds is de-reached", func() {
 						BeforeEach(func() {
 							var err error
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1736,7 +1768,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false)
 					Expect(err).ToNot(HaveOccurred())
 
 					err = job.SaveNextInputMapping(db.InputMapping{
@@ -1781,7 +1813,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false)
 					Expect(err).ToNot(HaveOccurred())
 
 					setupTx, err := dbConn.Begin()
@@ -1891,7 +1923,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2124,7 +2156,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -320,7 +320,10 @@ func (c *checkFactory) Resources() ([]Resource, error) {
 	var resources []Resource
 
 	rows, err := resourcesQuery.
-		Where(sq.Eq{"p.paused": false}).
+		Where(sq.Eq{
+			"p.paused":   false,
+			"p.archived": false,
+		}).
 		RunWith(c.conn).
 		Query()
 
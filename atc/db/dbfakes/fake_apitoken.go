@@ -0,0 +1,678 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeAPIToken struct {
+	CreatedAtStub        func() time.Time
+	createdAtMutex       sync.RWMutex
+	createdAtArgsForCall []struct {
+	}
+	createdAtReturns struct {
+		result1 time.Time
+	}
+	createdAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	CreatedByStub        func() string
+	createdByMutex       sync.RWMutex
+	createdByArgsForCall []struct {
+	}
+	createdByReturns struct {
+		result1 string
+	}
+	createdByReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ExpiresAtStub        func() time.Time
+	expiresAtMutex       sync.RWMutex
+	expiresAtArgsForCall []struct {
+	}
+	expiresAtReturns struct {
+		result1 time.Time
+	}
+	expiresAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	IDStub        func() int
+	iDMutex       sync.RWMutex
+	iDArgsForCall []struct {
+	}
+	iDReturns struct {
+		result1 int
+	}
+	iDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	LastUsedAtStub        func() time.Time
+	lastUsedAtMutex       sync.RWMutex
+	lastUsedAtArgsForCall []struct {
+	}
+	lastUsedAtReturns struct {
+		result1 time.Time
+	}
+	lastUsedAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	NameStub        func() string
+	nameMutex       sync.RWMutex
+	nameArgsForCall []struct {
+	}
+	nameReturns struct {
+		result1 string
+	}
+	nameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RoleStub        func() string
+	roleMutex       sync.RWMutex
+	roleArgsForCall []struct {
+	}
+	roleReturns struct {
+		result1 string
+	}
+	roleReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ServiceAccountStub        func() bool
+	serviceAccountMutex       sync.RWMutex
+	serviceAccountArgsForCall []struct {
+	}
+	serviceAccountReturns struct {
+		result1 bool
+	}
+	serviceAccountReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	TeamIDStub        func() int
+	teamIDMutex       sync.RWMutex
+	teamIDArgsForCall []struct {
+	}
+	teamIDReturns struct {
+		result1 int
+	}
+	teamIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	TeamNameStub        func() string
+	teamNameMutex       sync.RWMutex
+	teamNameArgsForCall []struct {
+	}
+	teamNameReturns struct {
+		result1 string
+	}
+	teamNameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAPIToken) CreatedAt() time.Time {
+	fake.createdAtMutex.Lock()
+	ret, specificReturn := fake.createdAtReturnsOnCall[len(fake.createdAtArgsForCall)]
+	fake.createdAtArgsForCall = append(fake.createdAtArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreatedAt", []interface{}{})
+	fake.createdAtMutex.Unlock()
+	if fake.CreatedAtStub != nil {
+		return fake.CreatedAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createdAtReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) CreatedAtCallCount() int {
+	fake.createdAtMutex.RLock()
+	defer fake.createdAtMutex.RUnlock()
+	return len(fake.createdAtArgsForCall)
+}
+
+func (fake *FakeAPIToken) CreatedAtCalls(stub func() time.Time) {
+	fake.createdAtMutex.Lock()
+	defer fake.createdAtMutex.Unlock()
+	fake.CreatedAtStub = stub
+}
+
+func (fake *FakeAPIToken) CreatedAtReturns(result1 time.Time) {
+	fake.createdAtMutex.Lock()
+	defer fake.createdAtMutex.Unlock()
+	fake.CreatedAtStub = nil
+	fake.createdAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeAPIToken) CreatedAtReturnsOnCall(i int, result1 time.Time) {
+	fake.createdAtMutex.Lock()
+	defer fake.createdAtMutex.Unlock()
+	fake.CreatedAtStub = nil
+	if fake.createdAtReturnsOnCall == nil {
+		fake.createdAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.createdAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeAPIToken) CreatedBy() string {
+	fake.createdByMutex.Lock()
+	ret, specificReturn := fake.createdByReturnsOnCall[len(fake.createdByArgsForCall)]
+	fake.createdByArgsForCall = append(fake.createdByArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreatedBy", []interface{}{})
+	fake.createdByMutex.Unlock()
+	if fake.CreatedByStub != nil {
+		return fake.CreatedByStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createdByReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) CreatedByCallCount() int {
+	fake.createdByMutex.RLock()
+	defer fake.createdByMutex.RUnlock()
+	return len(fake.createdByArgsForCall)
+}
+
+func (fake *FakeAPIToken) CreatedByCalls(stub func() string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = stub
+}
+
+func (fake *FakeAPIToken) CreatedByReturns(result1 string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = nil
+	fake.createdByReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAPIToken) CreatedByReturnsOnCall(i int, result1 string) {
+	fake.createdByMutex.Lock()
+	defer fake.createdByMutex.Unlock()
+	fake.CreatedByStub = nil
+	if fake.createdByReturnsOnCall == nil {
+		fake.createdByReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.createdByReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAPIToken) ExpiresAt() time.Time {
+	fake.expiresAtMutex.Lock()
+	ret, specificReturn := fake.expiresAtReturnsOnCall[len(fake.expiresAtArgsForCall)]
+	fake.expiresAtArgsForCall = append(fake.expiresAtArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ExpiresAt", []interface{}{})
+	fake.expiresAtMutex.Unlock()
+	if fake.ExpiresAtStub != nil {
+		return fake.ExpiresAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.expiresAtReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) ExpiresAtCallCount() int {
+	fake.expiresAtMutex.RLock()
+	defer fake.expiresAtMutex.RUnlock()
+	return len(fake.expiresAtArgsForCall)
+}
+
+func (fake *FakeAPIToken) ExpiresAtCalls(stub func() time.Time) {
+	fake.expiresAtMutex.Lock()
+	defer fake.expiresAtMutex.Unlock()
+	fake.ExpiresAtStub = stub
+}
+
+func (fake *FakeAPIToken) ExpiresAtReturns(result1 time.Time) {
+	fake.expiresAtMutex.Lock()
+	defer fake.expiresAtMutex.Unlock()
+	fake.ExpiresAtStub = nil
+	fake.expiresAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeAPIToken) ExpiresAtReturnsOnCall(i int, result1 time.Time) {
+	fake.expiresAtMutex.Lock()
+	defer fake.expiresAtMutex.Unlock()
+	fake.ExpiresAtStub = nil
+	if fake.expiresAtReturnsOnCall == nil {
+		fake.expiresAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.expiresAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeAPIToken) ID() int {
+	fake.iDMutex.Lock()
+	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
+	fake.iDArgsForCall = append(fake.iDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ID", []interface{}{})
+	fake.iDMutex.Unlock()
+	if fake.IDStub != nil {
+		return fake.IDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.iDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) IDCallCount() int {
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	return len(fake.iDArgsForCall)
+}
+
+func (fake *FakeAPIToken) IDCalls(stub func() int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = stub
+}
+
+func (fake *FakeAPIToken) IDReturns(result1 int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = nil
+	fake.iDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeAPIToken) IDReturnsOnCall(i int, result1 int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = nil
+	if fake.iDReturnsOnCall == nil {
+		fake.iDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.iDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeAPIToken) LastUsedAt() time.Time {
+	fake.lastUsedAtMutex.Lock()
+	ret, specificReturn := fake.lastUsedAtReturnsOnCall[len(fake.lastUsedAtArgsForCall)]
+	fake.lastUsedAtArgsForCall = append(fake.lastUsedAtArgsForCall, struct {
+	}{})
+	fake.recordInvocation("LastUsedAt", []interface{}{})
+	fake.lastUsedAtMutex.Unlock()
+	if fake.LastUsedAtStub != nil {
+		return fake.LastUsedAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.lastUsedAtReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) LastUsedAtCallCount() int {
+	fake.lastUsedAtMutex.RLock()
+	defer fake.lastUsedAtMutex.RUnlock()
+	return len(fake.lastUsedAtArgsForCall)
+}
+
+func (fake *FakeAPIToken) LastUsedAtCalls(stub func() time.Time) {
+	fake.lastUsedAtMutex.Lock()
+	defer fake.lastUsedAtMutex.Unlock()
+	fake.LastUsedAtStub = stub
+}
+
+func (fake *FakeAPIToken) LastUsedAtReturns(result1 time.Time) {
+	fake.lastUsedAtMutex.Lock()
+	defer fake.lastUsedAtMutex.Unlock()
+	fake.LastUsedAtStub = nil
+	fake.lastUsedAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeAPIToken) LastUsedAtReturnsOnCall(i int, result1 time.Time) {
+	fake.lastUsedAtMutex.Lock()
+	defer fake.lastUsedAtMutex.Unlock()
+	fake.LastUsedAtStub = nil
+	if fake.lastUsedAtReturnsOnCall == nil {
+		fake.lastUsedAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.lastUsedAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeAPIToken) Name() string {
+	fake.nameMutex.Lock()
+	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
+	fake.nameArgsForCall = append(fake.nameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Name", []interface{}{})
+	fake.nameMutex.Unlock()
+	if fake.NameStub != nil {
+		return fake.NameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.nameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) NameCallCount() int {
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	return len(fake.nameArgsForCall)
+}
+
+func (fake *FakeAPIToken) NameCalls(stub func() string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = stub
+}
+
+func (fake *FakeAPIToken) NameReturns(result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	fake.nameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAPIToken) NameReturnsOnCall(i int, result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	if fake.nameReturnsOnCall == nil {
+		fake.nameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.nameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAPIToken) Role() string {
+	fake.roleMutex.Lock()
+	ret, specificReturn := fake.roleReturnsOnCall[len(fake.roleArgsForCall)]
+	fake.roleArgsForCall = append(fake.roleArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Role", []interface{}{})
+	fake.roleMutex.Unlock()
+	if fake.RoleStub != nil {
+		return fake.RoleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.roleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) RoleCallCount() int {
+	fake.roleMutex.RLock()
+	defer fake.roleMutex.RUnlock()
+	return len(fake.roleArgsForCall)
+}
+
+func (fake *FakeAPIToken) RoleCalls(stub func() string) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = stub
+}
+
+func (fake *FakeAPIToken) RoleReturns(result1 string) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = nil
+	fake.roleReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAPIToken) RoleReturnsOnCall(i int, result1 string) {
+	fake.roleMutex.Lock()
+	defer fake.roleMutex.Unlock()
+	fake.RoleStub = nil
+	if fake.roleReturnsOnCall == nil {
+		fake.roleReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.roleReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAPIToken) ServiceAccount() bool {
+	fake.serviceAccountMutex.Lock()
+	ret, specificReturn := fake.serviceAccountReturnsOnCall[len(fake.serviceAccountArgsForCall)]
+	fake.serviceAccountArgsForCall = append(fake.serviceAccountArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ServiceAccount", []interface{}{})
+	fake.serviceAccountMutex.Unlock()
+	if fake.ServiceAccountStub != nil {
+		return fake.ServiceAccountStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.serviceAccountReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) ServiceAccountCallCount() int {
+	fake.serviceAccountMutex.RLock()
+	defer fake.serviceAccountMutex.RUnlock()
+	return len(fake.serviceAccountArgsForCall)
+}
+
+func (fake *FakeAPIToken) ServiceAccountCalls(stub func() bool) {
+	fake.serviceAccountMutex.Lock()
+	defer fake.serviceAccountMutex.Unlock()
+	fake.ServiceAccountStub = stub
+}
+
+func (fake *FakeAPIToken) ServiceAccountReturns(result1 bool) {
+	fake.serviceAccountMutex.Lock()
+	defer fake.serviceAccountMutex.Unlock()
+	fake.ServiceAccountStub = nil
+	fake.serviceAccountReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAPIToken) ServiceAccountReturnsOnCall(i int, result1 bool) {
+	fake.serviceAccountMutex.Lock()
+	defer fake.serviceAccountMutex.Unlock()
+	fake.ServiceAccountStub = nil
+	if fake.serviceAccountReturnsOnCall == nil {
+		fake.serviceAccountReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.serviceAccountReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAPIToken) TeamID() int {
+	fake.teamIDMutex.Lock()
+	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
+	fake.teamIDArgsForCall = append(fake.teamIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TeamID", []interface{}{})
+	fake.teamIDMutex.Unlock()
+	if fake.TeamIDStub != nil {
+		return fake.TeamIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.teamIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) TeamIDCallCount() int {
+	fake.teamIDMutex.RLock()
+	defer fake.teamIDMutex.RUnlock()
+	return len(fake.teamIDArgsForCall)
+}
+
+func (fake *FakeAPIToken) TeamIDCalls(stub func() int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = stub
+}
+
+func (fake *FakeAPIToken) TeamIDReturns(result1 int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = nil
+	fake.teamIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeAPIToken) TeamIDReturnsOnCall(i int, result1 int) {
+	fake.teamIDMutex.Lock()
+	defer fake.teamIDMutex.Unlock()
+	fake.TeamIDStub = nil
+	if fake.teamIDReturnsOnCall == nil {
+		fake.teamIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.teamIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeAPIToken) TeamName() string {
+	fake.teamNameMutex.Lock()
+	ret, specificReturn := fake.teamNameReturnsOnCall[len(fake.teamNameArgsForCall)]
+	fake.teamNameArgsForCall = append(fake.teamNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TeamName", []interface{}{})
+	fake.teamNameMutex.Unlock()
+	if fake.TeamNameStub != nil {
+		return fake.TeamNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.teamNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAPIToken) TeamNameCallCount() int {
+	fake.teamNameMutex.RLock()
+	defer fake.teamNameMutex.RUnlock()
+	return len(fake.teamNameArgsForCall)
+}
+
+func (fake *FakeAPIToken) TeamNameCalls(stub func() string) {
+	fake.teamNameMutex.Lock()
+	defer fake.teamNameMutex.Unlock()
+	fake.TeamNameStub = stub
+}
+
+func (fake *FakeAPIToken) TeamNameReturns(result1 string) {
+	fake.teamNameMutex.Lock()
+	defer fake.teamNameMutex.Unlock()
+	fake.TeamNameStub = nil
+	fake.teamNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAPIToken) TeamNameReturnsOnCall(i int, result1 string) {
+	fake.teamNameMutex.Lock()
+	defer fake.teamNameMutex.Unlock()
+	fake.TeamNameStub = nil
+	if fake.teamNameReturnsOnCall == nil {
+		fake.teamNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.teamNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeAPIToken) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createdAtMutex.RLock()
+	defer fake.createdAtMutex.RUnlock()
+	fake.createdByMutex.RLock()
+	defer fake.createdByMutex.RUnlock()
+	fake.expiresAtMutex.RLock()
+	defer fake.expiresAtMutex.RUnlock()
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	fake.lastUsedAtMutex.RLock()
+	defer fake.lastUsedAtMutex.RUnlock()
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	fake.roleMutex.RLock()
+	defer fake.roleMutex.RUnlock()
+	fake.serviceAccountMutex.RLock()
+	defer fake.serviceAccountMutex.RUnlock()
+	fake.teamIDMutex.RLock()
+	defer fake.teamIDMutex.RUnlock()
+	fake.teamNameMutex.RLock()
+	defer fake.teamNameMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAPIToken) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.APIToken = new(FakeAPIToken)
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeAPITokenFactory struct {
+	UseAPITokenStub        func(string) (db.APIToken, bool, error)
+	useAPITokenMutex       sync.RWMutex
+	useAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	useAPITokenReturns struct {
+		result1 db.APIToken
+		result2 bool
+		result3 error
+	}
+	useAPITokenReturnsOnCall map[int]struct {
+		result1 db.APIToken
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAPITokenFactory) UseAPIToken(arg1 string) (db.APIToken, bool, error) {
+	fake.useAPITokenMutex.Lock()
+	ret, specificReturn := fake.useAPITokenReturnsOnCall[len(fake.useAPITokenArgsForCall)]
+	fake.useAPITokenArgsForCall = append(fake.useAPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UseAPIToken", []interface{}{arg1})
+	fake.useAPITokenMutex.Unlock()
+	if fake.UseAPITokenStub != nil {
+		return fake.UseAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.useAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAPITokenFactory) UseAPITokenCallCount() int {
+	fake.useAPITokenMutex.RLock()
+	defer fake.useAPITokenMutex.RUnlock()
+	return len(fake.useAPITokenArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) UseAPITokenCalls(stub func(string) (db.APIToken, bool, error)) {
+	fake.useAPITokenMutex.Lock()
+	defer fake.useAPITokenMutex.Unlock()
+	fake.UseAPITokenStub = stub
+}
+
+func (fake *FakeAPITokenFactory) UseAPITokenArgsForCall(i int) string {
+	fake.useAPITokenMutex.RLock()
+	defer fake.useAPITokenMutex.RUnlock()
+	argsForCall := fake.useAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPITokenFactory) UseAPITokenReturns(result1 db.APIToken, result2 bool, result3 error) {
+	fake.useAPITokenMutex.Lock()
+	defer fake.useAPITokenMutex.Unlock()
+	fake.UseAPITokenStub = nil
+	fake.useAPITokenReturns = struct {
+		result1 db.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPITokenFactory) UseAPITokenReturnsOnCall(i int, result1 db.APIToken, result2 bool, result3 error) {
+	fake.useAPITokenMutex.Lock()
+	defer fake.useAPITokenMutex.Unlock()
+	fake.UseAPITokenStub = nil
+	if fake.useAPITokenReturnsOnCall == nil {
+		fake.useAPITokenReturnsOnCall = make(map[int]struct {
+			result1 db.APIToken
+			result2 bool
+			result3 error
+		})
+	}
+	fake.useAPITokenReturnsOnCall[i] = struct {
+		result1 db.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAPITokenFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.useAPITokenMutex.RLock()
+	defer fake.useAPITokenMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAPITokenFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.APITokenFactory = new(FakeAPITokenFactory)
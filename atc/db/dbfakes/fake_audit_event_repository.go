@@ -0,0 +1,275 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeAuditEventRepository struct {
+	AuditEventsStub        func(db.AuditEventFilter, db.Page) ([]atc.AuditEvent, db.Pagination, error)
+	auditEventsMutex       sync.RWMutex
+	auditEventsArgsForCall []struct {
+		arg1 db.AuditEventFilter
+		arg2 db.Page
+	}
+	auditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}
+	auditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}
+	RemoveExpiredAuditEventsStub        func(time.Duration) (int, error)
+	removeExpiredAuditEventsMutex       sync.RWMutex
+	removeExpiredAuditEventsArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeExpiredAuditEventsReturns struct {
+		result1 int
+		result2 error
+	}
+	removeExpiredAuditEventsReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	SaveAuditEventStub        func(atc.AuditEvent) error
+	saveAuditEventMutex       sync.RWMutex
+	saveAuditEventArgsForCall []struct {
+		arg1 atc.AuditEvent
+	}
+	saveAuditEventReturns struct {
+		result1 error
+	}
+	saveAuditEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditEventRepository) AuditEvents(arg1 db.AuditEventFilter, arg2 db.Page) ([]atc.AuditEvent, db.Pagination, error) {
+	fake.auditEventsMutex.Lock()
+	ret, specificReturn := fake.auditEventsReturnsOnCall[len(fake.auditEventsArgsForCall)]
+	fake.auditEventsArgsForCall = append(fake.auditEventsArgsForCall, struct {
+		arg1 db.AuditEventFilter
+		arg2 db.Page
+	}{arg1, arg2})
+	fake.recordInvocation("AuditEvents", []interface{}{arg1, arg2})
+	fake.auditEventsMutex.Unlock()
+	if fake.AuditEventsStub != nil {
+		return fake.AuditEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.auditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAuditEventRepository) AuditEventsCallCount() int {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return len(fake.auditEventsArgsForCall)
+}
+
+func (fake *FakeAuditEventRepository) AuditEventsCalls(stub func(db.AuditEventFilter, db.Page) ([]atc.AuditEvent, db.Pagination, error)) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = stub
+}
+
+func (fake *FakeAuditEventRepository) AuditEventsArgsForCall(i int) (db.AuditEventFilter, db.Page) {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	argsForCall := fake.auditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuditEventRepository) AuditEventsReturns(result1 []atc.AuditEvent, result2 db.Pagination, result3 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	fake.auditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAuditEventRepository) AuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 db.Pagination, result3 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	if fake.auditEventsReturnsOnCall == nil {
+		fake.auditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 db.Pagination
+			result3 error
+		})
+	}
+	fake.auditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 db.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAuditEventRepository) RemoveExpiredAuditEvents(arg1 time.Duration) (int, error) {
+	fake.removeExpiredAuditEventsMutex.Lock()
+	ret, specificReturn := fake.removeExpiredAuditEventsReturnsOnCall[len(fake.removeExpiredAuditEventsArgsForCall)]
+	fake.removeExpiredAuditEventsArgsForCall = append(fake.removeExpiredAuditEventsArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("RemoveExpiredAuditEvents", []interface{}{arg1})
+	fake.removeExpiredAuditEventsMutex.Unlock()
+	if fake.RemoveExpiredAuditEventsStub != nil {
+		return fake.RemoveExpiredAuditEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeExpiredAuditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditEventRepository) RemoveExpiredAuditEventsCallCount() int {
+	fake.removeExpiredAuditEventsMutex.RLock()
+	defer fake.removeExpiredAuditEventsMutex.RUnlock()
+	return len(fake.removeExpiredAuditEventsArgsForCall)
+}
+
+func (fake *FakeAuditEventRepository) RemoveExpiredAuditEventsCalls(stub func(time.Duration) (int, error)) {
+	fake.removeExpiredAuditEventsMutex.Lock()
+	defer fake.removeExpiredAuditEventsMutex.Unlock()
+	fake.RemoveExpiredAuditEventsStub = stub
+}
+
+func (fake *FakeAuditEventRepository) RemoveExpiredAuditEventsArgsForCall(i int) time.Duration {
+	fake.removeExpiredAuditEventsMutex.RLock()
+	defer fake.removeExpiredAuditEventsMutex.RUnlock()
+	argsForCall := fake.removeExpiredAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditEventRepository) RemoveExpiredAuditEventsReturns(result1 int, result2 error) {
+	fake.removeExpiredAuditEventsMutex.Lock()
+	defer fake.removeExpiredAuditEventsMutex.Unlock()
+	fake.RemoveExpiredAuditEventsStub = nil
+	fake.removeExpiredAuditEventsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditEventRepository) RemoveExpiredAuditEventsReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeExpiredAuditEventsMutex.Lock()
+	defer fake.removeExpiredAuditEventsMutex.Unlock()
+	fake.RemoveExpiredAuditEventsStub = nil
+	if fake.removeExpiredAuditEventsReturnsOnCall == nil {
+		fake.removeExpiredAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeExpiredAuditEventsReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditEventRepository) SaveAuditEvent(arg1 atc.AuditEvent) error {
+	fake.saveAuditEventMutex.Lock()
+	ret, specificReturn := fake.saveAuditEventReturnsOnCall[len(fake.saveAuditEventArgsForCall)]
+	fake.saveAuditEventArgsForCall = append(fake.saveAuditEventArgsForCall, struct {
+		arg1 atc.AuditEvent
+	}{arg1})
+	fake.recordInvocation("SaveAuditEvent", []interface{}{arg1})
+	fake.saveAuditEventMutex.Unlock()
+	if fake.SaveAuditEventStub != nil {
+		return fake.SaveAuditEventStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveAuditEventReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuditEventRepository) SaveAuditEventCallCount() int {
+	fake.saveAuditEventMutex.RLock()
+	defer fake.saveAuditEventMutex.RUnlock()
+	return len(fake.saveAuditEventArgsForCall)
+}
+
+func (fake *FakeAuditEventRepository) SaveAuditEventCalls(stub func(atc.AuditEvent) error) {
+	fake.saveAuditEventMutex.Lock()
+	defer fake.saveAuditEventMutex.Unlock()
+	fake.SaveAuditEventStub = stub
+}
+
+func (fake *FakeAuditEventRepository) SaveAuditEventArgsForCall(i int) atc.AuditEvent {
+	fake.saveAuditEventMutex.RLock()
+	defer fake.saveAuditEventMutex.RUnlock()
+	argsForCall := fake.saveAuditEventArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditEventRepository) SaveAuditEventReturns(result1 error) {
+	fake.saveAuditEventMutex.Lock()
+	defer fake.saveAuditEventMutex.Unlock()
+	fake.SaveAuditEventStub = nil
+	fake.saveAuditEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditEventRepository) SaveAuditEventReturnsOnCall(i int, result1 error) {
+	fake.saveAuditEventMutex.Lock()
+	defer fake.saveAuditEventMutex.Unlock()
+	fake.SaveAuditEventStub = nil
+	if fake.saveAuditEventReturnsOnCall == nil {
+		fake.saveAuditEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveAuditEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditEventRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	fake.removeExpiredAuditEventsMutex.RLock()
+	defer fake.removeExpiredAuditEventsMutex.RUnlock()
+	fake.saveAuditEventMutex.RLock()
+	defer fake.saveAuditEventMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditEventRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.AuditEventRepository = new(FakeAuditEventRepository)
@@ -2,6 +2,7 @@
 package dbfakes
 
 import (
+	"context"
 	"encoding/json"
 	"sync"
 	"time"
@@ -69,6 +70,17 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	ArchiveEventsStub        func(context.Context) error
+	archiveEventsMutex       sync.RWMutex
+	archiveEventsArgsForCall []struct {
+		arg1 context.Context
+	}
+	archiveEventsReturns struct {
+		result1 error
+	}
+	archiveEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -94,6 +106,26 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	CommentStub        func() string
+	commentMutex       sync.RWMutex
+	commentArgsForCall []struct {
+	}
+	commentReturns struct {
+		result1 string
+	}
+	commentReturnsOnCall map[int]struct {
+		result1 string
+	}
+	CreateTimeStub        func() time.Time
+	createTimeMutex       sync.RWMutex
+	createTimeArgsForCall []struct {
+	}
+	createTimeReturns struct {
+		result1 time.Time
+	}
+	createTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -106,6 +138,17 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	DeleteArchivedEventsStub        func(context.Context) error
+	deleteArchivedEventsMutex       sync.RWMutex
+	deleteArchivedEventsArgsForCall []struct {
+		arg1 context.Context
+	}
+	deleteArchivedEventsReturns struct {
+		result1 error
+	}
+	deleteArchivedEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	EndTimeStub        func() time.Time
 	endTimeMutex       sync.RWMutex
 	endTimeArgsForCall []struct {
@@ -129,6 +172,16 @@ type FakeBuild struct {
 		result1 db.EventSource
 		result2 error
 	}
+	EventsArchivedStub        func() bool
+	eventsArchivedMutex       sync.RWMutex
+	eventsArchivedArgsForCall []struct {
+	}
+	eventsArchivedReturns struct {
+		result1 bool
+	}
+	eventsArchivedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	FinishStub        func(db.BuildStatus) error
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -341,6 +394,16 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	PriorityStub        func() int
+	priorityMutex       sync.RWMutex
+	priorityArgsForCall []struct {
+	}
+	priorityReturns struct {
+		result1 int
+	}
+	priorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PrivatePlanStub        func() atc.Plan
 	privatePlanMutex       sync.RWMutex
 	privatePlanArgsForCall []struct {
@@ -361,6 +424,20 @@ type FakeBuild struct {
 	publicPlanReturnsOnCall map[int]struct {
 		result1 *json.RawMessage
 	}
+	QueuePositionStub        func() (atc.BuildQueuePosition, bool, error)
+	queuePositionMutex       sync.RWMutex
+	queuePositionArgsForCall []struct {
+	}
+	queuePositionReturns struct {
+		result1 atc.BuildQueuePosition
+		result2 bool
+		result3 error
+	}
+	queuePositionReturnsOnCall map[int]struct {
+		result1 atc.BuildQueuePosition
+		result2 bool
+		result3 error
+	}
 	ReapTimeStub        func() time.Time
 	reapTimeMutex       sync.RWMutex
 	reapTimeArgsForCall []struct {
@@ -476,6 +553,17 @@ type FakeBuild struct {
 	schemaReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SetCommentStub        func(string) error
+	setCommentMutex       sync.RWMutex
+	setCommentArgsForCall []struct {
+		arg1 string
+	}
+	setCommentReturns struct {
+		result1 error
+	}
+	setCommentReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetDrainedStub        func(bool) error
 	setDrainedMutex       sync.RWMutex
 	setDrainedArgsForCall []struct {
@@ -793,6 +881,66 @@ func (fake *FakeBuild) AdoptRerunInputsAndPipesReturnsOnCall(i int, result1 []db
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) ArchiveEvents(arg1 context.Context) error {
+	fake.archiveEventsMutex.Lock()
+	ret, specificReturn := fake.archiveEventsReturnsOnCall[len(fake.archiveEventsArgsForCall)]
+	fake.archiveEventsArgsForCall = append(fake.archiveEventsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("ArchiveEvents", []interface{}{arg1})
+	fake.archiveEventsMutex.Unlock()
+	if fake.ArchiveEventsStub != nil {
+		return fake.ArchiveEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.archiveEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ArchiveEventsCallCount() int {
+	fake.archiveEventsMutex.RLock()
+	defer fake.archiveEventsMutex.RUnlock()
+	return len(fake.archiveEventsArgsForCall)
+}
+
+func (fake *FakeBuild) ArchiveEventsCalls(stub func(context.Context) error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = stub
+}
+
+func (fake *FakeBuild) ArchiveEventsArgsForCall(i int) context.Context {
+	fake.archiveEventsMutex.RLock()
+	defer fake.archiveEventsMutex.RUnlock()
+	argsForCall := fake.archiveEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ArchiveEventsReturns(result1 error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = nil
+	fake.archiveEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) ArchiveEventsReturnsOnCall(i int, result1 error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = nil
+	if fake.archiveEventsReturnsOnCall == nil {
+		fake.archiveEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.archiveEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -911,6 +1059,110 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) Comment() string {
+	fake.commentMutex.Lock()
+	ret, specificReturn := fake.commentReturnsOnCall[len(fake.commentArgsForCall)]
+	fake.commentArgsForCall = append(fake.commentArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Comment", []interface{}{})
+	fake.commentMutex.Unlock()
+	if fake.CommentStub != nil {
+		return fake.CommentStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.commentReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CommentCallCount() int {
+	fake.commentMutex.RLock()
+	defer fake.commentMutex.RUnlock()
+	return len(fake.commentArgsForCall)
+}
+
+func (fake *FakeBuild) CommentCalls(stub func() string) {
+	fake.commentMutex.Lock()
+	defer fake.commentMutex.Unlock()
+	fake.CommentStub = stub
+}
+
+func (fake *FakeBuild) CommentReturns(result1 string) {
+	fake.commentMutex.Lock()
+	defer fake.commentMutex.Unlock()
+	fake.CommentStub = nil
+	fake.commentReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) CommentReturnsOnCall(i int, result1 string) {
+	fake.commentMutex.Lock()
+	defer fake.commentMutex.Unlock()
+	fake.CommentStub = nil
+	if fake.commentReturnsOnCall == nil {
+		fake.commentReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.commentReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) CreateTime() time.Time {
+	fake.createTimeMutex.Lock()
+	ret, specificReturn := fake.createTimeReturnsOnCall[len(fake.createTimeArgsForCall)]
+	fake.createTimeArgsForCall = append(fake.createTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreateTime", []interface{}{})
+	fake.createTimeMutex.Unlock()
+	if fake.CreateTimeStub != nil {
+		return fake.CreateTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CreateTimeCallCount() int {
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
+	return len(fake.createTimeArgsForCall)
+}
+
+func (fake *FakeBuild) CreateTimeCalls(stub func() time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = stub
+}
+
+func (fake *FakeBuild) CreateTimeReturns(result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	fake.createTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeBuild) CreateTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	if fake.createTimeReturnsOnCall == nil {
+		fake.createTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.createTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -966,6 +1218,66 @@ func (fake *FakeBuild) DeleteReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) DeleteArchivedEvents(arg1 context.Context) error {
+	fake.deleteArchivedEventsMutex.Lock()
+	ret, specificReturn := fake.deleteArchivedEventsReturnsOnCall[len(fake.deleteArchivedEventsArgsForCall)]
+	fake.deleteArchivedEventsArgsForCall = append(fake.deleteArchivedEventsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("DeleteArchivedEvents", []interface{}{arg1})
+	fake.deleteArchivedEventsMutex.Unlock()
+	if fake.DeleteArchivedEventsStub != nil {
+		return fake.DeleteArchivedEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteArchivedEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) DeleteArchivedEventsCallCount() int {
+	fake.deleteArchivedEventsMutex.RLock()
+	defer fake.deleteArchivedEventsMutex.RUnlock()
+	return len(fake.deleteArchivedEventsArgsForCall)
+}
+
+func (fake *FakeBuild) DeleteArchivedEventsCalls(stub func(context.Context) error) {
+	fake.deleteArchivedEventsMutex.Lock()
+	defer fake.deleteArchivedEventsMutex.Unlock()
+	fake.DeleteArchivedEventsStub = stub
+}
+
+func (fake *FakeBuild) DeleteArchivedEventsArgsForCall(i int) context.Context {
+	fake.deleteArchivedEventsMutex.RLock()
+	defer fake.deleteArchivedEventsMutex.RUnlock()
+	argsForCall := fake.deleteArchivedEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) DeleteArchivedEventsReturns(result1 error) {
+	fake.deleteArchivedEventsMutex.Lock()
+	defer fake.deleteArchivedEventsMutex.Unlock()
+	fake.DeleteArchivedEventsStub = nil
+	fake.deleteArchivedEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) DeleteArchivedEventsReturnsOnCall(i int, result1 error) {
+	fake.deleteArchivedEventsMutex.Lock()
+	defer fake.deleteArchivedEventsMutex.Unlock()
+	fake.DeleteArchivedEventsStub = nil
+	if fake.deleteArchivedEventsReturnsOnCall == nil {
+		fake.deleteArchivedEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteArchivedEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) EndTime() time.Time {
 	fake.endTimeMutex.Lock()
 	ret, specificReturn := fake.endTimeReturnsOnCall[len(fake.endTimeArgsForCall)]
@@ -1081,6 +1393,58 @@ func (fake *FakeBuild) EventsReturnsOnCall(i int, result1 db.EventSource, result
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) EventsArchived() bool {
+	fake.eventsArchivedMutex.Lock()
+	ret, specificReturn := fake.eventsArchivedReturnsOnCall[len(fake.eventsArchivedArgsForCall)]
+	fake.eventsArchivedArgsForCall = append(fake.eventsArchivedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EventsArchived", []interface{}{})
+	fake.eventsArchivedMutex.Unlock()
+	if fake.EventsArchivedStub != nil {
+		return fake.EventsArchivedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.eventsArchivedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) EventsArchivedCallCount() int {
+	fake.eventsArchivedMutex.RLock()
+	defer fake.eventsArchivedMutex.RUnlock()
+	return len(fake.eventsArchivedArgsForCall)
+}
+
+func (fake *FakeBuild) EventsArchivedCalls(stub func() bool) {
+	fake.eventsArchivedMutex.Lock()
+	defer fake.eventsArchivedMutex.Unlock()
+	fake.EventsArchivedStub = stub
+}
+
+func (fake *FakeBuild) EventsArchivedReturns(result1 bool) {
+	fake.eventsArchivedMutex.Lock()
+	defer fake.eventsArchivedMutex.Unlock()
+	fake.EventsArchivedStub = nil
+	fake.eventsArchivedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) EventsArchivedReturnsOnCall(i int, result1 bool) {
+	fake.eventsArchivedMutex.Lock()
+	defer fake.eventsArchivedMutex.Unlock()
+	fake.EventsArchivedStub = nil
+	if fake.eventsArchivedReturnsOnCall == nil {
+		fake.eventsArchivedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.eventsArchivedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuild) Finish(arg1 db.BuildStatus) error {
 	fake.finishMutex.Lock()
 	ret, specificReturn := fake.finishReturnsOnCall[len(fake.finishArgsForCall)]
@@ -2152,6 +2516,58 @@ func (fake *FakeBuild) PreparationReturnsOnCall(i int, result1 db.BuildPreparati
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Priority() int {
+	fake.priorityMutex.Lock()
+	ret, specificReturn := fake.priorityReturnsOnCall[len(fake.priorityArgsForCall)]
+	fake.priorityArgsForCall = append(fake.priorityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Priority", []interface{}{})
+	fake.priorityMutex.Unlock()
+	if fake.PriorityStub != nil {
+		return fake.PriorityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.priorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PriorityCallCount() int {
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
+	return len(fake.priorityArgsForCall)
+}
+
+func (fake *FakeBuild) PriorityCalls(stub func() int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = stub
+}
+
+func (fake *FakeBuild) PriorityReturns(result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	fake.priorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) PriorityReturnsOnCall(i int, result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	if fake.priorityReturnsOnCall == nil {
+		fake.priorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.priorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) PrivatePlan() atc.Plan {
 	fake.privatePlanMutex.Lock()
 	ret, specificReturn := fake.privatePlanReturnsOnCall[len(fake.privatePlanArgsForCall)]
@@ -2256,6 +2672,64 @@ func (fake *FakeBuild) PublicPlanReturnsOnCall(i int, result1 *json.RawMessage)
 	}{result1}
 }
 
+func (fake *FakeBuild) QueuePosition() (atc.BuildQueuePosition, bool, error) {
+	fake.queuePositionMutex.Lock()
+	ret, specificReturn := fake.queuePositionReturnsOnCall[len(fake.queuePositionArgsForCall)]
+	fake.queuePositionArgsForCall = append(fake.queuePositionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("QueuePosition", []interface{}{})
+	fake.queuePositionMutex.Unlock()
+	if fake.QueuePositionStub != nil {
+		return fake.QueuePositionStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.queuePositionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) QueuePositionCallCount() int {
+	fake.queuePositionMutex.RLock()
+	defer fake.queuePositionMutex.RUnlock()
+	return len(fake.queuePositionArgsForCall)
+}
+
+func (fake *FakeBuild) QueuePositionCalls(stub func() (atc.BuildQueuePosition, bool, error)) {
+	fake.queuePositionMutex.Lock()
+	defer fake.queuePositionMutex.Unlock()
+	fake.QueuePositionStub = stub
+}
+
+func (fake *FakeBuild) QueuePositionReturns(result1 atc.BuildQueuePosition, result2 bool, result3 error) {
+	fake.queuePositionMutex.Lock()
+	defer fake.queuePositionMutex.Unlock()
+	fake.QueuePositionStub = nil
+	fake.queuePositionReturns = struct {
+		result1 atc.BuildQueuePosition
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) QueuePositionReturnsOnCall(i int, result1 atc.BuildQueuePosition, result2 bool, result3 error) {
+	fake.queuePositionMutex.Lock()
+	defer fake.queuePositionMutex.Unlock()
+	fake.QueuePositionStub = nil
+	if fake.queuePositionReturnsOnCall == nil {
+		fake.queuePositionReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildQueuePosition
+			result2 bool
+			result3 error
+		})
+	}
+	fake.queuePositionReturnsOnCall[i] = struct {
+		result1 atc.BuildQueuePosition
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) ReapTime() time.Time {
 	fake.reapTimeMutex.Lock()
 	ret, specificReturn := fake.reapTimeReturnsOnCall[len(fake.reapTimeArgsForCall)]
@@ -2815,6 +3289,66 @@ func (fake *FakeBuild) SchemaReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetComment(arg1 string) error {
+	fake.setCommentMutex.Lock()
+	ret, specificReturn := fake.setCommentReturnsOnCall[len(fake.setCommentArgsForCall)]
+	fake.setCommentArgsForCall = append(fake.setCommentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetComment", []interface{}{arg1})
+	fake.setCommentMutex.Unlock()
+	if fake.SetCommentStub != nil {
+		return fake.SetCommentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setCommentReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetCommentCallCount() int {
+	fake.setCommentMutex.RLock()
+	defer fake.setCommentMutex.RUnlock()
+	return len(fake.setCommentArgsForCall)
+}
+
+func (fake *FakeBuild) SetCommentCalls(stub func(string) error) {
+	fake.setCommentMutex.Lock()
+	defer fake.setCommentMutex.Unlock()
+	fake.SetCommentStub = stub
+}
+
+func (fake *FakeBuild) SetCommentArgsForCall(i int) string {
+	fake.setCommentMutex.RLock()
+	defer fake.setCommentMutex.RUnlock()
+	argsForCall := fake.setCommentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetCommentReturns(result1 error) {
+	fake.setCommentMutex.Lock()
+	defer fake.setCommentMutex.Unlock()
+	fake.SetCommentStub = nil
+	fake.setCommentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetCommentReturnsOnCall(i int, result1 error) {
+	fake.setCommentMutex.Lock()
+	defer fake.setCommentMutex.Unlock()
+	fake.SetCommentStub = nil
+	if fake.setCommentReturnsOnCall == nil {
+		fake.setCommentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCommentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) SetDrained(arg1 bool) error {
 	fake.setDrainedMutex.Lock()
 	ret, specificReturn := fake.setDrainedReturnsOnCall[len(fake.setDrainedArgsForCall)]
@@ -3217,16 +3751,26 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.adoptInputsAndPipesMutex.RUnlock()
 	fake.adoptRerunInputsAndPipesMutex.RLock()
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
+	fake.archiveEventsMutex.RLock()
+	defer fake.archiveEventsMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.commentMutex.RLock()
+	defer fake.commentMutex.RUnlock()
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.deleteArchivedEventsMutex.RLock()
+	defer fake.deleteArchivedEventsMutex.RUnlock()
 	fake.endTimeMutex.RLock()
 	defer fake.endTimeMutex.RUnlock()
 	fake.eventsMutex.RLock()
 	defer fake.eventsMutex.RUnlock()
+	fake.eventsArchivedMutex.RLock()
+	defer fake.eventsArchivedMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	fake.hasPlanMutex.RLock()
@@ -3267,10 +3811,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
 	fake.privatePlanMutex.RLock()
 	defer fake.privatePlanMutex.RUnlock()
 	fake.publicPlanMutex.RLock()
 	defer fake.publicPlanMutex.RUnlock()
+	fake.queuePositionMutex.RLock()
+	defer fake.queuePositionMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
@@ -3291,6 +3839,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveOutputMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
+	fake.setCommentMutex.RLock()
+	defer fake.setCommentMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	"sync"
+	"time"
 
 	"github.com/concourse/concourse/atc/db"
 )
@@ -50,6 +51,20 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetArchivableBuildsStub        func(time.Time, int) ([]db.Build, error)
+	getArchivableBuildsMutex       sync.RWMutex
+	getArchivableBuildsArgsForCall []struct {
+		arg1 time.Time
+		arg2 int
+	}
+	getArchivableBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getArchivableBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	GetDrainableBuildsStub        func() ([]db.Build, error)
 	getDrainableBuildsMutex       sync.RWMutex
 	getDrainableBuildsArgsForCall []struct {
@@ -62,6 +77,19 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetReapedArchivedBuildsStub        func(int) ([]db.Build, error)
+	getReapedArchivedBuildsMutex       sync.RWMutex
+	getReapedArchivedBuildsArgsForCall []struct {
+		arg1 int
+	}
+	getReapedArchivedBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getReapedArchivedBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	MarkNonInterceptibleBuildsStub        func() error
 	markNonInterceptibleBuildsMutex       sync.RWMutex
 	markNonInterceptibleBuildsArgsForCall []struct {
@@ -294,6 +322,70 @@ func (fake *FakeBuildFactory) GetAllStartedBuildsReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetArchivableBuilds(arg1 time.Time, arg2 int) ([]db.Build, error) {
+	fake.getArchivableBuildsMutex.Lock()
+	ret, specificReturn := fake.getArchivableBuildsReturnsOnCall[len(fake.getArchivableBuildsArgsForCall)]
+	fake.getArchivableBuildsArgsForCall = append(fake.getArchivableBuildsArgsForCall, struct {
+		arg1 time.Time
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetArchivableBuilds", []interface{}{arg1, arg2})
+	fake.getArchivableBuildsMutex.Unlock()
+	if fake.GetArchivableBuildsStub != nil {
+		return fake.GetArchivableBuildsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getArchivableBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsCallCount() int {
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
+	return len(fake.getArchivableBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsCalls(stub func(time.Time, int) ([]db.Build, error)) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsArgsForCall(i int) (time.Time, int) {
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
+	argsForCall := fake.getArchivableBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsReturns(result1 []db.Build, result2 error) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = nil
+	fake.getArchivableBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = nil
+	if fake.getArchivableBuildsReturnsOnCall == nil {
+		fake.getArchivableBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getArchivableBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) GetDrainableBuilds() ([]db.Build, error) {
 	fake.getDrainableBuildsMutex.Lock()
 	ret, specificReturn := fake.getDrainableBuildsReturnsOnCall[len(fake.getDrainableBuildsArgsForCall)]
@@ -349,6 +441,69 @@ func (fake *FakeBuildFactory) GetDrainableBuildsReturnsOnCall(i int, result1 []d
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetReapedArchivedBuilds(arg1 int) ([]db.Build, error) {
+	fake.getReapedArchivedBuildsMutex.Lock()
+	ret, specificReturn := fake.getReapedArchivedBuildsReturnsOnCall[len(fake.getReapedArchivedBuildsArgsForCall)]
+	fake.getReapedArchivedBuildsArgsForCall = append(fake.getReapedArchivedBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetReapedArchivedBuilds", []interface{}{arg1})
+	fake.getReapedArchivedBuildsMutex.Unlock()
+	if fake.GetReapedArchivedBuildsStub != nil {
+		return fake.GetReapedArchivedBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getReapedArchivedBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetReapedArchivedBuildsCallCount() int {
+	fake.getReapedArchivedBuildsMutex.RLock()
+	defer fake.getReapedArchivedBuildsMutex.RUnlock()
+	return len(fake.getReapedArchivedBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetReapedArchivedBuildsCalls(stub func(int) ([]db.Build, error)) {
+	fake.getReapedArchivedBuildsMutex.Lock()
+	defer fake.getReapedArchivedBuildsMutex.Unlock()
+	fake.GetReapedArchivedBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) GetReapedArchivedBuildsArgsForCall(i int) int {
+	fake.getReapedArchivedBuildsMutex.RLock()
+	defer fake.getReapedArchivedBuildsMutex.RUnlock()
+	argsForCall := fake.getReapedArchivedBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) GetReapedArchivedBuildsReturns(result1 []db.Build, result2 error) {
+	fake.getReapedArchivedBuildsMutex.Lock()
+	defer fake.getReapedArchivedBuildsMutex.Unlock()
+	fake.GetReapedArchivedBuildsStub = nil
+	fake.getReapedArchivedBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetReapedArchivedBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getReapedArchivedBuildsMutex.Lock()
+	defer fake.getReapedArchivedBuildsMutex.Unlock()
+	fake.GetReapedArchivedBuildsStub = nil
+	if fake.getReapedArchivedBuildsReturnsOnCall == nil {
+		fake.getReapedArchivedBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getReapedArchivedBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) MarkNonInterceptibleBuilds() error {
 	fake.markNonInterceptibleBuildsMutex.Lock()
 	ret, specificReturn := fake.markNonInterceptibleBuildsReturnsOnCall[len(fake.markNonInterceptibleBuildsArgsForCall)]
@@ -548,8 +703,12 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
 	defer fake.getAllStartedBuildsMutex.RUnlock()
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
+	fake.getReapedArchivedBuildsMutex.RLock()
+	defer fake.getReapedArchivedBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
 	defer fake.markNonInterceptibleBuildsMutex.RUnlock()
 	fake.publicBuildsMutex.RLock()
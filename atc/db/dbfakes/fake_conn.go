@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/encryption"
 )
@@ -79,6 +80,16 @@ type FakeConn struct {
 	encryptionStrategyReturnsOnCall map[int]struct {
 		result1 encryption.Strategy
 	}
+	EventArchiveStub        func() blobstore.Store
+	eventArchiveMutex       sync.RWMutex
+	eventArchiveArgsForCall []struct {
+	}
+	eventArchiveReturns struct {
+		result1 blobstore.Store
+	}
+	eventArchiveReturnsOnCall map[int]struct {
+		result1 blobstore.Store
+	}
 	ExecStub        func(string, ...interface{}) (sql.Result, error)
 	execMutex       sync.RWMutex
 	execArgsForCall []struct {
@@ -560,6 +571,58 @@ func (fake *FakeConn) EncryptionStrategyReturnsOnCall(i int, result1 encryption.
 	}{result1}
 }
 
+func (fake *FakeConn) EventArchive() blobstore.Store {
+	fake.eventArchiveMutex.Lock()
+	ret, specificReturn := fake.eventArchiveReturnsOnCall[len(fake.eventArchiveArgsForCall)]
+	fake.eventArchiveArgsForCall = append(fake.eventArchiveArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EventArchive", []interface{}{})
+	fake.eventArchiveMutex.Unlock()
+	if fake.EventArchiveStub != nil {
+		return fake.EventArchiveStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.eventArchiveReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConn) EventArchiveCallCount() int {
+	fake.eventArchiveMutex.RLock()
+	defer fake.eventArchiveMutex.RUnlock()
+	return len(fake.eventArchiveArgsForCall)
+}
+
+func (fake *FakeConn) EventArchiveCalls(stub func() blobstore.Store) {
+	fake.eventArchiveMutex.Lock()
+	defer fake.eventArchiveMutex.Unlock()
+	fake.EventArchiveStub = stub
+}
+
+func (fake *FakeConn) EventArchiveReturns(result1 blobstore.Store) {
+	fake.eventArchiveMutex.Lock()
+	defer fake.eventArchiveMutex.Unlock()
+	fake.EventArchiveStub = nil
+	fake.eventArchiveReturns = struct {
+		result1 blobstore.Store
+	}{result1}
+}
+
+func (fake *FakeConn) EventArchiveReturnsOnCall(i int, result1 blobstore.Store) {
+	fake.eventArchiveMutex.Lock()
+	defer fake.eventArchiveMutex.Unlock()
+	fake.EventArchiveStub = nil
+	if fake.eventArchiveReturnsOnCall == nil {
+		fake.eventArchiveReturnsOnCall = make(map[int]struct {
+			result1 blobstore.Store
+		})
+	}
+	fake.eventArchiveReturnsOnCall[i] = struct {
+		result1 blobstore.Store
+	}{result1}
+}
+
 func (fake *FakeConn) Exec(arg1 string, arg2 ...interface{}) (sql.Result, error) {
 	fake.execMutex.Lock()
 	ret, specificReturn := fake.execReturnsOnCall[len(fake.execArgsForCall)]
@@ -1301,6 +1364,8 @@ func (fake *FakeConn) Invocations() map[string][][]interface{} {
 	defer fake.driverMutex.RUnlock()
 	fake.encryptionStrategyMutex.RLock()
 	defer fake.encryptionStrategyMutex.RUnlock()
+	fake.eventArchiveMutex.RLock()
+	defer fake.eventArchiveMutex.RUnlock()
 	fake.execMutex.RLock()
 	defer fake.execMutex.RUnlock()
 	fake.execContextMutex.RLock()
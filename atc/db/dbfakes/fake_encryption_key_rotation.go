@@ -0,0 +1,185 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeEncryptionKeyRotation struct {
+	RotateBatchStub        func(int) (bool, error)
+	rotateBatchMutex       sync.RWMutex
+	rotateBatchArgsForCall []struct {
+		arg1 int
+	}
+	rotateBatchReturns struct {
+		result1 bool
+		result2 error
+	}
+	rotateBatchReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	StatusStub        func() (atc.EncryptionKeyRotation, error)
+	statusMutex       sync.RWMutex
+	statusArgsForCall []struct {
+	}
+	statusReturns struct {
+		result1 atc.EncryptionKeyRotation
+		result2 error
+	}
+	statusReturnsOnCall map[int]struct {
+		result1 atc.EncryptionKeyRotation
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEncryptionKeyRotation) RotateBatch(arg1 int) (bool, error) {
+	fake.rotateBatchMutex.Lock()
+	ret, specificReturn := fake.rotateBatchReturnsOnCall[len(fake.rotateBatchArgsForCall)]
+	fake.rotateBatchArgsForCall = append(fake.rotateBatchArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("RotateBatch", []interface{}{arg1})
+	fake.rotateBatchMutex.Unlock()
+	if fake.RotateBatchStub != nil {
+		return fake.RotateBatchStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rotateBatchReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEncryptionKeyRotation) RotateBatchCallCount() int {
+	fake.rotateBatchMutex.RLock()
+	defer fake.rotateBatchMutex.RUnlock()
+	return len(fake.rotateBatchArgsForCall)
+}
+
+func (fake *FakeEncryptionKeyRotation) RotateBatchCalls(stub func(int) (bool, error)) {
+	fake.rotateBatchMutex.Lock()
+	defer fake.rotateBatchMutex.Unlock()
+	fake.RotateBatchStub = stub
+}
+
+func (fake *FakeEncryptionKeyRotation) RotateBatchArgsForCall(i int) int {
+	fake.rotateBatchMutex.RLock()
+	defer fake.rotateBatchMutex.RUnlock()
+	argsForCall := fake.rotateBatchArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEncryptionKeyRotation) RotateBatchReturns(result1 bool, result2 error) {
+	fake.rotateBatchMutex.Lock()
+	defer fake.rotateBatchMutex.Unlock()
+	fake.RotateBatchStub = nil
+	fake.rotateBatchReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionKeyRotation) RotateBatchReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.rotateBatchMutex.Lock()
+	defer fake.rotateBatchMutex.Unlock()
+	fake.RotateBatchStub = nil
+	if fake.rotateBatchReturnsOnCall == nil {
+		fake.rotateBatchReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.rotateBatchReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionKeyRotation) Status() (atc.EncryptionKeyRotation, error) {
+	fake.statusMutex.Lock()
+	ret, specificReturn := fake.statusReturnsOnCall[len(fake.statusArgsForCall)]
+	fake.statusArgsForCall = append(fake.statusArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Status", []interface{}{})
+	fake.statusMutex.Unlock()
+	if fake.StatusStub != nil {
+		return fake.StatusStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.statusReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEncryptionKeyRotation) StatusCallCount() int {
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	return len(fake.statusArgsForCall)
+}
+
+func (fake *FakeEncryptionKeyRotation) StatusCalls(stub func() (atc.EncryptionKeyRotation, error)) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = stub
+}
+
+func (fake *FakeEncryptionKeyRotation) StatusReturns(result1 atc.EncryptionKeyRotation, result2 error) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = nil
+	fake.statusReturns = struct {
+		result1 atc.EncryptionKeyRotation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionKeyRotation) StatusReturnsOnCall(i int, result1 atc.EncryptionKeyRotation, result2 error) {
+	fake.statusMutex.Lock()
+	defer fake.statusMutex.Unlock()
+	fake.StatusStub = nil
+	if fake.statusReturnsOnCall == nil {
+		fake.statusReturnsOnCall = make(map[int]struct {
+			result1 atc.EncryptionKeyRotation
+			result2 error
+		})
+	}
+	fake.statusReturnsOnCall[i] = struct {
+		result1 atc.EncryptionKeyRotation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEncryptionKeyRotation) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.rotateBatchMutex.RLock()
+	defer fake.rotateBatchMutex.RUnlock()
+	fake.statusMutex.RLock()
+	defer fake.statusMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEncryptionKeyRotation) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.EncryptionKeyRotation = new(FakeEncryptionKeyRotation)
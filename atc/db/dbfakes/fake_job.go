@@ -310,6 +310,16 @@ type FakeJob struct {
 	pipelineNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PriorityStub        func() int
+	priorityMutex       sync.RWMutex
+	priorityArgsForCall []struct {
+	}
+	priorityReturns struct {
+		result1 int
+	}
+	priorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PublicStub        func() bool
 	publicMutex       sync.RWMutex
 	publicArgsForCall []struct {
@@ -320,6 +330,18 @@ type FakeJob struct {
 	publicReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	QueuedBuildsStub        func() ([]atc.BuildQueuePosition, error)
+	queuedBuildsMutex       sync.RWMutex
+	queuedBuildsArgsForCall []struct {
+	}
+	queuedBuildsReturns struct {
+		result1 []atc.BuildQueuePosition
+		result2 error
+	}
+	queuedBuildsReturnsOnCall map[int]struct {
+		result1 []atc.BuildQueuePosition
+		result2 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -1871,6 +1893,58 @@ func (fake *FakeJob) PipelineNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) Priority() int {
+	fake.priorityMutex.Lock()
+	ret, specificReturn := fake.priorityReturnsOnCall[len(fake.priorityArgsForCall)]
+	fake.priorityArgsForCall = append(fake.priorityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Priority", []interface{}{})
+	fake.priorityMutex.Unlock()
+	if fake.PriorityStub != nil {
+		return fake.PriorityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.priorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PriorityCallCount() int {
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
+	return len(fake.priorityArgsForCall)
+}
+
+func (fake *FakeJob) PriorityCalls(stub func() int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = stub
+}
+
+func (fake *FakeJob) PriorityReturns(result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	fake.priorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeJob) PriorityReturnsOnCall(i int, result1 int) {
+	fake.priorityMutex.Lock()
+	defer fake.priorityMutex.Unlock()
+	fake.PriorityStub = nil
+	if fake.priorityReturnsOnCall == nil {
+		fake.priorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.priorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeJob) Public() bool {
 	fake.publicMutex.Lock()
 	ret, specificReturn := fake.publicReturnsOnCall[len(fake.publicArgsForCall)]
@@ -1923,6 +1997,61 @@ func (fake *FakeJob) PublicReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeJob) QueuedBuilds() ([]atc.BuildQueuePosition, error) {
+	fake.queuedBuildsMutex.Lock()
+	ret, specificReturn := fake.queuedBuildsReturnsOnCall[len(fake.queuedBuildsArgsForCall)]
+	fake.queuedBuildsArgsForCall = append(fake.queuedBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("QueuedBuilds", []interface{}{})
+	fake.queuedBuildsMutex.Unlock()
+	if fake.QueuedBuildsStub != nil {
+		return fake.QueuedBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.queuedBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) QueuedBuildsCallCount() int {
+	fake.queuedBuildsMutex.RLock()
+	defer fake.queuedBuildsMutex.RUnlock()
+	return len(fake.queuedBuildsArgsForCall)
+}
+
+func (fake *FakeJob) QueuedBuildsCalls(stub func() ([]atc.BuildQueuePosition, error)) {
+	fake.queuedBuildsMutex.Lock()
+	defer fake.queuedBuildsMutex.Unlock()
+	fake.QueuedBuildsStub = stub
+}
+
+func (fake *FakeJob) QueuedBuildsReturns(result1 []atc.BuildQueuePosition, result2 error) {
+	fake.queuedBuildsMutex.Lock()
+	defer fake.queuedBuildsMutex.Unlock()
+	fake.QueuedBuildsStub = nil
+	fake.queuedBuildsReturns = struct {
+		result1 []atc.BuildQueuePosition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) QueuedBuildsReturnsOnCall(i int, result1 []atc.BuildQueuePosition, result2 error) {
+	fake.queuedBuildsMutex.Lock()
+	defer fake.queuedBuildsMutex.Unlock()
+	fake.QueuedBuildsStub = nil
+	if fake.queuedBuildsReturnsOnCall == nil {
+		fake.queuedBuildsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildQueuePosition
+			result2 error
+		})
+	}
+	fake.queuedBuildsReturnsOnCall[i] = struct {
+		result1 []atc.BuildQueuePosition
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -2710,8 +2839,12 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.pipelineIDMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
+	fake.priorityMutex.RLock()
+	defer fake.priorityMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
+	fake.queuedBuildsMutex.RLock()
+	defer fake.queuedBuildsMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.requestScheduleMutex.RLock()
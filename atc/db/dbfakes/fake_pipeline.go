@@ -12,6 +12,26 @@ import (
 )
 
 type FakePipeline struct {
+	ArchiveStub        func() error
+	archiveMutex       sync.RWMutex
+	archiveArgsForCall []struct {
+	}
+	archiveReturns struct {
+		result1 error
+	}
+	archiveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ArchivedStub        func() bool
+	archivedMutex       sync.RWMutex
+	archivedArgsForCall []struct {
+	}
+	archivedReturns struct {
+		result1 bool
+	}
+	archivedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	BuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -215,6 +235,16 @@ type FakePipeline struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InstanceVarsStub        func() atc.InstanceVars
+	instanceVarsMutex       sync.RWMutex
+	instanceVarsArgsForCall []struct {
+	}
+	instanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	instanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	JobStub        func(string) (db.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -264,6 +294,26 @@ type FakePipeline struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ParentBuildIDStub        func() int
+	parentBuildIDMutex       sync.RWMutex
+	parentBuildIDArgsForCall []struct {
+	}
+	parentBuildIDReturns struct {
+		result1 int
+	}
+	parentBuildIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	ParentJobIDStub        func() int
+	parentJobIDMutex       sync.RWMutex
+	parentJobIDArgsForCall []struct {
+	}
+	parentJobIDReturns struct {
+		result1 int
+	}
+	parentJobIDReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PauseStub        func() error
 	pauseMutex       sync.RWMutex
 	pauseArgsForCall []struct {
@@ -284,6 +334,16 @@ type FakePipeline struct {
 	pausedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PipelineRefStub        func() atc.PipelineRef
+	pipelineRefMutex       sync.RWMutex
+	pipelineRefArgsForCall []struct {
+	}
+	pipelineRefReturns struct {
+		result1 atc.PipelineRef
+	}
+	pipelineRefReturnsOnCall map[int]struct {
+		result1 atc.PipelineRef
+	}
 	PublicStub        func() bool
 	publicMutex       sync.RWMutex
 	publicArgsForCall []struct {
@@ -416,6 +476,18 @@ type FakePipeline struct {
 		result1 db.Resources
 		result2 error
 	}
+	SetParentIDsStub        func(int, int) error
+	setParentIDsMutex       sync.RWMutex
+	setParentIDsArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	setParentIDsReturns struct {
+		result1 error
+	}
+	setParentIDsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TeamIDStub        func() int
 	teamIDMutex       sync.RWMutex
 	teamIDArgsForCall []struct {
@@ -475,6 +547,110 @@ type FakePipeline struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePipeline) Archive() error {
+	fake.archiveMutex.Lock()
+	ret, specificReturn := fake.archiveReturnsOnCall[len(fake.archiveArgsForCall)]
+	fake.archiveArgsForCall = append(fake.archiveArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Archive", []interface{}{})
+	fake.archiveMutex.Unlock()
+	if fake.ArchiveStub != nil {
+		return fake.ArchiveStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.archiveReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ArchiveCallCount() int {
+	fake.archiveMutex.RLock()
+	defer fake.archiveMutex.RUnlock()
+	return len(fake.archiveArgsForCall)
+}
+
+func (fake *FakePipeline) ArchiveCalls(stub func() error) {
+	fake.archiveMutex.Lock()
+	defer fake.archiveMutex.Unlock()
+	fake.ArchiveStub = stub
+}
+
+func (fake *FakePipeline) ArchiveReturns(result1 error) {
+	fake.archiveMutex.Lock()
+	defer fake.archiveMutex.Unlock()
+	fake.ArchiveStub = nil
+	fake.archiveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) ArchiveReturnsOnCall(i int, result1 error) {
+	fake.archiveMutex.Lock()
+	defer fake.archiveMutex.Unlock()
+	fake.ArchiveStub = nil
+	if fake.archiveReturnsOnCall == nil {
+		fake.archiveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.archiveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) Archived() bool {
+	fake.archivedMutex.Lock()
+	ret, specificReturn := fake.archivedReturnsOnCall[len(fake.archivedArgsForCall)]
+	fake.archivedArgsForCall = append(fake.archivedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Archived", []interface{}{})
+	fake.archivedMutex.Unlock()
+	if fake.ArchivedStub != nil {
+		return fake.ArchivedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.archivedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ArchivedCallCount() int {
+	fake.archivedMutex.RLock()
+	defer fake.archivedMutex.RUnlock()
+	return len(fake.archivedArgsForCall)
+}
+
+func (fake *FakePipeline) ArchivedCalls(stub func() bool) {
+	fake.archivedMutex.Lock()
+	defer fake.archivedMutex.Unlock()
+	fake.ArchivedStub = stub
+}
+
+func (fake *FakePipeline) ArchivedReturns(result1 bool) {
+	fake.archivedMutex.Lock()
+	defer fake.archivedMutex.Unlock()
+	fake.ArchivedStub = nil
+	fake.archivedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakePipeline) ArchivedReturnsOnCall(i int, result1 bool) {
+	fake.archivedMutex.Lock()
+	defer fake.archivedMutex.Unlock()
+	fake.ArchivedStub = nil
+	if fake.archivedReturnsOnCall == nil {
+		fake.archivedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.archivedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakePipeline) Builds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -1458,6 +1634,58 @@ func (fake *FakePipeline) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakePipeline) InstanceVars() atc.InstanceVars {
+	fake.instanceVarsMutex.Lock()
+	ret, specificReturn := fake.instanceVarsReturnsOnCall[len(fake.instanceVarsArgsForCall)]
+	fake.instanceVarsArgsForCall = append(fake.instanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InstanceVars", []interface{}{})
+	fake.instanceVarsMutex.Unlock()
+	if fake.InstanceVarsStub != nil {
+		return fake.InstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.instanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) InstanceVarsCallCount() int {
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
+	return len(fake.instanceVarsArgsForCall)
+}
+
+func (fake *FakePipeline) InstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = stub
+}
+
+func (fake *FakePipeline) InstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	fake.instanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakePipeline) InstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	if fake.instanceVarsReturnsOnCall == nil {
+		fake.instanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.instanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakePipeline) Job(arg1 string) (db.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -1686,6 +1914,110 @@ func (fake *FakePipeline) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakePipeline) ParentBuildID() int {
+	fake.parentBuildIDMutex.Lock()
+	ret, specificReturn := fake.parentBuildIDReturnsOnCall[len(fake.parentBuildIDArgsForCall)]
+	fake.parentBuildIDArgsForCall = append(fake.parentBuildIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ParentBuildID", []interface{}{})
+	fake.parentBuildIDMutex.Unlock()
+	if fake.ParentBuildIDStub != nil {
+		return fake.ParentBuildIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.parentBuildIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ParentBuildIDCallCount() int {
+	fake.parentBuildIDMutex.RLock()
+	defer fake.parentBuildIDMutex.RUnlock()
+	return len(fake.parentBuildIDArgsForCall)
+}
+
+func (fake *FakePipeline) ParentBuildIDCalls(stub func() int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = stub
+}
+
+func (fake *FakePipeline) ParentBuildIDReturns(result1 int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = nil
+	fake.parentBuildIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) ParentBuildIDReturnsOnCall(i int, result1 int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = nil
+	if fake.parentBuildIDReturnsOnCall == nil {
+		fake.parentBuildIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.parentBuildIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) ParentJobID() int {
+	fake.parentJobIDMutex.Lock()
+	ret, specificReturn := fake.parentJobIDReturnsOnCall[len(fake.parentJobIDArgsForCall)]
+	fake.parentJobIDArgsForCall = append(fake.parentJobIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ParentJobID", []interface{}{})
+	fake.parentJobIDMutex.Unlock()
+	if fake.ParentJobIDStub != nil {
+		return fake.ParentJobIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.parentJobIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) ParentJobIDCallCount() int {
+	fake.parentJobIDMutex.RLock()
+	defer fake.parentJobIDMutex.RUnlock()
+	return len(fake.parentJobIDArgsForCall)
+}
+
+func (fake *FakePipeline) ParentJobIDCalls(stub func() int) {
+	fake.parentJobIDMutex.Lock()
+	defer fake.parentJobIDMutex.Unlock()
+	fake.ParentJobIDStub = stub
+}
+
+func (fake *FakePipeline) ParentJobIDReturns(result1 int) {
+	fake.parentJobIDMutex.Lock()
+	defer fake.parentJobIDMutex.Unlock()
+	fake.ParentJobIDStub = nil
+	fake.parentJobIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) ParentJobIDReturnsOnCall(i int, result1 int) {
+	fake.parentJobIDMutex.Lock()
+	defer fake.parentJobIDMutex.Unlock()
+	fake.ParentJobIDStub = nil
+	if fake.parentJobIDReturnsOnCall == nil {
+		fake.parentJobIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.parentJobIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakePipeline) Pause() error {
 	fake.pauseMutex.Lock()
 	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
@@ -1790,6 +2122,58 @@ func (fake *FakePipeline) PausedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakePipeline) PipelineRef() atc.PipelineRef {
+	fake.pipelineRefMutex.Lock()
+	ret, specificReturn := fake.pipelineRefReturnsOnCall[len(fake.pipelineRefArgsForCall)]
+	fake.pipelineRefArgsForCall = append(fake.pipelineRefArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineRef", []interface{}{})
+	fake.pipelineRefMutex.Unlock()
+	if fake.PipelineRefStub != nil {
+		return fake.PipelineRefStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineRefReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) PipelineRefCallCount() int {
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
+	return len(fake.pipelineRefArgsForCall)
+}
+
+func (fake *FakePipeline) PipelineRefCalls(stub func() atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = stub
+}
+
+func (fake *FakePipeline) PipelineRefReturns(result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	fake.pipelineRefReturns = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
+func (fake *FakePipeline) PipelineRefReturnsOnCall(i int, result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	if fake.pipelineRefReturnsOnCall == nil {
+		fake.pipelineRefReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineRef
+		})
+	}
+	fake.pipelineRefReturnsOnCall[i] = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
 func (fake *FakePipeline) Public() bool {
 	fake.publicMutex.Lock()
 	ret, specificReturn := fake.publicReturnsOnCall[len(fake.publicArgsForCall)]
@@ -2397,6 +2781,67 @@ func (fake *FakePipeline) ResourcesReturnsOnCall(i int, result1 db.Resources, re
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) SetParentIDs(arg1 int, arg2 int) error {
+	fake.setParentIDsMutex.Lock()
+	ret, specificReturn := fake.setParentIDsReturnsOnCall[len(fake.setParentIDsArgsForCall)]
+	fake.setParentIDsArgsForCall = append(fake.setParentIDsArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("SetParentIDs", []interface{}{arg1, arg2})
+	fake.setParentIDsMutex.Unlock()
+	if fake.SetParentIDsStub != nil {
+		return fake.SetParentIDsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setParentIDsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) SetParentIDsCallCount() int {
+	fake.setParentIDsMutex.RLock()
+	defer fake.setParentIDsMutex.RUnlock()
+	return len(fake.setParentIDsArgsForCall)
+}
+
+func (fake *FakePipeline) SetParentIDsCalls(stub func(int, int) error) {
+	fake.setParentIDsMutex.Lock()
+	defer fake.setParentIDsMutex.Unlock()
+	fake.SetParentIDsStub = stub
+}
+
+func (fake *FakePipeline) SetParentIDsArgsForCall(i int) (int, int) {
+	fake.setParentIDsMutex.RLock()
+	defer fake.setParentIDsMutex.RUnlock()
+	argsForCall := fake.setParentIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePipeline) SetParentIDsReturns(result1 error) {
+	fake.setParentIDsMutex.Lock()
+	defer fake.setParentIDsMutex.Unlock()
+	fake.SetParentIDsStub = nil
+	fake.setParentIDsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) SetParentIDsReturnsOnCall(i int, result1 error) {
+	fake.setParentIDsMutex.Lock()
+	defer fake.setParentIDsMutex.Unlock()
+	fake.SetParentIDsStub = nil
+	if fake.setParentIDsReturnsOnCall == nil {
+		fake.setParentIDsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setParentIDsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePipeline) TeamID() int {
 	fake.teamIDMutex.Lock()
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
@@ -2673,6 +3118,10 @@ func (fake *FakePipeline) VariablesReturnsOnCall(i int, result1 vars.Variables,
 func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.archiveMutex.RLock()
+	defer fake.archiveMutex.RUnlock()
+	fake.archivedMutex.RLock()
+	defer fake.archivedMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithTimeMutex.RLock()
@@ -2707,6 +3156,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.hideMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobsMutex.RLock()
@@ -2715,10 +3166,16 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.loadDebugVersionsDBMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.parentBuildIDMutex.RLock()
+	defer fake.parentBuildIDMutex.RUnlock()
+	fake.parentJobIDMutex.RLock()
+	defer fake.parentJobIDMutex.RUnlock()
 	fake.pauseMutex.RLock()
 	defer fake.pauseMutex.RUnlock()
 	fake.pausedMutex.RLock()
 	defer fake.pausedMutex.RUnlock()
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
@@ -2739,6 +3196,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.resourceVersionMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
+	fake.setParentIDsMutex.RLock()
+	defer fake.setParentIDsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
@@ -12,6 +12,18 @@ import (
 )
 
 type FakeTeam struct {
+	APITokensStub        func() ([]db.APIToken, error)
+	aPITokensMutex       sync.RWMutex
+	aPITokensArgsForCall []struct {
+	}
+	aPITokensReturns struct {
+		result1 []db.APIToken
+		result2 error
+	}
+	aPITokensReturnsOnCall map[int]struct {
+		result1 []db.APIToken
+		result2 error
+	}
 	AdminStub        func() bool
 	adminMutex       sync.RWMutex
 	adminArgsForCall []struct {
@@ -74,6 +86,25 @@ type FakeTeam struct {
 		result1 []db.Container
 		result2 error
 	}
+	CreateAPITokenStub        func(string, string, bool, string, time.Time) (db.APIToken, string, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 string
+		arg5 time.Time
+	}
+	createAPITokenReturns struct {
+		result1 db.APIToken
+		result2 string
+		result3 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 db.APIToken
+		result2 string
+		result3 error
+	}
 	CreateOneOffBuildStub        func() (db.Build, error)
 	createOneOffBuildMutex       sync.RWMutex
 	createOneOffBuildArgsForCall []struct {
@@ -99,6 +130,16 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
+	DefaultBuildPriorityStub        func() int
+	defaultBuildPriorityMutex       sync.RWMutex
+	defaultBuildPriorityArgsForCall []struct {
+	}
+	defaultBuildPriorityReturns struct {
+		result1 int
+	}
+	defaultBuildPriorityReturnsOnCall map[int]struct {
+		result1 int
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -109,11 +150,24 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	FindCheckContainersStub        func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
+	DeleteWebhookStub        func(string) (bool, error)
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		arg1 string
+	}
+	deleteWebhookReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteWebhookReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.Secrets
 		arg5 creds.VarSourcePool
@@ -253,6 +307,16 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	MaxRunningBuildsStub        func() int
+	maxRunningBuildsMutex       sync.RWMutex
+	maxRunningBuildsArgsForCall []struct {
+	}
+	maxRunningBuildsReturns struct {
+		result1 int
+	}
+	maxRunningBuildsReturnsOnCall map[int]struct {
+		result1 int
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -274,10 +338,10 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PipelineStub        func(string) (db.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 db.Pipeline
@@ -289,6 +353,16 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	PipelineAuthStub        func() atc.PipelineAuth
+	pipelineAuthMutex       sync.RWMutex
+	pipelineAuthArgsForCall []struct {
+	}
+	pipelineAuthReturns struct {
+		result1 atc.PipelineAuth
+	}
+	pipelineAuthReturnsOnCall map[int]struct {
+		result1 atc.PipelineAuth
+	}
 	PipelinesStub        func() ([]db.Pipeline, error)
 	pipelinesMutex       sync.RWMutex
 	pipelinesArgsForCall []struct {
@@ -339,10 +413,23 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	RevokeAPITokenStub        func(string) (bool, error)
+	revokeAPITokenMutex       sync.RWMutex
+	revokeAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	revokeAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
@@ -371,6 +458,72 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SetWebhookSecretStub        func(string, string) error
+	setWebhookSecretMutex       sync.RWMutex
+	setWebhookSecretArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setWebhookSecretReturns struct {
+		result1 error
+	}
+	setWebhookSecretReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SyslogDrainsStub        func() []atc.SyslogDrainConfig
+	syslogDrainsMutex       sync.RWMutex
+	syslogDrainsArgsForCall []struct {
+	}
+	syslogDrainsReturns struct {
+		result1 []atc.SyslogDrainConfig
+	}
+	syslogDrainsReturnsOnCall map[int]struct {
+		result1 []atc.SyslogDrainConfig
+	}
+	UpdateStub        func(atc.Team) error
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 atc.Team
+	}
+	updateReturns struct {
+		result1 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateDefaultBuildPriorityStub        func(int) error
+	updateDefaultBuildPriorityMutex       sync.RWMutex
+	updateDefaultBuildPriorityArgsForCall []struct {
+		arg1 int
+	}
+	updateDefaultBuildPriorityReturns struct {
+		result1 error
+	}
+	updateDefaultBuildPriorityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateMaxRunningBuildsStub        func(int) error
+	updateMaxRunningBuildsMutex       sync.RWMutex
+	updateMaxRunningBuildsArgsForCall []struct {
+		arg1 int
+	}
+	updateMaxRunningBuildsReturns struct {
+		result1 error
+	}
+	updateMaxRunningBuildsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdatePipelineAuthStub        func(atc.PipelineAuth) error
+	updatePipelineAuthMutex       sync.RWMutex
+	updatePipelineAuthArgsForCall []struct {
+		arg1 atc.PipelineAuth
+	}
+	updatePipelineAuthReturns struct {
+		result1 error
+	}
+	updatePipelineAuthReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -382,6 +535,53 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateSyslogDrainsStub        func([]atc.SyslogDrainConfig) error
+	updateSyslogDrainsMutex       sync.RWMutex
+	updateSyslogDrainsArgsForCall []struct {
+		arg1 []atc.SyslogDrainConfig
+	}
+	updateSyslogDrainsReturns struct {
+		result1 error
+	}
+	updateSyslogDrainsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateVarSourcesStub        func(atc.VarSourceConfigs) error
+	updateVarSourcesMutex       sync.RWMutex
+	updateVarSourcesArgsForCall []struct {
+		arg1 atc.VarSourceConfigs
+	}
+	updateVarSourcesReturns struct {
+		result1 error
+	}
+	updateVarSourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VarSourcesStub        func() atc.VarSourceConfigs
+	varSourcesMutex       sync.RWMutex
+	varSourcesArgsForCall []struct {
+	}
+	varSourcesReturns struct {
+		result1 atc.VarSourceConfigs
+	}
+	varSourcesReturnsOnCall map[int]struct {
+		result1 atc.VarSourceConfigs
+	}
+	WebhookSecretStub        func(string) (string, bool, error)
+	webhookSecretMutex       sync.RWMutex
+	webhookSecretArgsForCall []struct {
+		arg1 string
+	}
+	webhookSecretReturns struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
+	webhookSecretReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -398,6 +598,61 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) APITokens() ([]db.APIToken, error) {
+	fake.aPITokensMutex.Lock()
+	ret, specificReturn := fake.aPITokensReturnsOnCall[len(fake.aPITokensArgsForCall)]
+	fake.aPITokensArgsForCall = append(fake.aPITokensArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APITokens", []interface{}{})
+	fake.aPITokensMutex.Unlock()
+	if fake.APITokensStub != nil {
+		return fake.APITokensStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.aPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) APITokensCallCount() int {
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
+	return len(fake.aPITokensArgsForCall)
+}
+
+func (fake *FakeTeam) APITokensCalls(stub func() ([]db.APIToken, error)) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = stub
+}
+
+func (fake *FakeTeam) APITokensReturns(result1 []db.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	fake.aPITokensReturns = struct {
+		result1 []db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) APITokensReturnsOnCall(i int, result1 []db.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	if fake.aPITokensReturnsOnCall == nil {
+		fake.aPITokensReturnsOnCall = make(map[int]struct {
+			result1 []db.APIToken
+			result2 error
+		})
+	}
+	fake.aPITokensReturnsOnCall[i] = struct {
+		result1 []db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Admin() bool {
 	fake.adminMutex.Lock()
 	ret, specificReturn := fake.adminReturnsOnCall[len(fake.adminArgsForCall)]
@@ -689,6 +944,76 @@ func (fake *FakeTeam) ContainersReturnsOnCall(i int, result1 []db.Container, res
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateAPIToken(arg1 string, arg2 string, arg3 bool, arg4 string, arg5 time.Time) (db.APIToken, string, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+		arg4 string
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateAPITokenCalls(stub func(string, string, bool, string, time.Time) (db.APIToken, string, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeTeam) CreateAPITokenArgsForCall(i int) (string, string, bool, string, time.Time) {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTeam) CreateAPITokenReturns(result1 db.APIToken, result2 string, result3 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 db.APIToken
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) CreateAPITokenReturnsOnCall(i int, result1 db.APIToken, result2 string, result3 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 db.APIToken
+			result2 string
+			result3 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 db.APIToken
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) CreateOneOffBuild() (db.Build, error) {
 	fake.createOneOffBuildMutex.Lock()
 	ret, specificReturn := fake.createOneOffBuildReturnsOnCall[len(fake.createOneOffBuildArgsForCall)]
@@ -807,6 +1132,58 @@ func (fake *FakeTeam) CreateStartedBuildReturnsOnCall(i int, result1 db.Build, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DefaultBuildPriority() int {
+	fake.defaultBuildPriorityMutex.Lock()
+	ret, specificReturn := fake.defaultBuildPriorityReturnsOnCall[len(fake.defaultBuildPriorityArgsForCall)]
+	fake.defaultBuildPriorityArgsForCall = append(fake.defaultBuildPriorityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DefaultBuildPriority", []interface{}{})
+	fake.defaultBuildPriorityMutex.Unlock()
+	if fake.DefaultBuildPriorityStub != nil {
+		return fake.DefaultBuildPriorityStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.defaultBuildPriorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) DefaultBuildPriorityCallCount() int {
+	fake.defaultBuildPriorityMutex.RLock()
+	defer fake.defaultBuildPriorityMutex.RUnlock()
+	return len(fake.defaultBuildPriorityArgsForCall)
+}
+
+func (fake *FakeTeam) DefaultBuildPriorityCalls(stub func() int) {
+	fake.defaultBuildPriorityMutex.Lock()
+	defer fake.defaultBuildPriorityMutex.Unlock()
+	fake.DefaultBuildPriorityStub = stub
+}
+
+func (fake *FakeTeam) DefaultBuildPriorityReturns(result1 int) {
+	fake.defaultBuildPriorityMutex.Lock()
+	defer fake.defaultBuildPriorityMutex.Unlock()
+	fake.DefaultBuildPriorityStub = nil
+	fake.defaultBuildPriorityReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeam) DefaultBuildPriorityReturnsOnCall(i int, result1 int) {
+	fake.defaultBuildPriorityMutex.Lock()
+	defer fake.defaultBuildPriorityMutex.Unlock()
+	fake.DefaultBuildPriorityStub = nil
+	if fake.defaultBuildPriorityReturnsOnCall == nil {
+		fake.defaultBuildPriorityReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.defaultBuildPriorityReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeTeam) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -859,12 +1236,75 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 string, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
+func (fake *FakeTeam) DeleteWebhook(arg1 string) (bool, error) {
+	fake.deleteWebhookMutex.Lock()
+	ret, specificReturn := fake.deleteWebhookReturnsOnCall[len(fake.deleteWebhookArgsForCall)]
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteWebhook", []interface{}{arg1})
+	fake.deleteWebhookMutex.Unlock()
+	if fake.DeleteWebhookStub != nil {
+		return fake.DeleteWebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteWebhookCalls(stub func(string) (bool, error)) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = stub
+}
+
+func (fake *FakeTeam) DeleteWebhookArgsForCall(i int) string {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	argsForCall := fake.deleteWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteWebhookReturns(result1 bool, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteWebhookReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	if fake.deleteWebhookReturnsOnCall == nil {
+		fake.deleteWebhookReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteWebhookReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
 	fake.findCheckContainersArgsForCall = append(fake.findCheckContainersArgsForCall, struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.Secrets
 		arg5 creds.VarSourcePool
@@ -887,13 +1327,13 @@ func (fake *FakeTeam) FindCheckContainersCallCount() int {
 	return len(fake.findCheckContainersArgsForCall)
 }
 
-func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
+func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
 	fake.findCheckContainersMutex.Lock()
 	defer fake.findCheckContainersMutex.Unlock()
 	fake.FindCheckContainersStub = stub
 }
 
-func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) {
+func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) {
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	argsForCall := fake.findCheckContainersArgsForCall[i]
@@ -1501,6 +1941,58 @@ func (fake *FakeTeam) IsContainerWithinTeamReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) MaxRunningBuilds() int {
+	fake.maxRunningBuildsMutex.Lock()
+	ret, specificReturn := fake.maxRunningBuildsReturnsOnCall[len(fake.maxRunningBuildsArgsForCall)]
+	fake.maxRunningBuildsArgsForCall = append(fake.maxRunningBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaxRunningBuilds", []interface{}{})
+	fake.maxRunningBuildsMutex.Unlock()
+	if fake.MaxRunningBuildsStub != nil {
+		return fake.MaxRunningBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maxRunningBuildsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) MaxRunningBuildsCallCount() int {
+	fake.maxRunningBuildsMutex.RLock()
+	defer fake.maxRunningBuildsMutex.RUnlock()
+	return len(fake.maxRunningBuildsArgsForCall)
+}
+
+func (fake *FakeTeam) MaxRunningBuildsCalls(stub func() int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = stub
+}
+
+func (fake *FakeTeam) MaxRunningBuildsReturns(result1 int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = nil
+	fake.maxRunningBuildsReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeam) MaxRunningBuildsReturnsOnCall(i int, result1 int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = nil
+	if fake.maxRunningBuildsReturnsOnCall == nil {
+		fake.maxRunningBuildsReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.maxRunningBuildsReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeTeam) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1618,11 +2110,11 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -1642,13 +2134,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (db.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -1684,6 +2176,58 @@ func (fake *FakeTeam) PipelineReturnsOnCall(i int, result1 db.Pipeline, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) PipelineAuth() atc.PipelineAuth {
+	fake.pipelineAuthMutex.Lock()
+	ret, specificReturn := fake.pipelineAuthReturnsOnCall[len(fake.pipelineAuthArgsForCall)]
+	fake.pipelineAuthArgsForCall = append(fake.pipelineAuthArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineAuth", []interface{}{})
+	fake.pipelineAuthMutex.Unlock()
+	if fake.PipelineAuthStub != nil {
+		return fake.PipelineAuthStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineAuthReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) PipelineAuthCallCount() int {
+	fake.pipelineAuthMutex.RLock()
+	defer fake.pipelineAuthMutex.RUnlock()
+	return len(fake.pipelineAuthArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineAuthCalls(stub func() atc.PipelineAuth) {
+	fake.pipelineAuthMutex.Lock()
+	defer fake.pipelineAuthMutex.Unlock()
+	fake.PipelineAuthStub = stub
+}
+
+func (fake *FakeTeam) PipelineAuthReturns(result1 atc.PipelineAuth) {
+	fake.pipelineAuthMutex.Lock()
+	defer fake.pipelineAuthMutex.Unlock()
+	fake.PipelineAuthStub = nil
+	fake.pipelineAuthReturns = struct {
+		result1 atc.PipelineAuth
+	}{result1}
+}
+
+func (fake *FakeTeam) PipelineAuthReturnsOnCall(i int, result1 atc.PipelineAuth) {
+	fake.pipelineAuthMutex.Lock()
+	defer fake.pipelineAuthMutex.Unlock()
+	fake.PipelineAuthStub = nil
+	if fake.pipelineAuthReturnsOnCall == nil {
+		fake.pipelineAuthReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineAuth
+		})
+	}
+	fake.pipelineAuthReturnsOnCall[i] = struct {
+		result1 atc.PipelineAuth
+	}{result1}
+}
+
 func (fake *FakeTeam) Pipelines() ([]db.Pipeline, error) {
 	fake.pipelinesMutex.Lock()
 	ret, specificReturn := fake.pipelinesReturnsOnCall[len(fake.pipelinesArgsForCall)]
@@ -1920,16 +2464,79 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
-	fake.savePipelineMutex.Lock()
-	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
-	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
+func (fake *FakeTeam) RevokeAPIToken(arg1 string) (bool, error) {
+	fake.revokeAPITokenMutex.Lock()
+	ret, specificReturn := fake.revokeAPITokenReturnsOnCall[len(fake.revokeAPITokenArgsForCall)]
+	fake.revokeAPITokenArgsForCall = append(fake.revokeAPITokenArgsForCall, struct {
 		arg1 string
-		arg2 atc.Config
-		arg3 db.ConfigVersion
-		arg4 bool
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+	}{arg1})
+	fake.recordInvocation("RevokeAPIToken", []interface{}{arg1})
+	fake.revokeAPITokenMutex.Unlock()
+	if fake.RevokeAPITokenStub != nil {
+		return fake.RevokeAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeAPITokenCallCount() int {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	return len(fake.revokeAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeAPITokenCalls(stub func(string) (bool, error)) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeAPITokenArgsForCall(i int) string {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	argsForCall := fake.revokeAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) RevokeAPITokenReturns(result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	fake.revokeAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	if fake.revokeAPITokenReturnsOnCall == nil {
+		fake.revokeAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
+	fake.savePipelineMutex.Lock()
+	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
+	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 atc.Config
+		arg3 db.ConfigVersion
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
 	fake.savePipelineMutex.Unlock()
 	if fake.SavePipelineStub != nil {
 		return fake.SavePipelineStub(arg1, arg2, arg3, arg4)
@@ -1947,13 +2554,13 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
@@ -2053,6 +2660,359 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetWebhookSecret(arg1 string, arg2 string) error {
+	fake.setWebhookSecretMutex.Lock()
+	ret, specificReturn := fake.setWebhookSecretReturnsOnCall[len(fake.setWebhookSecretArgsForCall)]
+	fake.setWebhookSecretArgsForCall = append(fake.setWebhookSecretArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetWebhookSecret", []interface{}{arg1, arg2})
+	fake.setWebhookSecretMutex.Unlock()
+	if fake.SetWebhookSecretStub != nil {
+		return fake.SetWebhookSecretStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setWebhookSecretReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetWebhookSecretCallCount() int {
+	fake.setWebhookSecretMutex.RLock()
+	defer fake.setWebhookSecretMutex.RUnlock()
+	return len(fake.setWebhookSecretArgsForCall)
+}
+
+func (fake *FakeTeam) SetWebhookSecretCalls(stub func(string, string) error) {
+	fake.setWebhookSecretMutex.Lock()
+	defer fake.setWebhookSecretMutex.Unlock()
+	fake.SetWebhookSecretStub = stub
+}
+
+func (fake *FakeTeam) SetWebhookSecretArgsForCall(i int) (string, string) {
+	fake.setWebhookSecretMutex.RLock()
+	defer fake.setWebhookSecretMutex.RUnlock()
+	argsForCall := fake.setWebhookSecretArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) SetWebhookSecretReturns(result1 error) {
+	fake.setWebhookSecretMutex.Lock()
+	defer fake.setWebhookSecretMutex.Unlock()
+	fake.SetWebhookSecretStub = nil
+	fake.setWebhookSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetWebhookSecretReturnsOnCall(i int, result1 error) {
+	fake.setWebhookSecretMutex.Lock()
+	defer fake.setWebhookSecretMutex.Unlock()
+	fake.SetWebhookSecretStub = nil
+	if fake.setWebhookSecretReturnsOnCall == nil {
+		fake.setWebhookSecretReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setWebhookSecretReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SyslogDrains() []atc.SyslogDrainConfig {
+	fake.syslogDrainsMutex.Lock()
+	ret, specificReturn := fake.syslogDrainsReturnsOnCall[len(fake.syslogDrainsArgsForCall)]
+	fake.syslogDrainsArgsForCall = append(fake.syslogDrainsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SyslogDrains", []interface{}{})
+	fake.syslogDrainsMutex.Unlock()
+	if fake.SyslogDrainsStub != nil {
+		return fake.SyslogDrainsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.syslogDrainsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SyslogDrainsCallCount() int {
+	fake.syslogDrainsMutex.RLock()
+	defer fake.syslogDrainsMutex.RUnlock()
+	return len(fake.syslogDrainsArgsForCall)
+}
+
+func (fake *FakeTeam) SyslogDrainsCalls(stub func() []atc.SyslogDrainConfig) {
+	fake.syslogDrainsMutex.Lock()
+	defer fake.syslogDrainsMutex.Unlock()
+	fake.SyslogDrainsStub = stub
+}
+
+func (fake *FakeTeam) SyslogDrainsReturns(result1 []atc.SyslogDrainConfig) {
+	fake.syslogDrainsMutex.Lock()
+	defer fake.syslogDrainsMutex.Unlock()
+	fake.SyslogDrainsStub = nil
+	fake.syslogDrainsReturns = struct {
+		result1 []atc.SyslogDrainConfig
+	}{result1}
+}
+
+func (fake *FakeTeam) SyslogDrainsReturnsOnCall(i int, result1 []atc.SyslogDrainConfig) {
+	fake.syslogDrainsMutex.Lock()
+	defer fake.syslogDrainsMutex.Unlock()
+	fake.SyslogDrainsStub = nil
+	if fake.syslogDrainsReturnsOnCall == nil {
+		fake.syslogDrainsReturnsOnCall = make(map[int]struct {
+			result1 []atc.SyslogDrainConfig
+		})
+	}
+	fake.syslogDrainsReturnsOnCall[i] = struct {
+		result1 []atc.SyslogDrainConfig
+	}{result1}
+}
+
+func (fake *FakeTeam) Update(arg1 atc.Team) error {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 atc.Team
+	}{arg1})
+	fake.recordInvocation("Update", []interface{}{arg1})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateCalls(stub func(atc.Team) error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeTeam) UpdateArgsForCall(i int) atc.Team {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateReturns(result1 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateReturnsOnCall(i int, result1 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateDefaultBuildPriority(arg1 int) error {
+	fake.updateDefaultBuildPriorityMutex.Lock()
+	ret, specificReturn := fake.updateDefaultBuildPriorityReturnsOnCall[len(fake.updateDefaultBuildPriorityArgsForCall)]
+	fake.updateDefaultBuildPriorityArgsForCall = append(fake.updateDefaultBuildPriorityArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("UpdateDefaultBuildPriority", []interface{}{arg1})
+	fake.updateDefaultBuildPriorityMutex.Unlock()
+	if fake.UpdateDefaultBuildPriorityStub != nil {
+		return fake.UpdateDefaultBuildPriorityStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateDefaultBuildPriorityReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateDefaultBuildPriorityCallCount() int {
+	fake.updateDefaultBuildPriorityMutex.RLock()
+	defer fake.updateDefaultBuildPriorityMutex.RUnlock()
+	return len(fake.updateDefaultBuildPriorityArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateDefaultBuildPriorityCalls(stub func(int) error) {
+	fake.updateDefaultBuildPriorityMutex.Lock()
+	defer fake.updateDefaultBuildPriorityMutex.Unlock()
+	fake.UpdateDefaultBuildPriorityStub = stub
+}
+
+func (fake *FakeTeam) UpdateDefaultBuildPriorityArgsForCall(i int) int {
+	fake.updateDefaultBuildPriorityMutex.RLock()
+	defer fake.updateDefaultBuildPriorityMutex.RUnlock()
+	argsForCall := fake.updateDefaultBuildPriorityArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateDefaultBuildPriorityReturns(result1 error) {
+	fake.updateDefaultBuildPriorityMutex.Lock()
+	defer fake.updateDefaultBuildPriorityMutex.Unlock()
+	fake.UpdateDefaultBuildPriorityStub = nil
+	fake.updateDefaultBuildPriorityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateDefaultBuildPriorityReturnsOnCall(i int, result1 error) {
+	fake.updateDefaultBuildPriorityMutex.Lock()
+	defer fake.updateDefaultBuildPriorityMutex.Unlock()
+	fake.UpdateDefaultBuildPriorityStub = nil
+	if fake.updateDefaultBuildPriorityReturnsOnCall == nil {
+		fake.updateDefaultBuildPriorityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateDefaultBuildPriorityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateMaxRunningBuilds(arg1 int) error {
+	fake.updateMaxRunningBuildsMutex.Lock()
+	ret, specificReturn := fake.updateMaxRunningBuildsReturnsOnCall[len(fake.updateMaxRunningBuildsArgsForCall)]
+	fake.updateMaxRunningBuildsArgsForCall = append(fake.updateMaxRunningBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("UpdateMaxRunningBuilds", []interface{}{arg1})
+	fake.updateMaxRunningBuildsMutex.Unlock()
+	if fake.UpdateMaxRunningBuildsStub != nil {
+		return fake.UpdateMaxRunningBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateMaxRunningBuildsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateMaxRunningBuildsCallCount() int {
+	fake.updateMaxRunningBuildsMutex.RLock()
+	defer fake.updateMaxRunningBuildsMutex.RUnlock()
+	return len(fake.updateMaxRunningBuildsArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateMaxRunningBuildsCalls(stub func(int) error) {
+	fake.updateMaxRunningBuildsMutex.Lock()
+	defer fake.updateMaxRunningBuildsMutex.Unlock()
+	fake.UpdateMaxRunningBuildsStub = stub
+}
+
+func (fake *FakeTeam) UpdateMaxRunningBuildsArgsForCall(i int) int {
+	fake.updateMaxRunningBuildsMutex.RLock()
+	defer fake.updateMaxRunningBuildsMutex.RUnlock()
+	argsForCall := fake.updateMaxRunningBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateMaxRunningBuildsReturns(result1 error) {
+	fake.updateMaxRunningBuildsMutex.Lock()
+	defer fake.updateMaxRunningBuildsMutex.Unlock()
+	fake.UpdateMaxRunningBuildsStub = nil
+	fake.updateMaxRunningBuildsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateMaxRunningBuildsReturnsOnCall(i int, result1 error) {
+	fake.updateMaxRunningBuildsMutex.Lock()
+	defer fake.updateMaxRunningBuildsMutex.Unlock()
+	fake.UpdateMaxRunningBuildsStub = nil
+	if fake.updateMaxRunningBuildsReturnsOnCall == nil {
+		fake.updateMaxRunningBuildsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateMaxRunningBuildsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdatePipelineAuth(arg1 atc.PipelineAuth) error {
+	fake.updatePipelineAuthMutex.Lock()
+	ret, specificReturn := fake.updatePipelineAuthReturnsOnCall[len(fake.updatePipelineAuthArgsForCall)]
+	fake.updatePipelineAuthArgsForCall = append(fake.updatePipelineAuthArgsForCall, struct {
+		arg1 atc.PipelineAuth
+	}{arg1})
+	fake.recordInvocation("UpdatePipelineAuth", []interface{}{arg1})
+	fake.updatePipelineAuthMutex.Unlock()
+	if fake.UpdatePipelineAuthStub != nil {
+		return fake.UpdatePipelineAuthStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updatePipelineAuthReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdatePipelineAuthCallCount() int {
+	fake.updatePipelineAuthMutex.RLock()
+	defer fake.updatePipelineAuthMutex.RUnlock()
+	return len(fake.updatePipelineAuthArgsForCall)
+}
+
+func (fake *FakeTeam) UpdatePipelineAuthCalls(stub func(atc.PipelineAuth) error) {
+	fake.updatePipelineAuthMutex.Lock()
+	defer fake.updatePipelineAuthMutex.Unlock()
+	fake.UpdatePipelineAuthStub = stub
+}
+
+func (fake *FakeTeam) UpdatePipelineAuthArgsForCall(i int) atc.PipelineAuth {
+	fake.updatePipelineAuthMutex.RLock()
+	defer fake.updatePipelineAuthMutex.RUnlock()
+	argsForCall := fake.updatePipelineAuthArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdatePipelineAuthReturns(result1 error) {
+	fake.updatePipelineAuthMutex.Lock()
+	defer fake.updatePipelineAuthMutex.Unlock()
+	fake.UpdatePipelineAuthStub = nil
+	fake.updatePipelineAuthReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdatePipelineAuthReturnsOnCall(i int, result1 error) {
+	fake.updatePipelineAuthMutex.Lock()
+	defer fake.updatePipelineAuthMutex.Unlock()
+	fake.UpdatePipelineAuthStub = nil
+	if fake.updatePipelineAuthReturnsOnCall == nil {
+		fake.updatePipelineAuthReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updatePipelineAuthReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2113,6 +3073,249 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) UpdateSyslogDrains(arg1 []atc.SyslogDrainConfig) error {
+	var arg1Copy []atc.SyslogDrainConfig
+	if arg1 != nil {
+		arg1Copy = make([]atc.SyslogDrainConfig, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.updateSyslogDrainsMutex.Lock()
+	ret, specificReturn := fake.updateSyslogDrainsReturnsOnCall[len(fake.updateSyslogDrainsArgsForCall)]
+	fake.updateSyslogDrainsArgsForCall = append(fake.updateSyslogDrainsArgsForCall, struct {
+		arg1 []atc.SyslogDrainConfig
+	}{arg1Copy})
+	fake.recordInvocation("UpdateSyslogDrains", []interface{}{arg1Copy})
+	fake.updateSyslogDrainsMutex.Unlock()
+	if fake.UpdateSyslogDrainsStub != nil {
+		return fake.UpdateSyslogDrainsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateSyslogDrainsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateSyslogDrainsCallCount() int {
+	fake.updateSyslogDrainsMutex.RLock()
+	defer fake.updateSyslogDrainsMutex.RUnlock()
+	return len(fake.updateSyslogDrainsArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateSyslogDrainsCalls(stub func([]atc.SyslogDrainConfig) error) {
+	fake.updateSyslogDrainsMutex.Lock()
+	defer fake.updateSyslogDrainsMutex.Unlock()
+	fake.UpdateSyslogDrainsStub = stub
+}
+
+func (fake *FakeTeam) UpdateSyslogDrainsArgsForCall(i int) []atc.SyslogDrainConfig {
+	fake.updateSyslogDrainsMutex.RLock()
+	defer fake.updateSyslogDrainsMutex.RUnlock()
+	argsForCall := fake.updateSyslogDrainsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateSyslogDrainsReturns(result1 error) {
+	fake.updateSyslogDrainsMutex.Lock()
+	defer fake.updateSyslogDrainsMutex.Unlock()
+	fake.UpdateSyslogDrainsStub = nil
+	fake.updateSyslogDrainsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateSyslogDrainsReturnsOnCall(i int, result1 error) {
+	fake.updateSyslogDrainsMutex.Lock()
+	defer fake.updateSyslogDrainsMutex.Unlock()
+	fake.UpdateSyslogDrainsStub = nil
+	if fake.updateSyslogDrainsReturnsOnCall == nil {
+		fake.updateSyslogDrainsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateSyslogDrainsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateVarSources(arg1 atc.VarSourceConfigs) error {
+	fake.updateVarSourcesMutex.Lock()
+	ret, specificReturn := fake.updateVarSourcesReturnsOnCall[len(fake.updateVarSourcesArgsForCall)]
+	fake.updateVarSourcesArgsForCall = append(fake.updateVarSourcesArgsForCall, struct {
+		arg1 atc.VarSourceConfigs
+	}{arg1})
+	fake.recordInvocation("UpdateVarSources", []interface{}{arg1})
+	fake.updateVarSourcesMutex.Unlock()
+	if fake.UpdateVarSourcesStub != nil {
+		return fake.UpdateVarSourcesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateVarSourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateVarSourcesCallCount() int {
+	fake.updateVarSourcesMutex.RLock()
+	defer fake.updateVarSourcesMutex.RUnlock()
+	return len(fake.updateVarSourcesArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateVarSourcesCalls(stub func(atc.VarSourceConfigs) error) {
+	fake.updateVarSourcesMutex.Lock()
+	defer fake.updateVarSourcesMutex.Unlock()
+	fake.UpdateVarSourcesStub = stub
+}
+
+func (fake *FakeTeam) UpdateVarSourcesArgsForCall(i int) atc.VarSourceConfigs {
+	fake.updateVarSourcesMutex.RLock()
+	defer fake.updateVarSourcesMutex.RUnlock()
+	argsForCall := fake.updateVarSourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateVarSourcesReturns(result1 error) {
+	fake.updateVarSourcesMutex.Lock()
+	defer fake.updateVarSourcesMutex.Unlock()
+	fake.UpdateVarSourcesStub = nil
+	fake.updateVarSourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateVarSourcesReturnsOnCall(i int, result1 error) {
+	fake.updateVarSourcesMutex.Lock()
+	defer fake.updateVarSourcesMutex.Unlock()
+	fake.UpdateVarSourcesStub = nil
+	if fake.updateVarSourcesReturnsOnCall == nil {
+		fake.updateVarSourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateVarSourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) VarSources() atc.VarSourceConfigs {
+	fake.varSourcesMutex.Lock()
+	ret, specificReturn := fake.varSourcesReturnsOnCall[len(fake.varSourcesArgsForCall)]
+	fake.varSourcesArgsForCall = append(fake.varSourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VarSources", []interface{}{})
+	fake.varSourcesMutex.Unlock()
+	if fake.VarSourcesStub != nil {
+		return fake.VarSourcesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.varSourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) VarSourcesCallCount() int {
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
+	return len(fake.varSourcesArgsForCall)
+}
+
+func (fake *FakeTeam) VarSourcesCalls(stub func() atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = stub
+}
+
+func (fake *FakeTeam) VarSourcesReturns(result1 atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	fake.varSourcesReturns = struct {
+		result1 atc.VarSourceConfigs
+	}{result1}
+}
+
+func (fake *FakeTeam) VarSourcesReturnsOnCall(i int, result1 atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	if fake.varSourcesReturnsOnCall == nil {
+		fake.varSourcesReturnsOnCall = make(map[int]struct {
+			result1 atc.VarSourceConfigs
+		})
+	}
+	fake.varSourcesReturnsOnCall[i] = struct {
+		result1 atc.VarSourceConfigs
+	}{result1}
+}
+
+func (fake *FakeTeam) WebhookSecret(arg1 string) (string, bool, error) {
+	fake.webhookSecretMutex.Lock()
+	ret, specificReturn := fake.webhookSecretReturnsOnCall[len(fake.webhookSecretArgsForCall)]
+	fake.webhookSecretArgsForCall = append(fake.webhookSecretArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("WebhookSecret", []interface{}{arg1})
+	fake.webhookSecretMutex.Unlock()
+	if fake.WebhookSecretStub != nil {
+		return fake.WebhookSecretStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.webhookSecretReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) WebhookSecretCallCount() int {
+	fake.webhookSecretMutex.RLock()
+	defer fake.webhookSecretMutex.RUnlock()
+	return len(fake.webhookSecretArgsForCall)
+}
+
+func (fake *FakeTeam) WebhookSecretCalls(stub func(string) (string, bool, error)) {
+	fake.webhookSecretMutex.Lock()
+	defer fake.webhookSecretMutex.Unlock()
+	fake.WebhookSecretStub = stub
+}
+
+func (fake *FakeTeam) WebhookSecretArgsForCall(i int) string {
+	fake.webhookSecretMutex.RLock()
+	defer fake.webhookSecretMutex.RUnlock()
+	argsForCall := fake.webhookSecretArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) WebhookSecretReturns(result1 string, result2 bool, result3 error) {
+	fake.webhookSecretMutex.Lock()
+	defer fake.webhookSecretMutex.Unlock()
+	fake.WebhookSecretStub = nil
+	fake.webhookSecretReturns = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) WebhookSecretReturnsOnCall(i int, result1 string, result2 bool, result3 error) {
+	fake.webhookSecretMutex.Lock()
+	defer fake.webhookSecretMutex.Unlock()
+	fake.WebhookSecretStub = nil
+	if fake.webhookSecretReturnsOnCall == nil {
+		fake.webhookSecretReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+			result3 error
+		})
+	}
+	fake.webhookSecretReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2171,6 +3374,8 @@ func (fake *FakeTeam) WorkersReturnsOnCall(i int, result1 []db.Worker, result2 e
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
 	fake.adminMutex.RLock()
 	defer fake.adminMutex.RUnlock()
 	fake.authMutex.RLock()
@@ -2181,12 +3386,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.buildsWithTimeMutex.RUnlock()
 	fake.containersMutex.RLock()
 	defer fake.containersMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
+	fake.defaultBuildPriorityMutex.RLock()
+	defer fake.defaultBuildPriorityMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2207,12 +3418,16 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.isCheckContainerMutex.RUnlock()
 	fake.isContainerWithinTeamMutex.RLock()
 	defer fake.isContainerWithinTeamMutex.RUnlock()
+	fake.maxRunningBuildsMutex.RLock()
+	defer fake.maxRunningBuildsMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
 	defer fake.orderPipelinesMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
+	fake.pipelineAuthMutex.RLock()
+	defer fake.pipelineAuthMutex.RUnlock()
 	fake.pipelinesMutex.RLock()
 	defer fake.pipelinesMutex.RUnlock()
 	fake.privateAndPublicBuildsMutex.RLock()
@@ -2221,12 +3436,34 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.publicPipelinesMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setWebhookSecretMutex.RLock()
+	defer fake.setWebhookSecretMutex.RUnlock()
+	fake.syslogDrainsMutex.RLock()
+	defer fake.syslogDrainsMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	fake.updateDefaultBuildPriorityMutex.RLock()
+	defer fake.updateDefaultBuildPriorityMutex.RUnlock()
+	fake.updateMaxRunningBuildsMutex.RLock()
+	defer fake.updateMaxRunningBuildsMutex.RUnlock()
+	fake.updatePipelineAuthMutex.RLock()
+	defer fake.updatePipelineAuthMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.updateSyslogDrainsMutex.RLock()
+	defer fake.updateSyslogDrainsMutex.RUnlock()
+	fake.updateVarSourcesMutex.RLock()
+	defer fake.updateVarSourcesMutex.RUnlock()
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
+	fake.webhookSecretMutex.RLock()
+	defer fake.webhookSecretMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
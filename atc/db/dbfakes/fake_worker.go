@@ -52,6 +52,16 @@ type FakeWorker struct {
 	baggageclaimURLReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	CPULoadStub        func() float64
+	cPULoadMutex       sync.RWMutex
+	cPULoadArgsForCall []struct {
+	}
+	cPULoadReturns struct {
+		result1 float64
+	}
+	cPULoadReturnsOnCall map[int]struct {
+		result1 float64
+	}
 	CertsPathStub        func() *string
 	certsPathMutex       sync.RWMutex
 	certsPathArgsForCall []struct {
@@ -181,6 +191,16 @@ type FakeWorker struct {
 	landReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MemoryLoadStub        func() float64
+	memoryLoadMutex       sync.RWMutex
+	memoryLoadArgsForCall []struct {
+	}
+	memoryLoadReturns struct {
+		result1 float64
+	}
+	memoryLoadReturnsOnCall map[int]struct {
+		result1 float64
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -542,6 +562,58 @@ func (fake *FakeWorker) BaggageclaimURLReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) CPULoad() float64 {
+	fake.cPULoadMutex.Lock()
+	ret, specificReturn := fake.cPULoadReturnsOnCall[len(fake.cPULoadArgsForCall)]
+	fake.cPULoadArgsForCall = append(fake.cPULoadArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CPULoad", []interface{}{})
+	fake.cPULoadMutex.Unlock()
+	if fake.CPULoadStub != nil {
+		return fake.CPULoadStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cPULoadReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CPULoadCallCount() int {
+	fake.cPULoadMutex.RLock()
+	defer fake.cPULoadMutex.RUnlock()
+	return len(fake.cPULoadArgsForCall)
+}
+
+func (fake *FakeWorker) CPULoadCalls(stub func() float64) {
+	fake.cPULoadMutex.Lock()
+	defer fake.cPULoadMutex.Unlock()
+	fake.CPULoadStub = stub
+}
+
+func (fake *FakeWorker) CPULoadReturns(result1 float64) {
+	fake.cPULoadMutex.Lock()
+	defer fake.cPULoadMutex.Unlock()
+	fake.CPULoadStub = nil
+	fake.cPULoadReturns = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeWorker) CPULoadReturnsOnCall(i int, result1 float64) {
+	fake.cPULoadMutex.Lock()
+	defer fake.cPULoadMutex.Unlock()
+	fake.CPULoadStub = nil
+	if fake.cPULoadReturnsOnCall == nil {
+		fake.cPULoadReturnsOnCall = make(map[int]struct {
+			result1 float64
+		})
+	}
+	fake.cPULoadReturnsOnCall[i] = struct {
+		result1 float64
+	}{result1}
+}
+
 func (fake *FakeWorker) CertsPath() *string {
 	fake.certsPathMutex.Lock()
 	ret, specificReturn := fake.certsPathReturnsOnCall[len(fake.certsPathArgsForCall)]
@@ -1192,6 +1264,58 @@ func (fake *FakeWorker) LandReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) MemoryLoad() float64 {
+	fake.memoryLoadMutex.Lock()
+	ret, specificReturn := fake.memoryLoadReturnsOnCall[len(fake.memoryLoadArgsForCall)]
+	fake.memoryLoadArgsForCall = append(fake.memoryLoadArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MemoryLoad", []interface{}{})
+	fake.memoryLoadMutex.Unlock()
+	if fake.MemoryLoadStub != nil {
+		return fake.MemoryLoadStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.memoryLoadReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) MemoryLoadCallCount() int {
+	fake.memoryLoadMutex.RLock()
+	defer fake.memoryLoadMutex.RUnlock()
+	return len(fake.memoryLoadArgsForCall)
+}
+
+func (fake *FakeWorker) MemoryLoadCalls(stub func() float64) {
+	fake.memoryLoadMutex.Lock()
+	defer fake.memoryLoadMutex.Unlock()
+	fake.MemoryLoadStub = stub
+}
+
+func (fake *FakeWorker) MemoryLoadReturns(result1 float64) {
+	fake.memoryLoadMutex.Lock()
+	defer fake.memoryLoadMutex.Unlock()
+	fake.MemoryLoadStub = nil
+	fake.memoryLoadReturns = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeWorker) MemoryLoadReturnsOnCall(i int, result1 float64) {
+	fake.memoryLoadMutex.Lock()
+	defer fake.memoryLoadMutex.Unlock()
+	fake.MemoryLoadStub = nil
+	if fake.memoryLoadReturnsOnCall == nil {
+		fake.memoryLoadReturnsOnCall = make(map[int]struct {
+			result1 float64
+		})
+	}
+	fake.memoryLoadReturnsOnCall[i] = struct {
+		result1 float64
+	}{result1}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1940,6 +2064,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.activeVolumesMutex.RUnlock()
 	fake.baggageclaimURLMutex.RLock()
 	defer fake.baggageclaimURLMutex.RUnlock()
+	fake.cPULoadMutex.RLock()
+	defer fake.cPULoadMutex.RUnlock()
 	fake.certsPathMutex.RLock()
 	defer fake.certsPathMutex.RUnlock()
 	fake.createContainerMutex.RLock()
@@ -1964,6 +2090,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.increaseActiveTasksMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
+	fake.memoryLoadMutex.RLock()
+	defer fake.memoryLoadMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.noProxyMutex.RLock()
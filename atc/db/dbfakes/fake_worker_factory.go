@@ -91,6 +91,19 @@ type FakeWorkerFactory struct {
 		result1 []db.Worker
 		result2 error
 	}
+	WorkerNamesForBuildStub        func(int) ([]string, error)
+	workerNamesForBuildMutex       sync.RWMutex
+	workerNamesForBuildArgsForCall []struct {
+		arg1 int
+	}
+	workerNamesForBuildReturns struct {
+		result1 []string
+		result2 error
+	}
+	workerNamesForBuildReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -487,6 +500,69 @@ func (fake *FakeWorkerFactory) VisibleWorkersReturnsOnCall(i int, result1 []db.W
 	}{result1, result2}
 }
 
+func (fake *FakeWorkerFactory) WorkerNamesForBuild(arg1 int) ([]string, error) {
+	fake.workerNamesForBuildMutex.Lock()
+	ret, specificReturn := fake.workerNamesForBuildReturnsOnCall[len(fake.workerNamesForBuildArgsForCall)]
+	fake.workerNamesForBuildArgsForCall = append(fake.workerNamesForBuildArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("WorkerNamesForBuild", []interface{}{arg1})
+	fake.workerNamesForBuildMutex.Unlock()
+	if fake.WorkerNamesForBuildStub != nil {
+		return fake.WorkerNamesForBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.workerNamesForBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerFactory) WorkerNamesForBuildCallCount() int {
+	fake.workerNamesForBuildMutex.RLock()
+	defer fake.workerNamesForBuildMutex.RUnlock()
+	return len(fake.workerNamesForBuildArgsForCall)
+}
+
+func (fake *FakeWorkerFactory) WorkerNamesForBuildCalls(stub func(int) ([]string, error)) {
+	fake.workerNamesForBuildMutex.Lock()
+	defer fake.workerNamesForBuildMutex.Unlock()
+	fake.WorkerNamesForBuildStub = stub
+}
+
+func (fake *FakeWorkerFactory) WorkerNamesForBuildArgsForCall(i int) int {
+	fake.workerNamesForBuildMutex.RLock()
+	defer fake.workerNamesForBuildMutex.RUnlock()
+	argsForCall := fake.workerNamesForBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorkerFactory) WorkerNamesForBuildReturns(result1 []string, result2 error) {
+	fake.workerNamesForBuildMutex.Lock()
+	defer fake.workerNamesForBuildMutex.Unlock()
+	fake.WorkerNamesForBuildStub = nil
+	fake.workerNamesForBuildReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerFactory) WorkerNamesForBuildReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.workerNamesForBuildMutex.Lock()
+	defer fake.workerNamesForBuildMutex.Unlock()
+	fake.WorkerNamesForBuildStub = nil
+	if fake.workerNamesForBuildReturnsOnCall == nil {
+		fake.workerNamesForBuildReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.workerNamesForBuildReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerFactory) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -557,6 +633,8 @@ func (fake *FakeWorkerFactory) Invocations() map[string][][]interface{} {
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.visibleWorkersMutex.RLock()
 	defer fake.visibleWorkersMutex.RUnlock()
+	fake.workerNamesForBuildMutex.RLock()
+	defer fake.workerNamesForBuildMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,267 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeWorkerTaskQueue struct {
+	HigherPriorityWaitingStub        func(int, *db.WorkerTaskSpec) (bool, error)
+	higherPriorityWaitingMutex       sync.RWMutex
+	higherPriorityWaitingArgsForCall []struct {
+		arg1 int
+		arg2 *db.WorkerTaskSpec
+	}
+	higherPriorityWaitingReturns struct {
+		result1 bool
+		result2 error
+	}
+	higherPriorityWaitingReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	LeaveStub        func(string) error
+	leaveMutex       sync.RWMutex
+	leaveArgsForCall []struct {
+		arg1 string
+	}
+	leaveReturns struct {
+		result1 error
+	}
+	leaveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WaitStub        func(string, int, db.WorkerTaskSpec) error
+	waitMutex       sync.RWMutex
+	waitArgsForCall []struct {
+		arg1 string
+		arg2 int
+		arg3 db.WorkerTaskSpec
+	}
+	waitReturns struct {
+		result1 error
+	}
+	waitReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWorkerTaskQueue) HigherPriorityWaiting(arg1 int, arg2 *db.WorkerTaskSpec) (bool, error) {
+	fake.higherPriorityWaitingMutex.Lock()
+	ret, specificReturn := fake.higherPriorityWaitingReturnsOnCall[len(fake.higherPriorityWaitingArgsForCall)]
+	fake.higherPriorityWaitingArgsForCall = append(fake.higherPriorityWaitingArgsForCall, struct {
+		arg1 int
+		arg2 *db.WorkerTaskSpec
+	}{arg1, arg2})
+	fake.recordInvocation("HigherPriorityWaiting", []interface{}{arg1, arg2})
+	fake.higherPriorityWaitingMutex.Unlock()
+	if fake.HigherPriorityWaitingStub != nil {
+		return fake.HigherPriorityWaitingStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.higherPriorityWaitingReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerTaskQueue) HigherPriorityWaitingCallCount() int {
+	fake.higherPriorityWaitingMutex.RLock()
+	defer fake.higherPriorityWaitingMutex.RUnlock()
+	return len(fake.higherPriorityWaitingArgsForCall)
+}
+
+func (fake *FakeWorkerTaskQueue) HigherPriorityWaitingCalls(stub func(int, *db.WorkerTaskSpec) (bool, error)) {
+	fake.higherPriorityWaitingMutex.Lock()
+	defer fake.higherPriorityWaitingMutex.Unlock()
+	fake.HigherPriorityWaitingStub = stub
+}
+
+func (fake *FakeWorkerTaskQueue) HigherPriorityWaitingArgsForCall(i int) (int, *db.WorkerTaskSpec) {
+	fake.higherPriorityWaitingMutex.RLock()
+	defer fake.higherPriorityWaitingMutex.RUnlock()
+	argsForCall := fake.higherPriorityWaitingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorkerTaskQueue) HigherPriorityWaitingReturns(result1 bool, result2 error) {
+	fake.higherPriorityWaitingMutex.Lock()
+	defer fake.higherPriorityWaitingMutex.Unlock()
+	fake.HigherPriorityWaitingStub = nil
+	fake.higherPriorityWaitingReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerTaskQueue) HigherPriorityWaitingReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.higherPriorityWaitingMutex.Lock()
+	defer fake.higherPriorityWaitingMutex.Unlock()
+	fake.HigherPriorityWaitingStub = nil
+	if fake.higherPriorityWaitingReturnsOnCall == nil {
+		fake.higherPriorityWaitingReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.higherPriorityWaitingReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerTaskQueue) Leave(arg1 string) error {
+	fake.leaveMutex.Lock()
+	ret, specificReturn := fake.leaveReturnsOnCall[len(fake.leaveArgsForCall)]
+	fake.leaveArgsForCall = append(fake.leaveArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Leave", []interface{}{arg1})
+	fake.leaveMutex.Unlock()
+	if fake.LeaveStub != nil {
+		return fake.LeaveStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.leaveReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkerTaskQueue) LeaveCallCount() int {
+	fake.leaveMutex.RLock()
+	defer fake.leaveMutex.RUnlock()
+	return len(fake.leaveArgsForCall)
+}
+
+func (fake *FakeWorkerTaskQueue) LeaveCalls(stub func(string) error) {
+	fake.leaveMutex.Lock()
+	defer fake.leaveMutex.Unlock()
+	fake.LeaveStub = stub
+}
+
+func (fake *FakeWorkerTaskQueue) LeaveArgsForCall(i int) string {
+	fake.leaveMutex.RLock()
+	defer fake.leaveMutex.RUnlock()
+	argsForCall := fake.leaveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorkerTaskQueue) LeaveReturns(result1 error) {
+	fake.leaveMutex.Lock()
+	defer fake.leaveMutex.Unlock()
+	fake.LeaveStub = nil
+	fake.leaveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerTaskQueue) LeaveReturnsOnCall(i int, result1 error) {
+	fake.leaveMutex.Lock()
+	defer fake.leaveMutex.Unlock()
+	fake.LeaveStub = nil
+	if fake.leaveReturnsOnCall == nil {
+		fake.leaveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.leaveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerTaskQueue) Wait(arg1 string, arg2 int, arg3 db.WorkerTaskSpec) error {
+	fake.waitMutex.Lock()
+	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
+	fake.waitArgsForCall = append(fake.waitArgsForCall, struct {
+		arg1 string
+		arg2 int
+		arg3 db.WorkerTaskSpec
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Wait", []interface{}{arg1, arg2, arg3})
+	fake.waitMutex.Unlock()
+	if fake.WaitStub != nil {
+		return fake.WaitStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.waitReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkerTaskQueue) WaitCallCount() int {
+	fake.waitMutex.RLock()
+	defer fake.waitMutex.RUnlock()
+	return len(fake.waitArgsForCall)
+}
+
+func (fake *FakeWorkerTaskQueue) WaitCalls(stub func(string, int, db.WorkerTaskSpec) error) {
+	fake.waitMutex.Lock()
+	defer fake.waitMutex.Unlock()
+	fake.WaitStub = stub
+}
+
+func (fake *FakeWorkerTaskQueue) WaitArgsForCall(i int) (string, int, db.WorkerTaskSpec) {
+	fake.waitMutex.RLock()
+	defer fake.waitMutex.RUnlock()
+	argsForCall := fake.waitArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeWorkerTaskQueue) WaitReturns(result1 error) {
+	fake.waitMutex.Lock()
+	defer fake.waitMutex.Unlock()
+	fake.WaitStub = nil
+	fake.waitReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerTaskQueue) WaitReturnsOnCall(i int, result1 error) {
+	fake.waitMutex.Lock()
+	defer fake.waitMutex.Unlock()
+	fake.WaitStub = nil
+	if fake.waitReturnsOnCall == nil {
+		fake.waitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerTaskQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.higherPriorityWaitingMutex.RLock()
+	defer fake.higherPriorityWaitingMutex.RUnlock()
+	fake.leaveMutex.RLock()
+	defer fake.leaveMutex.RUnlock()
+	fake.waitMutex.RLock()
+	defer fake.waitMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWorkerTaskQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.WorkerTaskQueue = new(FakeWorkerTaskQueue)
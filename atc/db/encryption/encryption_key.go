@@ -30,6 +30,14 @@ func (e Key) Encrypt(plaintext []byte) (string, *string, error) {
 	return hex.EncodeToString(ciphertext), &noncense, nil
 }
 
+// Fingerprint identifies the key without revealing it, by sealing a fixed
+// message with a fixed nonce. It is never used to encrypt any data.
+func (e Key) Fingerprint() string {
+	nonce := make([]byte, e.aesgcm.NonceSize())
+
+	return hex.EncodeToString(e.aesgcm.Seal(nil, nonce, []byte("concourse"), nil))
+}
+
 func (e Key) Decrypt(text string, n *string) ([]byte, error) {
 	if n == nil {
 		return nil, ErrDataIsNotEncrypted
@@ -82,4 +82,30 @@ var _ = Describe("Encryption Key", func() {
 			})
 		})
 	})
+
+	Describe("Fingerprint", func() {
+		It("is the same for the same key", func() {
+			k := []byte("AES256Key-32Characters1234567890")
+
+			block, err := aes.NewCipher(k)
+			Expect(err).ToNot(HaveOccurred())
+
+			aesgcm, err := cipher.NewGCM(block)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(key.Fingerprint()).To(Equal(encryption.NewKey(aesgcm).Fingerprint()))
+		})
+
+		It("differs between keys", func() {
+			k := []byte("AES256Key-32Characters9564567123")
+
+			block, err := aes.NewCipher(k)
+			Expect(err).ToNot(HaveOccurred())
+
+			aesgcm, err := cipher.NewGCM(block)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(key.Fingerprint()).ToNot(Equal(encryption.NewKey(aesgcm).Fingerprint()))
+		})
+	})
 })
@@ -0,0 +1,274 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package encryptionfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+)
+
+type FakeKeyProvider struct {
+	KeyIDStub        func() string
+	keyIDMutex       sync.RWMutex
+	keyIDArgsForCall []struct {
+	}
+	keyIDReturns struct {
+		result1 string
+	}
+	keyIDReturnsOnCall map[int]struct {
+		result1 string
+	}
+	UnwrapKeyStub        func(string, []byte) ([]byte, error)
+	unwrapKeyMutex       sync.RWMutex
+	unwrapKeyArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+	}
+	unwrapKeyReturns struct {
+		result1 []byte
+		result2 error
+	}
+	unwrapKeyReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	WrapKeyStub        func([]byte) (string, []byte, error)
+	wrapKeyMutex       sync.RWMutex
+	wrapKeyArgsForCall []struct {
+		arg1 []byte
+	}
+	wrapKeyReturns struct {
+		result1 string
+		result2 []byte
+		result3 error
+	}
+	wrapKeyReturnsOnCall map[int]struct {
+		result1 string
+		result2 []byte
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeKeyProvider) KeyID() string {
+	fake.keyIDMutex.Lock()
+	ret, specificReturn := fake.keyIDReturnsOnCall[len(fake.keyIDArgsForCall)]
+	fake.keyIDArgsForCall = append(fake.keyIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("KeyID", []interface{}{})
+	fake.keyIDMutex.Unlock()
+	if fake.KeyIDStub != nil {
+		return fake.KeyIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.keyIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeKeyProvider) KeyIDCallCount() int {
+	fake.keyIDMutex.RLock()
+	defer fake.keyIDMutex.RUnlock()
+	return len(fake.keyIDArgsForCall)
+}
+
+func (fake *FakeKeyProvider) KeyIDCalls(stub func() string) {
+	fake.keyIDMutex.Lock()
+	defer fake.keyIDMutex.Unlock()
+	fake.KeyIDStub = stub
+}
+
+func (fake *FakeKeyProvider) KeyIDReturns(result1 string) {
+	fake.keyIDMutex.Lock()
+	defer fake.keyIDMutex.Unlock()
+	fake.KeyIDStub = nil
+	fake.keyIDReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeKeyProvider) KeyIDReturnsOnCall(i int, result1 string) {
+	fake.keyIDMutex.Lock()
+	defer fake.keyIDMutex.Unlock()
+	fake.KeyIDStub = nil
+	if fake.keyIDReturnsOnCall == nil {
+		fake.keyIDReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.keyIDReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeKeyProvider) UnwrapKey(arg1 string, arg2 []byte) ([]byte, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.unwrapKeyMutex.Lock()
+	ret, specificReturn := fake.unwrapKeyReturnsOnCall[len(fake.unwrapKeyArgsForCall)]
+	fake.unwrapKeyArgsForCall = append(fake.unwrapKeyArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+	}{arg1, arg2Copy})
+	fake.recordInvocation("UnwrapKey", []interface{}{arg1, arg2Copy})
+	fake.unwrapKeyMutex.Unlock()
+	if fake.UnwrapKeyStub != nil {
+		return fake.UnwrapKeyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unwrapKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyCallCount() int {
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	return len(fake.unwrapKeyArgsForCall)
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyCalls(stub func(string, []byte) ([]byte, error)) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = stub
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyArgsForCall(i int) (string, []byte) {
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	argsForCall := fake.unwrapKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyReturns(result1 []byte, result2 error) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = nil
+	fake.unwrapKeyReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyProvider) UnwrapKeyReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = nil
+	if fake.unwrapKeyReturnsOnCall == nil {
+		fake.unwrapKeyReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.unwrapKeyReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyProvider) WrapKey(arg1 []byte) (string, []byte, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.wrapKeyMutex.Lock()
+	ret, specificReturn := fake.wrapKeyReturnsOnCall[len(fake.wrapKeyArgsForCall)]
+	fake.wrapKeyArgsForCall = append(fake.wrapKeyArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	fake.recordInvocation("WrapKey", []interface{}{arg1Copy})
+	fake.wrapKeyMutex.Unlock()
+	if fake.WrapKeyStub != nil {
+		return fake.WrapKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.wrapKeyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeKeyProvider) WrapKeyCallCount() int {
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	return len(fake.wrapKeyArgsForCall)
+}
+
+func (fake *FakeKeyProvider) WrapKeyCalls(stub func([]byte) (string, []byte, error)) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = stub
+}
+
+func (fake *FakeKeyProvider) WrapKeyArgsForCall(i int) []byte {
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	argsForCall := fake.wrapKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKeyProvider) WrapKeyReturns(result1 string, result2 []byte, result3 error) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = nil
+	fake.wrapKeyReturns = struct {
+		result1 string
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeKeyProvider) WrapKeyReturnsOnCall(i int, result1 string, result2 []byte, result3 error) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = nil
+	if fake.wrapKeyReturnsOnCall == nil {
+		fake.wrapKeyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 []byte
+			result3 error
+		})
+	}
+	fake.wrapKeyReturnsOnCall[i] = struct {
+		result1 string
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeKeyProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.keyIDMutex.RLock()
+	defer fake.keyIDMutex.RUnlock()
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeKeyProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ encryption.KeyProvider = new(FakeKeyProvider)
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package encryptionfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/hashicorp/vault/api"
+)
+
+type FakeVaultLogical struct {
+	WriteStub        func(string, map[string]interface{}) (*api.Secret, error)
+	writeMutex       sync.RWMutex
+	writeArgsForCall []struct {
+		arg1 string
+		arg2 map[string]interface{}
+	}
+	writeReturns struct {
+		result1 *api.Secret
+		result2 error
+	}
+	writeReturnsOnCall map[int]struct {
+		result1 *api.Secret
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeVaultLogical) Write(arg1 string, arg2 map[string]interface{}) (*api.Secret, error) {
+	fake.writeMutex.Lock()
+	ret, specificReturn := fake.writeReturnsOnCall[len(fake.writeArgsForCall)]
+	fake.writeArgsForCall = append(fake.writeArgsForCall, struct {
+		arg1 string
+		arg2 map[string]interface{}
+	}{arg1, arg2})
+	fake.recordInvocation("Write", []interface{}{arg1, arg2})
+	fake.writeMutex.Unlock()
+	if fake.WriteStub != nil {
+		return fake.WriteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.writeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeVaultLogical) WriteCallCount() int {
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	return len(fake.writeArgsForCall)
+}
+
+func (fake *FakeVaultLogical) WriteCalls(stub func(string, map[string]interface{}) (*api.Secret, error)) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = stub
+}
+
+func (fake *FakeVaultLogical) WriteArgsForCall(i int) (string, map[string]interface{}) {
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	argsForCall := fake.writeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVaultLogical) WriteReturns(result1 *api.Secret, result2 error) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = nil
+	fake.writeReturns = struct {
+		result1 *api.Secret
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVaultLogical) WriteReturnsOnCall(i int, result1 *api.Secret, result2 error) {
+	fake.writeMutex.Lock()
+	defer fake.writeMutex.Unlock()
+	fake.WriteStub = nil
+	if fake.writeReturnsOnCall == nil {
+		fake.writeReturnsOnCall = make(map[int]struct {
+			result1 *api.Secret
+			result2 error
+		})
+	}
+	fake.writeReturnsOnCall[i] = struct {
+		result1 *api.Secret
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVaultLogical) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.writeMutex.RLock()
+	defer fake.writeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeVaultLogical) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ encryption.VaultLogical = new(FakeVaultLogical)
@@ -0,0 +1,205 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrNotEnvelopeEncrypted = errors.New("data is not envelope encrypted")
+
+const envelopePrefix = "envelope"
+
+// maxCachedDataKeys bounds how many unwrapped data keys are kept in memory
+// so that frequently read rows don't have to be unwrapped by the key provider
+// every time.
+const maxCachedDataKeys = 1024
+
+// A data key is used to encrypt several values so that the key provider,
+// which may be a remote service, isn't called on every write. It is replaced
+// with a new one once it's DataKeyLifetime old or has encrypted MaxDataKeyUses
+// values, well within the number of random nonces AES-GCM allows per key.
+const (
+	DataKeyLifetime = 5 * time.Minute
+	MaxDataKeyUses  = 1 << 16
+)
+
+// Envelope encrypts values with data keys which are wrapped by a KeyProvider
+// and stored alongside the nonce together with the ID of the master key they
+// were wrapped with. This allows several master keys to coexist and keeps the
+// master key out of the ATC's flags.
+type Envelope struct {
+	provider KeyProvider
+
+	dataKeyLock sync.Mutex
+	dataKey     *wrappedDataKey
+
+	cacheLock sync.Mutex
+	cache     map[string][]byte
+}
+
+type wrappedDataKey struct {
+	aead       cipher.AEAD
+	keyID      string
+	wrappedKey []byte
+	createdAt  time.Time
+	uses       int
+}
+
+func NewEnvelope(provider KeyProvider) *Envelope {
+	return &Envelope{
+		provider: provider,
+		cache:    map[string][]byte{},
+	}
+}
+
+func (e *Envelope) Encrypt(plaintext []byte) (string, *string, error) {
+	dataKey, err := e.useDataKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	nonce := make([]byte, dataKey.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+
+	ciphertext := dataKey.aead.Seal(nil, nonce, plaintext, nil)
+
+	envelope := strings.Join([]string{
+		envelopePrefix,
+		url.QueryEscape(dataKey.keyID),
+		base64.StdEncoding.EncodeToString(dataKey.wrappedKey),
+		hex.EncodeToString(nonce),
+	}, ":")
+
+	return hex.EncodeToString(ciphertext), &envelope, nil
+}
+
+func (e *Envelope) Decrypt(text string, n *string) ([]byte, error) {
+	if n == nil {
+		return nil, ErrDataIsNotEncrypted
+	}
+
+	parts := strings.Split(*n, ":")
+	if len(parts) != 4 || parts[0] != envelopePrefix {
+		return nil, ErrNotEnvelopeEncrypted
+	}
+
+	keyID, err := url.QueryUnescape(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := e.unwrapKey(keyID, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aesgcm, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return aesgcm.Open(nil, nonce, ciphertext, nil)
+}
+
+// Fingerprint identifies the master key new data keys are wrapped with.
+func (e *Envelope) Fingerprint() string {
+	return envelopePrefix + ":" + e.provider.KeyID()
+}
+
+// useDataKey returns the data key to encrypt the next value with, generating
+// and wrapping a new one when the current one is used up.
+func (e *Envelope) useDataKey() (*wrappedDataKey, error) {
+	e.dataKeyLock.Lock()
+	defer e.dataKeyLock.Unlock()
+
+	if e.dataKey == nil ||
+		e.dataKey.uses >= MaxDataKeyUses ||
+		time.Since(e.dataKey.createdAt) >= DataKeyLifetime {
+		dataKey := make([]byte, 32)
+		if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+			return nil, err
+		}
+
+		aesgcm, err := newAEAD(dataKey)
+		if err != nil {
+			return nil, err
+		}
+
+		keyID, wrappedKey, err := e.provider.WrapKey(dataKey)
+		if err != nil {
+			return nil, err
+		}
+
+		e.dataKey = &wrappedDataKey{
+			aead:       aesgcm,
+			keyID:      keyID,
+			wrappedKey: wrappedKey,
+			createdAt:  time.Now(),
+		}
+	}
+
+	e.dataKey.uses++
+
+	return e.dataKey, nil
+}
+
+func (e *Envelope) unwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	cacheKey := keyID + ":" + string(wrappedKey)
+
+	e.cacheLock.Lock()
+	dataKey, found := e.cache[cacheKey]
+	e.cacheLock.Unlock()
+
+	if found {
+		return dataKey, nil
+	}
+
+	dataKey, err := e.provider.UnwrapKey(keyID, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	e.cacheLock.Lock()
+	if len(e.cache) >= maxCachedDataKeys {
+		e.cache = map[string][]byte{}
+	}
+	e.cache[cacheKey] = dataKey
+	e.cacheLock.Unlock()
+
+	return dataKey, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,183 @@
+package encryption_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/encryption/encryptionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Envelope", func() {
+	var (
+		masterKeys map[string]cipher.AEAD
+		provider   encryption.KeyProvider
+		envelope   *encryption.Envelope
+	)
+
+	newAEAD := func(k string) cipher.AEAD {
+		block, err := aes.NewCipher([]byte(k))
+		Expect(err).ToNot(HaveOccurred())
+
+		aesgcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+
+		return aesgcm
+	}
+
+	BeforeEach(func() {
+		masterKeys = map[string]cipher.AEAD{
+			"old-key": newAEAD("AES256Key-32Characters1234567890"),
+			"new-key": newAEAD("AES256Key-32Characters9564567123"),
+		}
+
+		var err error
+		provider, err = encryption.NewLocalKeyProvider(masterKeys, "new-key")
+		Expect(err).ToNot(HaveOccurred())
+
+		envelope = encryption.NewEnvelope(provider)
+	})
+
+	It("encrypts and decrypts plaintext", func() {
+		encryptedText, nonce, err := envelope.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encryptedText).ToNot(ContainSubstring("exampleplaintext"))
+
+		decryptedText, err := envelope.Decrypt(encryptedText, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+	})
+
+	It("stores the ID of the master key alongside the nonce", func() {
+		_, nonce, err := envelope.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(strings.Split(*nonce, ":")[:2]).To(Equal([]string{"envelope", "new-key"}))
+	})
+
+	Context("when encrypting several values", func() {
+		var fakeProvider *encryptionfakes.FakeKeyProvider
+
+		BeforeEach(func() {
+			fakeProvider = new(encryptionfakes.FakeKeyProvider)
+			fakeProvider.WrapKeyStub = provider.WrapKey
+			fakeProvider.UnwrapKeyStub = provider.UnwrapKey
+			envelope = encryption.NewEnvelope(fakeProvider)
+		})
+
+		It("wraps a data key once and reuses it with a new nonce", func() {
+			firstText, firstNonce, err := envelope.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			secondText, secondNonce, err := envelope.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeProvider.WrapKeyCallCount()).To(Equal(1))
+			Expect(strings.Split(*firstNonce, ":")[2]).To(Equal(strings.Split(*secondNonce, ":")[2]))
+			Expect(strings.Split(*firstNonce, ":")[3]).ToNot(Equal(strings.Split(*secondNonce, ":")[3]))
+			Expect(firstText).ToNot(Equal(secondText))
+
+			decryptedText, err := envelope.Decrypt(secondText, secondNonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+		})
+
+		It("wraps a new data key once the current one is used up", func() {
+			var firstNonce *string
+			for i := 0; i < encryption.MaxDataKeyUses; i++ {
+				var err error
+				_, firstNonce, err = envelope.Encrypt([]byte("exampleplaintext"))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(fakeProvider.WrapKeyCallCount()).To(Equal(1))
+
+			_, nextNonce, err := envelope.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeProvider.WrapKeyCallCount()).To(Equal(2))
+			Expect(strings.Split(*firstNonce, ":")[2]).ToNot(Equal(strings.Split(*nextNonce, ":")[2]))
+		})
+	})
+
+	It("decrypts data whose data key was wrapped with another master key", func() {
+		oldProvider, err := encryption.NewLocalKeyProvider(masterKeys, "old-key")
+		Expect(err).ToNot(HaveOccurred())
+
+		encryptedText, nonce, err := encryption.NewEnvelope(oldProvider).Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		decryptedText, err := envelope.Decrypt(encryptedText, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+	})
+
+	It("fails to decrypt data which is not encrypted", func() {
+		_, err := envelope.Decrypt("exampleplaintext", nil)
+		Expect(err).To(Equal(encryption.ErrDataIsNotEncrypted))
+	})
+
+	It("fails to decrypt data encrypted with a raw key", func() {
+		encryptedText, nonce, err := encryption.NewKey(masterKeys["new-key"]).Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = envelope.Decrypt(encryptedText, nonce)
+		Expect(err).To(Equal(encryption.ErrNotEnvelopeEncrypted))
+	})
+
+	It("is fingerprinted by the master key new data keys are wrapped with", func() {
+		Expect(encryption.Fingerprint(envelope)).To(Equal("envelope:new-key"))
+	})
+
+	Context("when the key provider fails", func() {
+		var fakeProvider *encryptionfakes.FakeKeyProvider
+
+		BeforeEach(func() {
+			fakeProvider = new(encryptionfakes.FakeKeyProvider)
+			envelope = encryption.NewEnvelope(fakeProvider)
+		})
+
+		It("fails to encrypt", func() {
+			fakeProvider.WrapKeyReturns("", nil, errors.New("disaster"))
+
+			_, _, err := envelope.Encrypt([]byte("exampleplaintext"))
+			Expect(err).To(MatchError("disaster"))
+		})
+
+		It("fails to decrypt", func() {
+			fakeProvider.UnwrapKeyReturns(nil, errors.New("disaster"))
+
+			nonce := "envelope:some-key:d3JhcHBlZA==:00"
+			_, err := envelope.Decrypt("00", &nonce)
+			Expect(err).To(MatchError("disaster"))
+		})
+	})
+
+	Context("when decrypting the same value again", func() {
+		var fakeProvider *encryptionfakes.FakeKeyProvider
+
+		BeforeEach(func() {
+			fakeProvider = new(encryptionfakes.FakeKeyProvider)
+			fakeProvider.WrapKeyStub = provider.WrapKey
+			fakeProvider.UnwrapKeyStub = provider.UnwrapKey
+
+			envelope = encryption.NewEnvelope(fakeProvider)
+		})
+
+		It("only unwraps its data key once", func() {
+			encryptedText, nonce, err := envelope.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			for i := 0; i < 3; i++ {
+				decryptedText, err := envelope.Decrypt(encryptedText, nonce)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+			}
+
+			Expect(fakeProvider.UnwrapKeyCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package encryption
+
+// Fallback encrypts data with its primary strategy and decrypts data with
+// whichever of its strategies is able to, so that data encrypted with an old
+// key remains readable while it is being re-encrypted with a new one.
+type Fallback struct {
+	primary  Strategy
+	fallback Strategy
+}
+
+func NewFallback(primary Strategy, fallback Strategy) *Fallback {
+	return &Fallback{
+		primary:  primary,
+		fallback: fallback,
+	}
+}
+
+func (f Fallback) Encrypt(plaintext []byte) (string, *string, error) {
+	return f.primary.Encrypt(plaintext)
+}
+
+func (f Fallback) Decrypt(text string, nonce *string) ([]byte, error) {
+	plaintext, err := f.primary.Decrypt(text, nonce)
+	if err == nil {
+		return plaintext, nil
+	}
+
+	plaintext, fallbackErr := f.fallback.Decrypt(text, nonce)
+	if fallbackErr != nil {
+		return nil, err
+	}
+
+	return plaintext, nil
+}
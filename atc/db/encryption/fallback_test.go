@@ -0,0 +1,95 @@
+package encryption_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Fallback", func() {
+	var (
+		oldKey   *encryption.Key
+		newKey   *encryption.Key
+		fallback *encryption.Fallback
+	)
+
+	newTestKey := func(k string) *encryption.Key {
+		block, err := aes.NewCipher([]byte(k))
+		Expect(err).ToNot(HaveOccurred())
+
+		aesgcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+
+		return encryption.NewKey(aesgcm)
+	}
+
+	BeforeEach(func() {
+		oldKey = newTestKey("AES256Key-32Characters1234567890")
+		newKey = newTestKey("AES256Key-32Characters9564567123")
+
+		fallback = encryption.NewFallback(newKey, oldKey)
+	})
+
+	It("encrypts with the primary strategy", func() {
+		encryptedText, nonce, err := fallback.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		decryptedText, err := newKey.Decrypt(encryptedText, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+
+		_, err = oldKey.Decrypt(encryptedText, nonce)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("decrypts data encrypted with the primary strategy", func() {
+		encryptedText, nonce, err := newKey.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		decryptedText, err := fallback.Decrypt(encryptedText, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+	})
+
+	It("decrypts data encrypted with the fallback strategy", func() {
+		encryptedText, nonce, err := oldKey.Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		decryptedText, err := fallback.Decrypt(encryptedText, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+	})
+
+	It("returns the primary strategy's error when neither can decrypt", func() {
+		encryptedText, nonce, err := newTestKey("AES256Key-32Characters0000000000").Encrypt([]byte("exampleplaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = fallback.Decrypt(encryptedText, nonce)
+		Expect(err).To(HaveOccurred())
+
+		_, primaryErr := newKey.Decrypt(encryptedText, nonce)
+		Expect(err).To(Equal(primaryErr))
+	})
+
+	Context("when decrypting to plaintext", func() {
+		BeforeEach(func() {
+			fallback = encryption.NewFallback(encryption.NewNoEncryption(), oldKey)
+		})
+
+		It("reads both plaintext and encrypted data", func() {
+			decryptedText, err := fallback.Decrypt("exampleplaintext", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+
+			encryptedText, nonce, err := oldKey.Encrypt([]byte("exampleplaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			decryptedText, err = fallback.Decrypt(encryptedText, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decryptedText).To(Equal([]byte("exampleplaintext")))
+		})
+	})
+})
@@ -0,0 +1,15 @@
+package encryption
+
+// Fingerprint identifies the key a strategy encrypts with without revealing
+// it, so that work done with one key is not mistaken for work done with
+// another. A nil strategy stores data as plaintext.
+func Fingerprint(strategy Strategy) string {
+	switch s := strategy.(type) {
+	case nil, NoEncryption, *NoEncryption:
+		return "plaintext"
+	case interface{ Fingerprint() string }:
+		return s.Fingerprint()
+	default:
+		return "unknown"
+	}
+}
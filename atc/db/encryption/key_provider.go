@@ -0,0 +1,15 @@
+package encryption
+
+//go:generate counterfeiter . KeyProvider
+
+// KeyProvider wraps data keys with a master key that never leaves it, e.g. a
+// key kept by an external key management service. It must be able to unwrap
+// data keys wrapped with any of its master keys, including ones it no longer
+// wraps new data keys with.
+type KeyProvider interface {
+	// KeyID identifies the master key new data keys are wrapped with.
+	KeyID() string
+
+	WrapKey(dataKey []byte) (string, []byte, error)
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+}
@@ -0,0 +1,117 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/flag"
+)
+
+// LocalKeys configures a LocalKeyProvider whose master keys are read from
+// files, e.g. for testing or when the keys are provisioned onto the host by
+// other means.
+type LocalKeys struct {
+	Dir   flag.Dir `long:"local-keys-dir" description:"Directory containing master keys used to wrap data keys, one per file named after the key's ID. Each key must be 16 or 32 characters long."`
+	KeyID string   `long:"local-key-id"   description:"ID of the master key in the local keys directory that new data keys are wrapped with."`
+}
+
+func (l LocalKeys) IsConfigured() bool {
+	return l.Dir != ""
+}
+
+func (l LocalKeys) KeyProvider() (KeyProvider, error) {
+	if l.KeyID == "" {
+		return nil, fmt.Errorf("--encryption-local-key-id must be specified when using local keys")
+	}
+
+	keys, err := LoadLocalKeys(l.Dir.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocalKeyProvider(keys, l.KeyID)
+}
+
+// LoadLocalKeys reads every file in the directory as a master key, skipping
+// hidden files.
+func LoadLocalKeys(dir string) (map[string]cipher.AEAD, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]cipher.AEAD{}
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+
+		key, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		aesgcm, err := newAEAD(bytes.TrimSpace(key))
+		if err != nil {
+			return nil, fmt.Errorf("invalid master key %s: %w", file.Name(), err)
+		}
+
+		keys[file.Name()] = aesgcm
+	}
+
+	return keys, nil
+}
+
+type localKeyProvider struct {
+	keys  map[string]cipher.AEAD
+	keyID string
+}
+
+// NewLocalKeyProvider returns a KeyProvider which wraps data keys with AES-GCM
+// using master keys held in memory.
+func NewLocalKeyProvider(keys map[string]cipher.AEAD, keyID string) (KeyProvider, error) {
+	if _, found := keys[keyID]; !found {
+		return nil, fmt.Errorf("unknown master key: %s", keyID)
+	}
+
+	return &localKeyProvider{
+		keys:  keys,
+		keyID: keyID,
+	}, nil
+}
+
+func (p *localKeyProvider) KeyID() string {
+	return p.keyID
+}
+
+func (p *localKeyProvider) WrapKey(dataKey []byte) (string, []byte, error) {
+	aesgcm := p.keys[p.keyID]
+
+	nonce := make([]byte, aesgcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+
+	return p.keyID, aesgcm.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func (p *localKeyProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	aesgcm, found := p.keys[keyID]
+	if !found {
+		return nil, fmt.Errorf("unknown master key: %s", keyID)
+	}
+
+	if len(wrappedKey) < aesgcm.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+
+	nonce, ciphertext := wrappedKey[:aesgcm.NonceSize()], wrappedKey[aesgcm.NonceSize():]
+
+	return aesgcm.Open(nil, nonce, ciphertext, nil)
+}
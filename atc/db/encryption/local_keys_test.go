@@ -0,0 +1,106 @@
+package encryption_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/flag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LocalKeys", func() {
+	var (
+		dir       string
+		localKeys encryption.LocalKeys
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "local-keys")
+		Expect(err).ToNot(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(dir, "some-key"), []byte("AES256Key-32Characters1234567890\n"), 0600)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(dir, "other-key"), []byte("AES128Key-16Char"), 0600)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = ioutil.WriteFile(filepath.Join(dir, ".hidden"), []byte("not a key"), 0600)
+		Expect(err).ToNot(HaveOccurred())
+
+		localKeys = encryption.LocalKeys{
+			Dir:   flag.Dir(dir),
+			KeyID: "some-key",
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("loads every key in the directory", func() {
+		keys, err := encryption.LoadLocalKeys(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keys).To(HaveLen(2))
+		Expect(keys).To(HaveKey("some-key"))
+		Expect(keys).To(HaveKey("other-key"))
+	})
+
+	It("wraps data keys with the configured key and unwraps them with any key", func() {
+		provider, err := localKeys.KeyProvider()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(provider.KeyID()).To(Equal("some-key"))
+
+		keyID, wrappedKey, err := provider.WrapKey([]byte("some-data-key"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyID).To(Equal("some-key"))
+		Expect(wrappedKey).ToNot(ContainSubstring("some-data-key"))
+
+		dataKey, err := provider.UnwrapKey(keyID, wrappedKey)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dataKey).To(Equal([]byte("some-data-key")))
+
+		localKeys.KeyID = "other-key"
+		otherProvider, err := localKeys.KeyProvider()
+		Expect(err).ToNot(HaveOccurred())
+
+		dataKey, err = otherProvider.UnwrapKey(keyID, wrappedKey)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dataKey).To(Equal([]byte("some-data-key")))
+	})
+
+	It("fails to unwrap a data key wrapped with an unknown key", func() {
+		provider, err := localKeys.KeyProvider()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = provider.UnwrapKey("bogus-key", []byte("some-wrapped-key"))
+		Expect(err).To(MatchError("unknown master key: bogus-key"))
+	})
+
+	Context("when the key ID is not in the directory", func() {
+		BeforeEach(func() {
+			localKeys.KeyID = "bogus-key"
+		})
+
+		It("fails", func() {
+			_, err := localKeys.KeyProvider()
+			Expect(err).To(MatchError("unknown master key: bogus-key"))
+		})
+	})
+
+	Context("when a key has an invalid length", func() {
+		BeforeEach(func() {
+			err := ioutil.WriteFile(filepath.Join(dir, "bad-key"), []byte("too-short"), 0600)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("fails", func() {
+			_, err := localKeys.KeyProvider()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid master key bad-key"))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path"
+
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+// VaultTransit configures a VaultTransitKeyProvider.
+type VaultTransit struct {
+	URL    string `long:"vault-transit-url"     description:"Vault server address whose transit secrets engine wraps data keys."`
+	Token  string `long:"vault-transit-token"   description:"Vault token allowed to encrypt and decrypt with the transit keys."`
+	Mount  string `long:"vault-transit-mount"   default:"transit" description:"Path the transit secrets engine is mounted at."`
+	Key    string `long:"vault-transit-key"     description:"Name of the transit key that new data keys are wrapped with."`
+	CACert string `long:"vault-transit-ca-cert" description:"Path to a PEM-encoded CA cert file to use to verify the vault server SSL cert."`
+}
+
+func (v VaultTransit) IsConfigured() bool {
+	return v.URL != ""
+}
+
+func (v VaultTransit) KeyProvider() (KeyProvider, error) {
+	if v.Key == "" {
+		return nil, fmt.Errorf("--encryption-vault-transit-key must be specified when using vault transit")
+	}
+
+	config := vaultapi.DefaultConfig()
+	config.Address = v.URL
+
+	if v.CACert != "" {
+		err := config.ConfigureTLS(&vaultapi.TLSConfig{CACert: v.CACert})
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure vault tls: %w", err)
+		}
+	}
+
+	client, err := vaultapi.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vault client: %w", err)
+	}
+
+	client.SetToken(v.Token)
+
+	return NewVaultTransitKeyProvider(client.Logical(), v.Mount, v.Key), nil
+}
+
+//go:generate counterfeiter . VaultLogical
+
+// VaultLogical is the part of vault's logical API used to call the transit
+// secrets engine.
+type VaultLogical interface {
+	Write(path string, data map[string]interface{}) (*vaultapi.Secret, error)
+}
+
+type vaultTransitKeyProvider struct {
+	logical VaultLogical
+	mount   string
+	key     string
+}
+
+// NewVaultTransitKeyProvider returns a KeyProvider which wraps data keys
+// with the named key of vault's transit secrets engine. Key IDs are names of
+// transit keys, which vault versions itself.
+func NewVaultTransitKeyProvider(logical VaultLogical, mount string, key string) KeyProvider {
+	return &vaultTransitKeyProvider{
+		logical: logical,
+		mount:   mount,
+		key:     key,
+	}
+}
+
+func (p *vaultTransitKeyProvider) KeyID() string {
+	return p.key
+}
+
+func (p *vaultTransitKeyProvider) WrapKey(dataKey []byte) (string, []byte, error) {
+	secret, err := p.logical.Write(path.Join(p.mount, "encrypt", p.key), map[string]interface{}{
+		"plaintext": base64.StdEncoding.EncodeToString(dataKey),
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+
+	ciphertext, err := p.field(secret, "ciphertext")
+	if err != nil {
+		return "", nil, err
+	}
+
+	return p.key, []byte(ciphertext), nil
+}
+
+func (p *vaultTransitKeyProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	secret, err := p.logical.Write(path.Join(p.mount, "decrypt", keyID), map[string]interface{}{
+		"ciphertext": string(wrappedKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+
+	plaintext, err := p.field(secret, "plaintext")
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(plaintext)
+}
+
+func (p *vaultTransitKeyProvider) field(secret *vaultapi.Secret, name string) (string, error) {
+	if secret == nil || secret.Data == nil {
+		return "", fmt.Errorf("vault transit returned no data")
+	}
+
+	value, ok := secret.Data[name].(string)
+	if !ok {
+		return "", fmt.Errorf("vault transit returned no %s", name)
+	}
+
+	return value, nil
+}
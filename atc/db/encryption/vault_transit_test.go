@@ -0,0 +1,93 @@
+package encryption_test
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/encryption/encryptionfakes"
+	vaultapi "github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VaultTransitKeyProvider", func() {
+	var (
+		fakeLogical *encryptionfakes.FakeVaultLogical
+		provider    encryption.KeyProvider
+	)
+
+	BeforeEach(func() {
+		fakeLogical = new(encryptionfakes.FakeVaultLogical)
+		provider = encryption.NewVaultTransitKeyProvider(fakeLogical, "transit", "concourse")
+	})
+
+	It("identifies keys by the name of the transit key", func() {
+		Expect(provider.KeyID()).To(Equal("concourse"))
+	})
+
+	Describe("WrapKey", func() {
+		BeforeEach(func() {
+			fakeLogical.WriteReturns(&vaultapi.Secret{
+				Data: map[string]interface{}{"ciphertext": "vault:v1:wrapped"},
+			}, nil)
+		})
+
+		It("encrypts the data key with the transit key", func() {
+			keyID, wrappedKey, err := provider.WrapKey([]byte("some-data-key"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keyID).To(Equal("concourse"))
+			Expect(wrappedKey).To(Equal([]byte("vault:v1:wrapped")))
+
+			path, data := fakeLogical.WriteArgsForCall(0)
+			Expect(path).To(Equal("transit/encrypt/concourse"))
+			Expect(data).To(Equal(map[string]interface{}{
+				"plaintext": base64.StdEncoding.EncodeToString([]byte("some-data-key")),
+			}))
+		})
+
+		Context("when vault fails", func() {
+			BeforeEach(func() {
+				fakeLogical.WriteReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				_, _, err := provider.WrapKey([]byte("some-data-key"))
+				Expect(err).To(MatchError("failed to wrap data key: disaster"))
+			})
+		})
+	})
+
+	Describe("UnwrapKey", func() {
+		BeforeEach(func() {
+			fakeLogical.WriteReturns(&vaultapi.Secret{
+				Data: map[string]interface{}{
+					"plaintext": base64.StdEncoding.EncodeToString([]byte("some-data-key")),
+				},
+			}, nil)
+		})
+
+		It("decrypts the data key with the transit key it was wrapped with", func() {
+			dataKey, err := provider.UnwrapKey("old-key", []byte("vault:v1:wrapped"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dataKey).To(Equal([]byte("some-data-key")))
+
+			path, data := fakeLogical.WriteArgsForCall(0)
+			Expect(path).To(Equal("transit/decrypt/old-key"))
+			Expect(data).To(Equal(map[string]interface{}{
+				"ciphertext": "vault:v1:wrapped",
+			}))
+		})
+
+		Context("when vault returns no plaintext", func() {
+			BeforeEach(func() {
+				fakeLogical.WriteReturns(&vaultapi.Secret{}, nil)
+			})
+
+			It("fails", func() {
+				_, err := provider.UnwrapKey("old-key", []byte("vault:v1:wrapped"))
+				Expect(err).To(MatchError("vault transit returned no data"))
+			})
+		})
+	})
+})
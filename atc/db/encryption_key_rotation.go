@@ -0,0 +1,434 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/encryption"
+)
+
+var ErrEncryptedWithUnknownKey = errors.New("row encrypted with neither old nor new key")
+
+//go:generate counterfeiter . EncryptionKeyRotation
+
+// EncryptionKeyRotation re-encrypts every encrypted column with the new
+// encryption key, or decrypts them to plaintext if there is no new key, a
+// batch of rows at a time. Its progress is kept in the database so that it
+// is resumed rather than restarted when the ATC restarts, and it is only
+// restarted when the keys change.
+type EncryptionKeyRotation interface {
+	RotateBatch(batchSize int) (bool, error)
+	Status() (atc.EncryptionKeyRotation, error)
+}
+
+type encryptionKeyRotation struct {
+	conn Conn
+
+	newStrategy encryption.Strategy
+	oldStrategy encryption.Strategy
+	keys        string
+}
+
+func NewEncryptionKeyRotation(conn Conn, newKey encryption.Strategy, oldKey encryption.Strategy) EncryptionKeyRotation {
+	keys := encryption.Fingerprint(oldKey) + ":" + encryption.Fingerprint(newKey)
+
+	if newKey == nil {
+		newKey = encryption.NewNoEncryption()
+	}
+
+	if oldKey == nil {
+		oldKey = encryption.NewNoEncryption()
+	}
+
+	return &encryptionKeyRotation{
+		conn: conn,
+
+		newStrategy: newKey,
+		oldStrategy: oldKey,
+		keys:        keys,
+	}
+}
+
+type rotationColumn struct {
+	encryptedColumn
+
+	cursor sql.NullString
+	done   bool
+}
+
+// RotateBatch rotates the next batch of rows of the first column which has
+// not been rotated yet, starting a new rotation first if the keys have
+// changed since the last one. It returns true once every column has been
+// rotated.
+func (r *encryptionKeyRotation) RotateBatch(batchSize int) (bool, error) {
+	rotationID, finished, err := r.start()
+	if err != nil {
+		return false, err
+	}
+
+	if finished {
+		return true, nil
+	}
+
+	column, found, err := r.nextColumn(rotationID)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		_, err = psql.Update("encryption_key_rotations").
+			Set("finished_at", sq.Expr("now()")).
+			Where(sq.Eq{"id": rotationID}).
+			RunWith(r.conn).
+			Exec()
+		if err != nil {
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	rotateErr := r.rotateBatch(rotationID, column, batchSize)
+
+	var lastError sql.NullString
+	if rotateErr != nil {
+		lastError = sql.NullString{String: rotateErr.Error(), Valid: true}
+	}
+
+	_, err = psql.Update("encryption_key_rotations").
+		Set("last_error", lastError).
+		Where(sq.Eq{"id": rotationID}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	return false, rotateErr
+}
+
+func (r *encryptionKeyRotation) start() (int, bool, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return 0, false, err
+	}
+
+	defer Rollback(tx)
+
+	var (
+		id         int
+		keys       string
+		finishedAt sql.NullTime
+	)
+
+	err = psql.Select("id", "keys", "finished_at").
+		From("encryption_key_rotations").
+		OrderBy("id DESC").
+		Limit(1).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&id, &keys, &finishedAt)
+	if err != nil && err != sql.ErrNoRows {
+		return 0, false, err
+	}
+
+	if err == nil && keys == r.keys {
+		return id, finishedAt.Valid, nil
+	}
+
+	_, err = psql.Delete("encryption_key_rotations").
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = psql.Insert("encryption_key_rotations").
+		Columns("keys").
+		Values(r.keys).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, ec := range encryptedColumns {
+		var rows int
+		err = psql.Select("COUNT(*)").
+			From(ec.Table).
+			Where(sq.NotEq{ec.Column: nil}).
+			RunWith(tx).
+			QueryRow().
+			Scan(&rows)
+		if err != nil {
+			return 0, false, err
+		}
+
+		_, err = psql.Insert("encryption_key_rotation_columns").
+			Columns("rotation_id", "table_name", "column_name", "rows_total").
+			Values(id, ec.Table, ec.Column, rows).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return 0, false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, false, err
+	}
+
+	return id, false, nil
+}
+
+func (r *encryptionKeyRotation) nextColumn(rotationID int) (rotationColumn, bool, error) {
+	rows, err := psql.Select("table_name", "column_name", "cursor", "done").
+		From("encryption_key_rotation_columns").
+		Where(sq.Eq{"rotation_id": rotationID}).
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return rotationColumn{}, false, err
+	}
+
+	defer Close(rows)
+
+	progress := map[encryptedColumn]rotationColumn{}
+	for rows.Next() {
+		var column rotationColumn
+		err = rows.Scan(&column.Table, &column.Column, &column.cursor, &column.done)
+		if err != nil {
+			return rotationColumn{}, false, err
+		}
+
+		progress[encryptedColumn{Table: column.Table, Column: column.Column}] = column
+	}
+
+	for _, ec := range encryptedColumns {
+		column, found := progress[encryptedColumn{Table: ec.Table, Column: ec.Column}]
+		if !found || column.done {
+			continue
+		}
+
+		column.PrimaryKey = ec.PrimaryKey
+
+		return column, true, nil
+	}
+
+	return rotationColumn{}, false, nil
+}
+
+func (r *encryptionKeyRotation) rotateBatch(rotationID int, column rotationColumn, batchSize int) error {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	query := psql.Select(column.PrimaryKey, "nonce", column.Column).
+		From(column.Table).
+		Where(sq.NotEq{column.Column: nil}).
+		OrderBy(column.PrimaryKey).
+		Limit(uint64(batchSize))
+
+	if column.cursor.Valid {
+		query = query.Where(sq.Gt{column.PrimaryKey: column.cursor.String})
+	}
+
+	rows, err := query.RunWith(tx).Query()
+	if err != nil {
+		return err
+	}
+
+	type row struct {
+		primaryKey interface{}
+		nonce      sql.NullString
+		val        string
+	}
+
+	var batch []row
+	for rows.Next() {
+		var r row
+		err = rows.Scan(&r.primaryKey, &r.nonce, &r.val)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		batch = append(batch, r)
+	}
+
+	Close(rows)
+
+	rotated := 0
+	for _, row := range batch {
+		var nonce *string
+		if row.nonce.Valid {
+			nonce = &row.nonce.String
+		}
+
+		changed, err := r.rotateRow(tx, column.encryptedColumn, row.primaryKey, row.val, nonce)
+		if err != nil {
+			return fmt.Errorf("rotate %s.%s of row %v: %w", column.Table, column.Column, row.primaryKey, err)
+		}
+
+		if changed {
+			rotated++
+		}
+	}
+
+	update := psql.Update("encryption_key_rotation_columns").
+		Set("rows_processed", sq.Expr("rows_processed + ?", len(batch))).
+		Set("rows_rotated", sq.Expr("rows_rotated + ?", rotated)).
+		Set("done", len(batch) < batchSize).
+		Where(sq.Eq{
+			"rotation_id": rotationID,
+			"table_name":  column.Table,
+			"column_name": column.Column,
+		})
+
+	if len(batch) > 0 {
+		update = update.Set("cursor", fmt.Sprint(batch[len(batch)-1].primaryKey))
+	}
+
+	_, err = update.RunWith(tx).Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// rotateRow re-encrypts a single value with the new strategy. The update is
+// conditional on the row not having been changed since it was read, as any
+// concurrent write already uses the new strategy.
+func (r *encryptionKeyRotation) rotateRow(tx Tx, ec encryptedColumn, primaryKey interface{}, val string, nonce *string) (bool, error) {
+	var (
+		plaintext []byte
+		oldNonce  interface{}
+	)
+
+	if nonce == nil {
+		plaintext = []byte(val)
+	} else {
+		_, err := r.newStrategy.Decrypt(val, nonce)
+		if err == nil {
+			return false, nil
+		}
+
+		plaintext, err = r.oldStrategy.Decrypt(val, nonce)
+		if err != nil {
+			return false, ErrEncryptedWithUnknownKey
+		}
+
+		oldNonce = *nonce
+	}
+
+	encrypted, newNonce, err := r.newStrategy.Encrypt(plaintext)
+	if err != nil {
+		return false, err
+	}
+
+	if nonce == nil && newNonce == nil {
+		return false, nil
+	}
+
+	result, err := psql.Update(ec.Table).
+		Set(ec.Column, encrypted).
+		Set("nonce", newNonce).
+		Where(sq.Eq{
+			ec.PrimaryKey: primaryKey,
+			"nonce":       oldNonce,
+			ec.Column:     val,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+// Status returns the progress of the most recent rotation, or an empty
+// rotation if there has never been one.
+func (r *encryptionKeyRotation) Status() (atc.EncryptionKeyRotation, error) {
+	var (
+		rotation   atc.EncryptionKeyRotation
+		id         int
+		startedAt  sql.NullTime
+		finishedAt sql.NullTime
+		lastError  sql.NullString
+	)
+
+	err := psql.Select("id", "started_at", "finished_at", "last_error").
+		From("encryption_key_rotations").
+		OrderBy("id DESC").
+		Limit(1).
+		RunWith(r.conn).
+		QueryRow().
+		Scan(&id, &startedAt, &finishedAt, &lastError)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.EncryptionKeyRotation{}, nil
+		}
+
+		return atc.EncryptionKeyRotation{}, err
+	}
+
+	rotation.State = atc.EncryptionKeyRotationRunning
+	if startedAt.Valid {
+		rotation.StartedAt = startedAt.Time.Unix()
+	}
+
+	if finishedAt.Valid {
+		rotation.State = atc.EncryptionKeyRotationDone
+		rotation.FinishedAt = finishedAt.Time.Unix()
+	}
+
+	rotation.LastError = lastError.String
+
+	rows, err := psql.Select("table_name", "column_name", "rows_total", "rows_processed", "rows_rotated", "done").
+		From("encryption_key_rotation_columns").
+		Where(sq.Eq{"rotation_id": id}).
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return atc.EncryptionKeyRotation{}, err
+	}
+
+	defer Close(rows)
+
+	progress := map[encryptedColumn]atc.EncryptionKeyRotationColumn{}
+	for rows.Next() {
+		var column atc.EncryptionKeyRotationColumn
+		err = rows.Scan(&column.Table, &column.Column, &column.RowsTotal, &column.RowsProcessed, &column.RowsRotated, &column.Done)
+		if err != nil {
+			return atc.EncryptionKeyRotation{}, err
+		}
+
+		progress[encryptedColumn{Table: column.Table, Column: column.Column}] = column
+	}
+
+	for _, ec := range encryptedColumns {
+		column, found := progress[encryptedColumn{Table: ec.Table, Column: ec.Column}]
+		if found {
+			rotation.Columns = append(rotation.Columns, column)
+		}
+	}
+
+	return rotation, nil
+}
@@ -0,0 +1,260 @@
+package db_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"database/sql"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptionKeyRotation", func() {
+	var (
+		oldKey *encryption.Key
+		newKey *encryption.Key
+
+		otherTeam db.Team
+	)
+
+	newTestKey := func(k string) *encryption.Key {
+		block, err := aes.NewCipher([]byte(k))
+		Expect(err).ToNot(HaveOccurred())
+
+		aesgcm, err := cipher.NewGCM(block)
+		Expect(err).ToNot(HaveOccurred())
+
+		return encryption.NewKey(aesgcm)
+	}
+
+	setLegacyAuth := func(team db.Team, strategy encryption.Strategy, auth string) {
+		encrypted, nonce, err := strategy.Encrypt([]byte(auth))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = dbConn.Exec(`UPDATE teams SET legacy_auth = $1, nonce = $2 WHERE id = $3`, encrypted, nonce, team.ID())
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	legacyAuth := func(team db.Team, strategy encryption.Strategy) (string, error) {
+		var (
+			val   string
+			nonce sql.NullString
+		)
+
+		err := dbConn.QueryRow(`SELECT legacy_auth, nonce FROM teams WHERE id = $1`, team.ID()).Scan(&val, &nonce)
+		Expect(err).ToNot(HaveOccurred())
+
+		var noncePtr *string
+		if nonce.Valid {
+			noncePtr = &nonce.String
+		}
+
+		decrypted, err := strategy.Decrypt(val, noncePtr)
+		return string(decrypted), err
+	}
+
+	rotateAll := func(rotation db.EncryptionKeyRotation) {
+		for i := 0; i < 100; i++ {
+			done, err := rotation.RotateBatch(1)
+			Expect(err).ToNot(HaveOccurred())
+
+			if done {
+				return
+			}
+		}
+
+		Fail("rotation did not finish")
+	}
+
+	BeforeEach(func() {
+		oldKey = newTestKey("AES256Key-32Characters1234567890")
+		newKey = newTestKey("AES256Key-32Characters9564567123")
+
+		var err error
+		otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("when there has never been a rotation", func() {
+		It("has an empty status", func() {
+			status, err := db.NewEncryptionKeyRotation(dbConn, newKey, oldKey).Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(Equal(atc.EncryptionKeyRotation{}))
+		})
+	})
+
+	Context("when rotating from an old key to a new key", func() {
+		var rotation db.EncryptionKeyRotation
+
+		BeforeEach(func() {
+			setLegacyAuth(defaultTeam, oldKey, "default-auth")
+			setLegacyAuth(otherTeam, oldKey, "other-auth")
+
+			rotation = db.NewEncryptionKeyRotation(dbConn, newKey, oldKey)
+		})
+
+		It("re-encrypts the rows with the new key", func() {
+			rotateAll(rotation)
+
+			auth, err := legacyAuth(defaultTeam, newKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal("default-auth"))
+
+			auth, err = legacyAuth(otherTeam, newKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal("other-auth"))
+
+			status, err := rotation.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.State).To(Equal(atc.EncryptionKeyRotationDone))
+			Expect(status.FinishedAt).ToNot(BeZero())
+			Expect(status.RowsRemaining()).To(BeZero())
+			Expect(status.Columns).To(ContainElement(atc.EncryptionKeyRotationColumn{
+				Table:         "teams",
+				Column:        "legacy_auth",
+				RowsTotal:     2,
+				RowsProcessed: 2,
+				RowsRotated:   2,
+				Done:          true,
+			}))
+		})
+
+		It("tracks its progress between batches", func() {
+			done, err := rotation.RotateBatch(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeFalse())
+
+			status, err := rotation.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.State).To(Equal(atc.EncryptionKeyRotationRunning))
+			Expect(status.Columns[0]).To(Equal(atc.EncryptionKeyRotationColumn{
+				Table:         "teams",
+				Column:        "legacy_auth",
+				RowsTotal:     2,
+				RowsProcessed: 1,
+				RowsRotated:   1,
+			}))
+
+			By("resuming from where it left off")
+			_, err = db.NewEncryptionKeyRotation(dbConn, newKey, oldKey).RotateBatch(1)
+			Expect(err).ToNot(HaveOccurred())
+
+			status, err = rotation.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Columns[0].RowsProcessed).To(Equal(2))
+			Expect(status.Columns[0].RowsRotated).To(Equal(2))
+		})
+
+		It("leaves rows already encrypted with the new key alone", func() {
+			setLegacyAuth(otherTeam, newKey, "other-auth")
+
+			rotateAll(rotation)
+
+			status, err := rotation.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Columns[0].RowsRotated).To(Equal(1))
+		})
+
+		It("starts over when the keys change", func() {
+			rotateAll(rotation)
+
+			rotation = db.NewEncryptionKeyRotation(dbConn, oldKey, newKey)
+
+			status, err := rotation.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.State).To(Equal(atc.EncryptionKeyRotationDone))
+
+			done, err := rotation.RotateBatch(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeFalse())
+
+			status, err = rotation.Status()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.State).To(Equal(atc.EncryptionKeyRotationRunning))
+
+			rotateAll(rotation)
+
+			auth, err := legacyAuth(defaultTeam, oldKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal("default-auth"))
+		})
+
+		Context("when a row is encrypted with neither key", func() {
+			BeforeEach(func() {
+				setLegacyAuth(defaultTeam, newTestKey("AES256Key-32Characters0000000000"), "default-auth")
+			})
+
+			It("fails and records the error", func() {
+				var err error
+				for i := 0; i < 100 && err == nil; i++ {
+					_, err = rotation.RotateBatch(1)
+				}
+				Expect(errors.Is(err, db.ErrEncryptedWithUnknownKey)).To(BeTrue())
+
+				status, err := rotation.Status()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(status.State).To(Equal(atc.EncryptionKeyRotationRunning))
+				Expect(status.LastError).To(ContainSubstring(db.ErrEncryptedWithUnknownKey.Error()))
+			})
+		})
+	})
+
+	Context("when decrypting to plaintext", func() {
+		BeforeEach(func() {
+			setLegacyAuth(defaultTeam, oldKey, "default-auth")
+		})
+
+		It("decrypts the rows", func() {
+			rotateAll(db.NewEncryptionKeyRotation(dbConn, nil, oldKey))
+
+			auth, err := legacyAuth(defaultTeam, encryption.NewNoEncryption())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal("default-auth"))
+		})
+	})
+
+	Context("when encrypting plaintext", func() {
+		BeforeEach(func() {
+			setLegacyAuth(defaultTeam, encryption.NewNoEncryption(), "default-auth")
+		})
+
+		It("encrypts the rows with the new key", func() {
+			rotateAll(db.NewEncryptionKeyRotation(dbConn, newKey, oldKey))
+
+			auth, err := legacyAuth(defaultTeam, newKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal("default-auth"))
+		})
+	})
+
+	Context("when moving from a raw key to envelope encryption", func() {
+		var envelope *encryption.Envelope
+
+		BeforeEach(func() {
+			block, err := aes.NewCipher([]byte("AES256Key-32Characters0000000000"))
+			Expect(err).ToNot(HaveOccurred())
+
+			aesgcm, err := cipher.NewGCM(block)
+			Expect(err).ToNot(HaveOccurred())
+
+			provider, err := encryption.NewLocalKeyProvider(map[string]cipher.AEAD{"some-key": aesgcm}, "some-key")
+			Expect(err).ToNot(HaveOccurred())
+
+			envelope = encryption.NewEnvelope(provider)
+
+			setLegacyAuth(defaultTeam, oldKey, "default-auth")
+		})
+
+		It("wraps a data key for every row", func() {
+			rotateAll(db.NewEncryptionKeyRotation(dbConn, envelope, oldKey))
+
+			auth, err := legacyAuth(defaultTeam, envelope)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(auth).To(Equal("default-auth"))
+		})
+	})
+})
@@ -39,6 +39,7 @@ type Job interface {
 	ScheduleRequestedTime() time.Time
 	MaxInFlight() int
 	DisableManualTrigger() bool
+	Priority() int
 
 	Config() (atc.JobConfig, error)
 	Inputs() ([]atc.JobInput, error)
@@ -63,6 +64,7 @@ type Job interface {
 	UpdateFirstLoggedBuildID(newFirstLoggedBuildID int) error
 	EnsurePendingBuildExists() error
 	GetPendingBuilds() ([]Build, error)
+	QueuedBuilds() ([]atc.BuildQueuePosition, error)
 
 	GetNextBuildInputs() ([]BuildInput, error)
 	GetFullNextBuildInputs() ([]BuildInput, bool, error)
@@ -76,7 +78,7 @@ type Job interface {
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger", "COALESCE(j.priority, t.default_build_priority, 0)").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -106,6 +108,7 @@ type job struct {
 	scheduleRequestedTime time.Time
 	maxInFlight           int
 	disableManualTrigger  bool
+	priority              int
 
 	config    *atc.JobConfig
 	rawConfig []byte
@@ -156,6 +159,7 @@ func (jobs Jobs) Configs() (atc.JobConfigs, error) {
 }
 
 func (j *job) ID() int                          { return j.id }
+func (j *job) Priority() int                    { return j.priority }
 func (j *job) Name() string                     { return j.name }
 func (j *job) Paused() bool                     { return j.paused }
 func (j *job) Public() bool                     { return j.public }
@@ -440,6 +444,13 @@ func (j *job) ScheduleBuild(build Build) (bool, error) {
 		return false, err
 	}
 
+	if !reached {
+		reached, err = j.isTeamLimitReached(tx, build.ID())
+		if err != nil {
+			return false, err
+		}
+	}
+
 	result, err := psql.Update("jobs").
 		Set("max_in_flight_reached", reached).
 		Where(sq.Eq{
@@ -597,6 +608,18 @@ func (j *job) EnsurePendingBuildExists() error {
 	return nil
 }
 
+func (j *job) QueuedBuilds() ([]atc.BuildQueuePosition, error) {
+	rows, err := j.conn.Query(buildQueueQuery+`
+		WHERE q.job_id = $1
+		ORDER BY q.position ASC
+	`, j.id)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanBuildQueuePositions(rows)
+}
+
 func (j *job) GetPendingBuilds() ([]Build, error) {
 	builds := []Build{}
 
@@ -839,6 +862,137 @@ func (j *job) getSerialGroups(tx Tx) ([]string, error) {
 	return serialGroups, nil
 }
 
+// isTeamLimitReached determines whether the build has to wait for a limit
+// shared by all pipelines of the team, i.e. the team's maximum number of
+// running builds or one of the job's team serial groups.
+//
+// When either applies, the team is locked for the rest of the transaction so
+// that builds of different pipelines, which may be scheduled by different web
+// nodes at the same time, do not exceed the limits together. Teams without
+// limits are never locked, so their builds are scheduled concurrently.
+func (j *job) isTeamLimitReached(tx Tx, buildID int) (bool, error) {
+	teamSerialGroups, err := j.getTeamSerialGroups(tx)
+	if err != nil {
+		return false, err
+	}
+
+	maxRunningBuilds, err := j.getTeamMaxRunningBuilds(tx, false)
+	if err != nil {
+		return false, err
+	}
+
+	if maxRunningBuilds == 0 && len(teamSerialGroups) == 0 {
+		return false, nil
+	}
+
+	// read the limit again, as it may have changed before the team was locked
+	maxRunningBuilds, err = j.getTeamMaxRunningBuilds(tx, true)
+	if err != nil {
+		return false, err
+	}
+
+	if maxRunningBuilds > 0 {
+		var runningBuilds int
+		err = psql.Select("COUNT(*)").
+			From("builds").
+			Where(sq.Eq{
+				"team_id":   j.teamID,
+				"completed": false,
+			}).
+			Where(sq.Or{
+				sq.Eq{"status": BuildStatusStarted},
+				sq.Eq{"scheduled": true},
+			}).
+			RunWith(tx).
+			QueryRow().
+			Scan(&runningBuilds)
+		if err != nil {
+			return false, err
+		}
+
+		if runningBuilds >= maxRunningBuilds {
+			return true, nil
+		}
+	}
+
+	if len(teamSerialGroups) == 0 {
+		return false, nil
+	}
+
+	builds, err := j.getRunningBuildsByTeamSerialGroup(tx, teamSerialGroups)
+	if err != nil {
+		return false, err
+	}
+
+	if len(builds) > 0 {
+		return true, nil
+	}
+
+	nextMostPendingBuild, found, err := j.getNextPendingBuildByTeamSerialGroup(tx, teamSerialGroups)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return true, nil
+	}
+
+	if nextMostPendingBuild.ID() != buildID {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (j *job) getTeamMaxRunningBuilds(tx Tx, lock bool) (int, error) {
+	query := psql.Select("max_running_builds").
+		From("teams").
+		Where(sq.Eq{"id": j.teamID})
+
+	if lock {
+		query = query.Suffix("FOR UPDATE")
+	}
+
+	var maxRunningBuilds int
+	err := query.
+		RunWith(tx).
+		QueryRow().
+		Scan(&maxRunningBuilds)
+	if err != nil {
+		return 0, err
+	}
+
+	return maxRunningBuilds, nil
+}
+
+func (j *job) getTeamSerialGroups(tx Tx) ([]string, error) {
+	rows, err := psql.Select("serial_group").
+		From("jobs_team_serial_groups").
+		Where(sq.Eq{
+			"job_id": j.id,
+		}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var serialGroups []string
+	for rows.Next() {
+		var serialGroup string
+		err = rows.Scan(&serialGroup)
+		if err != nil {
+			return nil, err
+		}
+
+		serialGroups = append(serialGroups, serialGroup)
+	}
+
+	return serialGroups, nil
+}
+
 func (j *job) RequestSchedule() error {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -924,6 +1078,68 @@ func (j *job) getNextPendingBuildBySerialGroup(tx Tx, serialGroups []string) (Bu
 	return build, true, nil
 }
 
+func (j *job) getRunningBuildsByTeamSerialGroup(tx Tx, serialGroups []string) ([]Build, error) {
+	rows, err := buildsQuery.Options(`DISTINCT ON (b.id)`).
+		Join(`jobs_team_serial_groups jtsg ON j.id = jtsg.job_id`).
+		Where(sq.Eq{
+			"jtsg.serial_group": serialGroups,
+			"b.team_id":         j.teamID,
+		}).
+		Where(sq.Eq{"b.completed": false, "b.scheduled": true}).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	bs := []Build{}
+
+	for rows.Next() {
+		build := newEmptyBuild(j.conn, j.lockFactory)
+		err = scanBuild(build, rows, j.conn.EncryptionStrategy())
+		if err != nil {
+			return nil, err
+		}
+
+		bs = append(bs, build)
+	}
+
+	return bs, nil
+}
+
+// getNextPendingBuildByTeamSerialGroup only considers builds of active jobs
+// in unpaused pipelines, as unlike with serial groups the jobs sharing a team
+// serial group can be paused independently of each other.
+func (j *job) getNextPendingBuildByTeamSerialGroup(tx Tx, serialGroups []string) (Build, bool, error) {
+	row := buildsQuery.Options(`DISTINCT ON (b.id)`).
+		Join(`jobs_team_serial_groups jtsg ON j.id = jtsg.job_id`).
+		Where(sq.Eq{
+			"jtsg.serial_group":   serialGroups,
+			"b.status":            BuildStatusPending,
+			"j.active":            true,
+			"j.paused":            false,
+			"j.inputs_determined": true,
+			"p.paused":            false,
+			"b.team_id":           j.teamID}).
+		OrderBy("b.id ASC").
+		Limit(1).
+		RunWith(tx).
+		QueryRow()
+
+	build := newEmptyBuild(j.conn, j.lockFactory)
+	err := scanBuild(build, row, j.conn.EncryptionStrategy())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return build, true, nil
+}
+
 func (j *job) updatePausedJob(pause bool) error {
 	result, err := psql.Update("jobs").
 		Set("paused", pause).
@@ -1185,7 +1401,7 @@ func scanJob(j *job, row scannable) error {
 		nonce sql.NullString
 	)
 
-	err := row.Scan(&j.id, &j.name, &j.rawConfig, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	err := row.Scan(&j.id, &j.name, &j.rawConfig, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &j.priority)
 	if err != nil {
 		return err
 	}
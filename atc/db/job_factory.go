@@ -82,10 +82,12 @@ func (j *jobFactory) JobsToSchedule() (Jobs, error) {
 	rows, err := jobsQuery.
 		Where(sq.Expr("j.schedule_requested > j.last_scheduled")).
 		Where(sq.Eq{
-			"j.active": true,
-			"j.paused": false,
-			"p.paused": false,
+			"j.active":   true,
+			"j.paused":   false,
+			"p.paused":   false,
+			"p.archived": false,
 		}).
+		OrderBy("COALESCE(j.priority, t.default_build_priority, 0) DESC", "j.id ASC").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
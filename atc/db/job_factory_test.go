@@ -21,7 +21,7 @@ var _ = Describe("Job Factory", func() {
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err = otherTeam.SavePipeline("public-pipeline", atc.Config{
+			publicPipeline, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "public-pipeline-job-1",
@@ -85,7 +85,7 @@ var _ = Describe("Job Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
-			_, _, err = otherTeam.SavePipeline("private-pipeline", atc.Config{
+			_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "private-pipeline-job",
@@ -331,7 +331,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job has a requested schedule time later than the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -357,7 +357,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job has a requested schedule time earlier than the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -382,7 +382,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job has a requested schedule time is the same as the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -414,7 +414,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when there are multiple jobs with different times", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -432,7 +432,7 @@ var _ = Describe("Job Factory", func() {
 				team, err := teamFactory.CreateTeam(atc.Team{Name: "some-team"})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err := team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err := team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
@@ -443,7 +443,7 @@ var _ = Describe("Job Factory", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				pipeline3, _, err := team.SavePipeline("fake-pipeline-three", atc.Config{
+				pipeline3, _, err := team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-three"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake-two"},
 					},
@@ -472,7 +472,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job is paused but has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -500,7 +500,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job is inactive but has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
@@ -515,7 +515,7 @@ var _ = Describe("Job Factory", func() {
 				err = job1.RequestSchedule()
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = defaultTeam.SavePipeline("fake-pipeline", atc.Config{}, pipeline1.ConfigVersion(), false)
+				_, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{}, pipeline1.ConfigVersion(), false)
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -528,7 +528,7 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the pipeline is paused but it's job has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
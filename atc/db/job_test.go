@@ -23,7 +23,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -179,7 +179,7 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
@@ -409,7 +409,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -646,7 +646,7 @@ var _ = Describe("Job", func() {
 		saveMaxInFlightPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -719,7 +719,7 @@ var _ = Describe("Job", func() {
 		saveSerialGroupsPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -817,7 +817,7 @@ var _ = Describe("Job", func() {
 
 			Context("when the job config doesn't specify max in flight", func() {
 				BeforeEach(func() {
-					pipeline, created, err := team.SavePipeline("other-pipeline", atc.Config{
+					pipeline, created, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "some-job",
@@ -1201,6 +1201,196 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("ScheduleBuild with team limits", func() {
+		var (
+			deployJob, otherDeployJob db.Job
+		)
+
+		BeforeEach(func() {
+			stagingPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "staging-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name:             "deploy",
+						TeamSerialGroups: []string{"staging"},
+					},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-staging-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name:             "deploy",
+						TeamSerialGroups: []string{"staging"},
+					},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			deployJob, found, err = stagingPipeline.Job("deploy")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			otherDeployJob, found, err = otherPipeline.Job("deploy")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = deployJob.SaveNextInputMapping(nil, true)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = otherDeployJob.SaveNextInputMapping(nil, true)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when a build of another pipeline in the team serial group is running", func() {
+			var runningBuild db.Build
+
+			BeforeEach(func() {
+				var err error
+				runningBuild, err = otherDeployJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				scheduled, err := otherDeployJob.ScheduleBuild(runningBuild)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scheduled).To(BeTrue())
+			})
+
+			It("does not schedule the build", func() {
+				build, err := deployJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				scheduled, err := deployJob.ScheduleBuild(build)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scheduled).To(BeFalse())
+			})
+
+			Context("when the running build finishes", func() {
+				BeforeEach(func() {
+					err := runningBuild.Finish(db.BuildStatusSucceeded)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("schedules the build", func() {
+					build, err := deployJob.CreateBuild()
+					Expect(err).ToNot(HaveOccurred())
+
+					scheduled, err := deployJob.ScheduleBuild(build)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when a build of another pipeline in the team serial group was created earlier", func() {
+			BeforeEach(func() {
+				_, err := otherDeployJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not schedule the build", func() {
+				build, err := deployJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				scheduled, err := deployJob.ScheduleBuild(build)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scheduled).To(BeFalse())
+			})
+
+			Context("when the other pipeline is paused", func() {
+				BeforeEach(func() {
+					otherPipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "other-staging-pipeline"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					err = otherPipeline.Pause()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("schedules the build", func() {
+					build, err := deployJob.CreateBuild()
+					Expect(err).ToNot(HaveOccurred())
+
+					scheduled, err := deployJob.ScheduleBuild(build)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the team has no limits", func() {
+			var (
+				build  db.Build
+				lockTx db.Tx
+			)
+
+			BeforeEach(func() {
+				var err error
+				build, err = job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				lockTx, err = dbConn.Begin()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = lockTx.Exec(`SELECT 1 FROM teams WHERE id = $1 FOR UPDATE`, team.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				Expect(lockTx.Rollback()).To(Succeed())
+			})
+
+			It("schedules the build without locking the team", func() {
+				scheduled := make(chan bool, 1)
+				go func() {
+					defer GinkgoRecover()
+
+					ok, err := job.ScheduleBuild(build)
+					Expect(err).ToNot(HaveOccurred())
+					scheduled <- ok
+				}()
+
+				Eventually(scheduled).Should(Receive(BeTrue()))
+			})
+		})
+
+		Context("when the team limits the number of running builds", func() {
+			BeforeEach(func() {
+				err := team.UpdateMaxRunningBuilds(1)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("schedules a build while under the limit", func() {
+				build, err := job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				scheduled, err := job.ScheduleBuild(build)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scheduled).To(BeTrue())
+			})
+
+			Context("when the limit is reached by a build of another pipeline", func() {
+				BeforeEach(func() {
+					build, err := otherDeployJob.CreateBuild()
+					Expect(err).ToNot(HaveOccurred())
+
+					scheduled, err := otherDeployJob.ScheduleBuild(build)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+				})
+
+				It("does not schedule the build", func() {
+					build, err := job.CreateBuild()
+					Expect(err).ToNot(HaveOccurred())
+
+					scheduled, err := job.ScheduleBuild(build)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scheduled).To(BeFalse())
+				})
+			})
+		})
+	})
+
 	Describe("GetNextBuildInputs", func() {
 		var (
 			versions            []atc.ResourceVersion
@@ -1222,7 +1412,7 @@ var _ = Describe("Job", func() {
 			Expect(setupTx.Commit()).To(Succeed())
 
 			var created bool
-			pipeline, created, err = team.SavePipeline("build-inputs-pipeline", atc.Config{
+			pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "build-inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -1453,7 +1643,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1636,7 +1826,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
@@ -1805,6 +1995,101 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("Priority", func() {
+		var otherJob db.Job
+
+		BeforeEach(func() {
+			priority := 10
+
+			var err error
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "priority-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job", Priority: &priority},
+					{Name: "some-other-job"},
+				},
+			}, db.ConfigVersion(0), false)
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			job, found, err = pipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			otherJob, found, err = pipeline.Job("some-other-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("returns the configured priority", func() {
+			Expect(job.Priority()).To(Equal(10))
+		})
+
+		It("defaults to the team's default build priority", func() {
+			Expect(otherJob.Priority()).To(Equal(0))
+
+			err := team.UpdateDefaultBuildPriority(5)
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := otherJob.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(otherJob.Priority()).To(Equal(5))
+		})
+
+		Describe("QueuedBuilds", func() {
+			var (
+				otherBuild1, build, otherBuild2 db.Build
+			)
+
+			BeforeEach(func() {
+				var err error
+				otherBuild1, err = otherJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				build, err = job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				otherBuild2, err = otherJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the positions of the job's pending builds ordered by priority and age", func() {
+				queue, err := job.QueuedBuilds()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queue).To(Equal([]atc.BuildQueuePosition{
+					{BuildID: build.ID(), BuildName: build.Name(), JobName: "some-job", Priority: 10, Position: 1},
+				}))
+
+				queue, err = otherJob.QueuedBuilds()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queue).To(Equal([]atc.BuildQueuePosition{
+					{BuildID: otherBuild1.ID(), BuildName: otherBuild1.Name(), JobName: "some-other-job", Priority: 0, Position: 2},
+					{BuildID: otherBuild2.ID(), BuildName: otherBuild2.Name(), JobName: "some-other-job", Priority: 0, Position: 3},
+				}))
+			})
+
+			It("does not include builds which have started", func() {
+				started, err := build.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				queue, err := job.QueuedBuilds()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(queue).To(BeEmpty())
+
+				position, found, err := otherBuild1.QueuePosition()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(position.Position).To(Equal(1))
+
+				_, found, err = build.QueuePosition()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Clear task cache", func() {
 		Context("when task cache exists", func() {
 			var (
@@ -1972,7 +2257,7 @@ var _ = Describe("Job", func() {
 		var inputsJob db.Job
 
 		BeforeEach(func() {
-			inputsPipeline, _, err := team.SavePipeline("inputs-pipeline", atc.Config{
+			inputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2083,7 +2368,7 @@ var _ = Describe("Job", func() {
 		var outputsJob db.Job
 
 		BeforeEach(func() {
-			outputsPipeline, _, err := team.SavePipeline("outputs-pipeline", atc.Config{
+			outputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "outputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -0,0 +1,8 @@
+BEGIN;
+  DROP INDEX pipelines_parent_job_id;
+
+  ALTER TABLE pipelines
+    DROP COLUMN archived,
+    DROP COLUMN parent_job_id,
+    DROP COLUMN parent_build_id;
+COMMIT;
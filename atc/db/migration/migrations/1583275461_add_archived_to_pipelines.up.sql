@@ -0,0 +1,8 @@
+BEGIN;
+  ALTER TABLE pipelines
+    ADD COLUMN archived boolean NOT NULL DEFAULT false,
+    ADD COLUMN parent_job_id integer REFERENCES jobs (id) ON DELETE SET NULL,
+    ADD COLUMN parent_build_id integer;
+
+  CREATE INDEX pipelines_parent_job_id ON pipelines (parent_job_id);
+COMMIT;
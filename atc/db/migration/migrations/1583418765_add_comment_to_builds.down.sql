@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE builds DROP COLUMN comment;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN cpu_load,
+    DROP COLUMN memory_load;
+COMMIT;
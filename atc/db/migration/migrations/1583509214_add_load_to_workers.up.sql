@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN cpu_load double precision NOT NULL DEFAULT 0,
+    ADD COLUMN memory_load double precision NOT NULL DEFAULT 0;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  DROP TABLE worker_task_queue;
+
+  ALTER TABLE teams DROP COLUMN default_build_priority;
+
+  ALTER TABLE jobs DROP COLUMN priority;
+COMMIT;
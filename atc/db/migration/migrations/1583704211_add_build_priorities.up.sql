@@ -0,0 +1,13 @@
+BEGIN;
+  ALTER TABLE jobs ADD COLUMN priority integer;
+
+  ALTER TABLE teams ADD COLUMN default_build_priority integer NOT NULL DEFAULT 0;
+
+  CREATE TABLE worker_task_queue (
+    "id" text PRIMARY KEY,
+    "build_id" integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    "updated_at" timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX worker_task_queue_build_id_idx ON worker_task_queue (build_id);
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  DROP TABLE jobs_team_serial_groups;
+
+  ALTER TABLE teams DROP COLUMN max_running_builds;
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+  ALTER TABLE teams ADD COLUMN max_running_builds integer NOT NULL DEFAULT 0;
+
+  CREATE TABLE jobs_team_serial_groups (
+    "id" serial PRIMARY KEY,
+    "serial_group" text NOT NULL,
+    "job_id" integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE
+  );
+
+  CREATE INDEX jobs_team_serial_groups_job_id_idx ON jobs_team_serial_groups (job_id);
+  CREATE INDEX jobs_team_serial_groups_serial_group_idx ON jobs_team_serial_groups (serial_group);
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+  CREATE TABLE team_webhooks (
+    "id" serial PRIMARY KEY,
+    "team_id" integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    "provider" text NOT NULL,
+    "secret" text NOT NULL,
+    "nonce" text,
+    UNIQUE (team_id, provider)
+  );
+COMMIT;
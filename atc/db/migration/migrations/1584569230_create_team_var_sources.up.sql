@@ -0,0 +1,9 @@
+BEGIN;
+  CREATE TABLE team_var_sources (
+    "id" serial PRIMARY KEY,
+    "team_id" integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    "var_sources" text NOT NULL,
+    "nonce" text,
+    UNIQUE (team_id)
+  );
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  DROP TABLE encryption_key_rotation_columns;
+  DROP TABLE encryption_key_rotations;
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+  CREATE TABLE encryption_key_rotations (
+    "id" serial PRIMARY KEY,
+    "keys" text NOT NULL,
+    "started_at" timestamp with time zone NOT NULL DEFAULT now(),
+    "finished_at" timestamp with time zone,
+    "last_error" text
+  );
+
+  CREATE TABLE encryption_key_rotation_columns (
+    "rotation_id" integer NOT NULL REFERENCES encryption_key_rotations (id) ON DELETE CASCADE,
+    "table_name" text NOT NULL,
+    "column_name" text NOT NULL,
+    "cursor" text,
+    "rows_total" bigint NOT NULL DEFAULT 0,
+    "rows_processed" bigint NOT NULL DEFAULT 0,
+    "rows_rotated" bigint NOT NULL DEFAULT 0,
+    "done" boolean NOT NULL DEFAULT false,
+    PRIMARY KEY (rotation_id, table_name, column_name)
+  );
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  DROP INDEX builds_archived_events_reaped_idx;
+  DROP INDEX builds_events_not_archived_idx;
+
+  ALTER TABLE builds DROP COLUMN "events_archived";
+COMMIT;
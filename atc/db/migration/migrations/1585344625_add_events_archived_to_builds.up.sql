@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN "events_archived" boolean NOT NULL DEFAULT false;
+
+  CREATE INDEX builds_events_not_archived_idx ON builds (end_time) WHERE completed AND NOT events_archived AND reap_time IS NULL;
+  CREATE INDEX builds_archived_events_reaped_idx ON builds (id) WHERE events_archived AND reap_time IS NOT NULL;
+COMMIT;
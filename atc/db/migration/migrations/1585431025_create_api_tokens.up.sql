@@ -0,0 +1,15 @@
+BEGIN;
+  CREATE TABLE api_tokens (
+    "id" serial PRIMARY KEY,
+    "team_id" integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    "name" text NOT NULL,
+    "token_hash" text NOT NULL UNIQUE,
+    "role" text NOT NULL,
+    "service_account" boolean NOT NULL DEFAULT false,
+    "created_by" text NOT NULL,
+    "created_at" timestamp with time zone NOT NULL DEFAULT now(),
+    "expires_at" timestamp with time zone,
+    "last_used_at" timestamp with time zone,
+    UNIQUE (team_id, name)
+  );
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE teams ADD COLUMN pipeline_auth text;
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+  CREATE TABLE audit_events (
+    "id" bigserial PRIMARY KEY,
+    "time" timestamp with time zone NOT NULL DEFAULT now(),
+    "user_name" text NOT NULL,
+    "team_name" text NOT NULL DEFAULT '',
+    "action" text NOT NULL,
+    "target" text NOT NULL,
+    "request_id" text NOT NULL DEFAULT '',
+    "source_ip" text NOT NULL DEFAULT '',
+    "status" integer NOT NULL
+  );
+
+  CREATE INDEX audit_events_time_idx ON audit_events (time);
+  CREATE INDEX audit_events_user_name_idx ON audit_events (user_name);
+  CREATE INDEX audit_events_team_name_idx ON audit_events (team_name);
+COMMIT;
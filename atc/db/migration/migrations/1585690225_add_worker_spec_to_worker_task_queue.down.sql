@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE worker_task_queue
+    DROP COLUMN platform,
+    DROP COLUMN tags;
+COMMIT;
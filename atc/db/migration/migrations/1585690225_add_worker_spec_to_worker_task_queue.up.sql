@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE worker_task_queue
+    ADD COLUMN platform text NOT NULL DEFAULT '',
+    ADD COLUMN tags jsonb NOT NULL DEFAULT '[]';
+COMMIT;
@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
-	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -12,6 +11,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
@@ -24,6 +24,7 @@ import (
 type Conn interface {
 	Bus() NotificationsBus
 	EncryptionStrategy() encryption.Strategy
+	EventArchive() blobstore.Store
 
 	Ping() error
 	Driver() driver.Driver
@@ -64,7 +65,7 @@ type Tx interface {
 	Stmt(*sql.Stmt) *sql.Stmt
 }
 
-func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey *encryption.Key, oldKey *encryption.Key, connectionName string, lockFactory lock.LockFactory) (Conn, error) {
+func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey encryption.Strategy, oldKey encryption.Strategy, connectionName string, lockFactory lock.LockFactory, eventArchive blobstore.Store) (Conn, error) {
 	for {
 		var strategy encryption.Strategy
 		if newKey != nil {
@@ -73,6 +74,10 @@ func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey *e
 			strategy = encryption.NewNoEncryption()
 		}
 
+		if oldKey != nil {
+			strategy = encryption.NewFallback(strategy, oldKey)
+		}
+
 		sqlDb, err := migration.NewOpenHelper(sqlDriver, sqlDataSource, lockFactory, strategy).Open()
 		if err != nil {
 			if shouldRetry(err) {
@@ -84,17 +89,9 @@ func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey *e
 			return nil, err
 		}
 
-		switch {
-		case oldKey != nil && newKey == nil:
-			err = decryptToPlaintext(logger.Session("decrypt"), sqlDb, oldKey)
-		case oldKey != nil && newKey != nil:
-			err = encryptWithNewKey(logger.Session("rotate"), sqlDb, newKey, oldKey)
-		}
-		if err != nil {
-			return nil, err
-		}
-
-		if newKey != nil {
+		// data encrypted with the old key is re-encrypted in the background by
+		// the encryption key rotation, so keep it readable in the meantime
+		if oldKey == nil && newKey != nil {
 			err = encryptPlaintext(logger.Session("encrypt"), sqlDb, newKey)
 			if err != nil {
 				return nil, err
@@ -106,9 +103,10 @@ func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey *e
 		return &db{
 			DB: sqlDb,
 
-			bus:        NewNotificationsBus(listener, sqlDb),
-			encryption: strategy,
-			name:       connectionName,
+			bus:          NewNotificationsBus(listener, sqlDb),
+			encryption:   strategy,
+			eventArchive: eventArchive,
+			name:         connectionName,
 		}, nil
 	}
 }
@@ -140,9 +138,12 @@ var encryptedColumns = []encryptedColumn{
 	{"cert_cache", "cert", "domain"},
 	{"checks", "plan", "id"},
 	{"pipelines", "var_sources", "id"},
+	{"team_webhooks", "secret", "id"},
+	{"team_var_sources", "var_sources", "id"},
+	{"team_syslog_drains", "drains", "id"},
 }
 
-func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
+func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key encryption.Strategy) error {
 	for _, ec := range encryptedColumns {
 		rows, err := sqlDB.Query(`
 			SELECT ` + ec.PrimaryKey + `, ` + ec.Column + `
@@ -209,150 +210,13 @@ func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) e
 	return nil
 }
 
-func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey *encryption.Key) error {
-	for _, ec := range encryptedColumns {
-		rows, err := sqlDB.Query(`
-			SELECT ` + ec.PrimaryKey + `, nonce, ` + ec.Column + `
-			FROM ` + ec.Table + `
-			WHERE nonce IS NOT NULL
-		`)
-		if err != nil {
-			return err
-		}
-
-		tLog := logger.Session("table", lager.Data{
-			"table": ec.Table,
-		})
-
-		decryptedRows := 0
-
-		for rows.Next() {
-			var (
-				primaryKey interface{}
-				val, nonce string
-			)
-
-			err := rows.Scan(&primaryKey, &nonce, &val)
-			if err != nil {
-				tLog.Error("failed-to-scan", err)
-				return err
-			}
-
-			rLog := tLog.Session("row", lager.Data{
-				"primary-key": primaryKey,
-			})
-
-			decrypted, err := oldKey.Decrypt(val, &nonce)
-			if err != nil {
-				rLog.Error("failed-to-decrypt", err)
-				return err
-			}
-
-			_, err = sqlDB.Exec(`
-				UPDATE `+ec.Table+`
-				SET `+ec.Column+` = $1, nonce = NULL
-				WHERE `+ec.PrimaryKey+` = $2
-			`, decrypted, primaryKey)
-			if err != nil {
-				rLog.Error("failed-to-update", err)
-				return err
-			}
-
-			decryptedRows++
-		}
-
-		if decryptedRows > 0 {
-			tLog.Info("decrypted-existing-encrypted-data", lager.Data{
-				"rows": decryptedRows,
-			})
-		}
-	}
-
-	return nil
-}
-
-var ErrEncryptedWithUnknownKey = errors.New("row encrypted with neither old nor new key")
-
-func encryptWithNewKey(logger lager.Logger, sqlDB *sql.DB, newKey *encryption.Key, oldKey *encryption.Key) error {
-	for _, ec := range encryptedColumns {
-		rows, err := sqlDB.Query(`
-			SELECT ` + ec.PrimaryKey + `, nonce, ` + ec.Column + `
-			FROM ` + ec.Table + `
-			WHERE nonce IS NOT NULL
-		`)
-		if err != nil {
-			return err
-		}
-
-		tLog := logger.Session("table", lager.Data{
-			"table": ec.Table,
-		})
-
-		encryptedRows := 0
-
-		for rows.Next() {
-			var (
-				primaryKey interface{}
-				val, nonce string
-			)
-
-			err := rows.Scan(&primaryKey, &nonce, &val)
-			if err != nil {
-				tLog.Error("failed-to-scan", err)
-				return err
-			}
-
-			rLog := tLog.Session("row", lager.Data{
-				"primary-key": primaryKey,
-			})
-
-			decrypted, err := oldKey.Decrypt(val, &nonce)
-			if err != nil {
-				_, err = newKey.Decrypt(val, &nonce)
-				if err == nil {
-					rLog.Debug("already-encrypted-with-new-key")
-					continue
-				}
-
-				logger.Error("failed-to-decrypt-with-either-key", err)
-				return ErrEncryptedWithUnknownKey
-			}
-
-			encrypted, newNonce, err := newKey.Encrypt(decrypted)
-			if err != nil {
-				rLog.Error("failed-to-encrypt", err)
-				return err
-			}
-
-			_, err = sqlDB.Exec(`
-				UPDATE `+ec.Table+`
-				SET `+ec.Column+` = $1, nonce = $2
-				WHERE `+ec.PrimaryKey+` = $3
-			`, encrypted, newNonce, primaryKey)
-			if err != nil {
-				rLog.Error("failed-to-update", err)
-				return err
-			}
-
-			encryptedRows++
-		}
-
-		if encryptedRows > 0 {
-			tLog.Info("re-encrypted-existing-encrypted-data", lager.Data{
-				"rows": encryptedRows,
-			})
-		}
-	}
-
-	return nil
-}
-
 type db struct {
 	*sql.DB
 
-	bus        NotificationsBus
-	encryption encryption.Strategy
-	name       string
+	bus          NotificationsBus
+	encryption   encryption.Strategy
+	eventArchive blobstore.Store
+	name         string
 }
 
 func (db *db) Name() string {
@@ -367,6 +231,12 @@ func (db *db) EncryptionStrategy() encryption.Strategy {
 	return db.encryption
 }
 
+// EventArchive returns the store which the events of completed builds are
+// archived to, or nil if build events are kept in the database.
+func (db *db) EventArchive() blobstore.Store {
+	return db.eventArchive
+}
+
 func (db *db) Close() error {
 	var errs error
 	dbErr := db.DB.Close()
@@ -27,6 +27,10 @@ func (e ErrResourceNotFound) Error() string {
 	return fmt.Sprintf("resource '%s' not found", e.Name)
 }
 
+// ErrSetByNewerBuild is returned when a pipeline's parent IDs are being set
+// by a build older than the one that last set it.
+var ErrSetByNewerBuild = errors.New("pipeline set by a newer build")
+
 //go:generate counterfeiter . Pipeline
 
 type Cause struct {
@@ -37,6 +41,8 @@ type Cause struct {
 type Pipeline interface {
 	ID() int
 	Name() string
+	InstanceVars() atc.InstanceVars
+	PipelineRef() atc.PipelineRef
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
@@ -45,6 +51,9 @@ type Pipeline interface {
 	Config() (atc.Config, error)
 	Public() bool
 	Paused() bool
+	Archived() bool
+	ParentJobID() int
+	ParentBuildID() int
 
 	CheckPaused() (bool, error)
 	Reload() (bool, error)
@@ -83,6 +92,9 @@ type Pipeline interface {
 	Pause() error
 	Unpause() error
 
+	Archive() error
+	SetParentIDs(jobID, buildID int) error
+
 	Destroy() error
 	Rename(string) error
 
@@ -92,6 +104,7 @@ type Pipeline interface {
 type pipeline struct {
 	id            int
 	name          string
+	instanceVars  atc.InstanceVars
 	teamID        int
 	teamName      string
 	groups        atc.GroupConfigs
@@ -99,6 +112,9 @@ type pipeline struct {
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
+	archived      bool
+	parentJobID   int
+	parentBuildID int
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -110,6 +126,7 @@ type ConfigVersion int
 var pipelinesQuery = psql.Select(`
 		p.id,
 		p.name,
+		p.instance_vars,
 		p.groups,
 		p.var_sources,
 		p.nonce,
@@ -117,7 +134,10 @@ var pipelinesQuery = psql.Select(`
 		p.team_id,
 		t.name,
 		p.paused,
-		p.public
+		p.public,
+		p.archived,
+		p.parent_job_id,
+		p.parent_build_id
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -129,16 +149,27 @@ func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	}
 }
 
-func (p *pipeline) ID() int                  { return p.id }
-func (p *pipeline) Name() string             { return p.name }
-func (p *pipeline) TeamID() int              { return p.teamID }
-func (p *pipeline) TeamName() string         { return p.teamName }
-func (p *pipeline) Groups() atc.GroupConfigs { return p.groups }
+func (p *pipeline) ID() int                        { return p.id }
+func (p *pipeline) Name() string                   { return p.name }
+func (p *pipeline) InstanceVars() atc.InstanceVars { return p.instanceVars }
+func (p *pipeline) TeamID() int                    { return p.teamID }
+func (p *pipeline) TeamName() string               { return p.teamName }
+func (p *pipeline) Groups() atc.GroupConfigs       { return p.groups }
 
 func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
 func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
 func (p *pipeline) Public() bool                     { return p.public }
 func (p *pipeline) Paused() bool                     { return p.paused }
+func (p *pipeline) Archived() bool                   { return p.archived }
+func (p *pipeline) ParentJobID() int                 { return p.parentJobID }
+func (p *pipeline) ParentBuildID() int               { return p.parentBuildID }
+
+func (p *pipeline) PipelineRef() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         p.name,
+		InstanceVars: p.instanceVars,
+	}
+}
 
 // IMPORTANT: This method is broken with the new resource config versions changes
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
@@ -616,6 +647,59 @@ func (p *pipeline) Unpause() error {
 	return tx.Commit()
 }
 
+// Archive pauses the pipeline and clears its config, deactivating its jobs,
+// resources and resource types so that nothing is scheduled or checked. The
+// pipeline and its builds are kept around until it is set again or destroyed.
+func (p *pipeline) Archive() error {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = archivePipelines(tx, sq.Eq{"id": p.id})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SetParentIDs records the job and build whose set_pipeline step last set the
+// pipeline. It fails with ErrSetByNewerBuild if a newer build of the job has
+// already set the pipeline.
+func (p *pipeline) SetParentIDs(jobID, buildID int) error {
+	if jobID <= 0 || buildID <= 0 {
+		return errors.New("job and build id cannot be negative or zero-value")
+	}
+
+	result, err := psql.Update("pipelines").
+		Set("parent_job_id", jobID).
+		Set("parent_build_id", buildID).
+		Where(sq.Eq{"id": p.id}).
+		Where(sq.Or{
+			sq.Eq{"parent_build_id": nil},
+			sq.Lt{"parent_build_id": buildID},
+		}).
+		RunWith(p.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrSetByNewerBuild
+	}
+
+	return nil
+}
+
 func (p *pipeline) Hide() error {
 	_, err := psql.Update("pipelines").
 		Set("public", false).
@@ -663,6 +747,68 @@ func (p *pipeline) Destroy() error {
 	return err
 }
 
+// archivePipelines archives every unarchived pipeline matching the given
+// condition.
+func archivePipelines(tx Tx, where sq.Sqlizer) error {
+	rows, err := psql.Update("pipelines").
+		Set("archived", true).
+		Set("paused", true).
+		Set("groups", nil).
+		Set("var_sources", nil).
+		Set("nonce", nil).
+		Set("version", sq.Expr("nextval('config_version_seq')")).
+		Where(where).
+		Where(sq.Eq{"archived": false}).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	var pipelineIDs []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		pipelineIDs = append(pipelineIDs, id)
+	}
+
+	Close(rows)
+
+	if len(pipelineIDs) == 0 {
+		return nil
+	}
+
+	for _, table := range []string{"jobs", "resource_types"} {
+		_, err = psql.Update(table).
+			Set("active", false).
+			Where(sq.Eq{"pipeline_id": pipelineIDs}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = psql.Update("resources").
+		Set("active", false).
+		Set("resource_config_id", nil).
+		Set("resource_config_scope_id", nil).
+		Where(sq.Eq{"pipeline_id": pipelineIDs}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (p *pipeline) LoadDebugVersionsDB() (*atc.DebugVersionsDB, error) {
 	db := &atc.DebugVersionsDB{
 		BuildOutputs:     []atc.DebugBuildOutput{},
@@ -1034,9 +1180,10 @@ func (p *pipeline) getBuildsFrom(tx Tx, col string) (map[string]Build, error) {
 	return nextBuilds, nil
 }
 
-// Variables creates variables for this pipeline. If this pipeline has its own
-// var_sources, a vars.MultiVars containing all pipeline specific var_sources
-// plug the global variables, otherwise just return the global variables.
+// Variables creates variables for this pipeline. If this pipeline or its team
+// has var_sources, a vars.MultiVars containing all pipeline specific and team
+// var_sources plug the global variables, otherwise just return the global
+// variables.
 func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, varSourcePool creds.VarSourcePool) (vars.Variables, error) {
 	globalVars := creds.NewVariables(globalSecrets, p.TeamName(), p.Name(), false)
 	namedVarsMap := vars.NamedVariables{}
@@ -1044,7 +1191,21 @@ func (p *pipeline) Variables(logger lager.Logger, globalSecrets creds.Secrets, v
 	// a map is passed by reference.
 	allVars := vars.NewMultiVars([]vars.Variables{globalVars, namedVarsMap})
 
-	orderedVarSources, err := p.varSources.OrderByDependency()
+	teamVarSources, err := teamVarSources(p.conn, p.teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The team's var_sources are only used if the pipeline does not have a
+	// var_source of the same name.
+	varSources := append(atc.VarSourceConfigs{}, p.varSources...)
+	for _, vs := range teamVarSources {
+		if _, found := p.varSources.Lookup(vs.Name); !found {
+			varSources = append(varSources, vs)
+		}
+	}
+
+	orderedVarSources, err := varSources.OrderByDependency()
 	if err != nil {
 		return nil, err
 	}
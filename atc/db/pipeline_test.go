@@ -148,7 +148,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), false)
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -339,13 +339,163 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("Archive", func() {
+		JustBeforeEach(func() {
+			Expect(pipeline.Archive()).To(Succeed())
+
+			found, err := pipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("archives and pauses the pipeline", func() {
+			Expect(pipeline.Archived()).To(BeTrue())
+			Expect(pipeline.Paused()).To(BeTrue())
+		})
+
+		It("clears the config of the pipeline", func() {
+			Expect(pipeline.Groups()).To(BeEmpty())
+			Expect(pipeline.VarSources()).To(BeEmpty())
+
+			config, err := pipeline.Config()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Jobs).To(BeEmpty())
+			Expect(config.Resources).To(BeEmpty())
+			Expect(config.ResourceTypes).To(BeEmpty())
+		})
+
+		It("keeps the builds of the pipeline", func() {
+			build, err := pipeline.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			builds, _, err := pipeline.Builds(db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+			Expect(builds[0].ID()).To(Equal(build.ID()))
+		})
+
+		It("does not schedule the jobs of the pipeline", func() {
+			jobs, err := db.NewJobFactory(dbConn, lockFactory).JobsToSchedule()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, job := range jobs {
+				Expect(job.PipelineID()).ToNot(Equal(pipeline.ID()))
+			}
+		})
+
+		It("does not check the resources of the pipeline", func() {
+			resources, err := checkFactory.Resources()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, resource := range resources {
+				Expect(resource.PipelineID()).ToNot(Equal(pipeline.ID()))
+			}
+		})
+
+		Context("when the pipeline is set again", func() {
+			It("is no longer archived", func() {
+				_, _, err := team.SavePipeline(pipeline.PipelineRef(), pipelineConfig, pipeline.ConfigVersion(), false)
+				Expect(err).ToNot(HaveOccurred())
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(pipeline.Archived()).To(BeFalse())
+				Expect(pipeline.Groups()).To(Equal(pipelineConfig.Groups))
+			})
+		})
+	})
+
+	Describe("SetParentIDs", func() {
+		var (
+			job   db.Job
+			build db.Build
+		)
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			job, found, err = pipeline.Job("job-name")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("records the parent job and build of the pipeline", func() {
+			Expect(pipeline.SetParentIDs(job.ID(), build.ID())).To(Succeed())
+
+			found, err := pipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(pipeline.ParentJobID()).To(Equal(job.ID()))
+			Expect(pipeline.ParentBuildID()).To(Equal(build.ID()))
+		})
+
+		Context("when the pipeline was set by a newer build", func() {
+			It("returns ErrSetByNewerBuild", func() {
+				newerBuild, err := job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pipeline.SetParentIDs(job.ID(), newerBuild.ID())).To(Succeed())
+				Expect(pipeline.SetParentIDs(job.ID(), build.ID())).To(Equal(db.ErrSetByNewerBuild))
+			})
+		})
+
+		Context("when a later build of the parent job succeeds", func() {
+			BeforeEach(func() {
+				Expect(pipeline.SetParentIDs(job.ID(), build.ID())).To(Succeed())
+			})
+
+			It("archives the pipeline if the build did not set it", func() {
+				laterBuild, err := job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(laterBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline.Archived()).To(BeTrue())
+			})
+
+			It("does not archive the pipeline if the build set it", func() {
+				laterBuild, err := job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pipeline.SetParentIDs(job.ID(), laterBuild.ID())).To(Succeed())
+				Expect(laterBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline.Archived()).To(BeFalse())
+			})
+
+			It("does not archive the pipeline if the build fails", func() {
+				laterBuild, err := job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(laterBuild.Finish(db.BuildStatusFailed)).To(Succeed())
+
+				found, err := pipeline.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline.Archived()).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Rename", func() {
 		JustBeforeEach(func() {
 			Expect(pipeline.Rename("oopsies")).To(Succeed())
 		})
 
 		It("renames the pipeline", func() {
-			pipeline, found, err := team.Pipeline("oopsies")
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "oopsies"})
 			Expect(pipeline.Name()).To(Equal("oopsies"))
 			Expect(found).To(BeTrue())
 			Expect(err).ToNot(HaveOccurred())
@@ -529,10 +679,10 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, false)
+			dbPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name"}, pipelineConfig, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
-			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, false)
+			otherDBPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline-name"}, otherPipelineConfig, 0, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = dbPipeline.Resource(resourceName)
@@ -1125,7 +1275,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 
-			_, found, err = team.Pipeline(pipeline.Name())
+			_, found, err = team.Pipeline(atc.PipelineRef{Name: pipeline.Name()})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -1920,7 +2070,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -1940,7 +2090,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline("another-pipeline", config, db.ConfigVersion(1), false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false)
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
@@ -2066,7 +2216,7 @@ var _ = Describe("Pipeline", func() {
 				})
 
 				var created bool
-				pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, pipeline.ConfigVersion(), false)
+				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
 			})
@@ -2080,6 +2230,42 @@ var _ = Describe("Pipeline", func() {
 				Expect(v.(string)).To(Equal("pv"))
 			})
 		})
+
+		Context("when the team has var_sources", func() {
+			BeforeEach(func() {
+				err := team.UpdateVarSources(atc.VarSourceConfigs{
+					{
+						Name: "team-var-source",
+						Type: "dummy",
+						Config: map[string]interface{}{
+							"vars": map[string]interface{}{"tk": "tv"},
+						},
+					},
+					{
+						Name: "some-var-source",
+						Type: "dummy",
+						Config: map[string]interface{}{
+							"vars": map[string]interface{}{"pk": "team-pv"},
+						},
+					},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should get var from team var source", func() {
+				v, found, err := pvars.Get(vars.VariableDefinition{Name: "team-var-source:tk"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(v.(string)).To(Equal("tv"))
+			})
+
+			It("should prefer the pipeline var source of the same name", func() {
+				v, found, err := pvars.Get(vars.VariableDefinition{Name: "some-var-source:pk"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(v.(string)).To(Equal("pv"))
+			})
+		})
 	})
 
 	Context("Config", func() {
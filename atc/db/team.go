@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
 )
@@ -28,18 +30,23 @@ type Team interface {
 	Admin() bool
 
 	Auth() atc.TeamAuth
+	PipelineAuth() atc.PipelineAuth
+	DefaultBuildPriority() int
+	MaxRunningBuilds() int
+	VarSources() atc.VarSourceConfigs
+	SyslogDrains() []atc.SyslogDrainConfig
 
 	Delete() error
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	OrderPipelines([]string) error
@@ -60,13 +67,27 @@ type Team interface {
 	IsContainerWithinTeam(string, bool) (bool, error)
 
 	FindContainerByHandle(string) (Container, bool, error)
-	FindCheckContainers(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 	FindWorkerForContainer(handle string) (Worker, bool, error)
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
+	Update(atcTeam atc.Team) error
 	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdatePipelineAuth(auth atc.PipelineAuth) error
+	UpdateDefaultBuildPriority(priority int) error
+	UpdateMaxRunningBuilds(maxRunningBuilds int) error
+	UpdateVarSources(varSources atc.VarSourceConfigs) error
+	UpdateSyslogDrains(drains []atc.SyslogDrainConfig) error
+
+	WebhookSecret(provider string) (string, bool, error)
+	SetWebhookSecret(provider string, secret string) error
+	DeleteWebhook(provider string) (bool, error)
+
+	CreateAPIToken(name string, role string, serviceAccount bool, createdBy string, expiresAt time.Time) (APIToken, string, error)
+	APITokens() ([]APIToken, error)
+	RevokeAPIToken(name string) (bool, error)
 }
 
 type team struct {
@@ -77,14 +98,28 @@ type team struct {
 	name  string
 	admin bool
 
-	auth atc.TeamAuth
+	auth         atc.TeamAuth
+	pipelineAuth atc.PipelineAuth
+
+	defaultBuildPriority int
+	maxRunningBuilds     int
+
+	varSources   atc.VarSourceConfigs
+	syslogDrains []atc.SyslogDrainConfig
 }
 
 func (t *team) ID() int      { return t.id }
 func (t *team) Name() string { return t.name }
 func (t *team) Admin() bool  { return t.admin }
 
-func (t *team) Auth() atc.TeamAuth { return t.auth }
+func (t *team) Auth() atc.TeamAuth             { return t.auth }
+func (t *team) PipelineAuth() atc.PipelineAuth { return t.pipelineAuth }
+
+func (t *team) DefaultBuildPriority() int { return t.defaultBuildPriority }
+func (t *team) MaxRunningBuilds() int     { return t.maxRunningBuilds }
+
+func (t *team) VarSources() atc.VarSourceConfigs      { return t.varSources }
+func (t *team) SyslogDrains() []atc.SyslogDrainConfig { return t.syslogDrains }
 
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
@@ -340,7 +375,7 @@ func (t *team) FindCreatedContainerByHandle(
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
@@ -352,13 +387,24 @@ func (t *team) SavePipeline(
 
 	defer Rollback(tx)
 
+	instanceVars, err := instanceVarsEq("instance_vars", pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	var existingConfig bool
-	err = tx.QueryRow(`SELECT EXISTS (
-		SELECT 1
-		FROM pipelines
-		WHERE name = $1
-		AND team_id = $2
-	)`, pipelineName, t.id).Scan(&existingConfig)
+	err = psql.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("pipelines").
+		Where(sq.Eq{
+			"name":    pipelineRef.Name,
+			"team_id": t.id,
+		}).
+		Where(instanceVars).
+		Suffix(")").
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
 	if err != nil {
 		return nil, false, err
 	}
@@ -378,18 +424,34 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	var instanceVarsPayload []byte
+	if len(pipelineRef.InstanceVars) != 0 {
+		instanceVarsPayload, err = json.Marshal(pipelineRef.InstanceVars)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
 	var pipelineID int
 	if !existingConfig {
+		// instances of the same pipeline share an ordering so that they are
+		// listed next to each other
+		ordering := sq.Expr(`COALESCE(
+			(SELECT MIN(ordering) FROM pipelines WHERE name = ? AND team_id = ?),
+			currval('pipelines_id_seq')
+		)`, pipelineRef.Name, t.id)
+
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":        pipelineName,
-				"groups":      groupsPayload,
-				"var_sources": encryptedVarSourcesPayload,
-				"nonce":       nonce,
-				"version":     sq.Expr("nextval('config_version_seq')"),
-				"ordering":    sq.Expr("currval('pipelines_id_seq')"),
-				"paused":      initiallyPaused,
-				"team_id":     t.id,
+				"name":          pipelineRef.Name,
+				"instance_vars": instanceVarsPayload,
+				"groups":        groupsPayload,
+				"var_sources":   encryptedVarSourcesPayload,
+				"nonce":         nonce,
+				"version":       sq.Expr("nextval('config_version_seq')"),
+				"ordering":      ordering,
+				"paused":        initiallyPaused,
+				"team_id":       t.id,
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
@@ -398,16 +460,20 @@ func (t *team) SavePipeline(
 			return nil, false, err
 		}
 	} else {
+		// setting an archived pipeline un-archives it, but it stays paused as
+		// archiving paused it; fly set-pipeline says so
 		err := psql.Update("pipelines").
 			Set("groups", groupsPayload).
 			Set("var_sources", encryptedVarSourcesPayload).
 			Set("nonce", nonce).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
+			Set("archived", false).
 			Where(sq.Eq{
-				"name":    pipelineName,
+				"name":    pipelineRef.Name,
 				"version": from,
 				"team_id": t.id,
 			}).
+			Where(instanceVars).
 			Suffix("RETURNING id").
 			RunWith(tx).
 			QueryRow().
@@ -493,16 +559,22 @@ func (t *team) SavePipeline(
 	return pipeline, !existingConfig, nil
 }
 
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	instanceVars, err := instanceVarsEq("p.instance_vars", pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
 			Where(sq.Eq{
 				"p.team_id": t.id,
-				"p.name":    pipelineName,
+				"p.name":    pipelineRef.Name,
 			}).
+			Where(instanceVars).
 			RunWith(t.conn).
 			QueryRow(),
 	)
@@ -521,7 +593,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		Where(sq.Eq{
 			"team_id": t.id,
 		}).
-		OrderBy("ordering").
+		OrderBy("ordering", "p.id").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -542,7 +614,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 			"team_id": t.id,
 			"public":  true,
 		}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "p.id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -709,6 +781,92 @@ func (t *team) SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, erro
 	return savedWorker, nil
 }
 
+// Update replaces the team's auth and settings in a single transaction, so
+// that a failure part way through leaves the team as it was. Pipeline auth,
+// var sources and syslog drains are left as they are when nil, rather than
+// removed.
+func (t *team) Update(atcTeam atc.Team) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	auth, err := json.Marshal(atcTeam.Auth)
+	if err != nil {
+		return err
+	}
+
+	err = revokeAPITokensIfAuthChanged(tx, t.id, auth)
+	if err != nil {
+		return err
+	}
+
+	update := psql.Update("teams").
+		Set("auth", auth).
+		Set("legacy_auth", nil).
+		Set("nonce", nil).
+		Set("default_build_priority", atcTeam.DefaultBuildPriority).
+		Set("max_running_builds", atcTeam.MaxRunningBuilds).
+		Where(sq.Eq{
+			"id": t.id,
+		})
+
+	if atcTeam.PipelineAuth != nil {
+		pipelineAuth, err := encodePipelineAuth(*atcTeam.PipelineAuth)
+		if err != nil {
+			return err
+		}
+
+		update = update.Set("pipeline_auth", pipelineAuth)
+	}
+
+	_, err = update.
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	if atcTeam.VarSources != nil {
+		err = saveTeamVarSources(tx, t.conn.EncryptionStrategy(), t.id, *atcTeam.VarSources)
+		if err != nil {
+			return err
+		}
+	}
+
+	if atcTeam.SyslogDrains != nil {
+		err = saveTeamSyslogDrains(tx, t.conn.EncryptionStrategy(), t.id, *atcTeam.SyslogDrains)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	t.auth = atcTeam.Auth
+	t.defaultBuildPriority = atcTeam.DefaultBuildPriority
+	t.maxRunningBuilds = atcTeam.MaxRunningBuilds
+
+	if atcTeam.PipelineAuth != nil {
+		t.pipelineAuth = *atcTeam.PipelineAuth
+	}
+
+	if atcTeam.VarSources != nil {
+		t.varSources = *atcTeam.VarSources
+	}
+
+	if atcTeam.SyslogDrains != nil {
+		t.syslogDrains = *atcTeam.SyslogDrains
+	}
+
+	return nil
+}
+
 func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -721,11 +879,16 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		return err
 	}
 
+	err = revokeAPITokensIfAuthChanged(tx, t.id, jsonEncodedProviderAuth)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, nonce, default_build_priority, max_running_builds
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -735,8 +898,257 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
-func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+// UpdatePipelineAuth replaces the roles bound in the team's individual
+// pipelines.
+func (t *team) UpdatePipelineAuth(auth atc.PipelineAuth) error {
+	encoded, err := encodePipelineAuth(auth)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("teams").
+		Set("pipeline_auth", encoded).
+		Where(sq.Eq{
+			"id": t.id,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.pipelineAuth = auth
+
+	return nil
+}
+
+func (t *team) UpdateDefaultBuildPriority(priority int) error {
+	_, err := psql.Update("teams").
+		Set("default_build_priority", priority).
+		Where(sq.Eq{
+			"id": t.id,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.defaultBuildPriority = priority
+
+	return nil
+}
+
+func (t *team) UpdateMaxRunningBuilds(maxRunningBuilds int) error {
+	_, err := psql.Update("teams").
+		Set("max_running_builds", maxRunningBuilds).
+		Where(sq.Eq{
+			"id": t.id,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.maxRunningBuilds = maxRunningBuilds
+
+	return nil
+}
+
+// UpdateVarSources replaces the var sources shared by the team's pipelines.
+func (t *team) UpdateVarSources(varSources atc.VarSourceConfigs) error {
+	err := saveTeamVarSources(t.conn, t.conn.EncryptionStrategy(), t.id, varSources)
+	if err != nil {
+		return err
+	}
+
+	t.varSources = varSources
+
+	return nil
+}
+
+// UpdateSyslogDrains replaces the drains which the team's build events are
+// sent to.
+func (t *team) UpdateSyslogDrains(drains []atc.SyslogDrainConfig) error {
+	err := saveTeamSyslogDrains(t.conn, t.conn.EncryptionStrategy(), t.id, drains)
+	if err != nil {
+		return err
+	}
+
+	t.syslogDrains = drains
+
+	return nil
+}
+
+func (t *team) WebhookSecret(provider string) (string, bool, error) {
+	var secret string
+	var nonce sql.NullString
+	err := psql.Select("secret", "nonce").
+		From("team_webhooks").
+		Where(sq.Eq{
+			"team_id":  t.id,
+			"provider": provider,
+		}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&secret, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := t.conn.EncryptionStrategy().Decrypt(secret, noncense)
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(decrypted), true, nil
+}
+
+func (t *team) SetWebhookSecret(provider string, secret string) error {
+	encryptedSecret, nonce, err := t.conn.EncryptionStrategy().Encrypt([]byte(secret))
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_webhooks").
+		Columns("team_id", "provider", "secret", "nonce").
+		Values(t.id, provider, encryptedSecret, nonce).
+		Suffix("ON CONFLICT (team_id, provider) DO UPDATE SET secret = EXCLUDED.secret, nonce = EXCLUDED.nonce").
+		RunWith(t.conn).
+		Exec()
+	return err
+}
+
+func (t *team) DeleteWebhook(provider string) (bool, error) {
+	result, err := psql.Delete("team_webhooks").
+		Where(sq.Eq{
+			"team_id":  t.id,
+			"provider": provider,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// CreateAPIToken creates a token granting the role in the team, returning it
+// along with the token itself, which can't be retrieved again. A zero
+// expiresAt means the token never expires.
+func (t *team) CreateAPIToken(name string, role string, serviceAccount bool, createdBy string, expiresAt time.Time) (APIToken, string, error) {
+	token, hash, err := generateAPIToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var expires pq.NullTime
+	if !expiresAt.IsZero() {
+		expires = pq.NullTime{Time: expiresAt, Valid: true}
+	}
+
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return nil, "", err
+	}
+
+	defer Rollback(tx)
+
+	var id int
+	err = psql.Insert("api_tokens").
+		Columns("team_id", "name", "token_hash", "role", "service_account", "created_by", "expires_at").
+		Values(t.id, name, hash, role, serviceAccount, createdBy, expires).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return nil, "", ErrAPITokenExists
+		}
+		return nil, "", err
+	}
+
+	created := &apiToken{}
+	err = scanAPIToken(created, apiTokensQuery.
+		Where(sq.Eq{"a.id": id}).
+		RunWith(tx).
+		QueryRow())
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return created, token, nil
+}
+
+func (t *team) APITokens() ([]APIToken, error) {
+	rows, err := apiTokensQuery.
+		Where(sq.Eq{"a.team_id": t.id}).
+		OrderBy("a.name").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	apiTokens := []APIToken{}
+	for rows.Next() {
+		a := &apiToken{}
+		err = scanAPIToken(a, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		apiTokens = append(apiTokens, a)
+	}
+
+	return apiTokens, nil
+}
+
+func (t *team) RevokeAPIToken(name string) (bool, error) {
+	result, err := psql.Delete("api_tokens").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -949,9 +1361,9 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "active", "nonce", "tags", "priority").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, true, nonce, pq.Array(groups), job.Priority).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags, priority = EXCLUDED.priority").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -972,6 +1384,15 @@ func (t *team) registerSerialGroup(tx Tx, serialGroup string, jobID int) error {
 	return err
 }
 
+func (t *team) registerTeamSerialGroup(tx Tx, serialGroup string, jobID int) error {
+	_, err := psql.Insert("jobs_team_serial_groups").
+		Columns("serial_group", "job_id").
+		Values(serialGroup, jobID).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
 func (t *team) saveResource(tx Tx, resource atc.ResourceConfig, pipelineID int) (int, error) {
 	configPayload, err := json.Marshal(resource)
 	if err != nil {
@@ -1090,16 +1511,31 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 
 func scanPipeline(p *pipeline, scan scannable) error {
 	var (
-		groups     sql.NullString
-		varSources sql.NullString
-		nonce      sql.NullString
-		nonceStr   *string
+		instanceVars sql.NullString
+		groups       sql.NullString
+		varSources   sql.NullString
+		nonce        sql.NullString
+		nonceStr     *string
+
+		parentJobID   sql.NullInt64
+		parentBuildID sql.NullInt64
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	err := scan.Scan(&p.id, &p.name, &instanceVars, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &parentJobID, &parentBuildID)
 	if err != nil {
 		return err
 	}
 
+	p.parentJobID = int(parentJobID.Int64)
+	p.parentBuildID = int(parentBuildID.Int64)
+
+	p.instanceVars = nil
+	if instanceVars.Valid {
+		err = json.Unmarshal([]byte(instanceVars.String), &p.instanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
@@ -1131,6 +1567,21 @@ func scanPipeline(p *pipeline, scan scannable) error {
 	return nil
 }
 
+// instanceVarsEq matches the pipelines whose instance vars are exactly the
+// given ones; no instance vars matches only pipelines that are not instanced.
+func instanceVarsEq(column string, instanceVars atc.InstanceVars) (sq.Sqlizer, error) {
+	if len(instanceVars) == 0 {
+		return sq.Eq{column: nil}, nil
+	}
+
+	payload, err := json.Marshal(instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return sq.Expr(column+" = ?", string(payload)), nil
+}
+
 func scanPipelines(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) ([]Pipeline, error) {
 	defer Close(rows)
 
@@ -1186,6 +1637,8 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		&t.admin,
 		&providerAuth,
 		&nonce,
+		&t.defaultBuildPriority,
+		&t.maxRunningBuilds,
 	)
 	if err != nil {
 		return err
@@ -1204,26 +1657,29 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 }
 
 func (t *team) resetDependentTableStates(tx Tx, pipelineID int) error {
-	_, err := psql.Delete("jobs_serial_groups").
-		Where(sq.Expr(`job_id in (
+	for _, table := range []string{"jobs_serial_groups", "jobs_team_serial_groups"} {
+		_, err := psql.Delete(table).
+			Where(sq.Expr(`job_id in (
         SELECT j.id
         FROM jobs j
         WHERE j.pipeline_id = $1
       )`, pipelineID)).
-		RunWith(tx).
-		Exec()
-	if err != nil {
-		return err
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
 	}
 
 	tableNames := []string{"jobs", "resources", "resource_types"}
 	for _, table := range tableNames {
-		err = t.inactivateTableForPipeline(tx, pipelineID, table)
+		err := t.inactivateTableForPipeline(tx, pipelineID, table)
 		if err != nil {
 			return err
 		}
 	}
-	return err
+
+	return nil
 }
 
 func (t *team) inactivateTableForPipeline(tx Tx, pipelineID int, tableName string) error {
@@ -1294,6 +1750,13 @@ func (t *team) saveJobsAndSerialGroups(tx Tx, jobs atc.JobConfigs, groups atc.Gr
 				}
 			}
 		}
+
+		for _, sg := range job.TeamSerialGroups {
+			err = t.registerTeamSerialGroup(tx, sg, jobID)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return jobNameToID, nil
@@ -1422,3 +1885,179 @@ func insertJobOutput(tx Tx, plan atc.PlanConfig, jobName string, resourceNameToI
 
 	return nil
 }
+
+func saveTeamVarSources(runner sq.BaseRunner, es encryption.Strategy, teamID int, varSources atc.VarSourceConfigs) error {
+	if len(varSources) == 0 {
+		_, err := psql.Delete("team_var_sources").
+			Where(sq.Eq{"team_id": teamID}).
+			RunWith(runner).
+			Exec()
+		return err
+	}
+
+	payload, err := json.Marshal(varSources)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := es.Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_var_sources").
+		Columns("team_id", "var_sources", "nonce").
+		Values(teamID, encryptedPayload, nonce).
+		Suffix("ON CONFLICT (team_id) DO UPDATE SET var_sources = EXCLUDED.var_sources, nonce = EXCLUDED.nonce").
+		RunWith(runner).
+		Exec()
+	return err
+}
+
+// encodePipelineAuth returns the value to store in the team's pipeline_auth
+// column, which is NULL when no roles are bound in pipelines.
+// revokeAPITokensIfAuthChanged deletes the API tokens users created for the
+// team when its auth changes, as their creators may no longer hold the role
+// the tokens grant. Service account tokens are not tied to a user and are kept.
+func revokeAPITokensIfAuthChanged(tx Tx, teamID int, auth []byte) error {
+	var currentAuth sql.NullString
+	err := psql.Select("auth").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&currentAuth)
+	if err != nil {
+		return err
+	}
+
+	var current, updated atc.TeamAuth
+	if currentAuth.Valid {
+		err = json.Unmarshal([]byte(currentAuth.String), &current)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = json.Unmarshal(auth, &updated)
+	if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(current, updated) {
+		return nil
+	}
+
+	_, err = psql.Delete("api_tokens").
+		Where(sq.Eq{
+			"team_id":         teamID,
+			"service_account": false,
+		}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func encodePipelineAuth(auth atc.PipelineAuth) (interface{}, error) {
+	if len(auth) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(auth)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(payload), nil
+}
+
+// teamVarSources returns the var sources shared by the pipelines of the team.
+func teamVarSources(conn Conn, teamID int) (atc.VarSourceConfigs, error) {
+	var payload string
+	var nonce sql.NullString
+	err := psql.Select("var_sources", "nonce").
+		From("team_var_sources").
+		Where(sq.Eq{"team_id": teamID}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&payload, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return decryptVarSources(conn.EncryptionStrategy(), payload, nonce)
+}
+
+func decryptVarSources(es encryption.Strategy, payload string, nonce sql.NullString) (atc.VarSourceConfigs, error) {
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := es.Decrypt(payload, noncense)
+	if err != nil {
+		return nil, err
+	}
+
+	var varSources atc.VarSourceConfigs
+	err = json.Unmarshal(decrypted, &varSources)
+	if err != nil {
+		return nil, err
+	}
+
+	return varSources, nil
+}
+
+// saveTeamSyslogDrains stores the drains encrypted, as their URLs may contain
+// credentials.
+func saveTeamSyslogDrains(runner sq.BaseRunner, es encryption.Strategy, teamID int, drains []atc.SyslogDrainConfig) error {
+	if len(drains) == 0 {
+		_, err := psql.Delete("team_syslog_drains").
+			Where(sq.Eq{"team_id": teamID}).
+			RunWith(runner).
+			Exec()
+		return err
+	}
+
+	payload, err := json.Marshal(drains)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := es.Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_syslog_drains").
+		Columns("team_id", "drains", "nonce").
+		Values(teamID, encryptedPayload, nonce).
+		Suffix("ON CONFLICT (team_id) DO UPDATE SET drains = EXCLUDED.drains, nonce = EXCLUDED.nonce").
+		RunWith(runner).
+		Exec()
+	return err
+}
+
+func decryptSyslogDrains(es encryption.Strategy, payload string, nonce sql.NullString) ([]atc.SyslogDrainConfig, error) {
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := es.Decrypt(payload, noncense)
+	if err != nil {
+		return nil, err
+	}
+
+	var drains []atc.SyslogDrainConfig
+	err = json.Unmarshal(decrypted, &drains)
+	if err != nil {
+		return nil, err
+	}
+
+	return drains, nil
+}
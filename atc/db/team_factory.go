@@ -11,6 +11,11 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 )
 
+var teamsQuery = psql.Select("t.id, t.name, t.admin, t.auth, t.pipeline_auth, t.default_build_priority, t.max_running_builds, v.var_sources, v.nonce, s.drains, s.nonce").
+	From("teams t").
+	LeftJoin("team_var_sources v ON v.team_id = t.id").
+	LeftJoin("team_syslog_drains s ON s.team_id = t.id")
+
 //go:generate counterfeiter . TeamFactory
 
 type TeamFactory interface {
@@ -51,10 +56,42 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
-	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+	var pipelineAuth interface{}
+	if t.PipelineAuth != nil {
+		pipelineAuth, err = encodePipelineAuth(*t.PipelineAuth)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var teamID int
+	err = psql.Insert("teams").
+		Columns("name, auth, pipeline_auth, admin, default_build_priority, max_running_builds").
+		Values(t.Name, auth, pipelineAuth, admin, t.DefaultBuildPriority, t.MaxRunningBuilds).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.VarSources != nil {
+		err = saveTeamVarSources(tx, factory.conn.EncryptionStrategy(), teamID, *t.VarSources)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if t.SyslogDrains != nil {
+		err = saveTeamSyslogDrains(tx, factory.conn.EncryptionStrategy(), teamID, *t.SyslogDrains)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	row := teamsQuery.
+		Where(sq.Eq{"t.id": teamID}).
 		RunWith(tx).
 		QueryRow()
 
@@ -90,9 +127,8 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
-		From("teams").
-		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
+	row := teamsQuery.
+		Where(sq.Eq{"LOWER(t.name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
 		QueryRow()
 
@@ -109,9 +145,8 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
-		From("teams").
-		OrderBy("id ASC").
+	rows, err := teamsQuery.
+		OrderBy("t.id ASC").
 		RunWith(factory.conn).
 		Query()
 	if err != nil {
@@ -171,14 +206,24 @@ func (factory *teamFactory) NotifyResourceScanner() error {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var providerAuth, pipelineAuth, varSources, nonce, syslogDrains, syslogDrainsNonce sql.NullString
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&pipelineAuth,
+		&t.defaultBuildPriority,
+		&t.maxRunningBuilds,
+		&varSources,
+		&nonce,
+		&syslogDrains,
+		&syslogDrainsNonce,
 	)
+	if err != nil {
+		return err
+	}
 
 	if providerAuth.Valid {
 		err = json.Unmarshal([]byte(providerAuth.String), &t.auth)
@@ -187,5 +232,26 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		}
 	}
 
-	return err
+	if pipelineAuth.Valid {
+		err = json.Unmarshal([]byte(pipelineAuth.String), &t.pipelineAuth)
+		if err != nil {
+			return err
+		}
+	}
+
+	if varSources.Valid {
+		t.varSources, err = decryptVarSources(factory.conn.EncryptionStrategy(), varSources.String, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	if syslogDrains.Valid {
+		t.syslogDrains, err = decryptSyslogDrains(factory.conn.EncryptionStrategy(), syslogDrains.String, syslogDrainsNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -38,6 +38,58 @@ var _ = Describe("Team Factory", func() {
 			Expect(found).To(BeTrue())
 			Expect(t.ID()).To(Equal(team.ID()))
 		})
+
+		Context("when the team has var sources", func() {
+			BeforeEach(func() {
+				atcTeam.Name = "team-with-var-sources"
+				atcTeam.VarSources = &atc.VarSourceConfigs{
+					{
+						Name: "some-var-source",
+						Type: "dummy",
+						Config: map[string]interface{}{
+							"vars": map[string]interface{}{"foo": "bar"},
+						},
+					},
+				}
+
+				var err error
+				team, err = teamFactory.CreateTeam(atcTeam)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("saves the var sources", func() {
+				Expect(team.VarSources()).To(Equal(*atcTeam.VarSources))
+
+				t, found, err := teamFactory.FindTeam(atcTeam.Name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(t.VarSources()).To(Equal(*atcTeam.VarSources))
+			})
+		})
+
+		Context("when the team binds roles in pipelines", func() {
+			BeforeEach(func() {
+				atcTeam.Name = "team-with-pipeline-auth"
+				atcTeam.PipelineAuth = &atc.PipelineAuth{
+					"deploy-prod": {
+						"member": {"users": []string{"local:username"}},
+					},
+				}
+
+				var err error
+				team, err = teamFactory.CreateTeam(atcTeam)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("saves the pipeline auth", func() {
+				Expect(team.PipelineAuth()).To(Equal(*atcTeam.PipelineAuth))
+
+				t, found, err := teamFactory.FindTeam(atcTeam.Name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(t.PipelineAuth()).To(Equal(*atcTeam.PipelineAuth))
+			})
+		})
 	})
 
 	Describe("FindTeam", func() {
@@ -61,6 +61,8 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	CPULoad() float64
+	MemoryLoad() float64
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
@@ -99,6 +101,8 @@ type worker struct {
 	activeContainers int
 	activeVolumes    int
 	activeTasks      int
+	cpuLoad          float64
+	memoryLoad       float64
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
@@ -122,6 +126,8 @@ func (worker *worker) HTTPSProxyURL() string                   { return worker.h
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) CPULoad() float64                        { return worker.cpuLoad }
+func (worker *worker) MemoryLoad() float64                     { return worker.memoryLoad }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
@@ -23,6 +23,7 @@ type WorkerFactory interface {
 
 	FindWorkersForContainerByOwner(ContainerOwner) ([]Worker, error)
 	BuildContainersCountPerWorker() (map[string]int, error)
+	WorkerNamesForBuild(buildID int) ([]string, error)
 }
 
 type workerFactory struct {
@@ -47,6 +48,8 @@ var workersQuery = psql.Select(`
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.cpu_load,
+		w.memory_load,
 		w.resource_types,
 		w.platform,
 		w.tags,
@@ -154,6 +157,8 @@ func scanWorker(worker *worker, row scannable) error {
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&worker.cpuLoad,
+		&worker.memoryLoad,
 		&resourceTypes,
 		&platform,
 		&tags,
@@ -256,6 +261,8 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("cpu_load", atcWorker.CPULoad).
+		Set("memory_load", atcWorker.MemoryLoad).
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
@@ -364,6 +371,33 @@ func (f *workerFactory) BuildContainersCountPerWorker() (map[string]int, error)
 	return countByWorker, nil
 }
 
+func (f *workerFactory) WorkerNamesForBuild(buildID int) ([]string, error) {
+	rows, err := psql.Select("DISTINCT worker_name").
+		From("containers").
+		Where(sq.Eq{"build_id": buildID}).
+		OrderBy("worker_name").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var workerNames []string
+	for rows.Next() {
+		var workerName string
+		err = rows.Scan(&workerName)
+		if err != nil {
+			return nil, err
+		}
+
+		workerNames = append(workerNames, workerName)
+	}
+
+	return workerNames, nil
+}
+
 func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, conn Conn) (Worker, error) {
 	resourceTypes, err := json.Marshal(atcWorker.ResourceTypes)
 	if err != nil {
@@ -398,6 +432,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
 		atcWorker.ActiveVolumes,
+		atcWorker.CPULoad,
+		atcWorker.MemoryLoad,
 		resourceTypes,
 		tags,
 		atcWorker.Platform,
@@ -429,6 +465,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"addr",
 			"active_containers",
 			"active_volumes",
+			"cpu_load",
+			"memory_load",
 			"resource_types",
 			"tags",
 			"platform",
@@ -454,6 +492,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				addr = ?,
 				active_containers = ?,
 				active_volumes = ?,
+				cpu_load = ?,
+				memory_load = ?,
 				resource_types = ?,
 				tags = ?,
 				platform = ?,
@@ -502,6 +542,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		noProxy:          atcWorker.NoProxy,
 		activeContainers: atcWorker.ActiveContainers,
 		activeVolumes:    atcWorker.ActiveVolumes,
+		cpuLoad:          atcWorker.CPULoad,
+		memoryLoad:       atcWorker.MemoryLoad,
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
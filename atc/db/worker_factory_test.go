@@ -435,6 +435,17 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(*foundWorker.BaggageclaimURL()).To(Equal("some-bc-url"))
 			})
 
+			It("updates the load of the worker", func() {
+				atcWorker.CPULoad = 1.5
+				atcWorker.MemoryLoad = 0.25
+
+				foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(foundWorker.CPULoad()).To(Equal(1.5))
+				Expect(foundWorker.MemoryLoad()).To(Equal(0.25))
+			})
+
 			Context("when the current state is landing", func() {
 				BeforeEach(func() {
 					atcWorker.State = string(db.WorkerStateLanding)
@@ -570,7 +581,7 @@ var _ = Describe("WorkerFactory", func() {
 					}
 
 					var err error
-					otherPipeline, _, err := defaultTeam.SavePipeline("other-pipeline", atc.Config{
+					otherPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Resources: atc.ResourceConfigs{
 							{
 								Name: "some-resource",
@@ -797,5 +808,24 @@ var _ = Describe("WorkerFactory", func() {
 			Expect(containersCountByWorker[defaultWorker.Name()]).To(Equal(1))
 			Expect(containersCountByWorker[worker.Name()]).To(Equal(1))
 		})
+
+		Describe("WorkerNamesForBuild", func() {
+			It("returns the workers with containers for the build", func() {
+				workerNames, err := workerFactory.WorkerNamesForBuild(build.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(workerNames).To(ConsistOf(defaultWorker.Name(), worker.Name()))
+			})
+
+			It("returns nothing for a build without containers", func() {
+				otherBuild, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				workerNames, err := workerFactory.WorkerNamesForBuild(otherBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(workerNames).To(BeEmpty())
+			})
+		})
 	})
 })
@@ -0,0 +1,163 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// WorkerTaskQueueTTL is how long an entry in the worker task queue is
+// considered without being refreshed by its waiting task.
+const WorkerTaskQueueTTL = time.Minute
+
+//go:generate counterfeiter . WorkerTaskQueue
+
+// WorkerTaskQueue tracks the tasks which are waiting for a worker to become
+// available when the number of active tasks per worker is limited, so that
+// the tasks of higher-priority builds can be given the next free worker.
+//
+// Waiting tasks periodically refresh their entry in the queue. Entries which
+// have not been refreshed within the WorkerTaskQueueTTL, e.g. because the web
+// node running the build went away, are no longer considered.
+//
+// Tasks only give way to tasks which compete with them for a worker, i.e.
+// when a running worker could run either of them.
+type WorkerTaskQueue interface {
+	Wait(id string, buildID int, spec WorkerTaskSpec) error
+	Leave(id string) error
+
+	HigherPriorityWaiting(buildID int, spec *WorkerTaskSpec) (bool, error)
+}
+
+// WorkerTaskSpec describes the workers a task can run on. Workers of other
+// teams than the task's build are never considered.
+type WorkerTaskSpec struct {
+	Platform string
+	Tags     []string
+}
+
+type workerTaskQueue struct {
+	conn Conn
+}
+
+func NewWorkerTaskQueue(conn Conn) WorkerTaskQueue {
+	return &workerTaskQueue{
+		conn: conn,
+	}
+}
+
+func (queue *workerTaskQueue) Wait(id string, buildID int, spec WorkerTaskSpec) error {
+	tags, err := spec.encodeTags()
+	if err != nil {
+		return err
+	}
+
+	tx, err := queue.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = tx.Exec(`
+		DELETE FROM worker_task_queue
+		WHERE updated_at < now() - $1::interval
+	`, queueTTLInterval())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO worker_task_queue (id, build_id, platform, tags)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET updated_at = now()
+	`, id, buildID, spec.Platform, tags)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (queue *workerTaskQueue) Leave(id string) error {
+	_, err := psql.Delete("worker_task_queue").
+		Where("id = ?", id).
+		RunWith(queue.conn).
+		Exec()
+	return err
+}
+
+// HigherPriorityWaiting returns true if a task of a build with a higher
+// priority than the given build is waiting for a worker which the given build
+// could run its task with the spec on. When the spec is nil, e.g. because the
+// build hasn't started yet, any worker the build's team can use is considered.
+func (queue *workerTaskQueue) HigherPriorityWaiting(buildID int, spec *WorkerTaskSpec) (bool, error) {
+	var platform, tags interface{}
+	if spec != nil {
+		encodedTags, err := spec.encodeTags()
+		if err != nil {
+			return false, err
+		}
+
+		platform = spec.Platform
+		tags = encodedTags
+	}
+
+	var waiting bool
+	err := queue.conn.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM worker_task_queue q
+			JOIN builds b ON b.id = q.build_id
+			LEFT JOIN jobs j ON j.id = b.job_id
+			JOIN teams t ON t.id = b.team_id,
+			builds mb
+			LEFT JOIN jobs mj ON mj.id = mb.job_id
+			JOIN teams mt ON mt.id = mb.team_id
+			WHERE mb.id = $1
+			AND q.updated_at >= now() - $2::interval
+			AND COALESCE(j.priority, t.default_build_priority, 0) > COALESCE(mj.priority, mt.default_build_priority, 0)
+			AND EXISTS (
+				SELECT 1
+				FROM (
+					SELECT team_id, platform, COALESCE(NULLIF(tags, 'null'), '[]')::jsonb AS tags
+					FROM workers
+					WHERE state = 'running'
+				) w
+				WHERE (w.team_id IS NULL OR w.team_id = b.team_id)
+				AND (w.team_id IS NULL OR w.team_id = mb.team_id)
+				AND (q.platform = '' OR w.platform = q.platform)
+				AND CASE WHEN q.tags = '[]' THEN w.tags = '[]' ELSE w.tags @> q.tags END
+				AND ($4::jsonb IS NULL OR (
+					($3::text = '' OR w.platform = $3::text)
+					AND CASE WHEN $4::jsonb = '[]' THEN w.tags = '[]' ELSE w.tags @> $4::jsonb END
+				))
+			)
+		)
+	`, buildID, queueTTLInterval(), platform, tags).Scan(&waiting)
+	if err != nil {
+		return false, err
+	}
+
+	return waiting, nil
+}
+
+// encodeTags encodes the tags as a JSON array, which is empty rather than
+// null when there are none so that they can be compared with workers' tags.
+func (spec WorkerTaskSpec) encodeTags() (string, error) {
+	tags := spec.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	encoded, err := json.Marshal(tags)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+func queueTTLInterval() string {
+	return fmt.Sprintf("%.0f seconds", WorkerTaskQueueTTL.Seconds())
+}
@@ -0,0 +1,165 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerTaskQueue", func() {
+	var (
+		queue db.WorkerTaskQueue
+
+		lowBuild, highBuild db.Build
+	)
+
+	BeforeEach(func() {
+		queue = db.NewWorkerTaskQueue(dbConn)
+
+		high := 10
+		pipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "queue-pipeline"}, atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "low-job"},
+				{Name: "high-job", Priority: &high},
+			},
+		}, db.ConfigVersion(0), false)
+		Expect(err).ToNot(HaveOccurred())
+
+		lowJob, found, err := pipeline.Job("low-job")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		highJob, found, err := pipeline.Job("high-job")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		lowBuild, err = lowJob.CreateBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		highBuild, err = highJob.CreateBuild()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("HigherPriorityWaiting", func() {
+		It("returns false when no tasks are waiting", func() {
+			waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), &db.WorkerTaskSpec{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(waiting).To(BeFalse())
+		})
+
+		Context("when a task of the higher-priority build is waiting", func() {
+			BeforeEach(func() {
+				err := queue.Wait("some-task", highBuild.ID(), db.WorkerTaskSpec{})
+				Expect(err).ToNot(HaveOccurred())
+
+				// waiting again refreshes the entry
+				err = queue.Wait("some-task", highBuild.ID(), db.WorkerTaskSpec{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns true for the lower-priority build", func() {
+				waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), &db.WorkerTaskSpec{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(waiting).To(BeTrue())
+			})
+
+			It("returns false for the higher-priority build", func() {
+				waiting, err := queue.HigherPriorityWaiting(highBuild.ID(), &db.WorkerTaskSpec{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(waiting).To(BeFalse())
+			})
+
+			It("returns true for the lower-priority build before it has started", func() {
+				waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(waiting).To(BeTrue())
+			})
+
+			It("returns false for a task of the lower-priority build which needs other workers", func() {
+				waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), &db.WorkerTaskSpec{Tags: []string{"gpu"}})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(waiting).To(BeFalse())
+			})
+
+			Context("when the task leaves the queue", func() {
+				BeforeEach(func() {
+					err := queue.Leave("some-task")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns false for the lower-priority build", func() {
+					waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), &db.WorkerTaskSpec{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(waiting).To(BeFalse())
+				})
+			})
+
+			Context("when the only workers it could run on belong to another team", func() {
+				BeforeEach(func() {
+					otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = dbConn.Exec(`UPDATE workers SET team_id = $1`, otherTeam.ID())
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns false for the lower-priority build", func() {
+					waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), nil)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(waiting).To(BeFalse())
+				})
+			})
+
+			Context("when the entry has not been refreshed in time", func() {
+				BeforeEach(func() {
+					_, err := dbConn.Exec(`UPDATE worker_task_queue SET updated_at = now() - interval '1 hour'`)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns false for the lower-priority build", func() {
+					waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), &db.WorkerTaskSpec{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(waiting).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when a task of the higher-priority build is waiting for tagged workers", func() {
+			BeforeEach(func() {
+				err := queue.Wait("some-task", highBuild.ID(), db.WorkerTaskSpec{Tags: []string{"gpu"}})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns false when no running worker has the tags", func() {
+				waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(waiting).To(BeFalse())
+			})
+
+			Context("when a worker has the tags", func() {
+				BeforeEach(func() {
+					taggedWorkerPayload := defaultWorkerPayload
+					taggedWorkerPayload.Name = "gpu-worker"
+					taggedWorkerPayload.GardenAddr = "3.4.5.6:7777"
+					taggedWorkerPayload.Tags = []string{"gpu", "big"}
+
+					_, err := workerFactory.SaveWorker(taggedWorkerPayload, 0)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns true for a task of the lower-priority build which needs the tags", func() {
+					waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), &db.WorkerTaskSpec{Tags: []string{"gpu"}})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(waiting).To(BeTrue())
+				})
+
+				It("returns false for a task of the lower-priority build which needs no tags", func() {
+					waiting, err := queue.HigherPriorityWaiting(lowBuild.ID(), &db.WorkerTaskSpec{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(waiting).To(BeFalse())
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,39 @@
+package atc
+
+const (
+	EncryptionKeyRotationRunning = "running"
+	EncryptionKeyRotationDone    = "done"
+)
+
+type EncryptionKeyRotation struct {
+	State      string                        `json:"state,omitempty"`
+	StartedAt  int64                         `json:"started_at,omitempty"`
+	FinishedAt int64                         `json:"finished_at,omitempty"`
+	LastError  string                        `json:"last_error,omitempty"`
+	Columns    []EncryptionKeyRotationColumn `json:"columns,omitempty"`
+}
+
+type EncryptionKeyRotationColumn struct {
+	Table         string `json:"table"`
+	Column        string `json:"column"`
+	RowsTotal     int    `json:"rows_total"`
+	RowsProcessed int    `json:"rows_processed"`
+	RowsRotated   int    `json:"rows_rotated"`
+	Done          bool   `json:"done"`
+}
+
+// RowsRemaining returns how many rows the rotation has yet to process.
+func (rotation EncryptionKeyRotation) RowsRemaining() int {
+	remaining := 0
+	for _, column := range rotation.Columns {
+		if column.Done {
+			continue
+		}
+
+		if column.RowsTotal > column.RowsProcessed {
+			remaining += column.RowsTotal - column.RowsProcessed
+		}
+	}
+
+	return remaining
+}
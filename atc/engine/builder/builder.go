@@ -37,6 +37,7 @@ type DelegateFactory interface {
 	TaskDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.TaskDelegate
 	CheckDelegate(db.Check, atc.PlanID, vars.CredVarsTracker) exec.CheckDelegate
 	BuildStepDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.BuildStepDelegate
+	AcrossDelegate(db.Build, atc.PlanID, vars.CredVarsTracker) exec.AcrossDelegate
 }
 
 func NewStepBuilder(
@@ -143,6 +144,10 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTra
 		return builder.buildDoStep(build, plan, credVarsTracker)
 	}
 
+	if plan.Across != nil {
+		return builder.buildAcrossStep(build, plan, credVarsTracker)
+	}
+
 	if plan.Timeout != nil {
 		return builder.buildTimeoutStep(build, plan, credVarsTracker)
 	}
@@ -246,6 +251,26 @@ func (builder *stepBuilder) buildDoStep(build db.Build, plan atc.Plan, credVarsT
 	return step
 }
 
+func (builder *stepBuilder) buildAcrossStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	acrossVars := plan.Across.Vars
+
+	return exec.Across(
+		plan.ID,
+		*plan.Across,
+		builder.delegateFactory.AcrossDelegate(build, plan.ID, credVarsTracker),
+		func(substep atc.VarScopedPlan) exec.Step {
+			scope := credVarsTracker.NewLocalScope()
+			for i, v := range acrossVars {
+				scope.AddLocalVar(v.Var, substep.Values[i], false)
+			}
+
+			innerPlan := substep.Step
+			innerPlan.Attempts = plan.Attempts
+			return builder.buildStep(build, innerPlan, scope)
+		},
+	)
+}
+
 func (builder *stepBuilder) buildTimeoutStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
 	innerPlan := plan.Timeout.Step
 	innerPlan.Attempts = plan.Attempts
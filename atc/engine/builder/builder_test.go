@@ -1,6 +1,8 @@
 package builder_test
 
 import (
+	"context"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
@@ -13,6 +15,8 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/engine/builder/builderfakes"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
 )
 
 type StepBuilder interface {
@@ -766,6 +770,72 @@ var _ = Describe("Builder", func() {
 					})
 				})
 
+				Context("running across steps", func() {
+					var (
+						taskPlan atc.Plan
+						step     exec.Step
+					)
+
+					BeforeEach(func() {
+						taskPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name: "some-task",
+						})
+
+						expectedPlan = planFactory.NewPlan(atc.AcrossPlan{
+							Vars: []atc.AcrossVar{
+								{
+									Var:    "go_version",
+									Values: []interface{}{"1.13", "1.14"},
+								},
+							},
+							Steps: []atc.VarScopedPlan{
+								{
+									Step:   taskPlan,
+									Values: []interface{}{"1.13"},
+								},
+								{
+									Step:   taskPlan,
+									Values: []interface{}{"1.14"},
+								},
+							},
+						})
+
+						fakeTaskStep := new(execfakes.FakeStep)
+						fakeTaskStep.SucceededReturns(true)
+						fakeStepFactory.TaskStepReturns(fakeTaskStep)
+					})
+
+					JustBeforeEach(func() {
+						step, err = stepBuilder.BuildStep(logger, fakeBuild)
+						Expect(err).NotTo(HaveOccurred())
+
+						err = step.Run(context.Background(), exec.NewRunState())
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("constructs a step for each combination of values", func() {
+						Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(2))
+
+						plan, stepMetadata, _, _ := fakeStepFactory.TaskStepArgsForCall(0)
+						Expect(plan).To(Equal(taskPlan))
+						Expect(stepMetadata).To(Equal(expectedMetadata))
+
+						Expect(step.Succeeded()).To(BeTrue())
+					})
+
+					It("scopes the across vars to each step", func() {
+						Expect(fakeDelegateFactory.TaskDelegateCallCount()).To(Equal(2))
+
+						for i, expected := range []string{"1.13", "1.14"} {
+							_, _, credVarsTracker := fakeDelegateFactory.TaskDelegateArgsForCall(i)
+							val, found, err := credVarsTracker.Get(vars.VariableDefinition{Name: ".:go_version"})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(val).To(Equal(expected))
+						}
+					})
+				})
+
 				Context("running try steps", func() {
 					var inputPlan atc.Plan
 
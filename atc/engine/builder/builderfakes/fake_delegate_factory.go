@@ -12,6 +12,19 @@ import (
 )
 
 type FakeDelegateFactory struct {
+	AcrossDelegateStub        func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.AcrossDelegate
+	acrossDelegateMutex       sync.RWMutex
+	acrossDelegateArgsForCall []struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 vars.CredVarsTracker
+	}
+	acrossDelegateReturns struct {
+		result1 exec.AcrossDelegate
+	}
+	acrossDelegateReturnsOnCall map[int]struct {
+		result1 exec.AcrossDelegate
+	}
 	BuildStepDelegateStub        func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.BuildStepDelegate
 	buildStepDelegateMutex       sync.RWMutex
 	buildStepDelegateArgsForCall []struct {
@@ -81,6 +94,68 @@ type FakeDelegateFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeDelegateFactory) AcrossDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 vars.CredVarsTracker) exec.AcrossDelegate {
+	fake.acrossDelegateMutex.Lock()
+	ret, specificReturn := fake.acrossDelegateReturnsOnCall[len(fake.acrossDelegateArgsForCall)]
+	fake.acrossDelegateArgsForCall = append(fake.acrossDelegateArgsForCall, struct {
+		arg1 db.Build
+		arg2 atc.PlanID
+		arg3 vars.CredVarsTracker
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AcrossDelegate", []interface{}{arg1, arg2, arg3})
+	fake.acrossDelegateMutex.Unlock()
+	if fake.AcrossDelegateStub != nil {
+		return fake.AcrossDelegateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.acrossDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeDelegateFactory) AcrossDelegateCallCount() int {
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
+	return len(fake.acrossDelegateArgsForCall)
+}
+
+func (fake *FakeDelegateFactory) AcrossDelegateCalls(stub func(db.Build, atc.PlanID, vars.CredVarsTracker) exec.AcrossDelegate) {
+	fake.acrossDelegateMutex.Lock()
+	defer fake.acrossDelegateMutex.Unlock()
+	fake.AcrossDelegateStub = stub
+}
+
+func (fake *FakeDelegateFactory) AcrossDelegateArgsForCall(i int) (db.Build, atc.PlanID, vars.CredVarsTracker) {
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
+	argsForCall := fake.acrossDelegateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDelegateFactory) AcrossDelegateReturns(result1 exec.AcrossDelegate) {
+	fake.acrossDelegateMutex.Lock()
+	defer fake.acrossDelegateMutex.Unlock()
+	fake.AcrossDelegateStub = nil
+	fake.acrossDelegateReturns = struct {
+		result1 exec.AcrossDelegate
+	}{result1}
+}
+
+func (fake *FakeDelegateFactory) AcrossDelegateReturnsOnCall(i int, result1 exec.AcrossDelegate) {
+	fake.acrossDelegateMutex.Lock()
+	defer fake.acrossDelegateMutex.Unlock()
+	fake.AcrossDelegateStub = nil
+	if fake.acrossDelegateReturnsOnCall == nil {
+		fake.acrossDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.AcrossDelegate
+		})
+	}
+	fake.acrossDelegateReturnsOnCall[i] = struct {
+		result1 exec.AcrossDelegate
+	}{result1}
+}
+
 func (fake *FakeDelegateFactory) BuildStepDelegate(arg1 db.Build, arg2 atc.PlanID, arg3 vars.CredVarsTracker) exec.BuildStepDelegate {
 	fake.buildStepDelegateMutex.Lock()
 	ret, specificReturn := fake.buildStepDelegateReturnsOnCall[len(fake.buildStepDelegateArgsForCall)]
@@ -394,6 +469,8 @@ func (fake *FakeDelegateFactory) TaskDelegateReturnsOnCall(i int, result1 exec.T
 func (fake *FakeDelegateFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
 	fake.checkDelegateMutex.RLock()
@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"encoding/json"
 	"io"
 	"strings"
 	"time"
@@ -43,6 +44,10 @@ func (delegate *delegateFactory) BuildStepDelegate(build db.Build, planID atc.Pl
 	return NewBuildStepDelegate(build, planID, credVarsTracker, clock.NewClock())
 }
 
+func (delegate *delegateFactory) AcrossDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker) exec.AcrossDelegate {
+	return NewAcrossDelegate(build, planID, credVarsTracker, clock.NewClock())
+}
+
 func NewGetDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.GetDelegate {
 	return &getDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
@@ -336,6 +341,43 @@ func (*checkDelegate) Stderr() io.Writer                                 { retur
 func (*checkDelegate) ImageVersionDetermined(db.UsedResourceCache) error { return nil }
 func (*checkDelegate) Errored(lager.Logger, string)                      { return }
 
+func NewAcrossDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.CredVarsTracker, clock clock.Clock) exec.AcrossDelegate {
+	return &acrossDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
+
+		eventOrigin: event.Origin{ID: event.OriginID(planID)},
+		build:       build,
+		clock:       clock,
+	}
+}
+
+type acrossDelegate struct {
+	exec.BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func (d *acrossDelegate) SubstepsExpanded(logger lager.Logger, substeps []atc.VarScopedPlan) {
+	public := make([]*json.RawMessage, len(substeps))
+	for i, substep := range substeps {
+		public[i] = substep.Public()
+	}
+
+	err := d.build.SaveEvent(event.AcrossSubsteps{
+		Origin:   d.eventOrigin,
+		Time:     d.clock.Now().Unix(),
+		Substeps: public,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-across-substeps-event", err)
+		return
+	}
+
+	logger.Info("substeps-expanded", lager.Data{"substeps": len(substeps)})
+}
+
 func NewBuildStepDelegate(
 	build db.Build,
 	planID atc.PlanID,
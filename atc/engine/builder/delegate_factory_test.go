@@ -317,6 +317,42 @@ var _ = Describe("DelegateFactory", func() {
 		})
 	})
 
+	Describe("AcrossDelegate", func() {
+		var (
+			delegate exec.AcrossDelegate
+			substeps []atc.VarScopedPlan
+		)
+
+		BeforeEach(func() {
+			delegate = builder.NewAcrossDelegate(fakeBuild, "some-plan-id", credVarsTracker, fakeClock)
+
+			substeps = []atc.VarScopedPlan{
+				{
+					Step: atc.Plan{
+						ID:   "some-plan-id/0",
+						Task: &atc.TaskPlan{Name: "some-task"},
+					},
+					Values: []interface{}{"a"},
+				},
+			}
+		})
+
+		Describe("SubstepsExpanded", func() {
+			JustBeforeEach(func() {
+				delegate.SubstepsExpanded(logger, substeps)
+			})
+
+			It("saves an event with the public substep plans", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.AcrossSubsteps{
+					Origin:   event.Origin{ID: event.OriginID("some-plan-id")},
+					Time:     123456789,
+					Substeps: []*json.RawMessage{substeps[0].Public()},
+				}))
+			})
+		})
+	})
+
 	Describe("BuildStepDelegate", func() {
 		var (
 			delegate exec.BuildStepDelegate
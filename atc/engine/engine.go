@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/tracing"
@@ -40,20 +41,22 @@ type StepBuilder interface {
 	BuildStepErrored(lager.Logger, db.Build, error)
 }
 
-func NewEngine(builder StepBuilder) Engine {
+func NewEngine(builder StepBuilder, defaultBuildTimeout time.Duration) Engine {
 	return &engine{
-		builder:       builder,
-		release:       make(chan bool),
-		trackedStates: new(sync.Map),
-		waitGroup:     new(sync.WaitGroup),
+		builder:             builder,
+		defaultBuildTimeout: defaultBuildTimeout,
+		release:             make(chan bool),
+		trackedStates:       new(sync.Map),
+		waitGroup:           new(sync.WaitGroup),
 	}
 }
 
 type engine struct {
-	builder       StepBuilder
-	release       chan bool
-	trackedStates *sync.Map
-	waitGroup     *sync.WaitGroup
+	builder             StepBuilder
+	defaultBuildTimeout time.Duration
+	release             chan bool
+	trackedStates       *sync.Map
+	waitGroup           *sync.WaitGroup
 }
 
 func (engine *engine) ReleaseAll(logger lager.Logger) {
@@ -77,6 +80,7 @@ func (engine *engine) NewBuild(build db.Build) Runnable {
 		cancel,
 		build,
 		engine.builder,
+		engine.defaultBuildTimeout,
 		engine.release,
 		engine.trackedStates,
 		engine.waitGroup,
@@ -103,6 +107,7 @@ func NewBuild(
 	cancel func(),
 	build db.Build,
 	builder StepBuilder,
+	defaultBuildTimeout time.Duration,
 	release chan bool,
 	trackedStates *sync.Map,
 	waitGroup *sync.WaitGroup,
@@ -111,8 +116,9 @@ func NewBuild(
 		ctx:    ctx,
 		cancel: cancel,
 
-		build:   build,
-		builder: builder,
+		build:               build,
+		builder:             builder,
+		defaultBuildTimeout: defaultBuildTimeout,
 
 		release:       release,
 		trackedStates: trackedStates,
@@ -124,14 +130,18 @@ type engineBuild struct {
 	ctx    context.Context
 	cancel func()
 
-	build   db.Build
-	builder StepBuilder
+	build               db.Build
+	builder             StepBuilder
+	defaultBuildTimeout time.Duration
 
 	release       chan bool
 	trackedStates *sync.Map
 	waitGroup     *sync.WaitGroup
 
 	pipelineCredMgrs []creds.Manager
+
+	timeout  time.Duration
+	timedOut bool
 }
 
 func (b *engineBuild) Run(logger lager.Logger) {
@@ -190,6 +200,12 @@ func (b *engineBuild) Run(logger lager.Logger) {
 	})
 	defer span.End()
 
+	ctx = metric.WithBuildLabels(ctx, metric.BuildLabels{
+		TeamName:     b.build.TeamName(),
+		PipelineName: b.build.PipelineName(),
+		JobName:      b.build.JobName(),
+	})
+
 	step, err := b.builder.BuildStep(logger, b.build)
 	if err != nil {
 		logger.Error("failed-to-build-step", err)
@@ -202,9 +218,18 @@ func (b *engineBuild) Run(logger lager.Logger) {
 
 		return
 	}
-	b.trackStarted(logger)
+	b.trackStarted(ctx, logger)
 	defer b.trackFinished(logger)
 
+	b.timeout = b.buildTimeout(logger)
+
+	timeoutCtx := ctx
+	if b.timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		timeoutCtx, cancelTimeout = context.WithDeadline(ctx, b.deadline())
+		defer cancelTimeout()
+	}
+
 	logger.Info("running")
 
 	state := b.runState()
@@ -224,7 +249,7 @@ func (b *engineBuild) Run(logger lager.Logger) {
 
 	done := make(chan error)
 	go func() {
-		ctx := lagerctx.NewContext(ctx, logger)
+		ctx := lagerctx.NewContext(timeoutCtx, logger)
 		done <- step.Run(ctx, state)
 	}()
 
@@ -234,12 +259,93 @@ func (b *engineBuild) Run(logger lager.Logger) {
 
 	case err = <-done:
 		logger.Debug("engine-build-done")
+		b.timedOut = isTimedOut(timeoutCtx, err)
 		b.finish(logger.Session("finish"), err, step.Succeeded())
 	}
 }
 
+// buildTimeout returns the timeout configured on the build's job, falling
+// back to the default build timeout for one-off builds and jobs without one.
+func (b *engineBuild) buildTimeout(logger lager.Logger) time.Duration {
+	if b.build.JobID() == 0 {
+		return b.defaultBuildTimeout
+	}
+
+	pipeline, found, err := b.build.Pipeline()
+	if err != nil {
+		logger.Error("failed-to-find-pipeline", err)
+		return b.defaultBuildTimeout
+	}
+
+	if !found {
+		return b.defaultBuildTimeout
+	}
+
+	job, found, err := pipeline.Job(b.build.JobName())
+	if err != nil {
+		logger.Error("failed-to-find-job", err)
+		return b.defaultBuildTimeout
+	}
+
+	if !found {
+		return b.defaultBuildTimeout
+	}
+
+	config, err := job.Config()
+	if err != nil {
+		logger.Error("failed-to-get-job-config", err)
+		return b.defaultBuildTimeout
+	}
+
+	if config.Timeout == "" {
+		return b.defaultBuildTimeout
+	}
+
+	timeout, err := time.ParseDuration(config.Timeout)
+	if err != nil {
+		logger.Error("failed-to-parse-build-timeout", err)
+		return b.defaultBuildTimeout
+	}
+
+	return timeout
+}
+
+// deadline is measured from when the build started, so that a build which is
+// picked back up by another ATC does not get its timeout reset.
+func (b *engineBuild) deadline() time.Time {
+	startTime := b.build.StartTime()
+	if startTime.IsZero() {
+		startTime = time.Now()
+	}
+
+	return startTime.Add(b.timeout)
+}
+
+// isTimedOut reports whether the build was interrupted by its timeout. A build
+// which finished on its own, or was aborted, just as the timeout elapsed did not
+// time out.
+func isTimedOut(timeoutCtx context.Context, err error) bool {
+	if err != context.Canceled && err != context.DeadlineExceeded {
+		return false
+	}
+
+	return timeoutCtx.Err() == context.DeadlineExceeded
+}
+
 func (b *engineBuild) finish(logger lager.Logger, err error, succeeded bool) {
-	if err == context.Canceled {
+	if b.timedOut {
+		err := b.build.SaveEvent(event.Error{
+			Message: fmt.Sprintf("build timed out after %s", b.timeout),
+			Time:    time.Now().Unix(),
+		})
+		if err != nil {
+			logger.Error("failed-to-save-timed-out-event", err)
+		}
+
+		b.saveStatus(logger, atc.StatusAborted)
+		logger.Info("timed-out")
+
+	} else if err == context.Canceled {
 		b.saveStatus(logger, atc.StatusAborted)
 		logger.Info("aborted")
 
@@ -263,7 +369,7 @@ func (b *engineBuild) saveStatus(logger lager.Logger, status atc.BuildStatus) {
 	}
 }
 
-func (b *engineBuild) trackStarted(logger lager.Logger) {
+func (b *engineBuild) trackStarted(ctx context.Context, logger lager.Logger) {
 	metric.BuildStarted{
 		PipelineName: b.build.PipelineName(),
 		JobName:      b.build.JobName(),
@@ -271,6 +377,13 @@ func (b *engineBuild) trackStarted(logger lager.Logger) {
 		BuildID:      b.build.ID(),
 		TeamName:     b.build.TeamName(),
 	}.Emit(logger)
+
+	metric.BuildQueueWait{
+		BuildLabels: metric.BuildLabelsFromContext(ctx),
+		BuildID:     b.build.ID(),
+		Duration:    b.build.StartTime().Sub(b.build.CreateTime()),
+		TraceID:     tracing.TraceID(ctx),
+	}.Emit(logger)
 }
 
 func (b *engineBuild) trackFinished(logger lager.Logger) {
@@ -294,6 +407,7 @@ func (b *engineBuild) trackFinished(logger lager.Logger) {
 			BuildStatus:   b.build.Status(),
 			BuildDuration: b.build.EndTime().Sub(b.build.StartTime()),
 			TeamName:      b.build.TeamName(),
+			TimedOut:      b.timedOut,
 		}.Emit(logger)
 	}
 }
@@ -385,9 +499,17 @@ func (c *engineCheck) Run(logger lager.Logger) {
 	state := c.runState()
 	defer c.clearRunState()
 
+	ctx, span := tracing.StartSpan(c.ctx, "check", tracing.Attrs{
+		"team":                     c.check.TeamName(),
+		"check_id":                 strconv.Itoa(c.check.ID()),
+		"resource_config_scope_id": strconv.Itoa(c.check.ResourceConfigScopeID()),
+		"name":                     c.check.Plan().Check.Name,
+		"type":                     c.check.Plan().Check.Type,
+	})
+
 	done := make(chan error)
 	go func() {
-		ctx := lagerctx.NewContext(c.ctx, logger)
+		ctx := lagerctx.NewContext(ctx, logger)
 		done <- step.Run(ctx, state)
 	}()
 
@@ -406,6 +528,8 @@ func (c *engineCheck) Run(logger lager.Logger) {
 			}
 		}
 	}
+
+	tracing.End(span, err)
 }
 
 func (c *engineCheck) runState() exec.RunState {
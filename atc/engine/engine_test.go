@@ -20,6 +20,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 )
 
 var _ = Describe("Engine", func() {
@@ -46,7 +47,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepBuilder)
+			engine = NewEngine(fakeStepBuilder, 0)
 		})
 
 		JustBeforeEach(func() {
@@ -65,7 +66,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepBuilder)
+			engine = NewEngine(fakeStepBuilder, 0)
 		})
 
 		JustBeforeEach(func() {
@@ -83,9 +84,15 @@ var _ = Describe("Engine", func() {
 			release   chan bool
 			cancel    chan bool
 			waitGroup *sync.WaitGroup
+
+			defaultBuildTimeout time.Duration
 		)
 
 		BeforeEach(func() {
+			defaultBuildTimeout = 0
+		})
+
+		JustBeforeEach(func() {
 
 			ctx := context.Background()
 			cancel = make(chan bool)
@@ -98,6 +105,7 @@ var _ = Describe("Engine", func() {
 				func() { cancel <- true },
 				fakeBuild,
 				fakeStepBuilder,
+				defaultBuildTimeout,
 				release,
 				trackedStates,
 				waitGroup,
@@ -206,6 +214,129 @@ var _ = Describe("Engine", func() {
 								})
 							})
 
+							Context("when the build times out", func() {
+								BeforeEach(func() {
+									fakeBuild.StartTimeReturns(time.Now().Add(-time.Hour))
+
+									fakeStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+										select {
+										case <-ctx.Done():
+											return ctx.Err()
+										case <-time.After(100 * time.Millisecond):
+											return nil
+										}
+									}
+								})
+
+								Context("with the default build timeout", func() {
+									BeforeEach(func() {
+										defaultBuildTimeout = time.Minute
+									})
+
+									It("saves a timed out error event", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+										Expect(fakeBuild.SaveEventArgsForCall(0)).To(MatchFields(IgnoreExtras, Fields{
+											"Message": Equal("build timed out after 1m0s"),
+										}))
+									})
+
+									It("aborts the build", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+										Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusAborted))
+									})
+
+									It("does not abort the build as if by a user", func() {
+										waitGroup.Wait()
+										Consistently(cancel).ShouldNot(Receive())
+									})
+
+									Context("when the build succeeds just as the timeout elapses", func() {
+										BeforeEach(func() {
+											fakeStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+												<-ctx.Done()
+												return nil
+											}
+
+											fakeStep.SucceededReturns(true)
+										})
+
+										It("does not time out the build", func() {
+											waitGroup.Wait()
+											Expect(fakeBuild.SaveEventCallCount()).To(Equal(0))
+											Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+											Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusSucceeded))
+										})
+									})
+
+									Context("when the build errors just as the timeout elapses", func() {
+										BeforeEach(func() {
+											fakeStep.RunStub = func(ctx context.Context, state exec.RunState) error {
+												<-ctx.Done()
+												return errors.New("nope")
+											}
+										})
+
+										It("errors the build rather than timing it out", func() {
+											waitGroup.Wait()
+											Expect(fakeBuild.SaveEventCallCount()).To(Equal(0))
+											Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusErrored))
+										})
+									})
+								})
+
+								Context("when the build belongs to a job with a build timeout", func() {
+									var fakeJob *dbfakes.FakeJob
+
+									BeforeEach(func() {
+										defaultBuildTimeout = 2 * time.Hour
+
+										fakeBuild.JobIDReturns(1)
+										fakeBuild.JobNameReturns("some-job")
+
+										fakeJob = new(dbfakes.FakeJob)
+										fakeJob.ConfigReturns(atc.JobConfig{
+											Name:    "some-job",
+											Timeout: "30m",
+										}, nil)
+
+										fakePipeline := new(dbfakes.FakePipeline)
+										fakePipeline.JobReturns(fakeJob, true, nil)
+
+										fakeBuild.PipelineReturns(fakePipeline, true, nil)
+									})
+
+									It("uses the job's build timeout", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.SaveEventArgsForCall(0)).To(MatchFields(IgnoreExtras, Fields{
+											"Message": Equal("build timed out after 30m0s"),
+										}))
+										Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusAborted))
+									})
+
+									Context("when the job has no build timeout", func() {
+										BeforeEach(func() {
+											fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job"}, nil)
+										})
+
+										It("falls back to the default build timeout", func() {
+											waitGroup.Wait()
+											Expect(fakeBuild.SaveEventCallCount()).To(Equal(0))
+											Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusFailed))
+										})
+									})
+								})
+
+								Context("without a build timeout", func() {
+									It("does not time out the build", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.SaveEventCallCount()).To(Equal(0))
+										Expect(fakeBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusFailed))
+									})
+								})
+							})
+
 							Context("when the build finishes without error", func() {
 								BeforeEach(func() {
 									fakeStep.RunReturns(nil)
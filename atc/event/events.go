@@ -1,6 +1,8 @@
 package event
 
 import (
+	"encoding/json"
+
 	"github.com/concourse/concourse/atc"
 )
 
@@ -191,3 +193,12 @@ type Finish struct {
 
 func (Finish) EventType() atc.EventType  { return EventTypeFinish }
 func (Finish) Version() atc.EventVersion { return "1.0" }
+
+type AcrossSubsteps struct {
+	Origin   Origin             `json:"origin"`
+	Time     int64              `json:"time"`
+	Substeps []*json.RawMessage `json:"substeps"`
+}
+
+func (AcrossSubsteps) EventType() atc.EventType  { return EventTypeAcrossSubsteps }
+func (AcrossSubsteps) Version() atc.EventVersion { return "1.0" }
@@ -46,6 +46,7 @@ func init() {
 	RegisterEvent(Status{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
+	RegisterEvent(AcrossSubsteps{})
 
 	// deprecated:
 	RegisterEvent(InitializeV10{})
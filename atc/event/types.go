@@ -45,6 +45,9 @@ const (
 	// finished step
 	EventTypeFinish atc.EventType = "finish"
 
+	// across step expanded its substeps at runtime
+	EventTypeAcrossSubsteps atc.EventType = "across-substeps"
+
 	// error occurred
 	EventTypeError atc.EventType = "error"
 )
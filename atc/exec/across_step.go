@@ -0,0 +1,193 @@
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/vars"
+)
+
+//go:generate counterfeiter . AcrossDelegate
+
+type AcrossDelegate interface {
+	Variables() vars.CredVarsTracker
+
+	SubstepsExpanded(lager.Logger, []atc.VarScopedPlan)
+}
+
+// A SubstepBuilder builds the step to run for one combination of an across
+// step's var values.
+type SubstepBuilder func(atc.VarScopedPlan) Step
+
+// AcrossStep runs a step once for each combination of its vars' values.
+type AcrossStep struct {
+	planID       atc.PlanID
+	plan         atc.AcrossPlan
+	delegate     AcrossDelegate
+	buildSubstep SubstepBuilder
+
+	step Step
+}
+
+// Across constructs an AcrossStep.
+func Across(
+	planID atc.PlanID,
+	plan atc.AcrossPlan,
+	delegate AcrossDelegate,
+	buildSubstep SubstepBuilder,
+) *AcrossStep {
+	return &AcrossStep{
+		planID:       planID,
+		plan:         plan,
+		delegate:     delegate,
+		buildSubstep: buildSubstep,
+	}
+}
+
+// AcrossValuesError is returned when a var's values cannot be resolved to a
+// list.
+type AcrossValuesError struct {
+	Var        string
+	ValuesFrom string
+	Err        error
+}
+
+// Error returns a human-friendly error message.
+func (err AcrossValuesError) Error() string {
+	return fmt.Sprintf("failed to resolve values of across var '%s' from %s to a list: %s", err.Var, err.ValuesFrom, err.Err)
+}
+
+// Run runs the substeps, one per combination of values. Combinations sharing
+// a value of a var are run together, with at most the var's max_in_flight of
+// its values running at once (default 1).
+//
+// If the values of any var come from a var reference, they are resolved first
+// and the substep template is expanded into one substep per combination.
+//
+// With fail fast set, the remaining substeps are aborted once one fails.
+func (step *AcrossStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).Session("across-step", lager.Data{
+		"plan-id": step.planID,
+	})
+
+	var values [][]interface{}
+	for _, v := range step.plan.Vars {
+		vals := v.Values
+		if v.ValuesFrom != "" {
+			var err error
+			vals, err = creds.NewList(step.delegate.Variables(), v.ValuesFrom).Evaluate()
+			if err != nil {
+				return AcrossValuesError{v.Var, v.ValuesFrom, err}
+			}
+		}
+
+		values = append(values, vals)
+	}
+
+	substeps := step.plan.Steps
+	if step.plan.SubStepTemplate != nil {
+		var err error
+		substeps, err = step.expand(values)
+		if err != nil {
+			return err
+		}
+
+		step.delegate.SubstepsExpanded(logger, substeps)
+	}
+
+	steps := make([]Step, len(substeps))
+	for i, substep := range substeps {
+		steps[i] = step.buildSubstep(substep)
+	}
+
+	step.step = step.nest(values, steps)
+
+	return step.step.Run(ctx, state)
+}
+
+// expand makes a copy of the substep template for each combination of values,
+// deriving the plan IDs of each copy from the template's.
+//
+// A get step's version_from referring to a step within the template refers to
+// that step's copy instead. Any other reference, e.g. to a put before the
+// across step, is left as it is.
+func (step *AcrossStep) expand(values [][]interface{}) ([]atc.VarScopedPlan, error) {
+	template, err := json.Marshal(step.plan.SubStepTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	templateIDs := map[atc.PlanID]bool{}
+	step.plan.SubStepTemplate.Each(func(plan *atc.Plan) {
+		templateIDs[plan.ID] = true
+	})
+
+	var substeps []atc.VarScopedPlan
+	for i, combination := range atc.AcrossCombinations(values) {
+		var substep atc.Plan
+		err := json.Unmarshal(template, &substep)
+		if err != nil {
+			return nil, err
+		}
+
+		substep.Each(func(plan *atc.Plan) {
+			plan.ID = derivedPlanID(plan.ID, i)
+
+			if plan.Get != nil && plan.Get.VersionFrom != nil && templateIDs[*plan.Get.VersionFrom] {
+				versionFrom := derivedPlanID(*plan.Get.VersionFrom, i)
+				plan.Get.VersionFrom = &versionFrom
+			}
+		})
+
+		substeps = append(substeps, atc.VarScopedPlan{
+			Step:   substep,
+			Values: combination,
+		})
+	}
+
+	return substeps, nil
+}
+
+func derivedPlanID(id atc.PlanID, index int) atc.PlanID {
+	return atc.PlanID(fmt.Sprintf("%s/%d", id, index))
+}
+
+// nest groups the steps, which are ordered as by atc.AcrossCombinations, into
+// a parallel step per var.
+func (step *AcrossStep) nest(values [][]interface{}, steps []Step) Step {
+	if len(values) == 0 {
+		return steps[0]
+	}
+
+	acrossVar := step.plan.Vars[len(step.plan.Vars)-len(values)]
+
+	maxInFlight := acrossVar.MaxInFlight
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	var children []Step
+	if len(values[0]) > 0 {
+		size := len(steps) / len(values[0])
+		for i := 0; i < len(values[0]); i++ {
+			children = append(children, step.nest(values[1:], steps[i*size:(i+1)*size]))
+		}
+	}
+
+	return InParallel(children, maxInFlight, step.plan.FailFast)
+}
+
+// Succeeded is true if every substep succeeded.
+func (step *AcrossStep) Succeeded() bool {
+	if step.step == nil {
+		return false
+	}
+
+	return step.step.Succeeded()
+}
@@ -0,0 +1,261 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AcrossStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		plan         atc.AcrossPlan
+		fakeDelegate *execfakes.FakeAcrossDelegate
+		varsTracker  vars.CredVarsTracker
+
+		lock          sync.Mutex
+		builtSubsteps []atc.VarScopedPlan
+		substepErr    map[string]error
+		substepFails  map[string]bool
+		ranSubsteps   []string
+
+		state   *execfakes.FakeRunState
+		step    *AcrossStep
+		stepErr error
+	)
+
+	key := func(values []interface{}) string {
+		k := ""
+		for _, v := range values {
+			k += v.(string)
+		}
+		return k
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeDelegate = new(execfakes.FakeAcrossDelegate)
+		varsTracker = vars.NewCredVarsTracker(vars.StaticVariables{}, false)
+		fakeDelegate.VariablesReturns(varsTracker)
+
+		builtSubsteps = nil
+		ranSubsteps = nil
+		substepErr = map[string]error{}
+		substepFails = map[string]bool{}
+
+		state = new(execfakes.FakeRunState)
+
+		plan = atc.AcrossPlan{
+			Vars: []atc.AcrossVar{
+				{Var: "v1", Values: []interface{}{"a", "b"}},
+				{Var: "v2", Values: []interface{}{"1", "2"}},
+			},
+		}
+		for _, values := range atc.AcrossCombinations([][]interface{}{{"a", "b"}, {"1", "2"}}) {
+			plan.Steps = append(plan.Steps, atc.VarScopedPlan{
+				Step:   atc.Plan{ID: atc.PlanID("step-" + key(values))},
+				Values: values,
+			})
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = Across("some-plan-id", plan, fakeDelegate, func(substep atc.VarScopedPlan) Step {
+			builtSubsteps = append(builtSubsteps, substep)
+
+			k := key(substep.Values)
+
+			fakeStep := new(execfakes.FakeStep)
+			fakeStep.RunStub = func(context.Context, RunState) error {
+				lock.Lock()
+				ranSubsteps = append(ranSubsteps, k)
+				lock.Unlock()
+				return substepErr[k]
+			}
+			fakeStep.SucceededReturns(substepErr[k] == nil && !substepFails[k])
+
+			return fakeStep
+		})
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("runs a substep for each combination in order", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(builtSubsteps).To(Equal(plan.Steps))
+		Expect(ranSubsteps).To(Equal([]string{"a1", "a2", "b1", "b2"}))
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	It("does not expand the substeps", func() {
+		Expect(fakeDelegate.SubstepsExpandedCallCount()).To(BeZero())
+	})
+
+	Context("when a substep fails", func() {
+		BeforeEach(func() {
+			substepFails["a1"] = true
+		})
+
+		It("runs the remaining substeps", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(ranSubsteps).To(HaveLen(4))
+		})
+
+		It("fails", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		Context("with fail fast", func() {
+			BeforeEach(func() {
+				plan.FailFast = true
+			})
+
+			It("does not run the remaining substeps", func() {
+				Expect(ranSubsteps).To(Equal([]string{"a1"}))
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when a substep errors", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			substepErr["b2"] = disaster
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(stepErr.Error()).To(ContainSubstring("nope"))
+		})
+	})
+
+	Context("when the values of a var come from a var reference", func() {
+		BeforeEach(func() {
+			varsTracker.AddLocalVar("list", []interface{}{"a", "b"}, false)
+
+			plan = atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{Var: "v1", ValuesFrom: "((.:list))", MaxInFlight: 2},
+				},
+				SubStepTemplate: &atc.Plan{
+					ID: "template",
+					OnSuccess: &atc.OnSuccessPlan{
+						Step: atc.Plan{
+							ID:  "put",
+							Put: &atc.PutPlan{Name: "some-put"},
+						},
+						Next: atc.Plan{
+							ID: "get",
+							Get: &atc.GetPlan{
+								Name:        "some-put",
+								VersionFrom: planIDPtr("put"),
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("expands the template with derived plan IDs", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			expected := []atc.VarScopedPlan{}
+			for i, value := range []string{"a", "b"} {
+				id := func(id string) atc.PlanID {
+					return atc.PlanID(id + "/" + string(rune('0'+i)))
+				}
+
+				expected = append(expected, atc.VarScopedPlan{
+					Step: atc.Plan{
+						ID: id("template"),
+						OnSuccess: &atc.OnSuccessPlan{
+							Step: atc.Plan{
+								ID:  id("put"),
+								Put: &atc.PutPlan{Name: "some-put"},
+							},
+							Next: atc.Plan{
+								ID: id("get"),
+								Get: &atc.GetPlan{
+									Name:        "some-put",
+									VersionFrom: planIDPtr(string(id("put"))),
+								},
+							},
+						},
+					},
+					Values: []interface{}{value},
+				})
+			}
+
+			Expect(builtSubsteps).To(Equal(expected))
+
+			Expect(fakeDelegate.SubstepsExpandedCallCount()).To(Equal(1))
+			_, substeps := fakeDelegate.SubstepsExpandedArgsForCall(0)
+			Expect(substeps).To(Equal(expected))
+		})
+
+		It("runs each substep", func() {
+			Expect(ranSubsteps).To(ConsistOf("a", "b"))
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		Context("when a get refers to a put outside of the template", func() {
+			BeforeEach(func() {
+				plan.SubStepTemplate.OnSuccess.Next.Get.VersionFrom = planIDPtr("outside-put")
+			})
+
+			It("keeps referring to it", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(builtSubsteps).To(HaveLen(2))
+
+				for _, substep := range builtSubsteps {
+					Expect(substep.Step.OnSuccess.Next.Get.VersionFrom).To(Equal(planIDPtr("outside-put")))
+				}
+			})
+		})
+
+		Context("when the var is not a list", func() {
+			BeforeEach(func() {
+				varsTracker.AddLocalVar("list", "nope", false)
+			})
+
+			It("errors without running anything", func() {
+				Expect(stepErr).To(HaveOccurred())
+				Expect(stepErr).To(BeAssignableToTypeOf(AcrossValuesError{}))
+				Expect(builtSubsteps).To(BeEmpty())
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("when the var is empty", func() {
+			BeforeEach(func() {
+				varsTracker.AddLocalVar("list", []interface{}{}, false)
+			})
+
+			It("succeeds without running anything", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(builtSubsteps).To(BeEmpty())
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+	})
+})
+
+func planIDPtr(id string) *atc.PlanID {
+	planID := atc.PlanID(id)
+	return &planID
+}
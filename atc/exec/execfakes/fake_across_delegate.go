@@ -0,0 +1,149 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package execfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/vars"
+)
+
+type FakeAcrossDelegate struct {
+	SubstepsExpandedStub        func(lager.Logger, []atc.VarScopedPlan)
+	substepsExpandedMutex       sync.RWMutex
+	substepsExpandedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 []atc.VarScopedPlan
+	}
+	VariablesStub        func() vars.CredVarsTracker
+	variablesMutex       sync.RWMutex
+	variablesArgsForCall []struct {
+	}
+	variablesReturns struct {
+		result1 vars.CredVarsTracker
+	}
+	variablesReturnsOnCall map[int]struct {
+		result1 vars.CredVarsTracker
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAcrossDelegate) SubstepsExpanded(arg1 lager.Logger, arg2 []atc.VarScopedPlan) {
+	var arg2Copy []atc.VarScopedPlan
+	if arg2 != nil {
+		arg2Copy = make([]atc.VarScopedPlan, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.substepsExpandedMutex.Lock()
+	fake.substepsExpandedArgsForCall = append(fake.substepsExpandedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 []atc.VarScopedPlan
+	}{arg1, arg2Copy})
+	fake.recordInvocation("SubstepsExpanded", []interface{}{arg1, arg2Copy})
+	fake.substepsExpandedMutex.Unlock()
+	if fake.SubstepsExpandedStub != nil {
+		fake.SubstepsExpandedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAcrossDelegate) SubstepsExpandedCallCount() int {
+	fake.substepsExpandedMutex.RLock()
+	defer fake.substepsExpandedMutex.RUnlock()
+	return len(fake.substepsExpandedArgsForCall)
+}
+
+func (fake *FakeAcrossDelegate) SubstepsExpandedCalls(stub func(lager.Logger, []atc.VarScopedPlan)) {
+	fake.substepsExpandedMutex.Lock()
+	defer fake.substepsExpandedMutex.Unlock()
+	fake.SubstepsExpandedStub = stub
+}
+
+func (fake *FakeAcrossDelegate) SubstepsExpandedArgsForCall(i int) (lager.Logger, []atc.VarScopedPlan) {
+	fake.substepsExpandedMutex.RLock()
+	defer fake.substepsExpandedMutex.RUnlock()
+	argsForCall := fake.substepsExpandedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAcrossDelegate) Variables() vars.CredVarsTracker {
+	fake.variablesMutex.Lock()
+	ret, specificReturn := fake.variablesReturnsOnCall[len(fake.variablesArgsForCall)]
+	fake.variablesArgsForCall = append(fake.variablesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Variables", []interface{}{})
+	fake.variablesMutex.Unlock()
+	if fake.VariablesStub != nil {
+		return fake.VariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.variablesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAcrossDelegate) VariablesCallCount() int {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	return len(fake.variablesArgsForCall)
+}
+
+func (fake *FakeAcrossDelegate) VariablesCalls(stub func() vars.CredVarsTracker) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = stub
+}
+
+func (fake *FakeAcrossDelegate) VariablesReturns(result1 vars.CredVarsTracker) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	fake.variablesReturns = struct {
+		result1 vars.CredVarsTracker
+	}{result1}
+}
+
+func (fake *FakeAcrossDelegate) VariablesReturnsOnCall(i int, result1 vars.CredVarsTracker) {
+	fake.variablesMutex.Lock()
+	defer fake.variablesMutex.Unlock()
+	fake.VariablesStub = nil
+	if fake.variablesReturnsOnCall == nil {
+		fake.variablesReturnsOnCall = make(map[int]struct {
+			result1 vars.CredVarsTracker
+		})
+	}
+	fake.variablesReturnsOnCall[i] = struct {
+		result1 vars.CredVarsTracker
+	}{result1}
+}
+
+func (fake *FakeAcrossDelegate) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.substepsExpandedMutex.RLock()
+	defer fake.substepsExpandedMutex.RUnlock()
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAcrossDelegate) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.AcrossDelegate = new(FakeAcrossDelegate)
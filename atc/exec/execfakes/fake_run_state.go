@@ -7,6 +7,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
 )
 
 type FakeRunState struct {
@@ -20,6 +21,19 @@ type FakeRunState struct {
 	artifactRepositoryReturnsOnCall map[int]struct {
 		result1 *build.Repository
 	}
+	FetchedVersionStub        func(build.ArtifactName) (runtime.VersionResult, bool)
+	fetchedVersionMutex       sync.RWMutex
+	fetchedVersionArgsForCall []struct {
+		arg1 build.ArtifactName
+	}
+	fetchedVersionReturns struct {
+		result1 runtime.VersionResult
+		result2 bool
+	}
+	fetchedVersionReturnsOnCall map[int]struct {
+		result1 runtime.VersionResult
+		result2 bool
+	}
 	ResultStub        func(atc.PlanID, interface{}) bool
 	resultMutex       sync.RWMutex
 	resultArgsForCall []struct {
@@ -32,6 +46,12 @@ type FakeRunState struct {
 	resultReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	StoreFetchedVersionStub        func(build.ArtifactName, runtime.VersionResult)
+	storeFetchedVersionMutex       sync.RWMutex
+	storeFetchedVersionArgsForCall []struct {
+		arg1 build.ArtifactName
+		arg2 runtime.VersionResult
+	}
 	StoreResultStub        func(atc.PlanID, interface{})
 	storeResultMutex       sync.RWMutex
 	storeResultArgsForCall []struct {
@@ -94,6 +114,69 @@ func (fake *FakeRunState) ArtifactRepositoryReturnsOnCall(i int, result1 *build.
 	}{result1}
 }
 
+func (fake *FakeRunState) FetchedVersion(arg1 build.ArtifactName) (runtime.VersionResult, bool) {
+	fake.fetchedVersionMutex.Lock()
+	ret, specificReturn := fake.fetchedVersionReturnsOnCall[len(fake.fetchedVersionArgsForCall)]
+	fake.fetchedVersionArgsForCall = append(fake.fetchedVersionArgsForCall, struct {
+		arg1 build.ArtifactName
+	}{arg1})
+	fake.recordInvocation("FetchedVersion", []interface{}{arg1})
+	fake.fetchedVersionMutex.Unlock()
+	if fake.FetchedVersionStub != nil {
+		return fake.FetchedVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.fetchedVersionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRunState) FetchedVersionCallCount() int {
+	fake.fetchedVersionMutex.RLock()
+	defer fake.fetchedVersionMutex.RUnlock()
+	return len(fake.fetchedVersionArgsForCall)
+}
+
+func (fake *FakeRunState) FetchedVersionCalls(stub func(build.ArtifactName) (runtime.VersionResult, bool)) {
+	fake.fetchedVersionMutex.Lock()
+	defer fake.fetchedVersionMutex.Unlock()
+	fake.FetchedVersionStub = stub
+}
+
+func (fake *FakeRunState) FetchedVersionArgsForCall(i int) build.ArtifactName {
+	fake.fetchedVersionMutex.RLock()
+	defer fake.fetchedVersionMutex.RUnlock()
+	argsForCall := fake.fetchedVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRunState) FetchedVersionReturns(result1 runtime.VersionResult, result2 bool) {
+	fake.fetchedVersionMutex.Lock()
+	defer fake.fetchedVersionMutex.Unlock()
+	fake.FetchedVersionStub = nil
+	fake.fetchedVersionReturns = struct {
+		result1 runtime.VersionResult
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeRunState) FetchedVersionReturnsOnCall(i int, result1 runtime.VersionResult, result2 bool) {
+	fake.fetchedVersionMutex.Lock()
+	defer fake.fetchedVersionMutex.Unlock()
+	fake.FetchedVersionStub = nil
+	if fake.fetchedVersionReturnsOnCall == nil {
+		fake.fetchedVersionReturnsOnCall = make(map[int]struct {
+			result1 runtime.VersionResult
+			result2 bool
+		})
+	}
+	fake.fetchedVersionReturnsOnCall[i] = struct {
+		result1 runtime.VersionResult
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeRunState) Result(arg1 atc.PlanID, arg2 interface{}) bool {
 	fake.resultMutex.Lock()
 	ret, specificReturn := fake.resultReturnsOnCall[len(fake.resultArgsForCall)]
@@ -155,6 +238,38 @@ func (fake *FakeRunState) ResultReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeRunState) StoreFetchedVersion(arg1 build.ArtifactName, arg2 runtime.VersionResult) {
+	fake.storeFetchedVersionMutex.Lock()
+	fake.storeFetchedVersionArgsForCall = append(fake.storeFetchedVersionArgsForCall, struct {
+		arg1 build.ArtifactName
+		arg2 runtime.VersionResult
+	}{arg1, arg2})
+	fake.recordInvocation("StoreFetchedVersion", []interface{}{arg1, arg2})
+	fake.storeFetchedVersionMutex.Unlock()
+	if fake.StoreFetchedVersionStub != nil {
+		fake.StoreFetchedVersionStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeRunState) StoreFetchedVersionCallCount() int {
+	fake.storeFetchedVersionMutex.RLock()
+	defer fake.storeFetchedVersionMutex.RUnlock()
+	return len(fake.storeFetchedVersionArgsForCall)
+}
+
+func (fake *FakeRunState) StoreFetchedVersionCalls(stub func(build.ArtifactName, runtime.VersionResult)) {
+	fake.storeFetchedVersionMutex.Lock()
+	defer fake.storeFetchedVersionMutex.Unlock()
+	fake.StoreFetchedVersionStub = stub
+}
+
+func (fake *FakeRunState) StoreFetchedVersionArgsForCall(i int) (build.ArtifactName, runtime.VersionResult) {
+	fake.storeFetchedVersionMutex.RLock()
+	defer fake.storeFetchedVersionMutex.RUnlock()
+	argsForCall := fake.storeFetchedVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeRunState) StoreResult(arg1 atc.PlanID, arg2 interface{}) {
 	fake.storeResultMutex.Lock()
 	fake.storeResultArgsForCall = append(fake.storeResultArgsForCall, struct {
@@ -192,8 +307,12 @@ func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.artifactRepositoryMutex.RLock()
 	defer fake.artifactRepositoryMutex.RUnlock()
+	fake.fetchedVersionMutex.RLock()
+	defer fake.fetchedVersionMutex.RUnlock()
 	fake.resultMutex.RLock()
 	defer fake.resultMutex.RUnlock()
+	fake.storeFetchedVersionMutex.RLock()
+	defer fake.storeFetchedVersionMutex.RUnlock()
 	fake.storeResultMutex.RLock()
 	defer fake.storeResultMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
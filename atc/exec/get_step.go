@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -11,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
@@ -100,9 +102,13 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		"name":     step.plan.Name,
 	})
 
+	started := time.Now()
+
 	err := step.run(ctx, state)
 	tracing.End(span, err)
 
+	emitStepFinished(ctx, step.metadata, "get", started, err == nil && step.succeeded)
+
 	return err
 }
 
@@ -141,8 +147,9 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 		ImageSpec: worker.ImageSpec{
 			ResourceType: step.plan.Type,
 		},
-		TeamID: step.metadata.TeamID,
-		Env:    step.metadata.Env(),
+		TeamID:  step.metadata.TeamID,
+		BuildID: step.metadata.BuildID,
+		Env:     step.metadata.Env(),
 	}
 
 	workerSpec := worker.WorkerSpec{
@@ -185,6 +192,8 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 
 	containerOwner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
 
+	fetchStarted := time.Now()
+
 	getResult, err := step.workerClient.RunGetStep(
 		ctx,
 		logger,
@@ -203,12 +212,25 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	metric.InputFetched{
+		BuildLabels:  step.metadata.buildLabels(),
+		ResourceType: step.plan.Type,
+		CacheHit:     getResult.FromCache,
+		Duration:     time.Since(fetchStarted),
+		TraceID:      tracing.TraceID(ctx),
+	}.Emit(logger)
+
 	if getResult.ExitStatus == 0 {
 		state.ArtifactRepository().RegisterArtifact(
 			build.ArtifactName(step.plan.Name),
 			getResult.GetArtifact,
 		)
 
+		state.StoreFetchedVersion(
+			build.ArtifactName(step.plan.Name),
+			getResult.VersionResult,
+		)
+
 		if step.plan.Resource != "" {
 			step.delegate.UpdateVersion(logger, step.plan, getResult.VersionResult)
 		}
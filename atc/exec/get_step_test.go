@@ -181,8 +181,9 @@ var _ = Describe("GetStep", func() {
 				ImageSpec: worker.ImageSpec{
 					ResourceType: "some-resource-type",
 				},
-				TeamID: stepMetadata.TeamID,
-				Env:    stepMetadata.Env(),
+				TeamID:  stepMetadata.TeamID,
+				BuildID: stepMetadata.BuildID,
+				Env:     stepMetadata.Env(),
 			},
 		))
 	})
@@ -285,6 +286,16 @@ var _ = Describe("GetStep", func() {
 			Expect(found).To(BeTrue())
 		})
 
+		It("stores the fetched version in the RunState", func() {
+			Expect(fakeState.StoreFetchedVersionCallCount()).To(Equal(1))
+			name, result := fakeState.StoreFetchedVersionArgsForCall(0)
+			Expect(name).To(Equal(build.ArtifactName(getPlan.Name)))
+			Expect(result).To(Equal(runtime.VersionResult{
+				Version:  atc.Version{"some": "version"},
+				Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+			}))
+		})
+
 		It("marks the step as succeeded", func() {
 			Expect(getStep.Succeeded()).To(BeTrue())
 		})
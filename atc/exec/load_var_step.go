@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -17,9 +18,11 @@ import (
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/tracing"
 )
 
-// LoadVarStep loads a value from a file and sets it as a build-local var.
+// LoadVarStep loads a value from a file, or from the version and metadata
+// fetched by a get step, and sets it as a build-local var.
 type LoadVarStep struct {
 	planID    atc.PlanID
 	plan      atc.LoadVarPlan
@@ -54,6 +57,17 @@ func (err UnspecifiedLoadVarStepFileError) Error() string {
 	return fmt.Sprintf("file '%s' does not specify where the file lives", err.File)
 }
 
+// UnfetchedLoadVarStepVersionError is returned when a load_var step loads
+// from a get step which has not fetched a version.
+type UnfetchedLoadVarStepVersionError struct {
+	From string
+}
+
+// Error returns a human-friendly error message.
+func (err UnfetchedLoadVarStepVersionError) Error() string {
+	return fmt.Sprintf("get step '%s' has not fetched a version", err.From)
+}
+
 type InvalidLocalVarFile struct {
 	File   string
 	Format string
@@ -65,6 +79,25 @@ func (err InvalidLocalVarFile) Error() string {
 }
 
 func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "load_var", tracing.Attrs{
+		"team":     step.metadata.TeamName,
+		"pipeline": step.metadata.PipelineName,
+		"job":      step.metadata.JobName,
+		"build":    step.metadata.BuildName,
+		"name":     step.plan.Name,
+	})
+
+	started := time.Now()
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	emitStepFinished(ctx, step.metadata, "load_var", started, err == nil && step.succeeded)
+
+	return err
+}
+
+func (step *LoadVarStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 	logger = logger.Session("load-var-step", lager.Data{
 		"step-name": step.plan.Name,
@@ -82,7 +115,13 @@ func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
 
 	step.delegate.Starting(logger)
 
-	value, err := step.fetchVars(ctx, logger, step.plan.File, state)
+	var value interface{}
+	var err error
+	if step.plan.From != "" {
+		value, err = step.versionVars(step.plan.From, state)
+	} else {
+		value, err = step.fetchVars(ctx, logger, step.plan.File, state)
+	}
 	if err != nil {
 		return err
 	}
@@ -101,6 +140,31 @@ func (step *LoadVarStep) Succeeded() bool {
 	return step.succeeded
 }
 
+// versionVars returns the version and metadata fetched by the given get step
+// as a var with "version" and "metadata" fields, e.g. to be used as
+// ((.:repo.version.ref)) or ((.:repo.metadata.author)).
+func (step *LoadVarStep) versionVars(from string, state RunState) (interface{}, error) {
+	result, found := state.FetchedVersion(build.ArtifactName(from))
+	if !found {
+		return nil, UnfetchedLoadVarStepVersionError{from}
+	}
+
+	version := map[string]interface{}{}
+	for k, v := range result.Version {
+		version[k] = v
+	}
+
+	metadata := map[string]interface{}{}
+	for _, field := range result.Metadata {
+		metadata[field.Name] = field.Value
+	}
+
+	return map[string]interface{}{
+		"version":  version,
+		"metadata": metadata,
+	}, nil
+}
+
 func (step *LoadVarStep) fetchVars(
 	ctx context.Context,
 	logger lager.Logger,
@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -337,6 +338,51 @@ var _ = Describe("LoadVarStep", func() {
 		})
 	})
 
+	Context("when loading from a get step", func() {
+		BeforeEach(func() {
+			loadVarPlan = &atc.LoadVarPlan{
+				Name: "some-var",
+				From: "some-resource",
+			}
+		})
+
+		Context("when the get step has fetched a version", func() {
+			BeforeEach(func() {
+				state.FetchedVersionReturns(runtime.VersionResult{
+					Version: atc.Version{"ref": "some-ref"},
+					Metadata: []atc.MetadataField{
+						{Name: "author", Value: "some-author"},
+					},
+				}, true)
+			})
+
+			It("step should not fail", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+			})
+
+			It("looks up the version fetched by the get step", func() {
+				Expect(state.FetchedVersionCallCount()).To(Equal(1))
+				Expect(state.FetchedVersionArgsForCall(0)).To(Equal(build.ArtifactName("some-resource")))
+			})
+
+			It("does not stream any file", func() {
+				Expect(fakeWorkerClient.StreamFileFromArtifactCallCount()).To(BeZero())
+			})
+
+			It("should set the version and metadata as var fields", func() {
+				value, err := vars.NewTemplate([]byte("((.:some-var.version.ref)) ((.:some-var.metadata.author))")).Evaluate(credVarsTracker, vars.EvaluateOpts{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(value)).To(Equal("some-ref some-author\n"))
+			})
+		})
+
+		Context("when the get step has not fetched a version", func() {
+			It("step should fail", func() {
+				Expect(stepErr).To(Equal(exec.UnfetchedLoadVarStepVersionError{From: "some-resource"}))
+			})
+		})
+	})
+
 	Context("reveal", func() {
 		var fakeCredVarsTracker *varsfakes.FakeCredVarsTracker
 
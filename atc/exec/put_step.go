@@ -3,6 +3,7 @@ package exec
 import (
 	"context"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -91,9 +92,13 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 		"name":     step.plan.Name,
 	})
 
+	started := time.Now()
+
 	err := step.run(ctx, state)
 	tracing.End(span, err)
 
+	emitStepFinished(ctx, step.metadata, "put", started, err == nil && step.succeeded)
+
 	return err
 }
 
@@ -145,8 +150,9 @@ func (step *PutStep) run(ctx context.Context, state RunState) error {
 		ImageSpec: worker.ImageSpec{
 			ResourceType: step.plan.Type,
 		},
-		Tags:   step.plan.Tags,
-		TeamID: step.metadata.TeamID,
+		Tags:    step.plan.Tags,
+		TeamID:  step.metadata.TeamID,
+		BuildID: step.metadata.BuildID,
 
 		Dir: step.containerMetadata.WorkingDirectory,
 
@@ -239,6 +239,7 @@ var _ = Describe("PutStep", func() {
 		}))
 		Expect(actualContainerSpec.Tags).To(Equal([]string{"some", "tags"}))
 		Expect(actualContainerSpec.TeamID).To(Equal(123))
+		Expect(actualContainerSpec.BuildID).To(Equal(42))
 		Expect(actualContainerSpec.Env).To(Equal(stepMetadata.Env()))
 		Expect(actualContainerSpec.Dir).To(Equal("/tmp/build/put"))
 
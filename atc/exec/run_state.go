@@ -6,17 +6,20 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
 )
 
 type runState struct {
 	artifacts *build.Repository
 	results   *sync.Map
+	versions  *sync.Map
 }
 
 func NewRunState() RunState {
 	return &runState{
 		artifacts: build.NewRepository(),
 		results:   &sync.Map{},
+		versions:  &sync.Map{},
 	}
 }
 
@@ -41,3 +44,18 @@ func (state *runState) Result(id atc.PlanID, to interface{}) bool {
 func (state *runState) StoreResult(id atc.PlanID, val interface{}) {
 	state.results.Store(id, val)
 }
+
+// FetchedVersion returns the version and metadata fetched by the get step
+// with the given name.
+func (state *runState) FetchedVersion(name build.ArtifactName) (runtime.VersionResult, bool) {
+	val, ok := state.versions.Load(name)
+	if !ok {
+		return runtime.VersionResult{}, false
+	}
+
+	return val.(runtime.VersionResult), true
+}
+
+func (state *runState) StoreFetchedVersion(name build.ArtifactName, result runtime.VersionResult) {
+	state.versions.Store(name, result)
+}
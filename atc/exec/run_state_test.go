@@ -3,6 +3,7 @@ package exec_test
 import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/runtime"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -93,4 +94,27 @@ var _ = Describe("RunState", func() {
 			})
 		})
 	})
+
+	Describe("FetchedVersion", func() {
+		It("returns false when no version has been fetched", func() {
+			_, found := state.FetchedVersion("some-name")
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns the version stored under the name", func() {
+			result := runtime.VersionResult{
+				Version:  atc.Version{"some": "version"},
+				Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+			}
+
+			state.StoreFetchedVersion("some-name", result)
+
+			fetched, found := state.FetchedVersion("some-name")
+			Expect(found).To(BeTrue())
+			Expect(fetched).To(Equal(result))
+
+			_, found = state.FetchedVersion("some-other-name")
+			Expect(found).To(BeFalse())
+		})
+	})
 })
@@ -7,6 +7,7 @@ import (
 	"io"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -19,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -53,6 +55,25 @@ func NewSetPipelineStep(
 }
 
 func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "set_pipeline", tracing.Attrs{
+		"team":     step.metadata.TeamName,
+		"pipeline": step.metadata.PipelineName,
+		"job":      step.metadata.JobName,
+		"build":    step.metadata.BuildName,
+		"name":     step.plan.Name,
+	})
+
+	started := time.Now()
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	emitStepFinished(ctx, step.metadata, "set_pipeline", started, err == nil && step.succeeded)
+
+	return err
+}
+
+func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 	logger = logger.Session("set-pipeline-step", lager.Data{
 		"step-name": step.plan.Name,
@@ -108,7 +129,9 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 	team := step.teamFactory.GetByID(step.metadata.TeamID)
 
 	fromVersion := db.ConfigVersion(0)
-	pipeline, found, err := team.Pipeline(step.plan.Name)
+	pipelineRef := atc.PipelineRef{Name: step.plan.Name}
+
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -117,6 +140,17 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 	if !found {
 		existingConfig = atc.Config{}
 	} else {
+		err = step.setParentIDs(pipeline)
+		if err == db.ErrSetByNewerBuild {
+			fmt.Fprintln(stderr, "\x1b[1;33mWARNING: the pipeline was set by a newer build of this job, not setting it\x1b[0m")
+			step.delegate.Finished(logger, false)
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
 		fromVersion = pipeline.ConfigVersion()
 		existingConfig, err = pipeline.Config()
 		if err != nil {
@@ -135,11 +169,18 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 	}
 
 	fmt.Fprintf(stdout, "setting pipeline: %s\n", step.plan.Name)
-	pipeline, _, err = team.SavePipeline(step.plan.Name, atcConfig, fromVersion, false)
+	pipeline, _, err = team.SavePipeline(pipelineRef, atcConfig, fromVersion, false)
 	if err != nil {
 		return err
 	}
 
+	if !found {
+		err = step.setParentIDs(pipeline)
+		if err != nil {
+			return err
+		}
+	}
+
 	fmt.Fprintf(stdout, "done\n")
 	logger.Info("saved-pipeline", lager.Data{"team": team.Name(), "pipeline": pipeline.Name()})
 	step.succeeded = true
@@ -148,6 +189,16 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 	return nil
 }
 
+// setParentIDs records the build's job as the parent of the pipeline, so that
+// the pipeline is archived once a later build of the job no longer sets it.
+func (step *SetPipelineStep) setParentIDs(pipeline db.Pipeline) error {
+	if step.metadata.JobID == 0 {
+		return nil
+	}
+
+	return pipeline.SetParentIDs(step.metadata.JobID, step.metadata.BuildID)
+}
+
 func (step *SetPipelineStep) Succeeded() bool {
 	return step.succeeded
 }
@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
@@ -238,8 +239,8 @@ jobs:
 
 				It("should save the pipeline un-paused", func() {
 					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					pipelineRef, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(paused).To(BeFalse())
 				})
 
@@ -288,8 +289,8 @@ jobs:
 
 				It("should save the pipeline un-paused", func() {
 					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					pipelineRef, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(paused).To(BeFalse())
 				})
 
@@ -303,6 +304,77 @@ jobs:
 					_, succeeded := fakeDelegate.FinishedArgsForCall(0)
 					Expect(succeeded).To(BeTrue())
 				})
+
+				It("should not set the parent of the pipeline", func() {
+					Expect(fakePipeline.SetParentIDsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the step runs in a build of a job", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 789
+				})
+
+				AfterEach(func() {
+					stepMetadata.JobID = 0
+				})
+
+				Context("when specified pipeline not found", func() {
+					BeforeEach(func() {
+						fakeTeam.PipelineReturns(nil, false, nil)
+						fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+					})
+
+					It("should set the job and build as the parent of the saved pipeline", func() {
+						Expect(fakePipeline.SetParentIDsCallCount()).To(Equal(1))
+						jobID, buildID := fakePipeline.SetParentIDsArgsForCall(0)
+						Expect(jobID).To(Equal(789))
+						Expect(buildID).To(Equal(42))
+					})
+				})
+
+				Context("when specified pipeline exists already", func() {
+					BeforeEach(func() {
+						fakeTeam.PipelineReturns(fakePipeline, true, nil)
+						fakeTeam.SavePipelineReturns(fakePipeline, false, nil)
+					})
+
+					It("should set the job and build as the parent of the pipeline before saving", func() {
+						Expect(fakePipeline.SetParentIDsCallCount()).To(Equal(1))
+						jobID, buildID := fakePipeline.SetParentIDsArgsForCall(0)
+						Expect(jobID).To(Equal(789))
+						Expect(buildID).To(Equal(42))
+						Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+					})
+
+					Context("when the pipeline was set by a newer build", func() {
+						BeforeEach(func() {
+							fakePipeline.SetParentIDsReturns(db.ErrSetByNewerBuild)
+						})
+
+						It("should not save the pipeline", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+							Expect(stderr).To(gbytes.Say("set by a newer build"))
+						})
+
+						It("should finish unsuccessfully", func() {
+							Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+							_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+							Expect(succeeded).To(BeFalse())
+						})
+					})
+
+					Context("when setting the parent fails", func() {
+						BeforeEach(func() {
+							fakePipeline.SetParentIDsReturns(errors.New("nope"))
+						})
+
+						It("should return error", func() {
+							Expect(stepErr).To(MatchError("nope"))
+						})
+					})
+				})
 			})
 		})
 	})
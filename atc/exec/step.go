@@ -6,6 +6,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
 )
 
 //go:generate counterfeiter . Step
@@ -40,6 +41,9 @@ type RunState interface {
 
 	Result(atc.PlanID, interface{}) bool
 	StoreResult(atc.PlanID, interface{})
+
+	FetchedVersion(build.ArtifactName) (runtime.VersionResult, bool)
+	StoreFetchedVersion(build.ArtifactName, runtime.VersionResult)
 }
 
 // ExitStatus is the resulting exit code from the process that the step ran.
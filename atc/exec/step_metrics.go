@@ -0,0 +1,30 @@
+package exec
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/tracing"
+)
+
+// emitStepFinished emits how long a step took to run, attributed to the build
+// it ran for and the trace it ran in.
+func emitStepFinished(ctx context.Context, metadata StepMetadata, stepType string, started time.Time, succeeded bool) {
+	metric.StepFinished{
+		BuildLabels: metadata.buildLabels(),
+		StepType:    stepType,
+		Succeeded:   succeeded,
+		Duration:    time.Since(started),
+		TraceID:     tracing.TraceID(ctx),
+	}.Emit(lagerctx.FromContext(ctx))
+}
+
+func (metadata StepMetadata) buildLabels() metric.BuildLabels {
+	return metric.BuildLabels{
+		TeamName:     metadata.TeamName,
+		PipelineName: metadata.PipelineName,
+		JobName:      metadata.JobName,
+	}
+}
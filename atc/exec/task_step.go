@@ -7,6 +7,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -131,9 +132,13 @@ func (step *TaskStep) Run(ctx context.Context, state RunState) error {
 		"name":     step.plan.Name,
 	})
 
+	started := time.Now()
+
 	err := step.run(ctx, state)
 	tracing.End(span, err)
 
+	emitStepFinished(ctx, step.metadata, "task", started, err == nil && step.succeeded)
+
 	return err
 }
 
@@ -209,6 +214,8 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	containerSpec.Env = append(containerSpec.Env, tracing.Environment(ctx)...)
+
 	processSpec := runtime.ProcessSpec{
 		Path:         config.Run.Path,
 		Args:         config.Run.Args,
@@ -355,6 +362,7 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 		Platform:  config.Platform,
 		Tags:      step.plan.Tags,
 		TeamID:    step.metadata.TeamID,
+		BuildID:   step.metadata.BuildID,
 		ImageSpec: imageSpec,
 		Limits:    worker.ContainerLimits(config.Limits),
 		User:      config.Run.User,
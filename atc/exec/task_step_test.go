@@ -15,8 +15,12 @@ import (
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
 	"github.com/onsi/gomega/gbytes"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/trace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -201,6 +205,7 @@ var _ = Describe("TaskStep", func() {
 						Platform: "some-platform",
 						Tags:     []string{"step", "tags"},
 						TeamID:   stepMetadata.TeamID,
+						BuildID:  stepMetadata.BuildID,
 						ImageSpec: worker.ImageSpec{
 							ImageURL:   "some-image",
 							Privileged: false,
@@ -233,6 +238,31 @@ var _ = Describe("TaskStep", func() {
 			Expect(containerSpec.User).To(BeEmpty())
 		})
 
+		Context("when tracing is configured", func() {
+			BeforeEach(func() {
+				tp, err := sdktrace.NewProvider(sdktrace.WithConfig(sdktrace.Config{
+					DefaultSampler: sdktrace.AlwaysSample(),
+				}))
+				Expect(err).ToNot(HaveOccurred())
+
+				global.SetTraceProvider(tp)
+				tracing.Configured = true
+			})
+
+			AfterEach(func() {
+				global.SetTraceProvider(trace.NoopProvider{})
+				tracing.Configured = false
+			})
+
+			It("propagates the trace context to the task through its env", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+
+				Expect(containerSpec.Env).To(ContainElement("SECURE=secret-task-param"))
+				Expect(containerSpec.Env).To(ContainElement(MatchRegexp(`^TRACEPARENT=00-[0-9a-f]{32}-[0-9a-f]{16}-01$`)))
+			})
+		})
+
 		It("creates the task process spec with the correct parameters", func() {
 			Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
 
@@ -0,0 +1,47 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type auditEventCollector struct {
+	auditEventRepository db.AuditEventRepository
+	retention            time.Duration
+}
+
+// NewAuditEventCollector removes the audit events recorded longer ago than
+// the retention period. A retention period of 0 keeps events forever.
+func NewAuditEventCollector(auditEventRepository db.AuditEventRepository, retention time.Duration) *auditEventCollector {
+	return &auditEventCollector{
+		auditEventRepository: auditEventRepository,
+		retention:            retention,
+	}
+}
+
+func (c *auditEventCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("audit-event-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	if c.retention == 0 {
+		return nil
+	}
+
+	removed, err := c.auditEventRepository.RemoveExpiredAuditEvents(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-audit-events", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Info("removed-expired-audit-events", lager.Data{"count": removed})
+	}
+
+	return nil
+}
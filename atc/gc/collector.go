@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc/lockrunner"
+	"github.com/concourse/concourse/tracing"
 )
 
 type Collector interface {
@@ -28,3 +29,23 @@ func (c *collectorTask) Run(ctx context.Context) error {
 
 	return c.collector.Collect(logger)
 }
+
+// NewTracedTask wraps a collector so that each of its runs is recorded as a
+// span named after the component.
+func NewTracedTask(name string, task lockrunner.Task) lockrunner.Task {
+	return &tracedTask{name: name, task: task}
+}
+
+type tracedTask struct {
+	name string
+	task lockrunner.Task
+}
+
+func (t *tracedTask) Run(ctx context.Context) error {
+	ctx, span := tracing.StartSpan(ctx, t.name, tracing.Attrs{})
+
+	err := t.task.Run(ctx)
+	tracing.End(span, err)
+
+	return err
+}
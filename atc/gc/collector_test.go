@@ -0,0 +1,69 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/lockrunner/lockrunnerfakes"
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/tracing/tracingfakes"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TracedTask", func() {
+	var (
+		fakeTask   *lockrunnerfakes.FakeTask
+		fakeTracer *tracingfakes.FakeTracer
+		fakeSpan   *tracingfakes.FakeSpan
+		spanCtx    context.Context
+	)
+
+	BeforeEach(func() {
+		fakeTask = new(lockrunnerfakes.FakeTask)
+		fakeTracer = new(tracingfakes.FakeTracer)
+		fakeSpan = new(tracingfakes.FakeSpan)
+
+		fakeProvider := new(tracingfakes.FakeProvider)
+		fakeProvider.TracerReturns(fakeTracer)
+
+		spanCtx = context.WithValue(context.Background(), "some-key", "some-value")
+		fakeTracer.StartReturns(spanCtx, fakeSpan)
+
+		global.SetTraceProvider(fakeProvider)
+		tracing.Configured = true
+	})
+
+	AfterEach(func() {
+		global.SetTraceProvider(trace.NoopProvider{})
+		tracing.Configured = false
+	})
+
+	It("runs the task within a span named after it", func() {
+		err := NewTracedTask("collector_builds", fakeTask).Run(context.Background())
+		Expect(err).ToNot(HaveOccurred())
+
+		_, name, _ := fakeTracer.StartArgsForCall(0)
+		Expect(name).To(Equal("collector_builds"))
+
+		Expect(fakeTask.RunCallCount()).To(Equal(1))
+		Expect(fakeTask.RunArgsForCall(0)).To(Equal(spanCtx))
+
+		Expect(fakeSpan.EndCallCount()).To(Equal(1))
+	})
+
+	It("returns the error of the task", func() {
+		disaster := errors.New("disaster")
+		fakeTask.RunReturns(disaster)
+
+		err := NewTracedTask("collector_builds", fakeTask).Run(context.Background())
+		Expect(err).To(Equal(disaster))
+
+		Expect(fakeSpan.SetStatusCallCount()).To(Equal(1))
+		Expect(fakeSpan.EndCallCount()).To(Equal(1))
+	})
+})
@@ -14,6 +14,19 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	// Timeout is the duration after which a build of the job is aborted as
+	// timed out, taking precedence over the ATC's default build timeout.
+	Timeout string `json:"timeout,omitempty"`
+
+	// Priority determines the order in which pending builds of the job are
+	// started relative to other jobs when workers are constrained. Builds of
+	// jobs without a priority use their team's default build priority.
+	Priority *int `json:"priority,omitempty"`
+
+	// TeamSerialGroups are like SerialGroups, but are shared by all jobs
+	// across all pipelines of the team.
+	TeamSerialGroups []string `json:"team_serial_groups,omitempty"`
+
 	Abort   *PlanConfig `json:"on_abort,omitempty"`
 	Error   *PlanConfig `json:"on_error,omitempty"`
 	Failure *PlanConfig `json:"on_failure,omitempty"`
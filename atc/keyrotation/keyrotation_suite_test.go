@@ -0,0 +1,13 @@
+package keyrotation_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestKeyRotation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Key Rotation Suite")
+}
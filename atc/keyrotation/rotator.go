@@ -0,0 +1,74 @@
+package keyrotation
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type rotator struct {
+	rotation  db.EncryptionKeyRotation
+	batchSize int
+	budget    time.Duration
+}
+
+// NewRotator returns a task which rotates the encryption key of the database
+// a batch at a time. Each run rotates batches until the rotation is done or
+// the budget is used up, so that a large rotation does not hold on to the
+// component's lock for too long.
+func NewRotator(rotation db.EncryptionKeyRotation, batchSize int, budget time.Duration) *rotator {
+	return &rotator{
+		rotation:  rotation,
+		batchSize: batchSize,
+		budget:    budget,
+	}
+}
+
+func (r *rotator) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("encryption-key-rotator")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	defer r.emitProgress(logger)
+
+	deadline := time.Now().Add(r.budget)
+
+	for {
+		done, err := r.rotation.RotateBatch(r.batchSize)
+		if err != nil {
+			logger.Error("failed-to-rotate-batch", err)
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+	}
+}
+
+func (r *rotator) emitProgress(logger lager.Logger) {
+	status, err := r.rotation.Status()
+	if err != nil {
+		logger.Error("failed-to-get-status", err)
+		return
+	}
+
+	metric.EncryptionKeyRotationRowsRemaining{
+		Rows: status.RowsRemaining(),
+	}.Emit(logger)
+}
@@ -0,0 +1,78 @@
+package keyrotation_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/keyrotation"
+	"github.com/concourse/concourse/atc/lockrunner"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rotator", func() {
+	var (
+		fakeRotation *dbfakes.FakeEncryptionKeyRotation
+		budget       time.Duration
+
+		rotator lockrunner.Task
+		runErr  error
+	)
+
+	BeforeEach(func() {
+		fakeRotation = new(dbfakes.FakeEncryptionKeyRotation)
+		budget = time.Minute
+	})
+
+	JustBeforeEach(func() {
+		rotator = keyrotation.NewRotator(fakeRotation, 500, budget)
+		runErr = rotator.Run(context.TODO())
+	})
+
+	Context("when the rotation finishes", func() {
+		BeforeEach(func() {
+			fakeRotation.RotateBatchReturnsOnCall(0, false, nil)
+			fakeRotation.RotateBatchReturnsOnCall(1, false, nil)
+			fakeRotation.RotateBatchReturnsOnCall(2, true, nil)
+		})
+
+		It("rotates batches until it is done", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeRotation.RotateBatchCallCount()).To(Equal(3))
+			Expect(fakeRotation.RotateBatchArgsForCall(0)).To(Equal(500))
+		})
+
+		It("reports the progress", func() {
+			Expect(fakeRotation.StatusCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the budget is used up", func() {
+		BeforeEach(func() {
+			budget = 0
+			fakeRotation.RotateBatchReturns(false, nil)
+		})
+
+		It("stops after the current batch", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeRotation.RotateBatchCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when rotating a batch fails", func() {
+		BeforeEach(func() {
+			fakeRotation.RotateBatchReturns(false, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+			Expect(fakeRotation.RotateBatchCallCount()).To(Equal(1))
+		})
+
+		It("still reports the progress", func() {
+			Expect(fakeRotation.StatusCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package metric
+
+import "context"
+
+// BuildLabels identify the build that a metric was observed for, so that
+// metrics observed deep within the worker client (e.g. while fetching an image
+// or streaming a volume) can be attributed to it.
+type BuildLabels struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+}
+
+type buildLabelsKey struct{}
+
+// WithBuildLabels returns a context carrying the given build labels.
+func WithBuildLabels(ctx context.Context, labels BuildLabels) context.Context {
+	return context.WithValue(ctx, buildLabelsKey{}, labels)
+}
+
+// BuildLabelsFromContext returns the build labels carried by the context, or
+// empty labels if there are none, e.g. for a check.
+func BuildLabelsFromContext(ctx context.Context) BuildLabels {
+	labels, _ := ctx.Value(buildLabelsKey{}).(BuildLabels)
+	return labels
+}
+
+func (labels BuildLabels) attributes() map[string]string {
+	return map[string]string{
+		"team_name": labels.TeamName,
+		"pipeline":  labels.PipelineName,
+		"job":       labels.JobName,
+	}
+}
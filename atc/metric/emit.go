@@ -16,6 +16,11 @@ type Event struct {
 	Attributes map[string]string
 	Host       string
 	Time       time.Time
+
+	// TraceID is the trace the event was observed in, if any. It's kept out of
+	// the attributes so that emitters which don't support exemplars don't end
+	// up with a distinct series per trace.
+	TraceID string
 }
 
 //go:generate counterfeiter . Emitter
@@ -94,6 +94,7 @@ func (emitter *NewRelicEmitter) Emit(logger lager.Logger, event metric.Event) {
 		"worker containers",
 		"worker volumes",
 		"http response time",
+		"audit event",
 		"database queries",
 		"database connections",
 		"worker unknown containers",
@@ -14,7 +14,6 @@ import (
 	"github.com/concourse/concourse/atc/metric"
 
 	"github.com/prometheus/client_golang/prometheus"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type PrometheusEmitter struct {
@@ -31,6 +30,14 @@ type PrometheusEmitter struct {
 	buildsFinished    prometheus.Counter
 	buildsFinishedVec *prometheus.CounterVec
 	buildsSucceeded   prometheus.Counter
+	buildsTimedOut    prometheus.Counter
+	buildQueueWaitVec *buildHistogram
+
+	stepDurationsVec        *buildHistogram
+	imageFetchDurationsVec  *buildHistogram
+	inputFetchDurationsVec  *buildHistogram
+	volumeStreamDurationVec *buildHistogram
+	volumeStreamBytesVec    *buildHistogram
 
 	dbConnections  *prometheus.GaugeVec
 	dbQueriesTotal prometheus.Counter
@@ -47,6 +54,8 @@ type PrometheusEmitter struct {
 	checkEnqueueVec *prometheus.CounterVec
 	checkQueueSize  prometheus.Gauge
 
+	encryptionKeyRotationRowsRemaining prometheus.Gauge
+
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
 	workerVolumes           *prometheus.GaugeVec
@@ -54,6 +63,8 @@ type PrometheusEmitter struct {
 	workerTasks             *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
 
+	containerPlacements *prometheus.CounterVec
+
 	workerContainersLabels map[string]map[string]prometheus.Labels
 	workerVolumesLabels    map[string]map[string]prometheus.Labels
 	workerTasksLabels      map[string]map[string]prometheus.Labels
@@ -64,8 +75,14 @@ type PrometheusEmitter struct {
 type PrometheusConfig struct {
 	BindIP   string `long:"prometheus-bind-ip" description:"IP to listen on to expose Prometheus metrics."`
 	BindPort string `long:"prometheus-bind-port" description:"Port to listen on to expose Prometheus metrics."`
+
+	HistogramLabels []string `long:"prometheus-histogram-label" default:"team" default:"pipeline" default:"job" choice:"team" choice:"pipeline" choice:"job" description:"Build label to partition the step, fetch, volume streaming and queue wait histograms by. Leave out labels to bound the number of series. Can be specified multiple times."`
 }
 
+// durationBuckets are the buckets of the build histograms measuring time, in
+// seconds.
+var durationBuckets = []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600}
+
 // The most natural data type to hold the labels is a set because each worker can have multiple but
 // unique sets of labels. A set in Go is represented by a map[T]struct{}. Unfortunately, we cannot
 // put prometheus.Labels inside a map[prometheus.Labels]struct{} because prometheus.Labels are not
@@ -192,6 +209,14 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	})
 	prometheus.MustRegister(buildsAborted)
 
+	buildsTimedOut := prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "concourse",
+		Subsystem: "builds",
+		Name:      "timed_out_total",
+		Help:      "Total number of Concourse builds aborted for exceeding their build timeout.",
+	})
+	prometheus.MustRegister(buildsTimedOut)
+
 	buildsFinishedVec := prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "concourse",
@@ -215,6 +240,94 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(buildDurationsVec)
 
+	// per-build histograms, carrying exemplars of the trace of each bucket's
+	// latest observation
+	exemplars := newExemplars()
+
+	buildQueueWaitVec := newBuildHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "builds",
+			Name:      "queue_wait_seconds",
+			Help:      "Time between a build being created and it starting, in seconds",
+			Buckets:   durationBuckets,
+		},
+		config.HistogramLabels,
+		nil,
+		exemplars,
+	)
+	prometheus.MustRegister(buildQueueWaitVec.vec)
+
+	stepDurationsVec := newBuildHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "duration_seconds",
+			Help:      "Step time in seconds",
+			Buckets:   durationBuckets,
+		},
+		config.HistogramLabels,
+		map[string]string{"type": "step_type"},
+		exemplars,
+	)
+	prometheus.MustRegister(stepDurationsVec.vec)
+
+	imageFetchDurationsVec := newBuildHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "image_fetch_duration_seconds",
+			Help:      "Time taken to fetch the image of a step's container, in seconds",
+			Buckets:   durationBuckets,
+		},
+		config.HistogramLabels,
+		nil,
+		exemplars,
+	)
+	prometheus.MustRegister(imageFetchDurationsVec.vec)
+
+	inputFetchDurationsVec := newBuildHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "input_fetch_duration_seconds",
+			Help:      "Time taken by a get step to fetch its resource, in seconds",
+			Buckets:   durationBuckets,
+		},
+		config.HistogramLabels,
+		map[string]string{"cache_hit": "cache_hit"},
+		exemplars,
+	)
+	prometheus.MustRegister(inputFetchDurationsVec.vec)
+
+	volumeStreamDurationVec := newBuildHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "streaming_duration_seconds",
+			Help:      "Time taken to stream a volume between workers, in seconds",
+			Buckets:   durationBuckets,
+		},
+		config.HistogramLabels,
+		nil,
+		exemplars,
+	)
+	prometheus.MustRegister(volumeStreamDurationVec.vec)
+
+	volumeStreamBytesVec := newBuildHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "streamed_bytes",
+			Help:      "Compressed size of a volume streamed between workers, in bytes",
+			Buckets:   prometheus.ExponentialBuckets(1024, 4, 11),
+		},
+		config.HistogramLabels,
+		nil,
+		exemplars,
+	)
+	prometheus.MustRegister(volumeStreamBytesVec.vec)
+
 	// worker metrics
 	workerContainers := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -271,6 +384,17 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workerTasks)
 
+	containerPlacements := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "container_placements_total",
+			Help:      "Number of containers placed, by the placement strategy which decided on the worker",
+		},
+		[]string{"decided_by", "container_type"},
+	)
+	prometheus.MustRegister(containerPlacements)
+
 	workersRegistered := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "concourse",
@@ -357,12 +481,22 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(checkQueueSize)
 
+	encryptionKeyRotationRowsRemaining := prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "db",
+			Name:      "encryption_key_rotation_rows_remaining",
+			Help:      "Number of rows yet to be re-encrypted by the encryption key rotation",
+		},
+	)
+	prometheus.MustRegister(encryptionKeyRotationRowsRemaining)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
 	}
 
-	go http.Serve(listener, promhttp.Handler())
+	go http.Serve(listener, metricsHandler(prometheus.DefaultGatherer, exemplars))
 
 	emitter := &PrometheusEmitter{
 		jobsScheduled:  jobsScheduled,
@@ -378,6 +512,14 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		buildsFinished:    buildsFinished,
 		buildsFinishedVec: buildsFinishedVec,
 		buildsSucceeded:   buildsSucceeded,
+		buildsTimedOut:    buildsTimedOut,
+		buildQueueWaitVec: buildQueueWaitVec,
+
+		stepDurationsVec:        stepDurationsVec,
+		imageFetchDurationsVec:  imageFetchDurationsVec,
+		inputFetchDurationsVec:  inputFetchDurationsVec,
+		volumeStreamDurationVec: volumeStreamDurationVec,
+		volumeStreamBytesVec:    volumeStreamBytesVec,
 
 		dbConnections:  dbConnections,
 		dbQueriesTotal: dbQueriesTotal,
@@ -394,14 +536,18 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		checkEnqueueVec: checkEnqueueVec,
 		checkQueueSize:  checkQueueSize,
 
-		workerContainers:        workerContainers,
-		workersRegistered:       workersRegistered,
-		workerContainersLabels:  map[string]map[string]prometheus.Labels{},
-		workerVolumesLabels:     map[string]map[string]prometheus.Labels{},
-		workerTasksLabels:       map[string]map[string]prometheus.Labels{},
-		workerLastSeen:          map[string]time.Time{},
-		workerVolumes:           workerVolumes,
-		workerTasks:             workerTasks,
+		encryptionKeyRotationRowsRemaining: encryptionKeyRotationRowsRemaining,
+
+		workerContainers:       workerContainers,
+		workersRegistered:      workersRegistered,
+		workerContainersLabels: map[string]map[string]prometheus.Labels{},
+		workerVolumesLabels:    map[string]map[string]prometheus.Labels{},
+		workerTasksLabels:      map[string]map[string]prometheus.Labels{},
+		workerLastSeen:         map[string]time.Time{},
+		workerVolumes:          workerVolumes,
+		workerTasks:            workerTasks,
+
+		containerPlacements:     containerPlacements,
 		workerUnknownContainers: workerUnknownContainers,
 		workerUnknownVolumes:    workerUnknownVolumes,
 	}
@@ -434,6 +580,19 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.buildsRunning.Set(event.Value)
 	case "build finished":
 		emitter.buildFinishedMetrics(logger, event)
+	case "build queue wait":
+		// seconds are the standard prometheus base unit for time
+		emitter.buildQueueWaitVec.observe(logger, event, event.Value/1000)
+	case "step finished":
+		emitter.stepDurationsVec.observe(logger, event, event.Value/1000)
+	case "image fetched":
+		emitter.imageFetchDurationsVec.observe(logger, event, event.Value/1000)
+	case "input fetched":
+		emitter.inputFetchDurationsVec.observe(logger, event, event.Value/1000)
+	case "volume streaming duration (ms)":
+		emitter.volumeStreamDurationVec.observe(logger, event, event.Value/1000)
+	case "volume streamed bytes":
+		emitter.volumeStreamBytesVec.observe(logger, event, event.Value)
 	case "worker containers":
 		emitter.workerContainersMetric(logger, event)
 	case "worker volumes":
@@ -444,6 +603,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.workerUnknownVolumesMetric(logger, event)
 	case "worker tasks":
 		emitter.workerTasksMetric(logger, event)
+	case "container placement":
+		emitter.containerPlacementMetric(logger, event)
 	case "worker state":
 		emitter.workersRegisteredMetric(logger, event)
 	case "http response time":
@@ -462,6 +623,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checkMetric(logger, event)
 	case "check finished":
 		emitter.checkMetric(logger, event)
+	case "encryption key rotation rows remaining":
+		emitter.encryptionKeyRotationRowsRemaining.Set(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -538,6 +701,11 @@ func (emitter *PrometheusEmitter) buildFinishedMetrics(logger lager.Logger, even
 		emitter.buildsErrored.Inc()
 	}
 
+	// concourse_builds_timed_out_total
+	if event.Attributes["timed_out"] == "true" {
+		emitter.buildsTimedOut.Inc()
+	}
+
 	// seconds are the standard prometheus base unit for time
 	duration := event.Value / 1000
 	emitter.buildDurationsVec.WithLabelValues(team, pipeline, job).Observe(duration)
@@ -679,6 +847,16 @@ func (emitter *PrometheusEmitter) workerTasksMetric(logger lager.Logger, event m
 	emitter.workerTasks.With(emitter.workerTasksLabels[worker][key]).Set(event.Value)
 }
 
+func (emitter *PrometheusEmitter) containerPlacementMetric(logger lager.Logger, event metric.Event) {
+	decidedBy, exists := event.Attributes["decided_by"]
+	if !exists {
+		logger.Error("failed-to-find-decided-by-in-event", fmt.Errorf("expected decided_by to exist in event.Attributes"))
+		return
+	}
+
+	emitter.containerPlacements.WithLabelValues(decidedBy, event.Attributes["container_type"]).Inc()
+}
+
 func (emitter *PrometheusEmitter) httpResponseTimeMetrics(logger lager.Logger, event metric.Event) {
 	route, exists := event.Attributes["route"]
 	if !exists {
@@ -779,7 +957,7 @@ func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	}
 }
 
-//periodically remove stale metrics for workers
+// periodically remove stale metrics for workers
 func (emitter *PrometheusEmitter) periodicMetricGC() {
 	for {
 		emitter.mu.Lock()
@@ -0,0 +1,361 @@
+package emitter
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const openMetricsContentType = "application/openmetrics-text; version=0.0.1; charset=utf-8"
+
+// buildLabelAttributes maps the build labels which histograms can be
+// partitioned by to the event attributes holding their values.
+var buildLabelAttributes = map[string]string{
+	"team":     "team_name",
+	"pipeline": "pipeline",
+	"job":      "job",
+}
+
+// buildHistogram is a histogram of something observed while running a build,
+// partitioned by the build labels allowed by the config (to bound its
+// cardinality) and by any labels specific to the event.
+//
+// The histogram remembers the trace of the latest observation in each bucket
+// as an exemplar, so that e.g. an unusually slow step can be looked up.
+type buildHistogram struct {
+	name    string
+	buckets []float64
+
+	labels     []string
+	attributes []string
+
+	vec       *prometheus.HistogramVec
+	exemplars *exemplars
+}
+
+func newBuildHistogram(
+	opts prometheus.HistogramOpts,
+	buildLabels []string,
+	eventLabels map[string]string,
+	exemplars *exemplars,
+) *buildHistogram {
+	histogram := &buildHistogram{
+		name:      prometheus.BuildFQName(opts.Namespace, opts.Subsystem, opts.Name),
+		buckets:   opts.Buckets,
+		exemplars: exemplars,
+	}
+
+	for _, label := range buildLabels {
+		histogram.labels = append(histogram.labels, label)
+		histogram.attributes = append(histogram.attributes, buildLabelAttributes[label])
+	}
+
+	var names []string
+	for label := range eventLabels {
+		names = append(names, label)
+	}
+	sort.Strings(names)
+
+	for _, label := range names {
+		histogram.labels = append(histogram.labels, label)
+		histogram.attributes = append(histogram.attributes, eventLabels[label])
+	}
+
+	histogram.vec = prometheus.NewHistogramVec(opts, histogram.labels)
+
+	return histogram
+}
+
+func (histogram *buildHistogram) observe(logger lager.Logger, event metric.Event, value float64) {
+	labels := prometheus.Labels{}
+	for i, label := range histogram.labels {
+		attribute := histogram.attributes[i]
+
+		labelValue, exists := event.Attributes[attribute]
+		if !exists {
+			logger.Error(
+				fmt.Sprintf("failed-to-find-%s-in-event", strings.Replace(attribute, "_", "-", -1)),
+				fmt.Errorf("expected %s to exist in event.Attributes", attribute),
+			)
+			return
+		}
+
+		labels[label] = labelValue
+	}
+
+	histogram.vec.With(labels).Observe(value)
+
+	if event.TraceID != "" {
+		histogram.exemplars.observe(histogram.name, labels, histogram.buckets, exemplar{
+			traceID:   event.TraceID,
+			value:     value,
+			timestamp: event.Time,
+		})
+	}
+}
+
+type exemplar struct {
+	traceID   string
+	value     float64
+	timestamp time.Time
+}
+
+// exemplars holds the latest exemplar of each bucket of each series of the
+// build histograms, keyed by metric name, then by label set, then by the
+// upper bound of the bucket.
+type exemplars struct {
+	series map[string]map[string]map[float64]exemplar
+	mu     sync.RWMutex
+}
+
+func newExemplars() *exemplars {
+	return &exemplars{
+		series: map[string]map[string]map[float64]exemplar{},
+	}
+}
+
+func (e *exemplars) observe(name string, labels prometheus.Labels, buckets []float64, ex exemplar) {
+	upperBound := math.Inf(1)
+	for _, bound := range buckets {
+		if ex.value <= bound {
+			upperBound = bound
+			break
+		}
+	}
+
+	pairs := make([]*dto.LabelPair, 0, len(labels))
+	for labelName, labelValue := range labels {
+		labelName, labelValue := labelName, labelValue
+		pairs = append(pairs, &dto.LabelPair{Name: &labelName, Value: &labelValue})
+	}
+
+	key := labelsKey(pairs)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.series[name] == nil {
+		e.series[name] = map[string]map[float64]exemplar{}
+	}
+
+	if e.series[name][key] == nil {
+		e.series[name][key] = map[float64]exemplar{}
+	}
+
+	e.series[name][key][upperBound] = ex
+}
+
+func (e *exemplars) lookup(name string, labels []*dto.LabelPair, upperBound float64) (exemplar, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	ex, found := e.series[name][labelsKey(labels)][upperBound]
+	return ex, found
+}
+
+func labelsKey(labels []*dto.LabelPair) string {
+	pairs := make([]string, len(labels))
+	for i, label := range labels {
+		pairs[i] = label.GetName() + "=" + label.GetValue()
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, "\xff")
+}
+
+// metricsHandler serves the gathered metrics in the OpenMetrics format to
+// scrapers which accept it, as only that format can carry exemplars, and in
+// the classic text format to all others.
+func metricsHandler(gatherer prometheus.Gatherer, exemplars *exemplars) http.Handler {
+	classic := promhttp.Handler()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text") {
+			classic.ServeHTTP(w, r)
+			return
+		}
+
+		families, err := gatherer.Gather()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		buf := new(bytes.Buffer)
+		writeOpenMetrics(buf, families, exemplars)
+
+		w.Header().Set("Content-Type", openMetricsContentType)
+		_, _ = buf.WriteTo(w)
+	})
+}
+
+// writeOpenMetrics encodes the metric families in the OpenMetrics text
+// format, attaching the exemplars of histogram buckets.
+//
+// OpenMetrics names a counter family without the _total suffix that its
+// samples carry. Counters already named with it, as most are, keep the same
+// sample names as in the classic text format.
+func writeOpenMetrics(w io.Writer, families []*dto.MetricFamily, exemplars *exemplars) {
+	for _, family := range families {
+		name := family.GetName()
+		if family.GetType() == dto.MetricType_COUNTER {
+			name = strings.TrimSuffix(name, "_total")
+		}
+
+		if family.Help != nil {
+			fmt.Fprintf(w, "# HELP %s %s\n", name, escapeOpenMetrics(family.GetHelp()))
+		}
+
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			fmt.Fprintf(w, "# TYPE %s counter\n", name)
+
+			for _, m := range family.GetMetric() {
+				writeOpenMetricsSample(w, name+"_total", m.GetLabel(), "", 0, m.GetCounter().GetValue(), "")
+			}
+
+		case dto.MetricType_GAUGE:
+			fmt.Fprintf(w, "# TYPE %s gauge\n", name)
+
+			for _, m := range family.GetMetric() {
+				writeOpenMetricsSample(w, name, m.GetLabel(), "", 0, m.GetGauge().GetValue(), "")
+			}
+
+		case dto.MetricType_UNTYPED:
+			fmt.Fprintf(w, "# TYPE %s unknown\n", name)
+
+			for _, m := range family.GetMetric() {
+				writeOpenMetricsSample(w, name, m.GetLabel(), "", 0, m.GetUntyped().GetValue(), "")
+			}
+
+		case dto.MetricType_SUMMARY:
+			fmt.Fprintf(w, "# TYPE %s summary\n", name)
+
+			for _, m := range family.GetMetric() {
+				summary := m.GetSummary()
+
+				for _, quantile := range summary.GetQuantile() {
+					writeOpenMetricsSample(w, name, m.GetLabel(), "quantile", quantile.GetQuantile(), quantile.GetValue(), "")
+				}
+
+				writeOpenMetricsSample(w, name+"_sum", m.GetLabel(), "", 0, summary.GetSampleSum(), "")
+				writeOpenMetricsSample(w, name+"_count", m.GetLabel(), "", 0, float64(summary.GetSampleCount()), "")
+			}
+
+		case dto.MetricType_HISTOGRAM:
+			fmt.Fprintf(w, "# TYPE %s histogram\n", name)
+
+			for _, m := range family.GetMetric() {
+				histogram := m.GetHistogram()
+
+				infSeen := false
+				for _, bucket := range histogram.GetBucket() {
+					upperBound := bucket.GetUpperBound()
+					if math.IsInf(upperBound, 1) {
+						infSeen = true
+					}
+
+					ex := openMetricsExemplar(exemplars, name, m.GetLabel(), upperBound)
+					writeOpenMetricsSample(w, name+"_bucket", m.GetLabel(), "le", upperBound, float64(bucket.GetCumulativeCount()), ex)
+				}
+
+				if !infSeen {
+					ex := openMetricsExemplar(exemplars, name, m.GetLabel(), math.Inf(1))
+					writeOpenMetricsSample(w, name+"_bucket", m.GetLabel(), "le", math.Inf(1), float64(histogram.GetSampleCount()), ex)
+				}
+
+				writeOpenMetricsSample(w, name+"_sum", m.GetLabel(), "", 0, histogram.GetSampleSum(), "")
+				writeOpenMetricsSample(w, name+"_count", m.GetLabel(), "", 0, float64(histogram.GetSampleCount()), "")
+			}
+		}
+	}
+
+	fmt.Fprint(w, "# EOF\n")
+}
+
+func writeOpenMetricsSample(
+	w io.Writer,
+	name string,
+	labels []*dto.LabelPair,
+	extraLabel string,
+	extraLabelValue float64,
+	value float64,
+	ex string,
+) {
+	pairs := make([]string, 0, len(labels)+1)
+	for _, label := range labels {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, label.GetName(), escapeOpenMetrics(label.GetValue())))
+	}
+
+	if extraLabel != "" {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, extraLabel, formatOpenMetricsLabelFloat(extraLabelValue)))
+	}
+
+	fmt.Fprint(w, name)
+	if len(pairs) != 0 {
+		fmt.Fprintf(w, "{%s}", strings.Join(pairs, ","))
+	}
+
+	fmt.Fprintf(w, " %s%s\n", formatOpenMetricsFloat(value), ex)
+}
+
+// openMetricsExemplar returns the exemplar of the bucket of the series to
+// append to its sample, if there is one.
+func openMetricsExemplar(exemplars *exemplars, name string, labels []*dto.LabelPair, upperBound float64) string {
+	ex, found := exemplars.lookup(name, labels, upperBound)
+	if !found {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		` # {trace_id="%s"} %s %s`,
+		escapeOpenMetrics(ex.traceID),
+		formatOpenMetricsFloat(ex.value),
+		strconv.FormatFloat(float64(ex.timestamp.UnixNano())/1e9, 'f', -1, 64),
+	)
+}
+
+func formatOpenMetricsFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+}
+
+// formatOpenMetricsLabelFloat formats the le and quantile labels canonically,
+// i.e. always as a float, so that series don't change identity between
+// formats.
+func formatOpenMetricsLabelFloat(f float64) string {
+	formatted := formatOpenMetricsFloat(f)
+	if strings.ContainsAny(formatted, ".eIN") {
+		return formatted
+	}
+
+	return formatted + ".0"
+}
+
+var openMetricsEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeOpenMetrics(s string) string {
+	return openMetricsEscaper.Replace(s)
+}
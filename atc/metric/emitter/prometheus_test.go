@@ -1,6 +1,13 @@
 package emitter_test
 
 import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/metric/emitter"
 	"github.com/concourse/concourse/atc/metric/emitter/emitterfakes"
 
@@ -160,3 +167,147 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 		workerTasksLabels = map[string]map[string]prometheus.Labels{}
 	})
 })
+
+var _ = Describe("PrometheusEmitter build histograms", func() {
+	var (
+		originalRegisterer prometheus.Registerer
+		originalGatherer   prometheus.Gatherer
+
+		config            *emitter.PrometheusConfig
+		prometheusEmitter metric.Emitter
+		logger            *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		originalRegisterer = prometheus.DefaultRegisterer
+		originalGatherer = prometheus.DefaultGatherer
+
+		registry := prometheus.NewRegistry()
+		prometheus.DefaultRegisterer = registry
+		prometheus.DefaultGatherer = registry
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, port, err := net.SplitHostPort(listener.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(listener.Close()).To(Succeed())
+
+		config = &emitter.PrometheusConfig{
+			BindIP:          "127.0.0.1",
+			BindPort:        port,
+			HistogramLabels: []string{"team"},
+		}
+
+		logger = lagertest.NewTestLogger("prometheus")
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		prometheusEmitter, err = config.NewEmitter()
+		Expect(err).ToNot(HaveOccurred())
+
+		prometheusEmitter.Emit(logger, metric.Event{
+			Name:  "step finished",
+			Value: 2500,
+			Attributes: map[string]string{
+				"team_name": "some-team",
+				"pipeline":  "some-pipeline",
+				"job":       "some-job",
+				"step_type": "task",
+				"succeeded": "true",
+			},
+			TraceID: "0102030405060708090a0b0c0d0e0f10",
+			Time:    time.Unix(1585000000, 0),
+		})
+	})
+
+	AfterEach(func() {
+		prometheus.DefaultRegisterer = originalRegisterer
+		prometheus.DefaultGatherer = originalGatherer
+	})
+
+	scrape := func(accept string) (string, string) {
+		req, err := http.NewRequest("GET", "http://"+config.BindIP+":"+config.BindPort+"/metrics", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+
+		return resp.Header.Get("Content-Type"), string(body)
+	}
+
+	It("partitions the histogram by the allowed labels only", func() {
+		_, body := scrape("")
+		Expect(body).To(ContainSubstring(`concourse_steps_duration_seconds_bucket{team="some-team",type="task",le="5"} 1`))
+		Expect(body).ToNot(ContainSubstring(`pipeline="some-pipeline"`))
+		Expect(body).ToNot(ContainSubstring("trace_id"))
+	})
+
+	It("attaches the trace as an exemplar for OpenMetrics scrapers", func() {
+		contentType, body := scrape("application/openmetrics-text; version=0.0.1,text/plain;version=0.0.4;q=0.5")
+		Expect(contentType).To(HavePrefix("application/openmetrics-text"))
+
+		Expect(body).To(ContainSubstring("# TYPE concourse_steps_duration_seconds histogram\n"))
+		Expect(body).To(ContainSubstring(`concourse_steps_duration_seconds_bucket{team="some-team",type="task",le="1.0"} 0` + "\n"))
+		Expect(body).To(ContainSubstring(
+			`concourse_steps_duration_seconds_bucket{team="some-team",type="task",le="5.0"} 1 # {trace_id="0102030405060708090a0b0c0d0e0f10"} 2.5 1585000000` + "\n",
+		))
+		Expect(body).To(ContainSubstring(`concourse_steps_duration_seconds_bucket{team="some-team",type="task",le="+Inf"} 1` + "\n"))
+		Expect(body).To(ContainSubstring(`concourse_steps_duration_seconds_count{team="some-team",type="task"} 1` + "\n"))
+		Expect(body).To(ContainSubstring(`concourse_steps_duration_seconds_sum{team="some-team",type="task"} 2.5` + "\n"))
+		Expect(body).To(HaveSuffix("# EOF\n"))
+	})
+
+	It("keeps the names of counters already suffixed with _total for OpenMetrics scrapers", func() {
+		prometheusEmitter.Emit(logger, metric.Event{
+			Name:  "build finished",
+			Value: 1,
+			Attributes: map[string]string{
+				"team_name":    "some-team",
+				"pipeline":     "some-pipeline",
+				"job":          "some-job",
+				"build_status": "succeeded",
+			},
+		})
+
+		_, classicBody := scrape("")
+		Expect(classicBody).To(ContainSubstring("concourse_builds_finished_total 1\n"))
+
+		_, body := scrape("application/openmetrics-text; version=0.0.1,text/plain;version=0.0.4;q=0.5")
+		Expect(body).To(ContainSubstring("# TYPE concourse_builds_finished counter\n"))
+		Expect(body).To(ContainSubstring("concourse_builds_finished_total 1\n"))
+		Expect(body).ToNot(ContainSubstring("_total_total"))
+	})
+
+	Context("when more labels are allowed", func() {
+		BeforeEach(func() {
+			config.HistogramLabels = []string{"team", "pipeline", "job"}
+		})
+
+		It("partitions the histogram by them", func() {
+			_, body := scrape("")
+			Expect(body).To(ContainSubstring(`concourse_steps_duration_seconds_count{job="some-job",pipeline="some-pipeline",team="some-team",type="task"} 1`))
+		})
+	})
+
+	It("observes volume sizes in bytes", func() {
+		prometheusEmitter.Emit(logger, metric.Event{
+			Name:       "volume streamed bytes",
+			Value:      2048,
+			Attributes: map[string]string{"team_name": "some-team"},
+		})
+
+		_, body := scrape("")
+		Expect(body).To(ContainSubstring(`concourse_volumes_streamed_bytes_sum{team="some-team"} 2048`))
+	})
+})
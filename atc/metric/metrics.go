@@ -1,6 +1,7 @@
 package metric
 
 import (
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -282,6 +283,29 @@ func (event WorkerTasks) Emit(logger lager.Logger) {
 	)
 }
 
+type ContainerPlacement struct {
+	WorkerName    string
+	ContainerType string
+	DecidedBy     string
+	Candidates    int
+}
+
+func (event ContainerPlacement) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("container-placement"),
+		Event{
+			Name:  "container placement",
+			Value: 1,
+			Attributes: map[string]string{
+				"worker":         event.WorkerName,
+				"container_type": event.ContainerType,
+				"decided_by":     event.DecidedBy,
+				"candidates":     strconv.Itoa(event.Candidates),
+			},
+		},
+	)
+}
+
 type VolumesToBeGarbageCollected struct {
 	Volumes int
 }
@@ -452,6 +476,7 @@ type BuildFinished struct {
 	BuildStatus   db.BuildStatus
 	BuildDuration time.Duration
 	TeamName      string
+	TimedOut      bool
 }
 
 func (event BuildFinished) Emit(logger lager.Logger) {
@@ -467,11 +492,135 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 				"build_id":     strconv.Itoa(event.BuildID),
 				"build_status": string(event.BuildStatus),
 				"team_name":    event.TeamName,
+				"timed_out":    strconv.FormatBool(event.TimedOut),
 			},
 		},
 	)
 }
 
+type BuildQueueWait struct {
+	BuildLabels
+	BuildID  int
+	Duration time.Duration
+	TraceID  string
+}
+
+func (event BuildQueueWait) Emit(logger lager.Logger) {
+	attributes := event.attributes()
+	attributes["build_id"] = strconv.Itoa(event.BuildID)
+
+	emit(
+		logger.Session("build-queue-wait"),
+		Event{
+			Name:       "build queue wait",
+			Value:      ms(event.Duration),
+			Attributes: attributes,
+			TraceID:    event.TraceID,
+		},
+	)
+}
+
+type StepFinished struct {
+	BuildLabels
+	StepType  string
+	Succeeded bool
+	Duration  time.Duration
+	TraceID   string
+}
+
+func (event StepFinished) Emit(logger lager.Logger) {
+	attributes := event.attributes()
+	attributes["step_type"] = event.StepType
+	attributes["succeeded"] = strconv.FormatBool(event.Succeeded)
+
+	emit(
+		logger.Session("step-finished"),
+		Event{
+			Name:       "step finished",
+			Value:      ms(event.Duration),
+			Attributes: attributes,
+			TraceID:    event.TraceID,
+		},
+	)
+}
+
+type ImageFetched struct {
+	BuildLabels
+	ResourceType string
+	Duration     time.Duration
+	TraceID      string
+}
+
+func (event ImageFetched) Emit(logger lager.Logger) {
+	attributes := event.attributes()
+	attributes["resource_type"] = event.ResourceType
+
+	emit(
+		logger.Session("image-fetched"),
+		Event{
+			Name:       "image fetched",
+			Value:      ms(event.Duration),
+			Attributes: attributes,
+			TraceID:    event.TraceID,
+		},
+	)
+}
+
+type InputFetched struct {
+	BuildLabels
+	ResourceType string
+	CacheHit     bool
+	Duration     time.Duration
+	TraceID      string
+}
+
+func (event InputFetched) Emit(logger lager.Logger) {
+	attributes := event.attributes()
+	attributes["resource_type"] = event.ResourceType
+	attributes["cache_hit"] = strconv.FormatBool(event.CacheHit)
+
+	emit(
+		logger.Session("input-fetched"),
+		Event{
+			Name:       "input fetched",
+			Value:      ms(event.Duration),
+			Attributes: attributes,
+			TraceID:    event.TraceID,
+		},
+	)
+}
+
+type VolumeStreamed struct {
+	BuildLabels
+	Bytes    int64
+	Duration time.Duration
+	TraceID  string
+}
+
+func (event VolumeStreamed) Emit(logger lager.Logger) {
+	logger = logger.Session("volume-streamed")
+
+	emit(
+		logger,
+		Event{
+			Name:       "volume streaming duration (ms)",
+			Value:      ms(event.Duration),
+			Attributes: event.attributes(),
+			TraceID:    event.TraceID,
+		},
+	)
+
+	emit(
+		logger,
+		Event{
+			Name:       "volume streamed bytes",
+			Value:      float64(event.Bytes),
+			Attributes: event.attributes(),
+			TraceID:    event.TraceID,
+		},
+	)
+}
+
 func ms(duration time.Duration) float64 {
 	return float64(duration) / 1000000
 }
@@ -518,6 +667,40 @@ func (event HTTPResponseTime) Emit(logger lager.Logger) {
 	)
 }
 
+// AuditEvent counts the audited requests. Only the attributes of bounded
+// cardinality are emitted; the rest of the event is recorded in the database.
+type AuditEvent struct {
+	TeamName string
+	Action   string
+	Status   int
+}
+
+func (event AuditEvent) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("audit-event"),
+		Event{
+			Name:  "audit event",
+			Value: 1,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"action":    event.Action,
+				"result":    auditResult(event.Status),
+			},
+		},
+	)
+}
+
+func auditResult(status int) string {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return "denied"
+	case status >= 400:
+		return "failed"
+	default:
+		return "succeeded"
+	}
+}
+
 type ResourceCheck struct {
 	PipelineName string
 	ResourceName string
@@ -618,6 +801,21 @@ func (event CheckQueueSize) Emit(logger lager.Logger) {
 	)
 }
 
+type EncryptionKeyRotationRowsRemaining struct {
+	Rows int
+}
+
+func (event EncryptionKeyRotationRowsRemaining) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("encryption-key-rotation-rows-remaining"),
+		Event{
+			Name:       "encryption key rotation rows remaining",
+			Value:      float64(event.Rows),
+			Attributes: map[string]string{},
+		},
+	)
+}
+
 var lockTypeNames = map[int]string{
 	lock.LockTypeResourceConfigChecking: "ResourceConfigChecking",
 	lock.LockTypeBuildTracking:          "BuildTracking",
@@ -1,6 +1,9 @@
 package metric_test
 
 import (
+	"context"
+	"time"
+
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/metric/metricfakes"
@@ -42,6 +45,117 @@ var _ = Describe("Metrics", func() {
 			Expect(event.Value).To(Equal(float64(1)))
 		})
 	})
+
+	Describe("build metrics", func() {
+		var emitter *smartFakeEmitter
+
+		BeforeEach(func() {
+			emitter = registerFakeEmitterInUnsafeGlobalMap()
+		})
+
+		AfterEach(func() {
+			metric.Deinitialize(testLogger)
+		})
+
+		It("labels the event with the build and keeps the trace out of the attributes", func() {
+			metric.StepFinished{
+				BuildLabels: metric.BuildLabelsFromContext(metric.WithBuildLabels(context.Background(), metric.BuildLabels{
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+				})),
+				StepType:  "task",
+				Succeeded: true,
+				Duration:  1500 * time.Millisecond,
+				TraceID:   "some-trace-id",
+			}.Emit(testLogger)
+
+			Eventually(emitter.EmitCallCount).Should(Equal(1))
+
+			_, event := emitter.EmitArgsForCall(0)
+			Expect(event.Name).To(Equal("step finished"))
+			Expect(event.Value).To(Equal(float64(1500)))
+			Expect(event.TraceID).To(Equal("some-trace-id"))
+			Expect(event.Attributes).To(Equal(map[string]string{
+				"team_name": "some-team",
+				"pipeline":  "some-pipeline",
+				"job":       "some-job",
+				"step_type": "task",
+				"succeeded": "true",
+			}))
+		})
+
+		It("emits both the duration and size of a streamed volume", func() {
+			metric.VolumeStreamed{
+				Bytes:    2048,
+				Duration: 3 * time.Second,
+				TraceID:  "some-trace-id",
+			}.Emit(testLogger)
+
+			Eventually(emitter.EmitCallCount).Should(Equal(2))
+
+			_, event := emitter.EmitArgsForCall(0)
+			Expect(event.Name).To(Equal("volume streaming duration (ms)"))
+			Expect(event.Value).To(Equal(float64(3000)))
+
+			_, event = emitter.EmitArgsForCall(1)
+			Expect(event.Name).To(Equal("volume streamed bytes"))
+			Expect(event.Value).To(Equal(float64(2048)))
+			Expect(event.TraceID).To(Equal("some-trace-id"))
+		})
+	})
+
+	Describe("audit event metric", func() {
+		var emitter *smartFakeEmitter
+
+		BeforeEach(func() {
+			emitter = registerFakeEmitterInUnsafeGlobalMap()
+		})
+
+		AfterEach(func() {
+			metric.Deinitialize(testLogger)
+		})
+
+		It("forwards the event as attributes", func() {
+			metric.AuditEvent{
+				TeamName: "some-team",
+				Action:   "SaveConfig",
+				Status:   200,
+			}.Emit(testLogger)
+
+			metric.AuditEvent{
+				TeamName: "some-team",
+				Action:   "SaveConfig",
+				Status:   403,
+			}.Emit(testLogger)
+
+			metric.AuditEvent{
+				Action: "SaveConfig",
+				Status: 500,
+			}.Emit(testLogger)
+
+			Eventually(emitter.EmitCallCount).Should(Equal(3))
+
+			_, event := emitter.EmitArgsForCall(0)
+			Expect(event.Name).To(Equal("audit event"))
+			Expect(event.Value).To(Equal(float64(1)))
+			Expect(event.Attributes).To(Equal(map[string]string{
+				"team_name": "some-team",
+				"action":    "SaveConfig",
+				"result":    "succeeded",
+			}))
+
+			_, event = emitter.EmitArgsForCall(1)
+			Expect(event.Attributes).To(HaveKeyWithValue("result", "denied"))
+
+			_, event = emitter.EmitArgsForCall(2)
+			Expect(event.Attributes).To(Equal(map[string]string{
+				"team_name": "",
+				"action":    "SaveConfig",
+				"result":    "failed",
+			}))
+		})
+	})
 })
 
 type smartFakeEmitter struct {
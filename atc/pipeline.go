@@ -1,12 +1,90 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const InstanceVarsQueryParam = "instance_vars"
+
+// InstanceVars are the vars that distinguish an instance of a pipeline from
+// the other pipelines sharing its name.
+type InstanceVars map[string]interface{}
+
+// PipelineRef identifies a pipeline within a team by its name and, for
+// instanced pipelines, its instance vars.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	keys := make([]string, 0, len(ref.InstanceVars))
+	for k := range ref.InstanceVars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		value, _ := json.Marshal(ref.InstanceVars[k])
+		pairs = append(pairs, fmt.Sprintf("%s:%s", k, strings.Trim(string(value), `"`)))
+	}
+
+	return ref.Name + "/" + strings.Join(pairs, ",")
+}
+
+func (ref PipelineRef) QueryParams() url.Values {
+	if len(ref.InstanceVars) == 0 {
+		return nil
+	}
+
+	payload, _ := json.Marshal(ref.InstanceVars)
+	return url.Values{InstanceVarsQueryParam: []string{string(payload)}}
+}
+
+func InstanceVarsFromQueryParams(params url.Values) (InstanceVars, error) {
+	payload := params.Get(InstanceVarsQueryParam)
+	if payload == "" {
+		return nil, nil
+	}
+
+	var instanceVars InstanceVars
+	err := json.Unmarshal([]byte(payload), &instanceVars)
+	if err != nil {
+		return nil, fmt.Errorf("malformed %s: %w", InstanceVarsQueryParam, err)
+	}
+
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	return instanceVars, nil
+}
+
 type Pipeline struct {
-	ID       int          `json:"id"`
-	Name     string       `json:"name"`
-	Paused   bool         `json:"paused"`
-	Public   bool         `json:"public"`
-	Groups   GroupConfigs `json:"groups,omitempty"`
-	TeamName string       `json:"team_name"`
+	ID           int          `json:"id"`
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+	Paused       bool         `json:"paused"`
+	Public       bool         `json:"public"`
+	Archived     bool         `json:"archived,omitempty"`
+	Groups       GroupConfigs `json:"groups,omitempty"`
+	TeamName     string       `json:"team_name"`
+}
+
+func (p Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         p.Name,
+		InstanceVars: p.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
@@ -0,0 +1,63 @@
+package atc_test
+
+import (
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("PipelineRef", func() {
+	Describe("String", func() {
+		It("returns just the name when there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.String()).To(Equal("some-pipeline"))
+		})
+
+		It("appends the instance vars sorted by key", func() {
+			ref := atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"version": "1.2",
+					"branch":  "master",
+				},
+			}
+			Expect(ref.String()).To(Equal("some-pipeline/branch:master,version:1.2"))
+		})
+	})
+
+	Describe("QueryParams", func() {
+		It("returns nil when there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.QueryParams()).To(BeNil())
+		})
+
+		It("round-trips through InstanceVarsFromQueryParams", func() {
+			ref := atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "master"},
+			}
+
+			instanceVars, err := atc.InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instanceVars).To(Equal(ref.InstanceVars))
+		})
+	})
+
+	Describe("InstanceVarsFromQueryParams", func() {
+		It("returns nil when the param is missing", func() {
+			instanceVars, err := atc.InstanceVarsFromQueryParams(url.Values{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instanceVars).To(BeNil())
+		})
+
+		It("errors when the param is malformed", func() {
+			_, err := atc.InstanceVarsFromQueryParams(url.Values{
+				atc.InstanceVarsQueryParam: []string{"{nope"},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
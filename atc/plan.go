@@ -21,6 +21,7 @@ type Plan struct {
 	Try         *TryPlan         `json:"try,omitempty"`
 	Timeout     *TimeoutPlan     `json:"timeout,omitempty"`
 	Retry       *RetryPlan       `json:"retry,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
 
 	// used for 'fly execute'
 	ArtifactInput  *ArtifactInputPlan  `json:"artifact_input,omitempty"`
@@ -85,6 +86,32 @@ type InParallelPlan struct {
 
 type DoPlan []Plan
 
+// An AcrossPlan runs a step once for each combination of its vars' values.
+//
+// When all of the values are known up front, Steps holds one sub-plan per
+// combination. Otherwise SubStepTemplate is expanded once the values have
+// been resolved at runtime.
+type AcrossPlan struct {
+	Vars            []AcrossVar     `json:"vars"`
+	Steps           []VarScopedPlan `json:"steps,omitempty"`
+	SubStepTemplate *Plan           `json:"substep_template,omitempty"`
+	FailFast        bool            `json:"fail_fast,omitempty"`
+}
+
+type AcrossVar struct {
+	Var         string        `json:"name"`
+	Values      []interface{} `json:"values,omitempty"`
+	ValuesFrom  string        `json:"values_from,omitempty"`
+	MaxInFlight int           `json:"max_in_flight,omitempty"`
+}
+
+// A VarScopedPlan is a step to run with the across vars set to Values, in the
+// same order as the AcrossPlan's Vars.
+type VarScopedPlan struct {
+	Step   Plan          `json:"step"`
+	Values []interface{} `json:"values"`
+}
+
 type GetPlan struct {
 	Type        string   `json:"type"`
 	Name        string   `json:"name,omitempty"`
@@ -148,7 +175,8 @@ type SetPipelinePlan struct {
 
 type LoadVarPlan struct {
 	Name   string `json:"name"`
-	File   string `json:"file"`
+	File   string `json:"file,omitempty"`
+	From   string `json:"from,omitempty"`
 	Format string `json:"format,omitempty"`
 	Reveal bool   `json:"reveal,omitempty"`
 }
@@ -160,3 +188,97 @@ type DependentGetPlan struct {
 	Name     string `json:"name,omitempty"`
 	Resource string `json:"resource"`
 }
+
+// Each calls f with the plan and every plan nested within it.
+func (plan *Plan) Each(f func(*Plan)) {
+	f(plan)
+
+	if plan.Aggregate != nil {
+		for i := range *plan.Aggregate {
+			(*plan.Aggregate)[i].Each(f)
+		}
+	}
+
+	if plan.InParallel != nil {
+		for i := range plan.InParallel.Steps {
+			plan.InParallel.Steps[i].Each(f)
+		}
+	}
+
+	if plan.Do != nil {
+		for i := range *plan.Do {
+			(*plan.Do)[i].Each(f)
+		}
+	}
+
+	if plan.Across != nil {
+		for i := range plan.Across.Steps {
+			plan.Across.Steps[i].Step.Each(f)
+		}
+
+		if plan.Across.SubStepTemplate != nil {
+			plan.Across.SubStepTemplate.Each(f)
+		}
+	}
+
+	if plan.OnAbort != nil {
+		plan.OnAbort.Step.Each(f)
+		plan.OnAbort.Next.Each(f)
+	}
+
+	if plan.OnError != nil {
+		plan.OnError.Step.Each(f)
+		plan.OnError.Next.Each(f)
+	}
+
+	if plan.Ensure != nil {
+		plan.Ensure.Step.Each(f)
+		plan.Ensure.Next.Each(f)
+	}
+
+	if plan.OnSuccess != nil {
+		plan.OnSuccess.Step.Each(f)
+		plan.OnSuccess.Next.Each(f)
+	}
+
+	if plan.OnFailure != nil {
+		plan.OnFailure.Step.Each(f)
+		plan.OnFailure.Next.Each(f)
+	}
+
+	if plan.Try != nil {
+		plan.Try.Step.Each(f)
+	}
+
+	if plan.Timeout != nil {
+		plan.Timeout.Step.Each(f)
+	}
+
+	if plan.Retry != nil {
+		for i := range *plan.Retry {
+			(*plan.Retry)[i].Each(f)
+		}
+	}
+}
+
+// AcrossCombinations returns every combination of the given values, taking
+// one value from each list. The last list varies the fastest, so combinations
+// sharing a prefix are adjacent.
+func AcrossCombinations(values [][]interface{}) [][]interface{} {
+	combinations := [][]interface{}{{}}
+
+	for _, vals := range values {
+		var next [][]interface{}
+		for _, combination := range combinations {
+			for _, val := range vals {
+				extended := make([]interface{}, len(combination), len(combination)+1)
+				copy(extended, combination)
+				next = append(next, append(extended, val))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
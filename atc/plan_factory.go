@@ -31,6 +31,8 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.InParallel = &t
 	case DoPlan:
 		plan.Do = &t
+	case AcrossPlan:
+		plan.Across = &t
 	case GetPlan:
 		plan.Get = &t
 	case PutPlan:
@@ -0,0 +1,86 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	Describe("Each", func() {
+		It("visits every nested plan", func() {
+			plan := atc.Plan{
+				ID: "1",
+				Do: &atc.DoPlan{
+					{
+						ID: "2",
+						Across: &atc.AcrossPlan{
+							Steps: []atc.VarScopedPlan{
+								{Step: atc.Plan{ID: "3", Task: &atc.TaskPlan{}}},
+							},
+							SubStepTemplate: &atc.Plan{
+								ID: "4",
+								Try: &atc.TryPlan{
+									Step: atc.Plan{ID: "5", Task: &atc.TaskPlan{}},
+								},
+							},
+						},
+					},
+					{
+						ID: "6",
+						OnSuccess: &atc.OnSuccessPlan{
+							Step: atc.Plan{ID: "7", Put: &atc.PutPlan{}},
+							Next: atc.Plan{ID: "8", Get: &atc.GetPlan{}},
+						},
+					},
+				},
+			}
+
+			var ids []atc.PlanID
+			plan.Each(func(p *atc.Plan) {
+				ids = append(ids, p.ID)
+			})
+
+			Expect(ids).To(Equal([]atc.PlanID{"1", "2", "3", "4", "5", "6", "7", "8"}))
+		})
+
+		It("allows the plans to be modified in place", func() {
+			plan := atc.Plan{
+				ID: "1",
+				InParallel: &atc.InParallelPlan{
+					Steps: []atc.Plan{{ID: "2"}},
+				},
+			}
+
+			plan.Each(func(p *atc.Plan) {
+				p.ID = p.ID + "/0"
+			})
+
+			Expect(plan.ID).To(Equal(atc.PlanID("1/0")))
+			Expect(plan.InParallel.Steps[0].ID).To(Equal(atc.PlanID("2/0")))
+		})
+	})
+
+	Describe("AcrossCombinations", func() {
+		It("returns every combination with the last values varying fastest", func() {
+			Expect(atc.AcrossCombinations([][]interface{}{
+				{"a", "b"},
+				{1, 2, 3},
+			})).To(Equal([][]interface{}{
+				{"a", 1},
+				{"a", 2},
+				{"a", 3},
+				{"b", 1},
+				{"b", 2},
+				{"b", 3},
+			}))
+		})
+
+		It("returns no combinations if any var has no values", func() {
+			Expect(atc.AcrossCombinations([][]interface{}{
+				{"a", "b"},
+				{},
+			})).To(BeEmpty())
+		})
+	})
+})
@@ -156,6 +156,7 @@ func (runner *Runner) OpenConn() db.Conn {
 		nil,
 		"postgresrunner",
 		nil,
+		nil,
 	)
 	Expect(err).NotTo(HaveOccurred())
 
@@ -9,6 +9,7 @@ func (plan Plan) Public() *json.RawMessage {
 		Aggregate      *json.RawMessage `json:"aggregate,omitempty"`
 		InParallel     *json.RawMessage `json:"in_parallel,omitempty"`
 		Do             *json.RawMessage `json:"do,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
 		Get            *json.RawMessage `json:"get,omitempty"`
 		Put            *json.RawMessage `json:"put,omitempty"`
 		Check          *json.RawMessage `json:"check,omitempty"`
@@ -42,6 +43,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Do = plan.Do.Public()
 	}
 
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
 	if plan.Get != nil {
 		public.Get = plan.Get.Public()
 	}
@@ -151,6 +156,34 @@ func (plan DoPlan) Public() *json.RawMessage {
 	return enc(public)
 }
 
+func (plan AcrossPlan) Public() *json.RawMessage {
+	steps := make([]*json.RawMessage, len(plan.Steps))
+
+	for i := 0; i < len(plan.Steps); i++ {
+		steps[i] = plan.Steps[i].Public()
+	}
+
+	return enc(struct {
+		Vars     []AcrossVar        `json:"vars"`
+		Steps    []*json.RawMessage `json:"steps,omitempty"`
+		FailFast bool               `json:"fail_fast,omitempty"`
+	}{
+		Vars:     plan.Vars,
+		Steps:    steps,
+		FailFast: plan.FailFast,
+	})
+}
+
+func (plan VarScopedPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step   *json.RawMessage `json:"step"`
+		Values []interface{}    `json:"values"`
+	}{
+		Step:   plan.Step.Public(),
+		Values: plan.Values,
+	})
+}
+
 func (plan EnsurePlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step *json.RawMessage `json:"step"`
@@ -359,6 +359,40 @@ var _ = Describe("Plan", func() {
 							Vars:     map[string]interface{}{"k1": "v1"},
 						},
 					},
+					atc.Plan{
+						ID: "38",
+						Across: &atc.AcrossPlan{
+							Vars: []atc.AcrossVar{
+								{
+									Var:         "v",
+									Values:      []interface{}{"a"},
+									MaxInFlight: 2,
+								},
+							},
+							Steps: []atc.VarScopedPlan{
+								{
+									Step: atc.Plan{
+										ID: "39",
+										Task: &atc.TaskPlan{
+											Name:       "name",
+											ConfigPath: "some/config/path.yml",
+											Config: &atc.TaskConfig{
+												Params: atc.TaskEnv{"some": "secret"},
+											},
+										},
+									},
+									Values: []interface{}{"a"},
+								},
+							},
+							SubStepTemplate: &atc.Plan{
+								ID: "40",
+								Task: &atc.TaskPlan{
+									Name: "name",
+								},
+							},
+							FailFast: true,
+						},
+					},
 				},
 			}
 
@@ -623,6 +657,31 @@ var _ = Describe("Plan", func() {
 	  "set_pipeline": {
 		"name": "some-pipeline"
 	  }
+	},
+	{
+	  "id": "38",
+	  "across": {
+		"vars": [
+		  {
+			"name": "v",
+			"values": ["a"],
+			"max_in_flight": 2
+		  }
+		],
+		"steps": [
+		  {
+			"step": {
+			  "id": "39",
+			  "task": {
+				"name": "name",
+				"privileged": false
+			  }
+			},
+			"values": ["a"]
+		  }
+		],
+		"fail_fast": true
+	  }
 	}
   ]
 }
@@ -14,6 +14,8 @@ const (
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
+	SetBuildComment     = "SetBuildComment"
+	GetBuildQueue       = "GetBuildQueue"
 
 	GetCheck = "GetCheck"
 
@@ -24,6 +26,7 @@ const (
 	ListJobs       = "ListJobs"
 	ListJobBuilds  = "ListJobBuilds"
 	ListJobInputs  = "ListJobInputs"
+	ListJobQueue   = "ListJobQueue"
 	GetJobBuild    = "GetJobBuild"
 	PauseJob       = "PauseJob"
 	UnpauseJob     = "UnpauseJob"
@@ -62,12 +65,14 @@ const (
 	OrderPipelines      = "OrderPipelines"
 	PausePipeline       = "PausePipeline"
 	UnpausePipeline     = "UnpausePipeline"
+	ArchivePipeline     = "ArchivePipeline"
 	ExposePipeline      = "ExposePipeline"
 	HidePipeline        = "HidePipeline"
 	RenamePipeline      = "RenamePipeline"
 	ListPipelineBuilds  = "ListPipelineBuilds"
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
+	ResolveVar          = "ResolveVar"
 
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
@@ -84,6 +89,8 @@ const (
 	GetInfo      = "Info"
 	GetInfoCreds = "InfoCreds"
 
+	GetEncryptionKeyRotation = "GetEncryptionKeyRotation"
+
 	ListContainers           = "ListContainers"
 	GetContainer             = "GetContainer"
 	HijackContainer          = "HijackContainer"
@@ -101,12 +108,22 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	SetTeamWebhook     = "SetTeamWebhook"
+	DestroyTeamWebhook = "DestroyTeamWebhook"
+	ReceiveTeamWebhook = "ReceiveTeamWebhook"
+
+	CreateAPIToken = "CreateAPIToken"
+	ListAPITokens  = "ListAPITokens"
+	RevokeAPIToken = "RevokeAPIToken"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
 
 	ListActiveUsersSince = "ListActiveUsersSince"
 
+	ListAuditEvents = "ListAuditEvents"
+
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
@@ -115,6 +132,8 @@ const (
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+	ResolveVarQueryName     = "name"
+	ResolveVarQueryReveal   = "reveal"
 )
 
 var Routes = rata.Routes([]rata.Route{
@@ -130,6 +149,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
+	{Path: "/api/v1/builds/:build_id/comment", Method: "PUT", Name: SetBuildComment},
+	{Path: "/api/v1/builds/:build_id/queue", Method: "GET", Name: GetBuildQueue},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
 
 	{Path: "/api/v1/checks/:check_id", Method: "GET", Name: GetCheck},
@@ -141,6 +162,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "POST", Name: RerunJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/queue", Method: "GET", Name: ListJobQueue},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
@@ -157,6 +179,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/ordering", Method: "PUT", Name: OrderPipelines},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/pause", Method: "PUT", Name: PausePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/unpause", Method: "PUT", Name: UnpausePipeline},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/archive", Method: "PUT", Name: ArchivePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/expose", Method: "PUT", Name: ExposePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/hide", Method: "PUT", Name: HidePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/versions-db", Method: "GET", Name: GetVersionsDB},
@@ -164,6 +187,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/vars/resolve", Method: "GET", Name: ResolveVar},
 
 	{Path: "/api/v1/resources", Method: "GET", Name: ListAllResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources", Method: "GET", Name: ListResources},
@@ -200,9 +224,12 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/cli", Method: "GET", Name: DownloadCLI},
 	{Path: "/api/v1/info", Method: "GET", Name: GetInfo},
 	{Path: "/api/v1/info/creds", Method: "GET", Name: GetInfoCreds},
+	{Path: "/api/v1/info/encryption", Method: "GET", Name: GetEncryptionKeyRotation},
 
 	{Path: "/api/v1/users", Method: "GET", Name: ListActiveUsersSince},
 
+	{Path: "/api/v1/audit_events", Method: "GET", Name: ListAuditEvents},
+
 	{Path: "/api/v1/containers/destroying", Method: "GET", Name: ListDestroyingContainers},
 	{Path: "/api/v1/containers/report", Method: "PUT", Name: ReportWorkerContainers},
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
@@ -220,6 +247,14 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
 
+	{Path: "/api/v1/teams/:team_name/webhooks/:provider", Method: "PUT", Name: SetTeamWebhook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:provider", Method: "DELETE", Name: DestroyTeamWebhook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:provider", Method: "POST", Name: ReceiveTeamWebhook},
+
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "POST", Name: CreateAPIToken},
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "GET", Name: ListAPITokens},
+	{Path: "/api/v1/teams/:team_name/tokens/:token_name", Method: "DELETE", Name: RevokeAPIToken},
+
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
 
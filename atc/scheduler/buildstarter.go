@@ -40,16 +40,19 @@ type Build interface {
 func NewBuildStarter(
 	factory BuildFactory,
 	algorithm Algorithm,
+	taskQueue db.WorkerTaskQueue,
 ) BuildStarter {
 	return &buildStarter{
 		factory:   factory,
 		algorithm: algorithm,
+		taskQueue: taskQueue,
 	}
 }
 
 type buildStarter struct {
 	factory   BuildFactory
 	algorithm Algorithm
+	taskQueue db.WorkerTaskQueue
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -178,6 +181,21 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
+	// when workers are constrained, leave them to the builds of higher
+	// priority which are already waiting for one the build could also use
+	higherPriorityWaiting, err := s.taskQueue.HigherPriorityWaiting(nextPendingBuild.ID(), nil)
+	if err != nil {
+		return startResults{}, fmt.Errorf("check worker task queue: %w", err)
+	}
+
+	if higherPriorityWaiting {
+		logger.Debug("waiting-for-higher-priority-builds")
+		return startResults{
+			started:    false,
+			needsRetry: true,
+		}, nil
+	}
+
 	scheduled, err := job.ScheduleBuild(nextPendingBuild)
 	if err != nil {
 		return startResults{}, fmt.Errorf("schedule build: %w", err)
@@ -23,6 +23,7 @@ var _ = Describe("BuildStarter", func() {
 		fakeFactory   *schedulerfakes.FakeBuildFactory
 		pendingBuilds []db.Build
 		fakeAlgorithm *schedulerfakes.FakeAlgorithm
+		fakeTaskQueue *dbfakes.FakeWorkerTaskQueue
 
 		buildStarter scheduler.BuildStarter
 
@@ -35,8 +36,9 @@ var _ = Describe("BuildStarter", func() {
 		fakePipeline = new(dbfakes.FakePipeline)
 		fakeFactory = new(schedulerfakes.FakeBuildFactory)
 		fakeAlgorithm = new(schedulerfakes.FakeAlgorithm)
+		fakeTaskQueue = new(dbfakes.FakeWorkerTaskQueue)
 
-		buildStarter = scheduler.NewBuildStarter(fakeFactory, fakeAlgorithm)
+		buildStarter = scheduler.NewBuildStarter(fakeFactory, fakeAlgorithm, fakeTaskQueue)
 
 		disaster = errors.New("bad thing")
 	})
@@ -861,6 +863,40 @@ var _ = Describe("BuildStarter", func() {
 							})
 						})
 
+						Context("when tasks of higher-priority builds are waiting for a worker", func() {
+							BeforeEach(func() {
+								fakeTaskQueue.HigherPriorityWaitingReturns(true, nil)
+							})
+
+							itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+							itDidNotAttemptToScheduleAnyBuilds()
+
+							It("does not try to start any further builds", func() {
+								Expect(fakeTaskQueue.HigherPriorityWaitingCallCount()).To(Equal(1))
+							})
+
+							It("considers tasks waiting for any worker the build could use", func() {
+								_, spec := fakeTaskQueue.HigherPriorityWaitingArgsForCall(0)
+								Expect(spec).To(BeNil())
+							})
+
+							It("needs to be rescheduled", func() {
+								Expect(needsReschedule).To(BeTrue())
+							})
+						})
+
+						Context("when checking the worker task queue fails", func() {
+							BeforeEach(func() {
+								fakeTaskQueue.HigherPriorityWaitingReturns(false, disaster)
+							})
+
+							It("returns the error", func() {
+								Expect(tryStartErr).To(Equal(fmt.Errorf("check worker task queue: %w", disaster)))
+							})
+
+							itDidNotAttemptToScheduleAnyBuilds()
+						})
+
 						Context("when fetching pending builds fail", func() {
 							BeforeEach(func() {
 								job.GetPendingBuildsReturns(nil, disaster)
@@ -110,6 +110,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if len(planConfig.Across) > 0 {
+		return factory.across(job, planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -142,6 +146,69 @@ func (factory *buildFactory) constructPlanFromConfig(
 	})
 }
 
+// across runs the step, including its hooks, once for each combination of
+// the across vars. If every var's values are known the combinations are
+// planned now; otherwise a template is planned and expanded at runtime.
+func (factory *buildFactory) across(
+	job atc.JobConfig,
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	acrossVars := planConfig.Across
+	failFast := planConfig.FailFast
+
+	planConfig.Across = nil
+	planConfig.FailFast = false
+
+	var vars []atc.AcrossVar
+	var values [][]interface{}
+	dynamic := false
+	for _, v := range acrossVars {
+		vars = append(vars, atc.AcrossVar{
+			Var:         v.Var,
+			Values:      v.Values,
+			ValuesFrom:  v.ValuesFrom,
+			MaxInFlight: v.MaxInFlight,
+		})
+
+		values = append(values, v.Values)
+
+		if v.ValuesFrom != "" {
+			dynamic = true
+		}
+	}
+
+	acrossPlan := atc.AcrossPlan{
+		Vars:     vars,
+		FailFast: failFast,
+	}
+
+	if dynamic {
+		template, err := factory.constructPlanFromConfig(job, planConfig, resources, resourceTypes, inputs)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		acrossPlan.SubStepTemplate = &template
+	} else {
+		for _, combination := range atc.AcrossCombinations(values) {
+			step, err := factory.constructPlanFromConfig(job, planConfig, resources, resourceTypes, inputs)
+			if err != nil {
+				return atc.Plan{}, err
+			}
+
+			acrossPlan.Steps = append(acrossPlan.Steps, atc.VarScopedPlan{
+				Step:   step,
+				Values: combination,
+			})
+		}
+	}
+
+	return factory.planFactory.NewPlan(acrossPlan), nil
+}
+
 func (factory *buildFactory) constructUnhookedPlan(
 	job atc.JobConfig,
 	planConfig atc.PlanConfig,
@@ -276,6 +343,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 		plan = factory.planFactory.NewPlan(atc.LoadVarPlan{
 			Name:   name,
 			File:   planConfig.File,
+			From:   planConfig.From,
 			Format: planConfig.Format,
 			Reveal: planConfig.Reveal,
 		})
@@ -0,0 +1,187 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across", func() {
+	var (
+		buildFactory factory.BuildFactory
+
+		resources           atc.ResourceConfigs
+		resourceTypes       atc.VersionedResourceTypes
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+
+		buildFactory = factory.NewBuildFactory(actualPlanFactory)
+
+		resources = atc.ResourceConfigs{}
+
+		resourceTypes = atc.VersionedResourceTypes{
+			{
+				ResourceType: atc.ResourceType{
+					Name:   "some-custom-resource",
+					Type:   "registry-image",
+					Source: atc.Source{"some": "custom-source"},
+				},
+				Version: atc.Version{"some": "version"},
+			},
+		}
+	})
+
+	Context("when the values of every var are known", func() {
+		It("plans a step for each combination of values", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:         "go_version",
+								Values:      []interface{}{"1.13", "1.14"},
+								MaxInFlight: 2,
+							},
+							{
+								Var:    "os",
+								Values: []interface{}{"linux", "darwin"},
+							},
+						},
+						FailFast: true,
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			var steps []atc.VarScopedPlan
+			for _, values := range [][]interface{}{
+				{"1.13", "linux"},
+				{"1.13", "darwin"},
+				{"1.14", "linux"},
+				{"1.14", "darwin"},
+			} {
+				steps = append(steps, atc.VarScopedPlan{
+					Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "some-task",
+						VersionedResourceTypes: resourceTypes,
+					}),
+					Values: values,
+				})
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:         "go_version",
+						Values:      []interface{}{"1.13", "1.14"},
+						MaxInFlight: 2,
+					},
+					{
+						Var:    "os",
+						Values: []interface{}{"linux", "darwin"},
+					},
+				},
+				Steps:    steps,
+				FailFast: true,
+			})
+			Expect(actual).To(Equal(expected))
+		})
+
+		It("runs the step's hooks for each combination", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "go_version",
+								Values: []interface{}{"1.13"},
+							},
+						},
+						Failure: &atc.PlanConfig{
+							Task: "some-failure-task",
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			task := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "some-task",
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			failureTask := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "some-failure-task",
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:    "go_version",
+						Values: []interface{}{"1.13"},
+					},
+				},
+				Steps: []atc.VarScopedPlan{
+					{
+						Step: expectedPlanFactory.NewPlan(atc.OnFailurePlan{
+							Step: task,
+							Next: failureTask,
+						}),
+						Values: []interface{}{"1.13"},
+					},
+				},
+			})
+			Expect(actual).To(Equal(expected))
+		})
+	})
+
+	Context("when the values of a var come from a var reference", func() {
+		It("plans a template to be expanded at runtime", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:        "go_version",
+								ValuesFrom: "((.:go_versions))",
+							},
+						},
+						Timeout: "1h",
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			task := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "some-task",
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			template := expectedPlanFactory.NewPlan(atc.TimeoutPlan{
+				Duration: "1h",
+				Step:     task,
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:        "go_version",
+						ValuesFrom: "((.:go_versions))",
+					},
+				},
+				SubStepTemplate: &template,
+			})
+			Expect(actual).To(Equal(expected))
+		})
+	})
+})
@@ -61,4 +61,28 @@ var _ = Describe("Factory LoadVar Step", func() {
 			Expect(actual).To(testhelpers.MatchPlan(expected))
 		})
 	})
+
+	Context("when load var from a get step", func() {
+		BeforeEach(func() {
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						LoadVar: "some-var",
+						From:    "some-get",
+					},
+				},
+			}
+		})
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(input, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.LoadVarPlan{
+				Name: "some-var",
+				From: "some-get",
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
 })
@@ -57,12 +57,16 @@ func (s *schedulerRunner) Run(ctx context.Context) error {
 		return fmt.Errorf("find jobs to schedule: %w", err)
 	}
 
-	pipelineIDToPipeline, pipelineIDToJobs, err := s.constructPipelineIDMaps(jobs)
+	pipelineIDs, pipelineIDToPipeline, pipelineIDToJobs, err := s.constructPipelineIDMaps(jobs)
 	if err != nil {
 		return err
 	}
 
-	for pipelineID, jobsToSchedule := range pipelineIDToJobs {
+	// the jobs to schedule are ordered by priority, so pipelines are visited
+	// in the order of their highest-priority job in order for those jobs to
+	// be scheduled first when the number of jobs scheduling at once is limited
+	for _, pipelineID := range pipelineIDs {
+		jobsToSchedule := pipelineIDToJobs[pipelineID]
 		pipeline := pipelineIDToPipeline[pipelineID]
 
 		pLog := s.logger.Session("pipeline", lager.Data{"pipeline": pipeline.Name()})
@@ -186,7 +190,8 @@ func (s *schedulerRunner) scheduleJob(ctx context.Context, logger lager.Logger,
 	return nil
 }
 
-func (s *schedulerRunner) constructPipelineIDMaps(jobs db.Jobs) (map[int]db.Pipeline, map[int]db.Jobs, error) {
+func (s *schedulerRunner) constructPipelineIDMaps(jobs db.Jobs) ([]int, map[int]db.Pipeline, map[int]db.Jobs, error) {
+	var pipelineIDs []int
 	pipelineIDToPipeline := make(map[int]db.Pipeline)
 	pipelineIDToJobs := make(map[int]db.Jobs)
 
@@ -197,7 +202,7 @@ func (s *schedulerRunner) constructPipelineIDMaps(jobs db.Jobs) (map[int]db.Pipe
 		if !found {
 			pipeline, found, err := job.Pipeline()
 			if err != nil {
-				return nil, nil, fmt.Errorf("find pipeline for job: %w", err)
+				return nil, nil, nil, fmt.Errorf("find pipeline for job: %w", err)
 			}
 
 			if !found {
@@ -206,10 +211,11 @@ func (s *schedulerRunner) constructPipelineIDMaps(jobs db.Jobs) (map[int]db.Pipe
 			}
 
 			pipelineIDToPipeline[pipelineID] = pipeline
+			pipelineIDs = append(pipelineIDs, pipelineID)
 		}
 
 		pipelineIDToJobs[pipelineID] = append(pipelineIDToJobs[pipelineID], job)
 	}
 
-	return pipelineIDToPipeline, pipelineIDToJobs, nil
+	return pipelineIDs, pipelineIDToPipeline, pipelineIDToJobs, nil
 }
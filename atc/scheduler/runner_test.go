@@ -461,6 +461,34 @@ var _ = Describe("Runner", func() {
 				})
 			})
 
+			Context("when the jobs of the second pipeline are to be scheduled first", func() {
+				BeforeEach(func() {
+					fakeJobFactory.JobsToScheduleReturns([]db.Job{fakeJob2, fakeJob1, fakeJob3}, nil)
+
+					fakePipeline.JobsReturns([]db.Job{fakeJob1}, nil)
+					fakePipeline.ResourcesReturns(db.Resources{fakeResource1}, nil)
+					fakeJob1.AcquireSchedulingLockReturns(lock, true, nil)
+
+					fakePipeline2.JobsReturns([]db.Job{fakeJob2, fakeJob3}, nil)
+					fakePipeline2.ResourcesReturns(db.Resources{fakeResource2}, nil)
+					fakeJob2.AcquireSchedulingLockReturns(lock, true, nil)
+					fakeJob3.AcquireSchedulingLockReturns(lock, true, nil)
+				})
+
+				It("schedules the jobs of the second pipeline first", func() {
+					Expect(schedulerErr).ToNot(HaveOccurred())
+					Eventually(fakeScheduler.ScheduleCallCount).Should(Equal(3))
+
+					var scheduledJobs []string
+					for i := 0; i < 3; i++ {
+						_, _, _, job, _, _ := fakeScheduler.ScheduleArgsForCall(i)
+						scheduledJobs = append(scheduledJobs, job.Name())
+					}
+
+					Expect(scheduledJobs).To(Equal([]string{"some-other-job", "another-other-job", "some-job"}))
+				})
+			})
+
 			Context("when the first pipeline fails to schedule", func() {
 				BeforeEach(func() {
 					fakePipeline.JobsReturns(nil, errors.New("error"))
@@ -2,13 +2,10 @@ package syslog
 
 import (
 	"context"
-	"encoding/json"
-	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc/db"
-	"github.com/concourse/concourse/atc/event"
 )
 
 //go:generate counterfeiter . Drainer
@@ -19,18 +16,21 @@ type Drainer interface {
 
 type drainer struct {
 	hostname     string
-	transport    string
-	address      string
+	destinations []string
 	caCerts      []string
 	buildFactory db.BuildFactory
+	teamFactory  db.TeamFactory
 }
 
-func NewDrainer(transport string, address string, hostname string, caCerts []string, buildFactory db.BuildFactory) Drainer {
+// NewDrainer returns a drainer which sends the events of finished builds to
+// the given destinations (see Open) and, if a team factory is given, to the
+// drains configured by the build's team.
+func NewDrainer(hostname string, destinations []string, caCerts []string, buildFactory db.BuildFactory, teamFactory db.TeamFactory) Drainer {
 	return &drainer{
 		hostname:     hostname,
-		transport:    transport,
-		address:      address,
+		destinations: destinations,
 		buildFactory: buildFactory,
+		teamFactory:  teamFactory,
 		caCerts:      caCerts,
 	}
 }
@@ -44,27 +44,92 @@ func (d *drainer) Run(ctx context.Context) error {
 		return err
 	}
 
-	if len(builds) > 0 {
-		syslog, err := Dial(d.transport, d.address, d.caCerts)
+	if len(builds) == 0 {
+		return nil
+	}
+
+	var sinks []Sink
+
+	// ignore any errors coming from closing the sinks
+	defer func() {
+		for _, sink := range sinks {
+			db.Close(sink)
+		}
+	}()
+
+	for _, destination := range d.destinations {
+		sink, err := Open(destination, d.caCerts)
 		if err != nil {
 			logger.Error("failed-to-connect", err)
 			return err
 		}
 
-		// ignore any errors coming from syslog.Close()
-		defer db.Close(syslog)
+		sinks = append(sinks, sink)
+	}
 
-		for _, build := range builds {
-			err := d.drainBuild(logger, build, syslog)
-			if err != nil {
-				return err
+	teamSinks := map[string][]Sink{}
+	defer func() {
+		for _, sinks := range teamSinks {
+			for _, sink := range sinks {
+				db.Close(sink)
 			}
 		}
+	}()
+
+	for _, build := range builds {
+		buildSinks := sinks
+
+		if d.teamFactory != nil {
+			if _, opened := teamSinks[build.TeamName()]; !opened {
+				teamSinks[build.TeamName()] = d.openTeamSinks(logger, build.TeamName())
+			}
+
+			buildSinks = append(append([]Sink{}, sinks...), teamSinks[build.TeamName()]...)
+		}
+
+		err := d.drainBuild(logger, build, buildSinks)
+		if err != nil {
+			return err
+		}
 	}
+
 	return nil
 }
 
-func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog) error {
+// openTeamSinks connects to the drains of the team. Drains which cannot be
+// reached are skipped, so that a team's misconfigured drain does not prevent
+// builds from being drained elsewhere.
+func (d *drainer) openTeamSinks(logger lager.Logger, teamName string) []Sink {
+	logger = logger.Session("open-team-drains", lager.Data{"team": teamName})
+
+	team, found, err := d.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-find-team", err)
+		return nil
+	}
+
+	if !found {
+		return nil
+	}
+
+	var sinks []Sink
+	for i, drain := range team.SyslogDrains() {
+		sink, err := Open(drain.URL, nil)
+		if err != nil {
+			logger.Error("failed-to-connect", err, lager.Data{"drain": i})
+			continue
+		}
+
+		sinks = append(sinks, &teamSink{
+			Sink:   sink,
+			logger: logger.WithData(lager.Data{"drain": i}),
+		})
+	}
+
+	return sinks
+}
+
+func (d *drainer) drainBuild(logger lager.Logger, build db.Build, sinks []Sink) error {
 	logger = logger.Session("drain-build", lager.Data{
 		"team":     build.TeamName(),
 		"pipeline": build.PipelineName(),
@@ -72,6 +137,23 @@ func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog
 		"build":    build.Name(),
 	})
 
+	if len(sinks) > 0 {
+		err := d.sendEvents(logger, build, sinks)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := build.SetDrained(true)
+	if err != nil {
+		logger.Error("failed-to-update-status", err)
+		return err
+	}
+
+	return nil
+}
+
+func (d *drainer) sendEvents(logger lager.Logger, build db.Build, sinks []Sink) error {
 	events, err := build.Events(0)
 	if err != nil {
 		return err
@@ -80,6 +162,8 @@ func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog
 	// ignore any errors coming from events.Close()
 	defer db.Close(events)
 
+	names := stepNames(build.PublicPlan())
+
 	for {
 		ev, err := events.Next()
 		if err != nil {
@@ -90,19 +174,20 @@ func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog
 			return err
 		}
 
-		if ev.Event == event.EventTypeLog {
-			var log event.Log
+		msg, drained, err := newMessage(build, names, ev)
+		if err != nil {
+			logger.Error("failed-to-unmarshal", err)
+			return err
+		}
 
-			err := json.Unmarshal(*ev.Data, &log)
-			if err != nil {
-				logger.Error("failed-to-unmarshal", err)
-				return err
-			}
+		if !drained {
+			continue
+		}
 
-			payload := log.Payload
-			tag := build.TeamName() + "/" + build.PipelineName() + "/" + build.JobName() + "/" + build.Name() + "/" + string(log.Origin.ID)
+		msg.Hostname = d.hostname
 
-			err = syslog.Write(d.hostname, tag, time.Unix(log.Time, 0), payload)
+		for _, sink := range sinks {
+			err = sink.Send(msg)
 			if err != nil {
 				logger.Error("failed-to-write-to-server", err)
 				return err
@@ -110,10 +195,49 @@ func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog
 		}
 	}
 
-	err = build.SetDrained(true)
+	for _, sink := range sinks {
+		err = sink.Flush()
+		if err != nil {
+			logger.Error("failed-to-flush", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// teamSink only logs the errors of a team's drain. Once it has failed, the
+// events of the remaining builds are no longer sent to it.
+type teamSink struct {
+	Sink
+
+	logger lager.Logger
+	failed bool
+}
+
+func (s *teamSink) Send(msg Message) error {
+	if s.failed {
+		return nil
+	}
+
+	err := s.Sink.Send(msg)
 	if err != nil {
-		logger.Error("failed-to-update-status", err)
-		return err
+		s.logger.Error("failed-to-write-to-team-drain", err)
+		s.failed = true
+	}
+
+	return nil
+}
+
+func (s *teamSink) Flush() error {
+	if s.failed {
+		return nil
+	}
+
+	err := s.Sink.Flush()
+	if err != nil {
+		s.logger.Error("failed-to-flush-team-drain", err)
+		s.failed = true
 	}
 
 	return nil
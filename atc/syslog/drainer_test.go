@@ -3,20 +3,27 @@ package syslog_test
 import (
 	"context"
 	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/syslog"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
 )
 
-func newFakeBuild(id int) db.Build {
+func newFakeBuild(id int) *dbfakes.FakeBuild {
 	fakeEventSource := new(dbfakes.FakeEventSource)
 
-	msg1 := json.RawMessage(`{"time":1533744538,"payload":"build ` + strconv.Itoa(id) + ` log"}`)
+	msg1 := json.RawMessage(`{"time":1533744538,"payload":"build ` + strconv.Itoa(id) + ` log","origin":{"id":"some-task-id"}}`)
 
 	fakeEventSource.NextReturnsOnCall(0, event.Envelope{
 		Data:  &msg1,
@@ -41,6 +48,45 @@ func newFakeBuild(id int) db.Build {
 	return fakeBuild
 }
 
+func newFakeStepsBuild(id int) *dbfakes.FakeBuild {
+	fakeEventSource := new(dbfakes.FakeEventSource)
+
+	events := []struct {
+		event atc.EventType
+		data  string
+	}{
+		{"status", `{"time":1533744530,"status":"started"}`},
+		{"initialize-task", `{"time":1533744531,"origin":{"id":"some-task-id"}}`},
+		{"start-task", `{"time":1533744532,"origin":{"id":"some-task-id"}}`},
+		{"log", `{"time":1533744533,"payload":"hello","origin":{"id":"some-task-id"}}`},
+		{"finish-task", `{"time":1533744534,"exit_status":1,"origin":{"id":"some-task-id"}}`},
+		{"status", `{"time":1533744535,"status":"failed"}`},
+	}
+
+	for i, e := range events {
+		data := json.RawMessage(e.data)
+		fakeEventSource.NextReturnsOnCall(i, event.Envelope{
+			Data:  &data,
+			Event: e.event,
+		}, nil)
+	}
+
+	fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+	plan := json.RawMessage(`{"id":"some-do-id","do":[{"id":"some-task-id","task":{"name":"some-task"}}]}`)
+
+	fakeBuild := new(dbfakes.FakeBuild)
+	fakeBuild.EventsReturns(fakeEventSource, nil)
+	fakeBuild.IDReturns(id)
+	fakeBuild.NameReturns("42")
+	fakeBuild.TeamNameReturns("some-team")
+	fakeBuild.PipelineNameReturns("some-pipeline")
+	fakeBuild.JobNameReturns("some-job")
+	fakeBuild.PublicPlanReturns(&plan)
+
+	return fakeBuild
+}
+
 var _ = Describe("Drainer", func() {
 	var fakeBuildFactory *dbfakes.FakeBuildFactory
 	var server *testServer
@@ -51,7 +97,10 @@ var _ = Describe("Drainer", func() {
 	})
 
 	AfterEach(func() {
-		server.Close()
+		if server != nil {
+			server.Close()
+			server = nil
+		}
 	})
 
 	Context("when there are builds that have not been drained", func() {
@@ -61,7 +110,7 @@ var _ = Describe("Drainer", func() {
 			})
 
 			It("drains all build events by tcp", func() {
-				testDrainer := syslog.NewDrainer("tcp", server.Addr, "test", []string{}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer("test", []string{"tcp://" + server.Addr}, []string{}, fakeBuildFactory, nil)
 				err := testDrainer.Run(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
@@ -71,7 +120,231 @@ var _ = Describe("Drainer", func() {
 				Expect(got).NotTo(ContainSubstring("build 123 status"))
 				Expect(got).NotTo(ContainSubstring("build 345 status"))
 			}, 0.2)
+
+			It("marks the builds as drained", func() {
+				builds, _ := fakeBuildFactory.GetDrainableBuilds()
+
+				testDrainer := syslog.NewDrainer("test", []string{"tcp://" + server.Addr}, []string{}, fakeBuildFactory, nil)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, build := range builds {
+					fakeBuild := build.(*dbfakes.FakeBuild)
+					Expect(fakeBuild.SetDrainedCallCount()).To(Equal(1))
+					Expect(fakeBuild.SetDrainedArgsForCall(0)).To(BeTrue())
+				}
+			}, 0.2)
+
+			Context("when the build has steps", func() {
+				BeforeEach(func() {
+					fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakeStepsBuild(123)}, nil)
+				})
+
+				It("drains the status of the build and its steps with structured data", func() {
+					testDrainer := syslog.NewDrainer("test", []string{"tcp://" + server.Addr}, []string{}, fakeBuildFactory, nil)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					got := <-server.Messages
+					Expect(got).To(ContainSubstring(`some-team/some-pipeline/some-job/42/ - status [concourse@32473 team="some-team" pipeline="some-pipeline" job="some-job" build_id="123" build_name="42" status="started"] build started`))
+					Expect(got).To(ContainSubstring(`some-team/some-pipeline/some-job/42/some-task-id - start-task [concourse@32473 team="some-team" pipeline="some-pipeline" job="some-job" build_id="123" build_name="42" step="some-task" status="started"] some-task started`))
+					Expect(got).To(ContainSubstring(`some-team/some-pipeline/some-job/42/some-task-id - log [concourse@32473 team="some-team" pipeline="some-pipeline" job="some-job" build_id="123" build_name="42" step="some-task"] hello`))
+					Expect(got).To(ContainSubstring(`some-team/some-pipeline/some-job/42/some-task-id - finish-task [concourse@32473 team="some-team" pipeline="some-pipeline" job="some-job" build_id="123" build_name="42" step="some-task" status="failed"] some-task failed`))
+					Expect(got).To(ContainSubstring(`build_name="42" status="failed"] build failed`))
+					Expect(got).NotTo(ContainSubstring("initialize-task"))
+				}, 0.2)
+			})
+		})
+
+		Context("when the destination cannot be reached", func() {
+			It("returns an error without marking the builds as drained", func() {
+				testDrainer := syslog.NewDrainer("test", []string{"tcp://bad.address"}, []string{}, fakeBuildFactory, nil)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).To(HaveOccurred())
+
+				builds, _ := fakeBuildFactory.GetDrainableBuilds()
+				for _, build := range builds {
+					Expect(build.(*dbfakes.FakeBuild).SetDrainedCallCount()).To(BeZero())
+				}
+			})
+		})
+
+		Context("when draining to a JSON file", func() {
+			var dir string
+
+			BeforeEach(func() {
+				var err error
+				dir, err = ioutil.TempDir("", "drain")
+				Expect(err).NotTo(HaveOccurred())
+
+				fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakeStepsBuild(123)}, nil)
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(dir)
+			})
+
+			It("appends a JSON line for each event", func() {
+				path := filepath.Join(dir, "builds.log")
+
+				testDrainer := syslog.NewDrainer("test", []string{"file://" + path}, []string{}, fakeBuildFactory, nil)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				content, err := ioutil.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred())
+
+				lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+				Expect(lines).To(HaveLen(5))
+
+				var msg syslog.Message
+				err = json.Unmarshal([]byte(lines[3]), &msg)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(msg).To(Equal(syslog.Message{
+					Time:      msg.Time,
+					Hostname:  "test",
+					Event:     "finish-task",
+					Team:      "some-team",
+					Pipeline:  "some-pipeline",
+					Job:       "some-job",
+					BuildID:   123,
+					BuildName: "42",
+					Origin:    "some-task-id",
+					Step:      "some-task",
+					Status:    "failed",
+					Payload:   "some-task failed",
+				}))
+				Expect(msg.Time.Unix()).To(Equal(int64(1533744534)))
+			})
+		})
+
+		Context("when draining to a JSON HTTP endpoint", func() {
+			var httpServer *ghttp.Server
+
+			BeforeEach(func() {
+				httpServer = ghttp.NewServer()
+			})
+
+			AfterEach(func() {
+				httpServer.Close()
+			})
+
+			It("posts the events of each build as JSON lines", func() {
+				httpServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/drain"),
+						ghttp.VerifyContentType("application/x-ndjson"),
+						func(w http.ResponseWriter, r *http.Request) {
+							body, err := ioutil.ReadAll(r.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(ContainSubstring(`"payload":"build 123 log"`))
+							Expect(string(body)).NotTo(ContainSubstring("345"))
+						},
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/drain"),
+						func(w http.ResponseWriter, r *http.Request) {
+							body, err := ioutil.ReadAll(r.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(ContainSubstring(`"payload":"build 345 log"`))
+						},
+					),
+				)
+
+				testDrainer := syslog.NewDrainer("test", []string{httpServer.URL() + "/drain"}, []string{}, fakeBuildFactory, nil)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(httpServer.ReceivedRequests()).To(HaveLen(2))
+			})
+
+			It("returns an error when the endpoint rejects the events", func() {
+				httpServer.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, "nope"))
+
+				testDrainer := syslog.NewDrainer("test", []string{httpServer.URL()}, []string{}, fakeBuildFactory, nil)
+				err := testDrainer.Run(context.TODO())
+				Expect(err).To(MatchError(ContainSubstring("nope")))
+			})
 		})
 
+		Context("when team drains are allowed", func() {
+			var (
+				fakeTeamFactory *dbfakes.FakeTeamFactory
+				fakeTeam        *dbfakes.FakeTeam
+				httpServer      *ghttp.Server
+			)
+
+			BeforeEach(func() {
+				httpServer = ghttp.NewServer()
+
+				fakeTeam = new(dbfakes.FakeTeam)
+				fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+				fakeTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+				build := newFakeStepsBuild(123)
+				otherBuild := newFakeStepsBuild(345)
+				fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{build, otherBuild}, nil)
+			})
+
+			AfterEach(func() {
+				httpServer.Close()
+			})
+
+			Context("when the team has drains", func() {
+				BeforeEach(func() {
+					fakeTeam.SyslogDrainsReturns([]atc.SyslogDrainConfig{
+						{URL: httpServer.URL() + "/team"},
+					})
+				})
+
+				It("drains the builds of the team to them", func() {
+					httpServer.AppendHandlers(
+						ghttp.VerifyRequest("POST", "/team"),
+						ghttp.VerifyRequest("POST", "/team"),
+					)
+
+					testDrainer := syslog.NewDrainer("test", nil, nil, fakeBuildFactory, fakeTeamFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(httpServer.ReceivedRequests()).To(HaveLen(2))
+
+					By("only looking up the team once")
+					Expect(fakeTeamFactory.FindTeamCallCount()).To(Equal(1))
+					Expect(fakeTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+				})
+
+				It("still drains the builds when the team's drain fails", func() {
+					httpServer.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, ""))
+
+					testDrainer := syslog.NewDrainer("test", nil, nil, fakeBuildFactory, fakeTeamFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					By("giving up on the drain after it has failed")
+					Expect(httpServer.ReceivedRequests()).To(HaveLen(1))
+
+					builds, _ := fakeBuildFactory.GetDrainableBuilds()
+					for _, build := range builds {
+						Expect(build.(*dbfakes.FakeBuild).SetDrainedCallCount()).To(Equal(1))
+					}
+				})
+			})
+
+			Context("when the team has no drains", func() {
+				It("marks the builds as drained without reading their events", func() {
+					testDrainer := syslog.NewDrainer("test", nil, nil, fakeBuildFactory, fakeTeamFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					builds, _ := fakeBuildFactory.GetDrainableBuilds()
+					for _, build := range builds {
+						fakeBuild := build.(*dbfakes.FakeBuild)
+						Expect(fakeBuild.EventsCallCount()).To(BeZero())
+						Expect(fakeBuild.SetDrainedCallCount()).To(Equal(1))
+					}
+				})
+			})
+		})
 	})
 })
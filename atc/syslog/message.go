@@ -0,0 +1,232 @@
+package syslog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// structuredDataID identifies the structured data element of the messages.
+// 32473 is the private enterprise number reserved for documentation, as
+// Concourse does not have one of its own.
+const structuredDataID = "concourse@32473"
+
+// Message is a build event as it is sent to a drain.
+type Message struct {
+	Time     time.Time `json:"time"`
+	Hostname string    `json:"hostname"`
+	Event    string    `json:"event"`
+
+	Team      string `json:"team"`
+	Pipeline  string `json:"pipeline,omitempty"`
+	Job       string `json:"job,omitempty"`
+	BuildID   int    `json:"build_id"`
+	BuildName string `json:"build_name"`
+
+	Origin string `json:"origin,omitempty"`
+	Step   string `json:"step,omitempty"`
+	Status string `json:"status,omitempty"`
+
+	Payload string `json:"payload,omitempty"`
+}
+
+// Tag identifies the origin of the message. It is the APP-NAME of syslog
+// messages, in the format drained builds have always had.
+func (msg Message) Tag() string {
+	return msg.Team + "/" + msg.Pipeline + "/" + msg.Job + "/" + msg.BuildName + "/" + msg.Origin
+}
+
+// StructuredData formats the fields of the message as an RFC5424
+// STRUCTURED-DATA element.
+func (msg Message) StructuredData() string {
+	params := []struct {
+		name  string
+		value string
+	}{
+		{"team", msg.Team},
+		{"pipeline", msg.Pipeline},
+		{"job", msg.Job},
+		{"build_id", strconv.Itoa(msg.BuildID)},
+		{"build_name", msg.BuildName},
+		{"step", msg.Step},
+		{"status", msg.Status},
+	}
+
+	var sd strings.Builder
+	sd.WriteString("[" + structuredDataID)
+	for _, param := range params {
+		if param.value == "" {
+			continue
+		}
+
+		fmt.Fprintf(&sd, ` %s="%s"`, param.name, sdEscaper.Replace(param.value))
+	}
+	sd.WriteString("]")
+
+	return sd.String()
+}
+
+// sdEscaper escapes the characters which must be escaped within the value of
+// an SD-PARAM.
+var sdEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// newMessage converts a build event to a message. Events which are not
+// drained, e.g. the initialization of steps, are skipped by returning false.
+func newMessage(build db.Build, stepNames map[event.OriginID]string, ev event.Envelope) (Message, bool, error) {
+	msg := Message{
+		Event: string(ev.Event),
+
+		Team:      build.TeamName(),
+		Pipeline:  build.PipelineName(),
+		Job:       build.JobName(),
+		BuildID:   build.ID(),
+		BuildName: build.Name(),
+	}
+
+	var (
+		origin event.Origin
+		ts     int64
+	)
+
+	switch ev.Event {
+	case event.EventTypeLog:
+		var log event.Log
+		err := json.Unmarshal(*ev.Data, &log)
+		if err != nil {
+			return Message{}, false, err
+		}
+
+		origin, ts = log.Origin, log.Time
+		msg.Payload = log.Payload
+
+	case event.EventTypeError:
+		var e event.Error
+		err := json.Unmarshal(*ev.Data, &e)
+		if err != nil {
+			return Message{}, false, err
+		}
+
+		origin, ts = e.Origin, e.Time
+		msg.Payload = e.Message
+
+	case event.EventTypeStatus:
+		var status event.Status
+		err := json.Unmarshal(*ev.Data, &status)
+		if err != nil {
+			return Message{}, false, err
+		}
+
+		ts = status.Time
+		msg.Status = string(status.Status)
+		msg.Payload = "build " + string(status.Status)
+
+	case event.EventTypeStartTask, event.EventTypeStartGet, event.EventTypeStartPut, event.EventTypeStart:
+		var start struct {
+			Origin event.Origin `json:"origin"`
+			Time   int64        `json:"time"`
+		}
+		err := json.Unmarshal(*ev.Data, &start)
+		if err != nil {
+			return Message{}, false, err
+		}
+
+		origin, ts = start.Origin, start.Time
+		msg.Status = "started"
+
+	case event.EventTypeFinishTask, event.EventTypeFinishGet, event.EventTypeFinishPut:
+		var finish struct {
+			Origin     event.Origin `json:"origin"`
+			Time       int64        `json:"time"`
+			ExitStatus int          `json:"exit_status"`
+		}
+		err := json.Unmarshal(*ev.Data, &finish)
+		if err != nil {
+			return Message{}, false, err
+		}
+
+		origin, ts = finish.Origin, finish.Time
+		msg.Status = stepStatus(finish.ExitStatus == 0)
+
+	case event.EventTypeFinish:
+		var finish event.Finish
+		err := json.Unmarshal(*ev.Data, &finish)
+		if err != nil {
+			return Message{}, false, err
+		}
+
+		origin, ts = finish.Origin, finish.Time
+		msg.Status = stepStatus(finish.Succeeded)
+
+	default:
+		return Message{}, false, nil
+	}
+
+	msg.Time = time.Unix(ts, 0)
+	msg.Origin = string(origin.ID)
+	msg.Step = stepNames[origin.ID]
+
+	if msg.Payload == "" && msg.Status != "" {
+		step := msg.Step
+		if step == "" {
+			step = "step"
+		}
+
+		msg.Payload = step + " " + msg.Status
+	}
+
+	return msg, true, nil
+}
+
+func stepStatus(succeeded bool) string {
+	if succeeded {
+		return "succeeded"
+	}
+
+	return "failed"
+}
+
+// stepNames maps the IDs of the steps in a build's public plan to their
+// names.
+func stepNames(plan *json.RawMessage) map[event.OriginID]string {
+	names := map[event.OriginID]string{}
+	if plan == nil {
+		return names
+	}
+
+	var decoded interface{}
+	err := json.Unmarshal(*plan, &decoded)
+	if err != nil {
+		return names
+	}
+
+	collectStepNames(decoded, names)
+
+	return names
+}
+
+func collectStepNames(node interface{}, names map[event.OriginID]string) {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		id, hasID := n["id"].(string)
+
+		for _, value := range n {
+			if step, ok := value.(map[string]interface{}); ok && hasID {
+				if name, ok := step["name"].(string); ok {
+					names[event.OriginID(id)] = name
+				}
+			}
+
+			collectStepNames(value, names)
+		}
+
+	case []interface{}:
+		for _, value := range n {
+			collectStepNames(value, names)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package syslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+//go:generate counterfeiter . Sink
+
+// Sink is a destination which build events are drained to.
+type Sink interface {
+	Send(Message) error
+
+	// Flush is called once all of the events of a build have been sent, before
+	// the build is marked as drained.
+	Flush() error
+
+	Close() error
+}
+
+// Open connects to the destination given as a URL. udp://, tcp:// and tls://
+// URLs receive RFC5424 syslog messages, while http:// and https:// URLs and
+// file:// paths receive JSON lines.
+func Open(destination string, caCerts []string) (Sink, error) {
+	if strings.HasPrefix(destination, "file://") {
+		return openJSONFile(strings.TrimPrefix(destination, "file://"))
+	}
+
+	u, err := url.Parse(destination)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
+	case "udp", "tcp", "tls":
+		return Dial(u.Scheme, u.Host, caCerts)
+	case "http", "https":
+		return &jsonHTTPSink{
+			url:    destination,
+			client: &http.Client{Timeout: 30 * time.Second},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported drain destination scheme %q", u.Scheme)
+	}
+}
+
+// ValidateTeamDrain validates a destination configured by a team. Teams may
+// only drain to remote destinations.
+func ValidateTeamDrain(destination string) error {
+	u, err := url.Parse(destination)
+	if err != nil {
+		return err
+	}
+
+	switch u.Scheme {
+	case "udp", "tcp", "tls", "http", "https":
+	default:
+		return fmt.Errorf("unsupported scheme %q: must be one of udp, tcp, tls, http or https", u.Scheme)
+	}
+
+	if u.Host == "" {
+		return errors.New("missing host")
+	}
+
+	return nil
+}
+
+type jsonFileSink struct {
+	file *os.File
+}
+
+func openJSONFile(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonFileSink{file: file}, nil
+}
+
+func (s *jsonFileSink) Send(msg Message) error {
+	return json.NewEncoder(s.file).Encode(msg)
+}
+
+func (s *jsonFileSink) Flush() error {
+	return s.file.Sync()
+}
+
+func (s *jsonFileSink) Close() error {
+	return s.file.Close()
+}
+
+// jsonHTTPSink posts the events of each build as one request of JSON lines.
+type jsonHTTPSink struct {
+	url    string
+	client *http.Client
+
+	buf bytes.Buffer
+}
+
+func (s *jsonHTTPSink) Send(msg Message) error {
+	return json.NewEncoder(&s.buf).Encode(msg)
+}
+
+func (s *jsonHTTPSink) Flush() error {
+	if s.buf.Len() == 0 {
+		return nil
+	}
+
+	defer s.buf.Reset()
+
+	resp, err := s.client.Post(s.url, "application/x-ndjson", bytes.NewReader(s.buf.Bytes()))
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("drain responded with %s: %s", resp.Status, body)
+	}
+
+	return nil
+}
+
+func (s *jsonHTTPSink) Close() error {
+	return nil
+}
@@ -66,13 +66,28 @@ func Dial(transport, address string, caCerts []string) (*Syslog, error) {
 }
 
 func (s *Syslog) Write(hostname, tag string, ts time.Time, msg string) error {
+	return s.write(getSyslogFormatter(hostname, ts, tag, "-", "-"), msg)
+}
+
+// Send writes the message with its fields as RFC5424 structured data and its
+// event as the MSGID.
+func (s *Syslog) Send(msg Message) error {
+	return s.write(getSyslogFormatter(msg.Hostname, msg.Time, msg.Tag(), msg.Event, msg.StructuredData()), msg.Payload)
+}
+
+// Flush does nothing, as every message is written as soon as it is sent.
+func (s *Syslog) Flush() error {
+	return nil
+}
+
+func (s *Syslog) write(formatter sl.Formatter, msg string) error {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	if s.writer == nil {
 		return errors.New("connection already closed")
 	}
 
-	s.writer.SetFormatter(getSyslogFormatter(hostname, ts, tag))
+	s.writer.SetFormatter(formatter)
 	_, err := s.writer.Write([]byte(msg))
 	return err
 }
@@ -93,16 +108,16 @@ func (s *Syslog) Close() error {
 	return err
 }
 
-// generate custom formatter based on hostname and tag
-func getSyslogFormatter(hostname string, ts time.Time, tag string) sl.Formatter {
+// generate custom formatter based on hostname, tag, msgID and structured data
+func getSyslogFormatter(hostname string, ts time.Time, tag string, msgID string, structuredData string) sl.Formatter {
 	return func(priority sl.Priority, _, _, content string) string {
 		// strip whitespaces
 		s := strings.Replace(content, "\n", " ", -1)
 		s = strings.Replace(s, "\r", " ", -1)
 		s = strings.Replace(s, "\x00", " ", -1)
 
-		msg := fmt.Sprintf("<%d>1 %s %s %s - - - %s\n",
-			priority, ts.Format(rfc5424time), hostname, tag, s)
+		msg := fmt.Sprintf("<%d>1 %s %s %s - %s %s %s\n",
+			priority, ts.Format(rfc5424time), hostname, tag, msgID, structuredData, s)
 		return msg
 	}
 }
@@ -83,7 +83,7 @@ func (server *testServer) ServeTCP() {
 
 		time.Sleep(100 * time.Millisecond)
 
-		buf := make([]byte, 1024)
+		buf := make([]byte, 65536)
 		n, err := conn.Read(buf)
 
 		// expect bad certificate from 'bad cert' test
@@ -112,6 +112,37 @@ var _ = Describe("Syslog", func() {
 				err = sl.Close()
 				Expect(err).NotTo(HaveOccurred())
 			}, 0.2)
+
+			It("sends messages with structured data", func() {
+				sl, err := syslog.Dial("tcp", server.Addr, []string{})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = sl.Send(syslog.Message{
+					Time:      time.Unix(1533744538, 0).UTC(),
+					Hostname:  hostname,
+					Event:     "finish-task",
+					Team:      "main",
+					Pipeline:  "some-pipeline",
+					Job:       "some-job",
+					BuildID:   123,
+					BuildName: "42",
+					Origin:    "some-origin",
+					Step:      `some "quoted" step`,
+					Status:    "succeeded",
+					Payload:   "some-step succeeded",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				got := <-server.Messages
+				Expect(got).To(ContainSubstring(
+					`<14>1 2018-08-08T16:08:58Z hostname main/some-pipeline/some-job/42/some-origin - finish-task ` +
+						`[concourse@32473 team="main" pipeline="some-pipeline" job="some-job" build_id="123" build_name="42" step="some \"quoted\" step" status="succeeded"] ` +
+						`some-step succeeded`,
+				))
+
+				err = sl.Close()
+				Expect(err).NotTo(HaveOccurred())
+			}, 0.2)
 		})
 
 		Context("after the connection is closed", func() {
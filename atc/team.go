@@ -4,6 +4,45 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	// PipelineAuth binds roles in individual pipelines of the team, granting
+	// them in addition to any role the user has in the team as a whole.
+	//
+	// When setting a team, nil leaves its bindings as they are, while an empty
+	// map removes them.
+	PipelineAuth *PipelineAuth `json:"pipeline_auth,omitempty"`
+
+	// DefaultBuildPriority is the priority of builds of the team's jobs which
+	// do not configure their own.
+	DefaultBuildPriority int `json:"default_build_priority,omitempty"`
+
+	// MaxRunningBuilds limits the number of builds of the team which may run
+	// at the same time. Zero means unlimited.
+	MaxRunningBuilds int `json:"max_running_builds,omitempty"`
+
+	// VarSources are shared by all of the team's pipelines. A pipeline's own
+	// var source takes precedence over the team's var source of the same name.
+	//
+	// When setting a team, nil leaves its var sources as they are, while an
+	// empty list removes them.
+	VarSources *VarSourceConfigs `json:"var_sources,omitempty"`
+
+	// SyslogDrains receive the events of the team's builds, in addition to
+	// any drain configured by the operator.
+	//
+	// When setting a team, nil leaves its drains as they are, while an empty
+	// list removes them.
+	SyslogDrains *[]SyslogDrainConfig `json:"syslog_drains,omitempty"`
+}
+
+type SyslogDrainConfig struct {
+	// URL of the drain. udp://, tcp:// and tls:// URLs receive RFC5424 syslog
+	// messages, while http:// and https:// URLs receive JSON lines.
+	URL string `json:"url"`
 }
 
 type TeamAuth map[string]map[string][]string
+
+// PipelineAuth maps the name of a pipeline to the roles bound in it, each in
+// the same form as TeamAuth.
+type PipelineAuth map[string]TeamAuth
@@ -0,0 +1,29 @@
+package atc
+
+// VarTrace reports where a pipeline's var is looked up and which lookup its
+// value is taken from.
+type VarTrace struct {
+	Name string `json:"name"`
+
+	// Local is set for vars of the local var source ("."), which are only set
+	// within a build, e.g. by a load_var step, and so cannot be traced.
+	Local bool `json:"local,omitempty"`
+
+	Lookups []VarLookup `json:"lookups"`
+
+	// Value is the value of the chosen lookup. It is only set when the value
+	// was requested to be revealed by a team owner.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// VarLookup is a single path a var is looked up at.
+type VarLookup struct {
+	// Source is the name of the pipeline's or team's var source the lookup
+	// is made in, or empty for the cluster's credential manager.
+	Source string `json:"source,omitempty"`
+
+	Path   string `json:"path"`
+	Found  bool   `json:"found"`
+	Chosen bool   `json:"chosen"`
+	Error  string `json:"error,omitempty"`
+}
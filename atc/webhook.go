@@ -0,0 +1,26 @@
+package atc
+
+// Providers whose signed push events can be received by a team's webhooks.
+const (
+	WebhookProviderGitHub    = "github"
+	WebhookProviderGitLab    = "gitlab"
+	WebhookProviderBitbucket = "bitbucket"
+)
+
+var WebhookProviders = []string{
+	WebhookProviderGitHub,
+	WebhookProviderGitLab,
+	WebhookProviderBitbucket,
+}
+
+type SetTeamWebhookRequestBody struct {
+	Secret string `json:"secret"`
+}
+
+// WebhookCheckedResource is a resource which was checked in response to a
+// push event received by a team's webhook.
+type WebhookCheckedResource struct {
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	ResourceName         string       `json:"resource_name"`
+}
@@ -20,6 +20,12 @@ type Worker struct {
 	ActiveVolumes    int `json:"active_volumes"`
 	ActiveTasks      int `json:"active_tasks"`
 
+	// CPULoad is the CPU time used by the worker's containers per second since
+	// the previous heartbeat, i.e. the number of cores kept busy. MemoryLoad is
+	// the fraction of the worker's memory used by its containers.
+	CPULoad    float64 `json:"cpu_load,omitempty"`
+	MemoryLoad float64 `json:"memory_load,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
 	Platform  string   `json:"platform"`
@@ -4,10 +4,13 @@ import (
 	"archive/tar"
 	"context"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/DataDog/zstd"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/tracing"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -53,18 +56,56 @@ func (source *artifactSource) StreamTo(
 	logger lager.Logger,
 	destination ArtifactDestination,
 ) error {
+	ctx, span := tracing.StartSpan(ctx, "artifactSource.StreamTo", tracing.Attrs{
+		"volume": source.volume.Handle(),
+		"worker": source.volume.WorkerName(),
+	})
+
+	started := time.Now()
+
+	bytes, err := source.streamTo(ctx, destination)
+	tracing.End(span, err)
+
+	if err == nil {
+		metric.VolumeStreamed{
+			BuildLabels: metric.BuildLabelsFromContext(ctx),
+			Bytes:       bytes,
+			Duration:    time.Since(started),
+			TraceID:     tracing.TraceID(ctx),
+		}.Emit(logger)
+	}
+
+	return err
+}
+
+func (source *artifactSource) streamTo(ctx context.Context, destination ArtifactDestination) (int64, error) {
 	out, err := source.volume.StreamOut(ctx, ".")
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	defer out.Close()
 
-	err = destination.StreamIn(ctx, ".", out)
+	counter := &countingReader{reader: out}
+
+	err = destination.StreamIn(ctx, ".", counter)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return counter.bytes, nil
+}
+
+// countingReader counts the bytes read through it, i.e. the size of the
+// compressed stream rather than of the volume's contents.
+type countingReader struct {
+	reader io.Reader
+	bytes  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.bytes += int64(n)
+	return n, err
 }
 
 // TODO: figure out if we want logging before and after streams, I remove logger from private methods
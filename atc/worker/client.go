@@ -18,6 +18,7 @@ import (
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/hashicorp/go-multierror"
+	uuid "github.com/nu7hatch/gouuid"
 )
 
 const taskProcessID = "task"
@@ -80,16 +81,18 @@ type Client interface {
 	) (GetResult, error)
 }
 
-func NewClient(pool Pool, provider WorkerProvider) *client {
+func NewClient(pool Pool, provider WorkerProvider, taskQueue db.WorkerTaskQueue) *client {
 	return &client{
-		pool:     pool,
-		provider: provider,
+		pool:      pool,
+		provider:  provider,
+		taskQueue: taskQueue,
 	}
 }
 
 type client struct {
-	pool     Pool
-	provider WorkerProvider
+	pool      Pool
+	provider  WorkerProvider
+	taskQueue db.WorkerTaskQueue
 }
 
 type TaskResult struct {
@@ -106,6 +109,10 @@ type GetResult struct {
 	ExitStatus    int
 	VersionResult runtime.VersionResult
 	GetArtifact   runtime.GetArtifact
+
+	// FromCache is true when the resource was found in an existing resource
+	// cache on the worker, rather than fetched by running the resource.
+	FromCache bool
 }
 
 type ImageFetcherSpec struct {
@@ -378,8 +385,23 @@ func (client *client) chooseTaskWorker(
 		elapsed           time.Duration
 		err               error
 		existingContainer bool
+		queueID           string
 	)
 
+	taskSpec := db.WorkerTaskSpec{
+		Platform: workerSpec.Platform,
+		Tags:     workerSpec.Tags,
+	}
+
+	defer func() {
+		if queueID != "" {
+			err := client.taskQueue.Leave(queueID)
+			if err != nil {
+				logger.Error("failed-to-leave-task-queue", err)
+			}
+		}
+	}()
+
 	for {
 		if strategy.ModifiesActiveTasks() {
 			var acquired bool
@@ -428,12 +450,37 @@ func (client *client) chooseTaskWorker(
 			default:
 			}
 
+			// give way to the tasks of higher-priority builds which are also
+			// waiting for a worker
+			if chosenWorker != nil && !existingContainer && containerSpec.BuildID != 0 {
+				higherPriorityWaiting, err := client.taskQueue.HigherPriorityWaiting(containerSpec.BuildID, &taskSpec)
+				if err != nil {
+					release_err := activeTasksLock.Release()
+					if release_err != nil {
+						err = multierror.Append(err, release_err)
+					}
+					return nil, err
+				}
+
+				if higherPriorityWaiting {
+					logger.Debug("waiting-for-higher-priority-tasks")
+					chosenWorker = nil
+				}
+			}
+
 			if chosenWorker == nil {
 				err = activeTasksLock.Release()
 				if err != nil {
 					return nil, err
 				}
 
+				if containerSpec.BuildID != 0 {
+					queueID, err = client.waitInTaskQueue(queueID, containerSpec.BuildID, taskSpec)
+					if err != nil {
+						logger.Error("failed-to-wait-in-task-queue", err)
+					}
+				}
+
 				if elapsed%time.Duration(time.Minute) == 0 { // Every minute report that it is still waiting
 					_, err := outputWriter.Write([]byte("All workers are busy at the moment, please stand-by.\n"))
 					if err != nil {
@@ -472,6 +519,21 @@ func (client *client) chooseTaskWorker(
 	return chosenWorker, nil
 }
 
+// waitInTaskQueue enters the task into the worker task queue, or refreshes
+// its entry if it is already waiting.
+func (client *client) waitInTaskQueue(queueID string, buildID int, spec db.WorkerTaskSpec) (string, error) {
+	if queueID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return "", err
+		}
+
+		queueID = id.String()
+	}
+
+	return queueID, client.taskQueue.Wait(queueID, buildID, spec)
+}
+
 func decreaseActiveTasks(logger lager.Logger, w Worker) {
 	err := w.DecreaseActiveTasks()
 	if err != nil {
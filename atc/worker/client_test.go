@@ -32,6 +32,7 @@ var _ = Describe("Client", func() {
 		logger          *lagertest.TestLogger
 		fakePool        *workerfakes.FakePool
 		fakeProvider    *workerfakes.FakeWorkerProvider
+		fakeTaskQueue   *dbfakes.FakeWorkerTaskQueue
 		client          worker.Client
 		fakeLock        *lockfakes.FakeLock
 		fakeLockFactory *lockfakes.FakeLockFactory
@@ -41,8 +42,9 @@ var _ = Describe("Client", func() {
 		logger = lagertest.NewTestLogger("test")
 		fakePool = new(workerfakes.FakePool)
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
+		fakeTaskQueue = new(dbfakes.FakeWorkerTaskQueue)
 
-		client = worker.NewClient(fakePool, fakeProvider)
+		client = worker.NewClient(fakePool, fakeProvider, fakeTaskQueue)
 	})
 
 	Describe("FindContainer", func() {
@@ -528,6 +530,74 @@ var _ = Describe("Client", func() {
 							Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(0))
 						})
 					})
+
+					Context("when the task belongs to a build", func() {
+						BeforeEach(func() {
+							fakeContainerSpec.BuildID = 1234
+							fakeWorkerSpec.Platform = "some-platform"
+							fakeWorkerSpec.Tags = []string{"some-tag"}
+						})
+
+						It("checks for tasks of higher-priority builds waiting for a worker it could use", func() {
+							Expect(fakeTaskQueue.HigherPriorityWaitingCallCount()).To(Equal(1))
+							buildID, spec := fakeTaskQueue.HigherPriorityWaitingArgsForCall(0)
+							Expect(buildID).To(Equal(1234))
+							Expect(spec).To(Equal(&db.WorkerTaskSpec{
+								Platform: "some-platform",
+								Tags:     []string{"some-tag"},
+							}))
+						})
+
+						It("does not wait in the task queue", func() {
+							Expect(fakeTaskQueue.WaitCallCount()).To(BeZero())
+							Expect(fakeTaskQueue.LeaveCallCount()).To(BeZero())
+						})
+
+						Context("when a task of a higher-priority build is waiting", func() {
+							var stdout *gbytes.Buffer
+
+							BeforeEach(func() {
+								stdout = gbytes.NewBuffer()
+								fakeTaskProcessSpec.StdoutWriter = stdout
+
+								fakeTaskQueue.HigherPriorityWaitingStub = func(int, *db.WorkerTaskSpec) (bool, error) {
+									return fakeTaskQueue.HigherPriorityWaitingCallCount() == 1, nil
+								}
+							})
+
+							It("waits in the task queue before taking the worker", func() {
+								Expect(err).ToNot(HaveOccurred())
+								Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(2))
+
+								Expect(fakeTaskQueue.WaitCallCount()).To(Equal(1))
+								queueID, buildID, spec := fakeTaskQueue.WaitArgsForCall(0)
+								Expect(buildID).To(Equal(1234))
+								Expect(spec).To(Equal(db.WorkerTaskSpec{
+									Platform: "some-platform",
+									Tags:     []string{"some-tag"},
+								}))
+
+								Expect(fakeTaskQueue.LeaveCallCount()).To(Equal(1))
+								Expect(fakeTaskQueue.LeaveArgsForCall(0)).To(Equal(queueID))
+
+								Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(1))
+								Expect(fakeLock.ReleaseCallCount()).To(Equal(fakeLockFactory.AcquireCallCount()))
+
+								Expect(stdout).To(gbytes.Say("All workers are busy at the moment, please stand-by."))
+							})
+						})
+
+						Context("when checking the task queue fails", func() {
+							BeforeEach(func() {
+								fakeTaskQueue.HigherPriorityWaitingReturns(false, errors.New("nope"))
+							})
+
+							It("returns the error and releases the lock", func() {
+								Expect(err).To(HaveOccurred())
+								Expect(fakeLock.ReleaseCallCount()).To(Equal(fakeLockFactory.AcquireCallCount()))
+							})
+						})
+					})
 				})
 
 				Context("when the task is aborted waiting for an available worker", func() {
@@ -23,8 +23,13 @@ type ContainerSpec struct {
 	Tags      []string
 	TeamID    int
 	ImageSpec ImageSpec
-	Env       []string
-	Type      db.ContainerType
+
+	// The build the container runs a step of, if any. Used for placing the
+	// container on the same worker as the build's other steps.
+	BuildID int
+
+	Env  []string
+	Type db.ContainerType
 
 	// Working directory for processes run in the container.
 	Dir string
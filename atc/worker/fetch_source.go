@@ -130,6 +130,7 @@ func (s *fetchSource) Find() (GetResult, Volume, bool, error) {
 				Metadata: atcMetaData,
 			},
 			GetArtifact: runtime.GetArtifact{VolumeHandle: volume.Handle()},
+			FromCache:   true,
 		},
 		volume, true, nil
 }
@@ -149,6 +149,7 @@ var _ = Describe("FetchSource", func() {
 					ExitStatus:    0,
 					VersionResult: runtime.VersionResult{Metadata: expectedMetadata},
 					GetArtifact:   runtime.GetArtifact{fakeVolume.Handle()},
+					FromCache:     true,
 				}
 			})
 
@@ -200,6 +201,7 @@ var _ = Describe("FetchSource", func() {
 					ExitStatus:    0,
 					VersionResult: runtime.VersionResult{Metadata: expectedMetadata},
 					GetArtifact:   runtime.GetArtifact{fakeVolume.Handle()},
+					FromCache:     true,
 				}
 			})
 
@@ -7,14 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/DataDog/zstd"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/tracing"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -113,6 +116,34 @@ func (i *imageResourceFetcher) Fetch(
 	logger lager.Logger,
 	container db.CreatingContainer,
 	privileged bool,
+) (worker.Volume, io.ReadCloser, atc.Version, error) {
+	ctx, span := tracing.StartSpan(ctx, "imageResourceFetcher.Fetch", tracing.Attrs{
+		"type":      i.imageResource.Type,
+		"worker":    i.worker.Name(),
+		"container": container.Handle(),
+	})
+
+	started := time.Now()
+
+	volume, reader, version, err := i.fetch(ctx, logger, container)
+	tracing.End(span, err)
+
+	if err == nil {
+		metric.ImageFetched{
+			BuildLabels:  metric.BuildLabelsFromContext(ctx),
+			ResourceType: i.imageResource.Type,
+			Duration:     time.Since(started),
+			TraceID:      tracing.TraceID(ctx),
+		}.Emit(logger)
+	}
+
+	return volume, reader, version, err
+}
+
+func (i *imageResourceFetcher) fetch(
+	ctx context.Context,
+	logger lager.Logger,
+	container db.CreatingContainer,
 ) (worker.Volume, io.ReadCloser, atc.Version, error) {
 	version := i.version
 	if version == nil {
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 )
 
 type ContainerPlacementStrategy interface {
@@ -15,17 +16,114 @@ type ContainerPlacementStrategy interface {
 	ModifiesActiveTasks() bool
 }
 
+// A ContainerPlacementStrategyChainNode narrows down the workers a container
+// may be placed on, leaving the rest of the choice to the strategies after it
+// in a ChainedPlacementStrategy.
+type ContainerPlacementStrategyChainNode interface {
+	Name() string
+	Candidates(lager.Logger, []Worker, ContainerSpec) ([]Worker, error)
+	ModifiesActiveTasks() bool
+}
+
+// ChainedPlacementStrategy applies its strategies in order, each narrowing
+// down the candidates left by the previous one, and picks a random worker out
+// of the candidates that remain.
+type ChainedPlacementStrategy struct {
+	nodes []ContainerPlacementStrategyChainNode
+	rand  *rand.Rand
+}
+
+func NewChainedPlacementStrategy(nodes ...ContainerPlacementStrategyChainNode) *ChainedPlacementStrategy {
+	return &ChainedPlacementStrategy{
+		nodes: nodes,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (strategy *ChainedPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	logger = logger.Session("choose-worker", lager.Data{
+		"team-id":  spec.TeamID,
+		"build-id": spec.BuildID,
+		"type":     spec.Type,
+	})
+
+	decidedBy := "random"
+	for _, node := range strategy.nodes {
+		candidates, err := node.Candidates(logger, workers, spec)
+		if err != nil {
+			logger.Error("failed-to-narrow-candidates", err, lager.Data{"strategy": node.Name()})
+			return nil, err
+		}
+
+		logger.Debug("narrowed-candidates", lager.Data{
+			"strategy":   node.Name(),
+			"candidates": workerNames(candidates),
+		})
+
+		if len(candidates) == 0 {
+			logger.Info("no-candidates", lager.Data{"strategy": node.Name()})
+			return nil, nil
+		}
+
+		if len(candidates) < len(workers) {
+			decidedBy = node.Name()
+		}
+
+		workers = candidates
+	}
+
+	chosenWorker := chooseRandom(strategy.rand, workers)
+
+	logger.Info("chose-worker", lager.Data{
+		"worker":     chosenWorker.Name(),
+		"decided-by": decidedBy,
+		"candidates": workerNames(workers),
+	})
+
+	metric.ContainerPlacement{
+		WorkerName:    chosenWorker.Name(),
+		ContainerType: string(spec.Type),
+		DecidedBy:     decidedBy,
+		Candidates:    len(workers),
+	}.Emit(logger)
+
+	return chosenWorker, nil
+}
+
+func (strategy *ChainedPlacementStrategy) ModifiesActiveTasks() bool {
+	for _, node := range strategy.nodes {
+		if node.ModifiesActiveTasks() {
+			return true
+		}
+	}
+
+	return false
+}
+
 type VolumeLocalityPlacementStrategy struct {
 	rand *rand.Rand
 }
 
-func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
+func NewVolumeLocalityPlacementStrategy() *VolumeLocalityPlacementStrategy {
 	return &VolumeLocalityPlacementStrategy{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
+func (strategy *VolumeLocalityPlacementStrategy) Name() string {
+	return "volume-locality"
+}
+
 func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	highestLocalityWorkers, err := strategy.Candidates(logger, workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return chooseRandom(strategy.rand, highestLocalityWorkers), nil
+}
+
+func (strategy *VolumeLocalityPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByCount := map[int][]Worker{}
 	var highestCount int
 	for _, w := range workers {
@@ -49,9 +147,7 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, wor
 		}
 	}
 
-	highestLocalityWorkers := workersByCount[highestCount]
-
-	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+	return workersByCount[highestCount], nil
 }
 
 func (strategy *VolumeLocalityPlacementStrategy) ModifiesActiveTasks() bool {
@@ -62,13 +158,26 @@ type FewestBuildContainersPlacementStrategy struct {
 	rand *rand.Rand
 }
 
-func NewFewestBuildContainersPlacementStrategy() ContainerPlacementStrategy {
+func NewFewestBuildContainersPlacementStrategy() *FewestBuildContainersPlacementStrategy {
 	return &FewestBuildContainersPlacementStrategy{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
+func (strategy *FewestBuildContainersPlacementStrategy) Name() string {
+	return "fewest-build-containers"
+}
+
 func (strategy *FewestBuildContainersPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	leastBusyWorkers, err := strategy.Candidates(logger, workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return chooseRandom(strategy.rand, leastBusyWorkers), nil
+}
+
+func (strategy *FewestBuildContainersPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByWork := map[int][]Worker{}
 	var minWork int
 
@@ -80,8 +189,7 @@ func (strategy *FewestBuildContainersPlacementStrategy) Choose(logger lager.Logg
 		}
 	}
 
-	leastBusyWorkers := workersByWork[minWork]
-	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+	return workersByWork[minWork], nil
 }
 
 func (strategy *FewestBuildContainersPlacementStrategy) ModifiesActiveTasks() bool {
@@ -93,14 +201,27 @@ type LimitActiveTasksPlacementStrategy struct {
 	maxTasks int
 }
 
-func NewLimitActiveTasksPlacementStrategy(maxTasks int) ContainerPlacementStrategy {
+func NewLimitActiveTasksPlacementStrategy(maxTasks int) *LimitActiveTasksPlacementStrategy {
 	return &LimitActiveTasksPlacementStrategy{
 		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
 		maxTasks: maxTasks,
 	}
 }
 
+func (strategy *LimitActiveTasksPlacementStrategy) Name() string {
+	return "limit-active-tasks"
+}
+
 func (strategy *LimitActiveTasksPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	leastBusyWorkers, err := strategy.Candidates(logger, workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return chooseRandom(strategy.rand, leastBusyWorkers), nil
+}
+
+func (strategy *LimitActiveTasksPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByWork := map[int][]Worker{}
 	minActiveTasks := -1
 
@@ -123,31 +244,200 @@ func (strategy *LimitActiveTasksPlacementStrategy) Choose(logger lager.Logger, w
 		}
 	}
 
-	leastBusyWorkers := workersByWork[minActiveTasks]
-	if len(leastBusyWorkers) < 1 {
-		return nil, nil
-	}
-	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+	return workersByWork[minActiveTasks], nil
 }
 
 func (strategy *LimitActiveTasksPlacementStrategy) ModifiesActiveTasks() bool {
 	return true
 }
 
+// LeastCPULoadPlacementStrategy places containers on the workers whose
+// containers used the least CPU as of their last heartbeat.
+type LeastCPULoadPlacementStrategy struct {
+	rand *rand.Rand
+}
+
+func NewLeastCPULoadPlacementStrategy() *LeastCPULoadPlacementStrategy {
+	return &LeastCPULoadPlacementStrategy{
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (strategy *LeastCPULoadPlacementStrategy) Name() string {
+	return "least-cpu-load"
+}
+
+func (strategy *LeastCPULoadPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	leastLoadedWorkers, err := strategy.Candidates(logger, workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return chooseRandom(strategy.rand, leastLoadedWorkers), nil
+}
+
+func (strategy *LeastCPULoadPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	return leastLoaded(workers, Worker.CPULoad), nil
+}
+
+func (strategy *LeastCPULoadPlacementStrategy) ModifiesActiveTasks() bool {
+	return false
+}
+
+// LeastMemoryLoadPlacementStrategy places containers on the workers whose
+// containers used the smallest fraction of their memory as of their last
+// heartbeat.
+type LeastMemoryLoadPlacementStrategy struct {
+	rand *rand.Rand
+}
+
+func NewLeastMemoryLoadPlacementStrategy() *LeastMemoryLoadPlacementStrategy {
+	return &LeastMemoryLoadPlacementStrategy{
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (strategy *LeastMemoryLoadPlacementStrategy) Name() string {
+	return "least-memory-load"
+}
+
+func (strategy *LeastMemoryLoadPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	leastLoadedWorkers, err := strategy.Candidates(logger, workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return chooseRandom(strategy.rand, leastLoadedWorkers), nil
+}
+
+func (strategy *LeastMemoryLoadPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	return leastLoaded(workers, Worker.MemoryLoad), nil
+}
+
+func (strategy *LeastMemoryLoadPlacementStrategy) ModifiesActiveTasks() bool {
+	return false
+}
+
+// BuildLocalityPlacementStrategy places containers on the workers that
+// already run containers for an earlier step of the same build. Containers
+// which are not for a build step, or whose build has no containers on any of
+// the workers yet, may be placed on any worker.
+type BuildLocalityPlacementStrategy struct {
+	rand          *rand.Rand
+	workerFactory db.WorkerFactory
+}
+
+func NewBuildLocalityPlacementStrategy(workerFactory db.WorkerFactory) *BuildLocalityPlacementStrategy {
+	return &BuildLocalityPlacementStrategy{
+		rand:          rand.New(rand.NewSource(time.Now().UnixNano())),
+		workerFactory: workerFactory,
+	}
+}
+
+func (strategy *BuildLocalityPlacementStrategy) Name() string {
+	return "build-locality"
+}
+
+func (strategy *BuildLocalityPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	buildWorkers, err := strategy.Candidates(logger, workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return chooseRandom(strategy.rand, buildWorkers), nil
+}
+
+func (strategy *BuildLocalityPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	if spec.BuildID == 0 {
+		return workers, nil
+	}
+
+	names, err := strategy.workerFactory.WorkerNamesForBuild(spec.BuildID)
+	if err != nil {
+		return nil, err
+	}
+
+	usedByBuild := map[string]bool{}
+	for _, name := range names {
+		usedByBuild[name] = true
+	}
+
+	var buildWorkers []Worker
+	for _, w := range workers {
+		if usedByBuild[w.Name()] {
+			buildWorkers = append(buildWorkers, w)
+		}
+	}
+
+	if len(buildWorkers) == 0 {
+		return workers, nil
+	}
+
+	return buildWorkers, nil
+}
+
+func (strategy *BuildLocalityPlacementStrategy) ModifiesActiveTasks() bool {
+	return false
+}
+
 type RandomPlacementStrategy struct {
 	rand *rand.Rand
 }
 
-func NewRandomPlacementStrategy() ContainerPlacementStrategy {
+func NewRandomPlacementStrategy() *RandomPlacementStrategy {
 	return &RandomPlacementStrategy{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
+func (strategy *RandomPlacementStrategy) Name() string {
+	return "random"
+}
+
 func (strategy *RandomPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
-	return workers[strategy.rand.Intn(len(workers))], nil
+	return chooseRandom(strategy.rand, workers), nil
+}
+
+// Candidates leaves all of the workers as candidates, as a chain always ends
+// with a random choice.
+func (strategy *RandomPlacementStrategy) Candidates(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	return workers, nil
 }
 
 func (strategy *RandomPlacementStrategy) ModifiesActiveTasks() bool {
 	return false
 }
+
+func leastLoaded(workers []Worker, load func(Worker) float64) []Worker {
+	var leastLoadedWorkers []Worker
+	var minLoad float64
+
+	for i, w := range workers {
+		workerLoad := load(w)
+		if i == 0 || workerLoad < minLoad {
+			minLoad = workerLoad
+			leastLoadedWorkers = []Worker{w}
+		} else if workerLoad == minLoad {
+			leastLoadedWorkers = append(leastLoadedWorkers, w)
+		}
+	}
+
+	return leastLoadedWorkers
+}
+
+func chooseRandom(r *rand.Rand, workers []Worker) Worker {
+	if len(workers) == 0 {
+		return nil
+	}
+
+	return workers[r.Intn(len(workers))]
+}
+
+func workerNames(workers []Worker) []string {
+	names := make([]string, len(workers))
+	for i, w := range workers {
+		names[i] = w.Name()
+	}
+
+	return names
+}
@@ -1,17 +1,22 @@
 package worker_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 //go:generate counterfeiter . ContainerPlacementStrategy
+//go:generate counterfeiter . ContainerPlacementStrategyChainNode
 
 var (
 	strategy ContainerPlacementStrategy
@@ -450,3 +455,261 @@ var _ = Describe("LimitActiveTasksPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("LeastCPULoadPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("least-cpu-load-placement-test")
+			strategy = NewLeastCPULoadPlacementStrategy()
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			compatibleWorker1.CPULoadReturns(2.5)
+			compatibleWorker2.CPULoadReturns(0.5)
+			compatibleWorker3.CPULoadReturns(1)
+
+			spec = ContainerSpec{TeamID: 4567}
+		})
+
+		It("picks the worker with the least CPU load", func() {
+			Consistently(func() Worker {
+				chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				return chosenWorker
+			}).Should(Equal(compatibleWorker2))
+		})
+
+		Context("when multiple workers have the same CPU load", func() {
+			BeforeEach(func() {
+				compatibleWorker3.CPULoadReturns(0.5)
+			})
+
+			It("picks any of them", func() {
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Or(Equal(compatibleWorker2), Equal(compatibleWorker3)))
+			})
+		})
+	})
+})
+
+var _ = Describe("LeastMemoryLoadPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("least-memory-load-placement-test")
+			strategy = NewLeastMemoryLoadPlacementStrategy()
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2}
+
+			compatibleWorker1.MemoryLoadReturns(0.2)
+			compatibleWorker2.MemoryLoadReturns(0.8)
+
+			spec = ContainerSpec{TeamID: 4567}
+		})
+
+		It("picks the worker with the least memory load", func() {
+			Consistently(func() Worker {
+				chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				return chosenWorker
+			}).Should(Equal(compatibleWorker1))
+		})
+	})
+})
+
+var _ = Describe("BuildLocalityPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var (
+			fakeWorkerFactory *dbfakes.FakeWorkerFactory
+
+			compatibleWorker1 *workerfakes.FakeWorker
+			compatibleWorker2 *workerfakes.FakeWorker
+			compatibleWorker3 *workerfakes.FakeWorker
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("build-locality-placement-test")
+
+			fakeWorkerFactory = new(dbfakes.FakeWorkerFactory)
+			strategy = NewBuildLocalityPlacementStrategy(fakeWorkerFactory)
+
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker1.NameReturns("worker-1")
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker2.NameReturns("worker-2")
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+			compatibleWorker3.NameReturns("worker-3")
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			spec = ContainerSpec{TeamID: 4567, BuildID: 42}
+		})
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+		})
+
+		Context("when the build has containers on some of the workers", func() {
+			BeforeEach(func() {
+				fakeWorkerFactory.WorkerNamesForBuildReturns([]string{"worker-2", "some-stalled-worker"}, nil)
+			})
+
+			It("picks a worker the build has containers on", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker2))
+
+				Expect(fakeWorkerFactory.WorkerNamesForBuildArgsForCall(0)).To(Equal(42))
+			})
+		})
+
+		Context("when the build has no containers on any of the workers", func() {
+			BeforeEach(func() {
+				fakeWorkerFactory.WorkerNamesForBuildReturns([]string{"some-stalled-worker"}, nil)
+			})
+
+			It("picks any of them", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(workers).To(ContainElement(chosenWorker))
+			})
+		})
+
+		Context("when the container is not for a build", func() {
+			BeforeEach(func() {
+				spec.BuildID = 0
+			})
+
+			It("does not look up the build's workers", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(workers).To(ContainElement(chosenWorker))
+				Expect(fakeWorkerFactory.WorkerNamesForBuildCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when looking up the build's workers fails", func() {
+			BeforeEach(func() {
+				fakeWorkerFactory.WorkerNamesForBuildReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				Expect(chooseErr).To(MatchError("disaster"))
+			})
+		})
+	})
+})
+
+var _ = Describe("ChainedPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var (
+			node1 *workerfakes.FakeContainerPlacementStrategyChainNode
+			node2 *workerfakes.FakeContainerPlacementStrategyChainNode
+
+			compatibleWorker1 *workerfakes.FakeWorker
+			compatibleWorker2 *workerfakes.FakeWorker
+			compatibleWorker3 *workerfakes.FakeWorker
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("chained-placement-test")
+
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker1.NameReturns("worker-1")
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker2.NameReturns("worker-2")
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+			compatibleWorker3.NameReturns("worker-3")
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			node1 = new(workerfakes.FakeContainerPlacementStrategyChainNode)
+			node1.NameReturns("some-strategy")
+			node1.CandidatesReturns([]Worker{compatibleWorker2, compatibleWorker3}, nil)
+
+			node2 = new(workerfakes.FakeContainerPlacementStrategyChainNode)
+			node2.NameReturns("some-other-strategy")
+			node2.CandidatesReturns([]Worker{compatibleWorker3}, nil)
+
+			spec = ContainerSpec{TeamID: 4567}
+
+			strategy = NewChainedPlacementStrategy(node1, node2)
+		})
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+		})
+
+		It("narrows down the candidates with each strategy in order", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(compatibleWorker3))
+
+			_, candidates, _ := node1.CandidatesArgsForCall(0)
+			Expect(candidates).To(Equal(workers))
+
+			_, candidates, _ = node2.CandidatesArgsForCall(0)
+			Expect(candidates).To(Equal([]Worker{compatibleWorker2, compatibleWorker3}))
+		})
+
+		It("logs which strategy decided on the worker", func() {
+			Expect(logger).To(gbytes.Say(`chose-worker.*"decided-by":"some-other-strategy"`))
+		})
+
+		Context("when a strategy leaves no candidates", func() {
+			BeforeEach(func() {
+				node1.CandidatesReturns([]Worker{}, nil)
+			})
+
+			It("picks no worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(BeNil())
+				Expect(node2.CandidatesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when a strategy fails", func() {
+			BeforeEach(func() {
+				node1.CandidatesReturns(nil, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				Expect(chooseErr).To(MatchError("disaster"))
+				Expect(node2.CandidatesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when there are no strategies", func() {
+			BeforeEach(func() {
+				strategy = NewChainedPlacementStrategy()
+			})
+
+			It("picks a random worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(workers).To(ContainElement(chosenWorker))
+				Expect(logger).To(gbytes.Say(`chose-worker.*"decided-by":"random"`))
+			})
+		})
+
+		Describe("ModifiesActiveTasks", func() {
+			It("is false if no strategy modifies active tasks", func() {
+				Expect(strategy.ModifiesActiveTasks()).To(BeFalse())
+			})
+
+			It("is true if any strategy modifies active tasks", func() {
+				node2.ModifiesActiveTasksReturns(true)
+				Expect(strategy.ModifiesActiveTasks()).To(BeTrue())
+			})
+		})
+	})
+})
@@ -53,6 +53,7 @@ func (c *baggageclaimRoundTripper) RoundTrip(request *http.Request) (*http.Respo
 
 	updatedRequest := *request
 	updatedRequest.URL = &updatedURL
+	propagateTraceContext(&updatedRequest)
 
 	response, err := c.innerRoundTripper.RoundTrip(&updatedRequest)
 	if err != nil {
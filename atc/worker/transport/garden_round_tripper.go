@@ -44,6 +44,7 @@ func (c *gardenRoundTripper) RoundTrip(request *http.Request) (*http.Response, e
 
 	updatedRequest := *request
 	updatedRequest.URL = &updatedURL
+	propagateTraceContext(&updatedRequest)
 
 	response, err := c.innerRoundTripper.RoundTrip(&updatedRequest)
 	if err != nil {
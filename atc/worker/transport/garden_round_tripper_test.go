@@ -1,6 +1,7 @@
 package transport_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
@@ -9,7 +10,11 @@ import (
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/worker/transport"
 	"github.com/concourse/concourse/atc/worker/transport/transportfakes"
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/tracing/tracingfakes"
 	"github.com/concourse/retryhttp/retryhttpfakes"
+	"go.opentelemetry.io/otel/api/core"
+	"go.opentelemetry.io/otel/api/trace"
 
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/onsi/ginkgo"
@@ -64,6 +69,45 @@ var _ = Describe("GardenRoundTripper #RoundTrip", func() {
 		Expect(fakeDB.GetWorkerCallCount()).To(Equal(0))
 	})
 
+	Context("when the request is part of a trace", func() {
+		var originalHeader http.Header
+
+		BeforeEach(func() {
+			traceID, err := core.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+			Expect(err).NotTo(HaveOccurred())
+
+			spanID, err := core.SpanIDFromHex("00f067aa0ba902b7")
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeSpan := new(tracingfakes.FakeSpan)
+			fakeSpan.SpanContextReturns(core.SpanContext{
+				TraceID:    traceID,
+				SpanID:     spanID,
+				TraceFlags: core.TraceFlagsSampled,
+			})
+
+			originalHeader = http.Header{"Content-Type": {"application/json"}}
+			request.Header = originalHeader
+			request = *request.WithContext(trace.ContextWithSpan(context.Background(), fakeSpan))
+
+			tracing.Configured = true
+		})
+
+		AfterEach(func() {
+			tracing.Configured = false
+		})
+
+		It("propagates the trace context to the worker", func() {
+			actualRequest := fakeRoundTripper.RoundTripArgsForCall(0)
+			Expect(actualRequest.Header.Get("traceparent")).To(Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+			Expect(actualRequest.Header.Get("Content-Type")).To(Equal("application/json"))
+		})
+
+		It("does not modify the original request", func() {
+			Expect(originalHeader).To(Equal(http.Header{"Content-Type": {"application/json"}}))
+		})
+	})
+
 	Context("when inner roundtrip fails", func() {
 		BeforeEach(func() {
 			fakeRoundTripper.RoundTripReturns(nil, errors.New("some-error"))
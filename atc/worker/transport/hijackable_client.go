@@ -48,6 +48,7 @@ func (c *hijackableClient) Do(request *http.Request) (*http.Response, retryhttp.
 
 	updatedRequest := *request
 	updatedRequest.URL = &updatedURL
+	propagateTraceContext(&updatedRequest)
 
 	response, hijackCloser, err := c.innerHijackableClient.Do(&updatedRequest)
 	if err != nil {
@@ -1,9 +1,27 @@
 package transport
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/tracing"
+)
 
 //go:generate counterfeiter . RoundTripper
 
 type RoundTripper interface {
 	RoundTrip(*http.Request) (*http.Response, error)
 }
+
+// propagateTraceContext adds the trace context of the request's span to the
+// headers of the request, copying them first so that the caller's request is
+// left untouched.
+func propagateTraceContext(request *http.Request) {
+	header := http.Header{}
+	for name, values := range request.Header {
+		header[name] = values
+	}
+
+	tracing.Inject(request.Context(), header)
+
+	request.Header = header
+}
@@ -34,6 +34,8 @@ var ResourceConfigCheckSessionExpiredError = errors.New("no db container was fou
 
 type Worker interface {
 	BuildContainers() int
+	CPULoad() float64
+	MemoryLoad() float64
 
 	Description() string
 	Name() string
@@ -679,6 +681,14 @@ func (worker *gardenWorker) BuildContainers() int {
 	return worker.buildContainers
 }
 
+func (worker *gardenWorker) CPULoad() float64 {
+	return worker.dbWorker.CPULoad()
+}
+
+func (worker *gardenWorker) MemoryLoad() float64 {
+	return worker.dbWorker.MemoryLoad()
+}
+
 func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool {
 	workerTeamID := worker.dbWorker.TeamID()
 	workerResourceTypes := worker.dbWorker.ResourceTypes()
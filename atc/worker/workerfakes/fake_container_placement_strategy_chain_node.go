@@ -0,0 +1,253 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package workerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+type FakeContainerPlacementStrategyChainNode struct {
+	CandidatesStub        func(lager.Logger, []worker.Worker, worker.ContainerSpec) ([]worker.Worker, error)
+	candidatesMutex       sync.RWMutex
+	candidatesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 []worker.Worker
+		arg3 worker.ContainerSpec
+	}
+	candidatesReturns struct {
+		result1 []worker.Worker
+		result2 error
+	}
+	candidatesReturnsOnCall map[int]struct {
+		result1 []worker.Worker
+		result2 error
+	}
+	ModifiesActiveTasksStub        func() bool
+	modifiesActiveTasksMutex       sync.RWMutex
+	modifiesActiveTasksArgsForCall []struct {
+	}
+	modifiesActiveTasksReturns struct {
+		result1 bool
+	}
+	modifiesActiveTasksReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	NameStub        func() string
+	nameMutex       sync.RWMutex
+	nameArgsForCall []struct {
+	}
+	nameReturns struct {
+		result1 string
+	}
+	nameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) Candidates(arg1 lager.Logger, arg2 []worker.Worker, arg3 worker.ContainerSpec) ([]worker.Worker, error) {
+	var arg2Copy []worker.Worker
+	if arg2 != nil {
+		arg2Copy = make([]worker.Worker, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.candidatesMutex.Lock()
+	ret, specificReturn := fake.candidatesReturnsOnCall[len(fake.candidatesArgsForCall)]
+	fake.candidatesArgsForCall = append(fake.candidatesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 []worker.Worker
+		arg3 worker.ContainerSpec
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("Candidates", []interface{}{arg1, arg2Copy, arg3})
+	fake.candidatesMutex.Unlock()
+	if fake.CandidatesStub != nil {
+		return fake.CandidatesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.candidatesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) CandidatesCallCount() int {
+	fake.candidatesMutex.RLock()
+	defer fake.candidatesMutex.RUnlock()
+	return len(fake.candidatesArgsForCall)
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) CandidatesCalls(stub func(lager.Logger, []worker.Worker, worker.ContainerSpec) ([]worker.Worker, error)) {
+	fake.candidatesMutex.Lock()
+	defer fake.candidatesMutex.Unlock()
+	fake.CandidatesStub = stub
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) CandidatesArgsForCall(i int) (lager.Logger, []worker.Worker, worker.ContainerSpec) {
+	fake.candidatesMutex.RLock()
+	defer fake.candidatesMutex.RUnlock()
+	argsForCall := fake.candidatesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) CandidatesReturns(result1 []worker.Worker, result2 error) {
+	fake.candidatesMutex.Lock()
+	defer fake.candidatesMutex.Unlock()
+	fake.CandidatesStub = nil
+	fake.candidatesReturns = struct {
+		result1 []worker.Worker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) CandidatesReturnsOnCall(i int, result1 []worker.Worker, result2 error) {
+	fake.candidatesMutex.Lock()
+	defer fake.candidatesMutex.Unlock()
+	fake.CandidatesStub = nil
+	if fake.candidatesReturnsOnCall == nil {
+		fake.candidatesReturnsOnCall = make(map[int]struct {
+			result1 []worker.Worker
+			result2 error
+		})
+	}
+	fake.candidatesReturnsOnCall[i] = struct {
+		result1 []worker.Worker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) ModifiesActiveTasks() bool {
+	fake.modifiesActiveTasksMutex.Lock()
+	ret, specificReturn := fake.modifiesActiveTasksReturnsOnCall[len(fake.modifiesActiveTasksArgsForCall)]
+	fake.modifiesActiveTasksArgsForCall = append(fake.modifiesActiveTasksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ModifiesActiveTasks", []interface{}{})
+	fake.modifiesActiveTasksMutex.Unlock()
+	if fake.ModifiesActiveTasksStub != nil {
+		return fake.ModifiesActiveTasksStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.modifiesActiveTasksReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) ModifiesActiveTasksCallCount() int {
+	fake.modifiesActiveTasksMutex.RLock()
+	defer fake.modifiesActiveTasksMutex.RUnlock()
+	return len(fake.modifiesActiveTasksArgsForCall)
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) ModifiesActiveTasksCalls(stub func() bool) {
+	fake.modifiesActiveTasksMutex.Lock()
+	defer fake.modifiesActiveTasksMutex.Unlock()
+	fake.ModifiesActiveTasksStub = stub
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) ModifiesActiveTasksReturns(result1 bool) {
+	fake.modifiesActiveTasksMutex.Lock()
+	defer fake.modifiesActiveTasksMutex.Unlock()
+	fake.ModifiesActiveTasksStub = nil
+	fake.modifiesActiveTasksReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) ModifiesActiveTasksReturnsOnCall(i int, result1 bool) {
+	fake.modifiesActiveTasksMutex.Lock()
+	defer fake.modifiesActiveTasksMutex.Unlock()
+	fake.ModifiesActiveTasksStub = nil
+	if fake.modifiesActiveTasksReturnsOnCall == nil {
+		fake.modifiesActiveTasksReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.modifiesActiveTasksReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) Name() string {
+	fake.nameMutex.Lock()
+	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
+	fake.nameArgsForCall = append(fake.nameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Name", []interface{}{})
+	fake.nameMutex.Unlock()
+	if fake.NameStub != nil {
+		return fake.NameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.nameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) NameCallCount() int {
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	return len(fake.nameArgsForCall)
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) NameCalls(stub func() string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = stub
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) NameReturns(result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	fake.nameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) NameReturnsOnCall(i int, result1 string) {
+	fake.nameMutex.Lock()
+	defer fake.nameMutex.Unlock()
+	fake.NameStub = nil
+	if fake.nameReturnsOnCall == nil {
+		fake.nameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.nameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.candidatesMutex.RLock()
+	defer fake.candidatesMutex.RUnlock()
+	fake.modifiesActiveTasksMutex.RLock()
+	defer fake.modifiesActiveTasksMutex.RUnlock()
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeContainerPlacementStrategyChainNode) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ worker.ContainerPlacementStrategyChainNode = new(FakeContainerPlacementStrategyChainNode)
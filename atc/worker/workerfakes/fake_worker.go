@@ -39,6 +39,16 @@ type FakeWorker struct {
 	buildContainersReturnsOnCall map[int]struct {
 		result1 int
 	}
+	CPULoadStub        func() float64
+	cPULoadMutex       sync.RWMutex
+	cPULoadArgsForCall []struct {
+	}
+	cPULoadReturns struct {
+		result1 float64
+	}
+	cPULoadReturnsOnCall map[int]struct {
+		result1 float64
+	}
 	CertsVolumeStub        func(lager.Logger) (worker.Volume, bool, error)
 	certsVolumeMutex       sync.RWMutex
 	certsVolumeArgsForCall []struct {
@@ -254,6 +264,16 @@ type FakeWorker struct {
 		result2 bool
 		result3 error
 	}
+	MemoryLoadStub        func() float64
+	memoryLoadMutex       sync.RWMutex
+	memoryLoadArgsForCall []struct {
+	}
+	memoryLoadReturns struct {
+		result1 float64
+	}
+	memoryLoadReturnsOnCall map[int]struct {
+		result1 float64
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -417,6 +437,58 @@ func (fake *FakeWorker) BuildContainersReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorker) CPULoad() float64 {
+	fake.cPULoadMutex.Lock()
+	ret, specificReturn := fake.cPULoadReturnsOnCall[len(fake.cPULoadArgsForCall)]
+	fake.cPULoadArgsForCall = append(fake.cPULoadArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CPULoad", []interface{}{})
+	fake.cPULoadMutex.Unlock()
+	if fake.CPULoadStub != nil {
+		return fake.CPULoadStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cPULoadReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CPULoadCallCount() int {
+	fake.cPULoadMutex.RLock()
+	defer fake.cPULoadMutex.RUnlock()
+	return len(fake.cPULoadArgsForCall)
+}
+
+func (fake *FakeWorker) CPULoadCalls(stub func() float64) {
+	fake.cPULoadMutex.Lock()
+	defer fake.cPULoadMutex.Unlock()
+	fake.CPULoadStub = stub
+}
+
+func (fake *FakeWorker) CPULoadReturns(result1 float64) {
+	fake.cPULoadMutex.Lock()
+	defer fake.cPULoadMutex.Unlock()
+	fake.CPULoadStub = nil
+	fake.cPULoadReturns = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeWorker) CPULoadReturnsOnCall(i int, result1 float64) {
+	fake.cPULoadMutex.Lock()
+	defer fake.cPULoadMutex.Unlock()
+	fake.CPULoadStub = nil
+	if fake.cPULoadReturnsOnCall == nil {
+		fake.cPULoadReturnsOnCall = make(map[int]struct {
+			result1 float64
+		})
+	}
+	fake.cPULoadReturnsOnCall[i] = struct {
+		result1 float64
+	}{result1}
+}
+
 func (fake *FakeWorker) CertsVolume(arg1 lager.Logger) (worker.Volume, bool, error) {
 	fake.certsVolumeMutex.Lock()
 	ret, specificReturn := fake.certsVolumeReturnsOnCall[len(fake.certsVolumeArgsForCall)]
@@ -1339,6 +1411,58 @@ func (fake *FakeWorker) LookupVolumeReturnsOnCall(i int, result1 worker.Volume,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeWorker) MemoryLoad() float64 {
+	fake.memoryLoadMutex.Lock()
+	ret, specificReturn := fake.memoryLoadReturnsOnCall[len(fake.memoryLoadArgsForCall)]
+	fake.memoryLoadArgsForCall = append(fake.memoryLoadArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MemoryLoad", []interface{}{})
+	fake.memoryLoadMutex.Unlock()
+	if fake.MemoryLoadStub != nil {
+		return fake.MemoryLoadStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.memoryLoadReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) MemoryLoadCallCount() int {
+	fake.memoryLoadMutex.RLock()
+	defer fake.memoryLoadMutex.RUnlock()
+	return len(fake.memoryLoadArgsForCall)
+}
+
+func (fake *FakeWorker) MemoryLoadCalls(stub func() float64) {
+	fake.memoryLoadMutex.Lock()
+	defer fake.memoryLoadMutex.Unlock()
+	fake.MemoryLoadStub = stub
+}
+
+func (fake *FakeWorker) MemoryLoadReturns(result1 float64) {
+	fake.memoryLoadMutex.Lock()
+	defer fake.memoryLoadMutex.Unlock()
+	fake.MemoryLoadStub = nil
+	fake.memoryLoadReturns = struct {
+		result1 float64
+	}{result1}
+}
+
+func (fake *FakeWorker) MemoryLoadReturnsOnCall(i int, result1 float64) {
+	fake.memoryLoadMutex.Lock()
+	defer fake.memoryLoadMutex.Unlock()
+	fake.MemoryLoadStub = nil
+	if fake.memoryLoadReturnsOnCall == nil {
+		fake.memoryLoadReturnsOnCall = make(map[int]struct {
+			result1 float64
+		})
+	}
+	fake.memoryLoadReturnsOnCall[i] = struct {
+		result1 float64
+	}{result1}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1615,6 +1739,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.activeTasksMutex.RUnlock()
 	fake.buildContainersMutex.RLock()
 	defer fake.buildContainersMutex.RUnlock()
+	fake.cPULoadMutex.RLock()
+	defer fake.cPULoadMutex.RUnlock()
 	fake.certsVolumeMutex.RLock()
 	defer fake.certsVolumeMutex.RUnlock()
 	fake.createVolumeMutex.RLock()
@@ -1645,6 +1771,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.isVersionCompatibleMutex.RUnlock()
 	fake.lookupVolumeMutex.RLock()
 	defer fake.lookupVolumeMutex.RUnlock()
+	fake.memoryLoadMutex.RLock()
+	defer fake.memoryLoadMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
@@ -43,13 +43,15 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// pipeline and job are public or authorized
 		case atc.GetBuildPreparation,
+			atc.GetBuildQueue,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
 			atc.ListBuildArtifacts:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.SetBuildComment:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -70,6 +72,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListJobs,
 			atc.GetJob,
 			atc.ListJobBuilds,
+			atc.ListJobQueue,
 			atc.ListPipelineBuilds,
 			atc.GetResource,
 			atc.ListBuildsWithVersionAsInput,
@@ -101,6 +104,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// unauthenticated / delegating to handler (validate token if provided)
 		case atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.ReceiveTeamWebhook,
 			atc.GetInfo,
 			atc.GetCheck,
 			atc.ListTeams,
@@ -115,8 +119,10 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		case atc.GetLogLevel,
 			atc.ListActiveUsersSince,
+			atc.ListAuditEvents,
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
+			atc.GetEncryptionKeyRotation,
 			atc.SetWall,
 			atc.ClearWall:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
@@ -134,6 +140,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
+			atc.ResolveVar,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
@@ -143,12 +150,18 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenamePipeline,
 			atc.UnpauseJob,
 			atc.UnpausePipeline,
+			atc.ArchivePipeline,
 			atc.ExposePipeline,
 			atc.HidePipeline,
 			atc.SaveConfig,
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
+			atc.SetTeamWebhook,
+			atc.DestroyTeamWebhook,
+			atc.CreateAPIToken,
+			atc.ListAPITokens,
+			atc.RevokeAPIToken,
 			atc.GetArtifact:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
@@ -151,10 +151,12 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.BuildEvents:         checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
 				atc.ListBuildArtifacts:  checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
+				atc.GetBuildQueue:       checksIfPrivateJob(inputHandlers[atc.GetBuildQueue]),
 				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
 
 				// resource belongs to authorized team
-				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.AbortBuild:      checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.SetBuildComment: checkWritePermissionForBuild(inputHandlers[atc.SetBuildComment]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
@@ -173,6 +175,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListJobs:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobs]),
 				atc.GetJob:                        openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJob]),
 				atc.ListJobBuilds:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobBuilds]),
+				atc.ListJobQueue:                  openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobQueue]),
 				atc.ListPipelineBuilds:            openForPublicPipelineOrAuthorized(inputHandlers[atc.ListPipelineBuilds]),
 				atc.GetResource:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResource]),
 				atc.ListBuildsWithVersionAsInput:  openForPublicPipelineOrAuthorized(inputHandlers[atc.ListBuildsWithVersionAsInput]),
@@ -204,6 +207,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetCheck:             authenticateIfTokenProvided(inputHandlers[atc.GetCheck]),
 				atc.DownloadCLI:          authenticateIfTokenProvided(inputHandlers[atc.DownloadCLI]),
 				atc.CheckResourceWebHook: authenticateIfTokenProvided(inputHandlers[atc.CheckResourceWebHook]),
+				atc.ReceiveTeamWebhook:   authenticateIfTokenProvided(inputHandlers[atc.ReceiveTeamWebhook]),
 				atc.ListAllPipelines:     authenticateIfTokenProvided(inputHandlers[atc.ListAllPipelines]),
 				atc.ListBuilds:           authenticateIfTokenProvided(inputHandlers[atc.ListBuilds]),
 				atc.ListPipelines:        authenticateIfTokenProvided(inputHandlers[atc.ListPipelines]),
@@ -214,12 +218,14 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetWall:              authenticateIfTokenProvided(inputHandlers[atc.GetWall]),
 
 				// authenticated and is admin
-				atc.GetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
-				atc.SetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
-				atc.GetInfoCreds:         authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
-				atc.ListActiveUsersSince: authenticatedAndAdmin(inputHandlers[atc.ListActiveUsersSince]),
-				atc.SetWall:              authenticatedAndAdmin(inputHandlers[atc.SetWall]),
-				atc.ClearWall:            authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
+				atc.GetLogLevel:              authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
+				atc.SetLogLevel:              authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
+				atc.GetInfoCreds:             authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
+				atc.GetEncryptionKeyRotation: authenticatedAndAdmin(inputHandlers[atc.GetEncryptionKeyRotation]),
+				atc.ListActiveUsersSince:     authenticatedAndAdmin(inputHandlers[atc.ListActiveUsersSince]),
+				atc.ListAuditEvents:          authenticatedAndAdmin(inputHandlers[atc.ListAuditEvents]),
+				atc.SetWall:                  authenticatedAndAdmin(inputHandlers[atc.SetWall]),
+				atc.ClearWall:                authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
 
 				// authorized (requested team matches resource team)
 				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
@@ -233,6 +239,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.UnpinResource:           authorized(inputHandlers[atc.UnpinResource]),
 				atc.SetPinCommentOnResource: authorized(inputHandlers[atc.SetPinCommentOnResource]),
 				atc.GetConfig:               authorized(inputHandlers[atc.GetConfig]),
+				atc.ResolveVar:              authorized(inputHandlers[atc.ResolveVar]),
 				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
 				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
 				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
@@ -244,12 +251,18 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.UnpauseJob:              authorized(inputHandlers[atc.UnpauseJob]),
 				atc.ScheduleJob:             authorized(inputHandlers[atc.ScheduleJob]),
 				atc.UnpausePipeline:         authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ArchivePipeline:         authorized(inputHandlers[atc.ArchivePipeline]),
 				atc.ExposePipeline:          authorized(inputHandlers[atc.ExposePipeline]),
 				atc.HidePipeline:            authorized(inputHandlers[atc.HidePipeline]),
 				atc.CreatePipelineBuild:     authorized(inputHandlers[atc.CreatePipelineBuild]),
 				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
 				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
 				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.SetTeamWebhook:          authorized(inputHandlers[atc.SetTeamWebhook]),
+				atc.DestroyTeamWebhook:      authorized(inputHandlers[atc.DestroyTeamWebhook]),
+				atc.CreateAPIToken:          authorized(inputHandlers[atc.CreateAPIToken]),
+				atc.ListAPITokens:           authorized(inputHandlers[atc.ListAPITokens]),
+				atc.RevokeAPIToken:          authorized(inputHandlers[atc.RevokeAPIToken]),
 			}
 		})
 
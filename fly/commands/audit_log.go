@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type AuditLogCommand struct {
+	User   string `short:"u" long:"user" description:"Show the requests made by this user"`
+	Team   string `short:"n" long:"team" description:"Show the requests made against this team"`
+	Action string `short:"a" long:"action" description:"Show the requests for this api action, e.g. SaveConfig"`
+	Since  string `long:"since" description:"Start of the range to filter requests"`
+	Until  string `long:"until" description:"End of the range to filter requests"`
+	Count  int    `short:"c" long:"count" default:"50" description:"Number of requests you want to limit the return to"`
+	Json   bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *AuditLogCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	filter := concourse.AuditEventFilter{
+		UserName: command.User,
+		TeamName: command.Team,
+		Action:   command.Action,
+	}
+
+	if command.Since != "" {
+		filter.From, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		filter.To, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Since != "" && command.Until != "" && filter.From.After(filter.To) {
+		return errors.New("Cannot have --since after --until")
+	}
+
+	events, _, err := target.Client().AuditEvents(filter, concourse.Page{Limit: command.Count})
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(events)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "time", Color: color.New(color.Bold)},
+			{Contents: "user", Color: color.New(color.Bold)},
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "action", Color: color.New(color.Bold)},
+			{Contents: "target", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "source ip", Color: color.New(color.Bold)},
+			{Contents: "request id", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, event := range events {
+		row := ui.TableRow{
+			{Contents: time.Unix(event.Time, 0).Local().Format(timeDateLayout)},
+			{Contents: event.UserName},
+		}
+
+		if event.TeamName != "" {
+			row = append(row, ui.TableCell{Contents: event.TeamName})
+		} else {
+			row = append(row, ui.TableCell{Contents: "none", Color: color.New(color.Faint)})
+		}
+
+		statusCell := ui.TableCell{Contents: strconv.Itoa(event.Status)}
+		if event.Status >= 400 {
+			statusCell.Color = color.New(color.FgRed)
+		}
+
+		row = append(row,
+			ui.TableCell{Contents: event.Action},
+			ui.TableCell{Contents: event.Target},
+			statusCell,
+			ui.TableCell{Contents: event.SourceIP},
+			ui.TableCell{Contents: event.RequestID},
+		)
+
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -20,15 +20,16 @@ const timeDateLayout = "2006-01-02@15:04:05-0700"
 const inputTimeLayout = "2006-01-02 15:04:05"
 
 type BuildsCommand struct {
-	AllTeams    bool                     `short:"a" long:"all-teams" description:"Show builds for the all teams that user has access to"`
-	Count       int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
-	CurrentTeam bool                     `long:"current-team" description:"Show builds for the currently targeted team"`
-	Job         flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
-	Json        bool                     `long:"json" description:"Print command result as JSON"`
-	Pipeline    flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
-	Teams       []string                 `short:"n"  long:"team" description:"Show builds for these teams"`
-	Since       string                   `long:"since" description:"Start of the range to filter builds"`
-	Until       string                   `long:"until" description:"End of the range to filter builds"`
+	AllTeams     bool                              `short:"a" long:"all-teams" description:"Show builds for the all teams that user has access to"`
+	Count        int                               `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
+	CurrentTeam  bool                              `long:"current-team" description:"Show builds for the currently targeted team"`
+	Job          flaghelpers.JobFlag               `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
+	Json         bool                              `long:"json" description:"Print command result as JSON"`
+	Pipeline     flaghelpers.PipelineFlag          `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
+	InstanceVars []flaghelpers.InstanceVarPairFlag `short:"i" long:"instance-var" value-name:"[NAME=YAML]" description:"Instance var identifying an instance of the pipeline"`
+	Teams        []string                          `short:"n"  long:"team" description:"Show builds for these teams"`
+	Since        string                            `long:"since" description:"Start of the range to filter builds"`
+	Until        string                            `long:"until" description:"End of the range to filter builds"`
 }
 
 func (command *BuildsCommand) Execute([]string) error {
@@ -157,7 +158,7 @@ func (command *BuildsCommand) validatePipelineBuilds(builds []atc.Build, current
 
 	var found bool
 	builds, _, found, err = currentTeam.PipelineBuilds(
-		string(command.Pipeline),
+		flaghelpers.PipelineRef(command.Pipeline, command.InstanceVars),
 		page,
 	)
 
@@ -192,6 +193,7 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			{Contents: "end", Color: color.New(color.Bold)},
 			{Contents: "duration", Color: color.New(color.Bold)},
 			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "comment", Color: color.New(color.Bold)},
 		},
 	}
 
@@ -237,6 +239,7 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			endTimeCell,
 			durationCell,
 			{Contents: b.TeamName},
+			{Contents: b.Comment},
 		})
 	}
 
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type CommentBuildCommand struct {
+	Job     flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to comment on a build of"`
+	Build   string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to comment on. If job not specified: build id"`
+	Comment string              `short:"c" long:"comment" required:"true" description:"Comment to attach to the build. An empty comment removes it"`
+}
+
+func (command *CommentBuildCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineName == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineName, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	found, err := target.Client().SetBuildComment(strconv.Itoa(build.ID), command.Comment)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("build does not exist")
+	}
+
+	fmt.Println("comment set on build")
+	return nil
+}
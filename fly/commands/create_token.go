@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type CreateTokenCommand struct {
+	Name           string        `short:"n" long:"name" required:"true" description:"Name of the token"`
+	Role           string        `short:"r" long:"role" default:"member" description:"Team role granted by the token, at most your own: owner, member, pipeline-operator, viewer or a custom role"`
+	ServiceAccount bool          `long:"service-account" description:"Act as a service account named after the token rather than as yourself (requires the owner role)"`
+	ExpiresIn      time.Duration `long:"expires-in" description:"Revoke the token automatically after this long (e.g. 720h), instead of only when revoked"`
+	Team           string        `long:"team" description:"Name of the team to create the token for, if different from the target default"`
+	Json           bool          `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *CreateTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	request := atc.CreateAPITokenRequestBody{
+		Name:           command.Name,
+		Role:           command.Role,
+		ServiceAccount: command.ServiceAccount,
+	}
+
+	if command.ExpiresIn != 0 {
+		request.ExpiresAt = time.Now().Add(command.ExpiresIn).Unix()
+	}
+
+	team := GetTeam(target, command.Team)
+	apiToken, err := team.CreateAPIToken(request)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(apiToken)
+	}
+
+	fmt.Printf("created token '%s' granting the %s role in team '%s'\n", apiToken.Name, apiToken.Role, apiToken.TeamName)
+	fmt.Println("copy it now, as it will not be shown again:")
+	fmt.Println("")
+	fmt.Printf("  %s\n", apiToken.Token)
+
+	return nil
+}
@@ -10,8 +10,9 @@ import (
 )
 
 type DestroyPipelineCommand struct {
-	Pipeline        flaghelpers.PipelineFlag `short:"p"  long:"pipeline" required:"true" description:"Pipeline to destroy"`
-	SkipInteractive bool                     `short:"n"  long:"non-interactive"          description:"Destroy the pipeline without confirmation"`
+	Pipeline        flaghelpers.PipelineFlag          `short:"p"  long:"pipeline" required:"true" description:"Pipeline to destroy"`
+	InstanceVars    []flaghelpers.InstanceVarPairFlag `short:"i"  long:"instance-var" value-name:"[NAME=YAML]" description:"Instance var identifying an instance of the pipeline"`
+	SkipInteractive bool                              `short:"n"  long:"non-interactive"          description:"Destroy the pipeline without confirmation"`
 }
 
 func (command *DestroyPipelineCommand) Validate() error {
@@ -34,8 +35,8 @@ func (command *DestroyPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
-	fmt.Printf("!!! this will remove all data for pipeline `%s`\n\n", pipelineName)
+	pipelineRef := flaghelpers.PipelineRef(command.Pipeline, command.InstanceVars)
+	fmt.Printf("!!! this will remove all data for pipeline `%s`\n\n", pipelineRef.String())
 
 	confirm := command.SkipInteractive
 	if !confirm {
@@ -46,15 +47,15 @@ func (command *DestroyPipelineCommand) Execute(args []string) error {
 		}
 	}
 
-	found, err := target.Team().DeletePipeline(pipelineName)
+	found, err := target.Team().DeletePipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		fmt.Printf("`%s` does not exist\n", pipelineName)
+		fmt.Printf("`%s` does not exist\n", pipelineRef.String())
 	} else {
-		fmt.Printf("`%s` deleted\n", pipelineName)
+		fmt.Printf("`%s` deleted\n", pipelineRef.String())
 	}
 
 	return nil
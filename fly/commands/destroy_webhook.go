@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DestroyWebhookCommand struct {
+	Provider string `short:"p" long:"provider" required:"true" description:"Provider of the webhook to destroy (github, gitlab or bitbucket)"`
+	Team     string `long:"team" description:"Name of the team to which the webhook belongs, if different from the target default"`
+}
+
+func (command *DestroyWebhookCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := GetTeam(target, command.Team)
+	found, err := team.DestroyWebhook(command.Provider)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s webhook not found on team %s", command.Provider, team.Name())
+	}
+
+	fmt.Printf("destroyed %s webhook\n", command.Provider)
+
+	return nil
+}
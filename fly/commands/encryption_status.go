@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type EncryptionStatusCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *EncryptionStatusCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	rotation, err := target.Client().GetEncryptionKeyRotation()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(rotation)
+	}
+
+	if rotation.State == "" {
+		fmt.Println("the encryption key has never been rotated")
+		return nil
+	}
+
+	fmt.Printf("state: %s\n", rotation.State)
+	fmt.Printf("started: %s\n", time.Unix(rotation.StartedAt, 0).Format(timeDateLayout))
+
+	if rotation.FinishedAt != 0 {
+		fmt.Printf("finished: %s\n", time.Unix(rotation.FinishedAt, 0).Format(timeDateLayout))
+	} else {
+		fmt.Printf("rows remaining: %d\n", rotation.RowsRemaining())
+	}
+
+	if rotation.LastError != "" {
+		fmt.Printf("last error: %s\n", ui.ErroredColor.Sprint(rotation.LastError))
+	}
+
+	fmt.Println()
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "table", Color: color.New(color.Bold)},
+			{Contents: "column", Color: color.New(color.Bold)},
+			{Contents: "processed", Color: color.New(color.Bold)},
+			{Contents: "rotated", Color: color.New(color.Bold)},
+			{Contents: "done", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, column := range rotation.Columns {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: column.Table},
+			{Contents: column.Column},
+			{Contents: fmt.Sprintf("%d/%d", column.RowsProcessed, column.RowsTotal)},
+			{Contents: strconv.Itoa(column.RowsRotated)},
+			boolCell(column.Done),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
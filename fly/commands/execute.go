@@ -27,9 +27,11 @@ type ExecuteCommand struct {
 	TaskConfig     atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute"`
 	Privileged     bool                               `short:"p" long:"privileged"                            description:"Run the task with full privileges"`
 	IncludeIgnored bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
-	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
+	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times). Instead of a local path to upload, it can be fetched by the build from a Git ref with git:URI#REF, authenticated as the pipeline's resource for the same URI, or from a resource version with resource:PIPELINE/RESOURCE@KEY:VALUE[,KEY:VALUE]"`
 	InputMappings  []flaghelpers.VariablePairFlag     `short:"m" long:"input-mapping"       value-name:"[NAME=STRING]"    description:"Map a resource to a different name as task input"`
 	InputsFrom     flaghelpers.JobFlag                `short:"j" long:"inputs-from" value-name:"PIPELINE/JOB" description:"A job to base the inputs on"`
+	Pipeline       flaghelpers.PipelineFlag           `          long:"pipeline"    value-name:"PIPELINE"     description:"Pipeline to run the build in, whose resources authenticate the git inputs"`
+	InstanceVars   []flaghelpers.InstanceVarPairFlag  `          long:"instance-var" value-name:"[NAME=YAML]" description:"Instance var identifying the instance of the pipeline the inputs come from"`
 	Outputs        []flaghelpers.OutputPairFlag       `short:"o" long:"output"      value-name:"NAME=PATH"    description:"An output to fetch from the task (can be specified multiple times)"`
 	Image          string                             `long:"image" description:"Image resource for the one-off build"`
 	Tags           []string                           `          long:"tag"         value-name:"TAG"          description:"A tag for a specific environment (can be specified multiple times)"`
@@ -54,6 +56,16 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		return err
 	}
 
+	err = command.Pipeline.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef, err := executehelpers.DeterminePipeline(command.Inputs, command.Pipeline, command.InputsFrom, command.InstanceVars)
+	if err != nil {
+		return err
+	}
+
 	planFactory := atc.NewPlanFactory(time.Now().Unix())
 
 	inputs, inputMappings, imageResource, err := executehelpers.DetermineInputs(
@@ -64,6 +76,7 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		command.InputMappings,
 		command.Image,
 		command.InputsFrom,
+		pipelineRef,
 		command.IncludeIgnored,
 		taskConfig.Platform,
 	)
@@ -108,8 +121,8 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	var build atc.Build
 	var buildURL *url.URL
 
-	if command.InputsFrom.PipelineName != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineName, plan)
+	if pipelineRef.Name != "" {
+		build, err = target.Team().CreatePipelineBuild(pipelineRef, plan)
 		if err != nil {
 			return err
 		}
@@ -9,7 +9,8 @@ import (
 )
 
 type ExposePipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to expose"`
+	Pipeline     flaghelpers.PipelineFlag          `short:"p" long:"pipeline" required:"true" description:"Pipeline to expose"`
+	InstanceVars []flaghelpers.InstanceVarPairFlag `short:"i" long:"instance-var" value-name:"[NAME=YAML]" description:"Instance var identifying an instance of the pipeline"`
 }
 
 func (command *ExposePipelineCommand) Validate() error {
@@ -22,7 +23,7 @@ func (command *ExposePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
+	pipelineRef := flaghelpers.PipelineRef(command.Pipeline, command.InstanceVars)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -34,15 +35,15 @@ func (command *ExposePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	found, err := target.Team().ExposePipeline(pipelineName)
+	found, err := target.Team().ExposePipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
 
 	if found {
-		fmt.Printf("exposed '%s'\n", pipelineName)
+		fmt.Printf("exposed '%s'\n", pipelineRef.String())
 	} else {
-		displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
 	}
 
 	return nil
@@ -25,6 +25,9 @@ type FlyCommand struct {
 
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
+	AuditLog    AuditLogCommand    `command:"audit-log" alias:"al" description:"List the audited api requests recorded by the ATC"`
+
+	EncryptionStatus EncryptionStatusCommand `command:"encryption-status" description:"Show the progress of the database encryption key rotation"`
 
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	GetTeam     GetTeamCommand     `command:"get-team"  alias:"gt" description:"Show team configuration"`
@@ -32,6 +35,13 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	SetWebhook     SetWebhookCommand     `command:"set-webhook"     alias:"sw" description:"Receive a provider's push events to check the team's resources"`
+	DestroyWebhook DestroyWebhookCommand `command:"destroy-webhook" alias:"dw" description:"Stop receiving a provider's push events"`
+
+	CreateToken CreateTokenCommand `command:"create-token" alias:"ctk" description:"Create a long-lived API token for automation"`
+	Tokens      TokensCommand      `command:"tokens"       alias:"tks" description:"List the team's API tokens"`
+	RevokeToken RevokeTokenCommand `command:"revoke-token" alias:"rtk" description:"Revoke an API token"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -51,12 +61,14 @@ type FlyCommand struct {
 	SetPipeline      SetPipelineCommand      `command:"set-pipeline"        alias:"sp"   description:"Create or update a pipeline's configuration"`
 	PausePipeline    PausePipelineCommand    `command:"pause-pipeline"      alias:"pp"   description:"Pause a pipeline"`
 	UnpausePipeline  UnpausePipelineCommand  `command:"unpause-pipeline"    alias:"up"   description:"Un-pause a pipeline"`
+	ArchivePipeline  ArchivePipelineCommand  `command:"archive-pipeline"    alias:"ap"   description:"Archive a pipeline"`
 	ExposePipeline   ExposePipelineCommand   `command:"expose-pipeline"     alias:"ep"   description:"Make a pipeline publicly viewable"`
 	HidePipeline     HidePipelineCommand     `command:"hide-pipeline"       alias:"hp"   description:"Hide a pipeline from the public"`
 	RenamePipeline   RenamePipelineCommand   `command:"rename-pipeline"     alias:"rp"   description:"Rename a pipeline"`
 	ValidatePipeline ValidatePipelineCommand `command:"validate-pipeline"   alias:"vp"   description:"Validate a pipeline config"`
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
+	ResolveVar       ResolveVarCommand       `command:"resolve-var"         alias:"rv"   description:"Show where a pipeline's var resolves from"`
 
 	Resources              ResourcesCommand              `command:"resources"                  alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"          alias:"rvs"  description:"List the versions of a resource"`
@@ -70,9 +82,10 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds       BuildsCommand       `command:"builds"        alias:"bs" description:"List builds data"`
+	AbortBuild   AbortBuildCommand   `command:"abort-build"   alias:"ab" description:"Abort a build"`
+	RerunBuild   RerunBuildCommand   `command:"rerun-build"   alias:"rb" description:"Rerun a build"`
+	CommentBuild CommentBuildCommand `command:"comment-build" alias:"cb" description:"Attach a comment to a build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
@@ -17,8 +17,9 @@ import (
 )
 
 type GetPipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get configuration of this pipeline"`
-	JSON     bool                     `short:"j" long:"json"                     description:"Print config as json instead of yaml"`
+	Pipeline     flaghelpers.PipelineFlag          `short:"p" long:"pipeline" required:"true" description:"Get configuration of this pipeline"`
+	InstanceVars []flaghelpers.InstanceVarPairFlag `short:"i" long:"instance-var" value-name:"[NAME=YAML]" description:"Instance var identifying an instance of the pipeline"`
+	JSON         bool                              `short:"j" long:"json"                     description:"Print config as json instead of yaml"`
 }
 
 func (command *GetPipelineCommand) Validate() error {
@@ -32,7 +33,7 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 	}
 
 	asJSON := command.JSON
-	pipelineName := string(command.Pipeline)
+	pipelineRef := flaghelpers.PipelineRef(command.Pipeline, command.InstanceVars)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -44,7 +45,7 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	config, _, found, err := target.Team().PipelineConfig(pipelineName)
+	config, _, found, err := target.Team().PipelineConfig(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -82,5 +83,97 @@ func (command *GetTeamCommand) Execute(args []string) error {
 		table.Data = append(table.Data, row)
 	}
 	sort.Sort(table.Data)
-	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+
+	err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	if team.PipelineAuth != nil && len(*team.PipelineAuth) > 0 {
+		pipelineAuthTable := ui.Table{
+			Headers: ui.TableRow{
+				{Contents: "pipeline/role", Color: color.New(color.Bold)},
+				{Contents: "users", Color: color.New(color.Bold)},
+				{Contents: "groups", Color: color.New(color.Bold)},
+			},
+		}
+		for pipeline, pipelineAuth := range *team.PipelineAuth {
+			for role, auth := range pipelineAuth {
+				// roles bound in a pipeline always name their users or groups
+				usersCell := ui.TableCell{Contents: strings.Join(auth["users"], ",")}
+				if len(auth["users"]) == 0 {
+					usersCell = ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+				}
+
+				groupsCell := ui.TableCell{Contents: strings.Join(auth["groups"], ",")}
+				if len(auth["groups"]) == 0 {
+					groupsCell = ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+				}
+
+				pipelineAuthTable.Data = append(pipelineAuthTable.Data, ui.TableRow{
+					{Contents: fmt.Sprintf("%s/%s", pipeline, role)},
+					usersCell,
+					groupsCell,
+				})
+			}
+		}
+		sort.Sort(pipelineAuthTable.Data)
+
+		fmt.Println()
+		err = pipelineAuthTable.Render(os.Stdout, Fly.PrintTableHeaders)
+		if err != nil {
+			return err
+		}
+	}
+
+	if team.VarSources != nil && len(*team.VarSources) > 0 {
+		// the secrets in the config of var sources are redacted by the API
+		varSourcesTable := ui.Table{
+			Headers: ui.TableRow{
+				{Contents: "var source", Color: color.New(color.Bold)},
+				{Contents: "type", Color: color.New(color.Bold)},
+				{Contents: "config", Color: color.New(color.Bold)},
+			},
+		}
+		for _, vs := range *team.VarSources {
+			config, err := json.Marshal(vs.Config)
+			if err != nil {
+				return err
+			}
+
+			varSourcesTable.Data = append(varSourcesTable.Data, ui.TableRow{
+				{Contents: vs.Name},
+				{Contents: vs.Type},
+				{Contents: string(config)},
+			})
+		}
+
+		fmt.Println()
+		err = varSourcesTable.Render(os.Stdout, Fly.PrintTableHeaders)
+		if err != nil {
+			return err
+		}
+	}
+
+	if team.SyslogDrains != nil && len(*team.SyslogDrains) > 0 {
+		// the credentials in the URLs of drains are redacted by the API
+		drainsTable := ui.Table{
+			Headers: ui.TableRow{
+				{Contents: "syslog drain", Color: color.New(color.Bold)},
+			},
+		}
+		for _, drain := range *team.SyslogDrains {
+			drainsTable.Data = append(drainsTable.Data, ui.TableRow{
+				{Contents: drain.URL},
+			})
+		}
+
+		fmt.Println()
+		err = drainsTable.Render(os.Stdout, Fly.PrintTableHeaders)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
@@ -1,10 +1,14 @@
 package executehelpers
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"sync"
 
 	"github.com/concourse/concourse/atc"
@@ -29,6 +33,7 @@ func DetermineInputs(
 	userInputMappings []flaghelpers.VariablePairFlag,
 	jobInputImage string,
 	inputsFrom flaghelpers.JobFlag,
+	pipelineRef atc.PipelineRef,
 	includeIgnored bool,
 	platform string,
 ) ([]Input, map[string]string, *atc.ImageResource, error) {
@@ -56,12 +61,30 @@ func DetermineInputs(
 		})
 	}
 
-	inputsFromLocal, err := GenerateLocalInputs(fact, team, localInputMappings, includeIgnored, platform)
+	var uploadedInputMappings, remoteInputMappings []flaghelpers.InputPairFlag
+	for _, mapping := range localInputMappings {
+		if mapping.IsRemote() {
+			remoteInputMappings = append(remoteInputMappings, mapping)
+		} else {
+			uploadedInputMappings = append(uploadedInputMappings, mapping)
+		}
+	}
+
+	inputsFromLocal, err := GenerateLocalInputs(fact, team, uploadedInputMappings, includeIgnored, platform)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	inputsFromJob, imageResourceFromJob, err := FetchInputsFromJob(fact, team, inputsFrom, jobInputImage)
+	inputsFromRemote, err := GenerateRemoteInputs(fact, remoteInputMappings)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	for name, input := range inputsFromRemote {
+		inputsFromLocal[name] = input
+	}
+
+	inputsFromJob, imageResourceFromJob, err := FetchInputsFromJob(fact, team, inputsFrom, pipelineRef, jobInputImage)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -184,29 +207,173 @@ func GenerateLocalInputs(
 	return inputs, nil
 }
 
-func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom flaghelpers.JobFlag, imageName string) (map[string]Input, *atc.ImageResource, error) {
+// GenerateRemoteInputs plans the inputs fetched by the build itself instead
+// of being uploaded. The ATC completes their configuration from the build's
+// pipeline, so that they use its credentials.
+func GenerateRemoteInputs(fact atc.PlanFactory, inputMappings []flaghelpers.InputPairFlag) (map[string]Input, error) {
+	inputs := map[string]Input{}
+
+	for _, mapping := range inputMappings {
+		var getPlan atc.GetPlan
+		if mapping.GitURI != "" {
+			commit, branch, err := ResolveGitRef(mapping.GitURI, mapping.GitRef)
+			if err != nil {
+				return nil, err
+			}
+
+			source := atc.Source{"uri": mapping.GitURI}
+			if branch != "" {
+				source["branch"] = branch
+			}
+
+			getPlan = atc.GetPlan{
+				Name:    mapping.Name,
+				Type:    "git",
+				Source:  source,
+				Version: &atc.Version{"ref": commit},
+			}
+		} else {
+			version := mapping.Version
+
+			getPlan = atc.GetPlan{
+				Name:     mapping.Name,
+				Resource: mapping.ResourceName,
+				Version:  &version,
+			}
+		}
+
+		inputs[mapping.Name] = Input{
+			Name: mapping.Name,
+			Plan: fact.NewPlan(getPlan),
+		}
+	}
+
+	return inputs, nil
+}
+
+var commitPattern = regexp.MustCompile(`^[0-9a-f]{4,40}$`)
+
+// ResolveGitRef returns the commit a branch or tag of the repository points
+// to, along with the branch or tag to clone so that the commit is fetched.
+//
+// The build's get step has to be given the commit rather than the ref, as
+// the fetched version is cached on the workers: a branch fetched by an
+// earlier build would otherwise be reused after it moved on.
+//
+// Refs which aren't the name of a branch or tag are taken to be commits.
+func ResolveGitRef(uri string, ref string) (string, string, error) {
+	if len(ref) == 40 && commitPattern.MatchString(ref) {
+		return ref, "", nil
+	}
+
+	stderr := new(bytes.Buffer)
+
+	lsRemote := exec.Command("git", "ls-remote", "--", uri, ref, ref+"^{}")
+	lsRemote.Stderr = stderr
+
+	output, err := lsRemote.Output()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to resolve `%s` of %s to a commit (give the commit instead): %s", ref, uri, strings.TrimSpace(stderr.String()))
+	}
+
+	commits := map[string]string{}
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 {
+			commits[fields[1]] = fields[0]
+		}
+	}
+
+	name := ref
+	if !strings.HasPrefix(ref, "refs/") {
+		name = "refs/tags/" + ref
+		if _, found := commits[name]; !found {
+			name = "refs/heads/" + ref
+		}
+	}
+
+	// annotated tags are peeled to the commit they tag
+	for _, candidate := range []string{name + "^{}", name} {
+		if commit, found := commits[candidate]; found {
+			branch := strings.TrimPrefix(strings.TrimPrefix(name, "refs/heads/"), "refs/tags/")
+			return commit, branch, nil
+		}
+	}
+
+	if commitPattern.MatchString(ref) {
+		return ref, "", nil
+	}
+
+	return "", "", fmt.Errorf("`%s` is neither a branch nor a tag of %s", ref, uri)
+}
+
+// DeterminePipeline returns the pipeline the build runs in, if any, which is
+// the one the inputs are fetched from. The inputs can't come from multiple
+// pipelines, and instance vars only make sense when they come from one.
+//
+// Git inputs are authenticated as the pipeline's resources, so they need one
+// too.
+func DeterminePipeline(inputMappings []flaghelpers.InputPairFlag, pipeline flaghelpers.PipelineFlag, inputsFrom flaghelpers.JobFlag, instanceVars []flaghelpers.InstanceVarPairFlag) (atc.PipelineRef, error) {
+	pipelineName := string(pipeline)
+
+	if inputsFrom.PipelineName != "" {
+		if pipelineName != "" && pipelineName != inputsFrom.PipelineName {
+			return atc.PipelineRef{}, fmt.Errorf("inputs can only come from a single pipeline, but the job's inputs come from `%s` rather than `%s`", inputsFrom.PipelineName, pipelineName)
+		}
+
+		pipelineName = inputsFrom.PipelineName
+	}
+
+	for _, mapping := range inputMappings {
+		if mapping.PipelineName == "" {
+			continue
+		}
+
+		if pipelineName != "" && pipelineName != mapping.PipelineName {
+			return atc.PipelineRef{}, fmt.Errorf("inputs can only come from a single pipeline, but `%s` comes from `%s` rather than `%s`", mapping.Name, mapping.PipelineName, pipelineName)
+		}
+
+		pipelineName = mapping.PipelineName
+	}
+
+	if pipelineName == "" {
+		for _, mapping := range inputMappings {
+			if mapping.GitURI != "" {
+				return atc.PipelineRef{}, fmt.Errorf("git input `%s` is authenticated as a resource of the build's pipeline, which must be given with --pipeline", mapping.Name)
+			}
+		}
+
+		if len(instanceVars) != 0 {
+			return atc.PipelineRef{}, errors.New("instance vars can only be given when the inputs come from a pipeline")
+		}
+	}
+
+	return flaghelpers.PipelineRef(flaghelpers.PipelineFlag(pipelineName), instanceVars), nil
+}
+
+func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom flaghelpers.JobFlag, pipelineRef atc.PipelineRef, imageName string) (map[string]Input, *atc.ImageResource, error) {
 	kvMap := map[string]Input{}
 
 	if inputsFrom.PipelineName == "" && inputsFrom.JobName == "" {
 		return kvMap, nil, nil
 	}
 
-	buildInputs, found, err := team.BuildInputsForJob(inputsFrom.PipelineName, inputsFrom.JobName)
+	buildInputs, found, err := team.BuildInputsForJob(pipelineRef, inputsFrom.JobName)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
-		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", inputsFrom.PipelineName, inputsFrom.JobName)
+		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", pipelineRef.String(), inputsFrom.JobName)
 	}
 
-	versionedResourceTypes, found, err := team.VersionedResourceTypes(inputsFrom.PipelineName)
+	versionedResourceTypes, found, err := team.VersionedResourceTypes(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
-		return nil, nil, fmt.Errorf("versioned resource types of %s not found", inputsFrom.PipelineName)
+		return nil, nil, fmt.Errorf("versioned resource types of %s not found", pipelineRef.String())
 	}
 
 	var imageResource *atc.ImageResource
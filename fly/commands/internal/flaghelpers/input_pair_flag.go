@@ -4,11 +4,28 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	gitInputPrefix      = "git:"
+	resourceInputPrefix = "resource:"
 )
 
+// InputPairFlag is an input to provide to a task, given either as a local
+// path to upload, a ref of a Git repository, or a version of a pipeline's
+// resource. The latter two are fetched by the build itself.
 type InputPairFlag struct {
 	Name string
 	Path string
+
+	GitURI string
+	GitRef string
+
+	PipelineName string
+	ResourceName string
+	Version      atc.Version
 }
 
 func (pair *InputPairFlag) UnmarshalFlag(value string) error {
@@ -17,6 +34,13 @@ func (pair *InputPairFlag) UnmarshalFlag(value string) error {
 		return fmt.Errorf("invalid input pair '%s' (must be name=path)", value)
 	}
 
+	switch {
+	case strings.HasPrefix(vs[1], gitInputPrefix):
+		return pair.unmarshalGit(vs[0], strings.TrimPrefix(vs[1], gitInputPrefix))
+	case strings.HasPrefix(vs[1], resourceInputPrefix):
+		return pair.unmarshalResource(vs[0], strings.TrimPrefix(vs[1], resourceInputPrefix))
+	}
+
 	matches, err := filepath.Glob(vs[1])
 	if err != nil {
 		return fmt.Errorf("failed to expand path '%s': %s", vs[1], err)
@@ -35,3 +59,53 @@ func (pair *InputPairFlag) UnmarshalFlag(value string) error {
 
 	return nil
 }
+
+// IsRemote returns whether the input is fetched by the build rather than
+// uploaded from a local path.
+func (pair InputPairFlag) IsRemote() bool {
+	return pair.GitURI != "" || pair.ResourceName != ""
+}
+
+func (pair *InputPairFlag) unmarshalGit(name string, value string) error {
+	hash := strings.LastIndex(value, "#")
+	if hash <= 0 || hash == len(value)-1 {
+		return fmt.Errorf("invalid git input '%s' (must be name=git:<uri>#<ref>)", name)
+	}
+
+	pair.Name = name
+	pair.GitURI = value[:hash]
+	pair.GitRef = value[hash+1:]
+
+	return nil
+}
+
+func (pair *InputPairFlag) unmarshalResource(name string, value string) error {
+	invalid := fmt.Errorf("invalid resource input '%s' (must be name=resource:<pipeline>/<resource>@<key:value>[,<key:value>])", name)
+
+	vs := strings.SplitN(value, "@", 2)
+	if len(vs) != 2 {
+		return invalid
+	}
+
+	names := strings.SplitN(vs[0], "/", 2)
+	if len(names) != 2 || names[0] == "" || names[1] == "" {
+		return invalid
+	}
+
+	version := atc.Version{}
+	for _, field := range strings.Split(vs[1], ",") {
+		kv := strings.SplitN(field, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return invalid
+		}
+
+		version[kv[0]] = kv[1]
+	}
+
+	pair.Name = name
+	pair.PipelineName = names[0]
+	pair.ResourceName = names[1]
+	pair.Version = version
+
+	return nil
+}
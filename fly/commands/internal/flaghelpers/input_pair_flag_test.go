@@ -0,0 +1,91 @@
+package flaghelpers_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InputPairFlag", func() {
+	var flag *InputPairFlag
+
+	BeforeEach(func() {
+		flag = &InputPairFlag{}
+	})
+
+	Context("when a path is given", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "input-pair-flag")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("expands the path", func() {
+			err := flag.UnmarshalFlag("some-input=" + filepath.Join(dir, "*"))
+			Expect(err).To(MatchError(ContainSubstring("does not exist")))
+
+			err = os.Mkdir(filepath.Join(dir, "some-dir"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = flag.UnmarshalFlag("some-input=" + filepath.Join(dir, "*"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(flag.Name).To(Equal("some-input"))
+			Expect(flag.Path).To(Equal(filepath.Join(dir, "some-dir")))
+			Expect(flag.IsRemote()).To(BeFalse())
+		})
+	})
+
+	Context("when a git ref is given", func() {
+		It("parses the uri and the ref", func() {
+			err := flag.UnmarshalFlag("some-input=git:git@example.com:some/repo.git#feature/some-branch")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(flag.Name).To(Equal("some-input"))
+			Expect(flag.GitURI).To(Equal("git@example.com:some/repo.git"))
+			Expect(flag.GitRef).To(Equal("feature/some-branch"))
+			Expect(flag.IsRemote()).To(BeTrue())
+		})
+
+		It("requires the ref", func() {
+			err := flag.UnmarshalFlag("some-input=git:https://example.com/some/repo.git")
+			Expect(err).To(MatchError("invalid git input 'some-input' (must be name=git:<uri>#<ref>)"))
+
+			err = flag.UnmarshalFlag("some-input=git:https://example.com/some/repo.git#")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when a resource version is given", func() {
+		It("parses the resource and the version", func() {
+			err := flag.UnmarshalFlag("some-input=resource:some-pipeline/some-resource@ref:abcd,path:some/path")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(flag.Name).To(Equal("some-input"))
+			Expect(flag.PipelineName).To(Equal("some-pipeline"))
+			Expect(flag.ResourceName).To(Equal("some-resource"))
+			Expect(flag.Version).To(Equal(atc.Version{"ref": "abcd", "path": "some/path"}))
+			Expect(flag.IsRemote()).To(BeTrue())
+		})
+
+		It("requires the pipeline, the resource and the version", func() {
+			for _, value := range []string{
+				"some-input=resource:some-resource@ref:abcd",
+				"some-input=resource:some-pipeline/some-resource",
+				"some-input=resource:some-pipeline/some-resource@abcd",
+			} {
+				err := flag.UnmarshalFlag(value)
+				Expect(err).To(MatchError(ContainSubstring("invalid resource input 'some-input'")), value)
+			}
+		})
+	})
+})
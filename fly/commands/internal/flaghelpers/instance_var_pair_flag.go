@@ -0,0 +1,44 @@
+package flaghelpers
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type InstanceVarPairFlag struct {
+	Name  string
+	Value interface{}
+}
+
+func (pair *InstanceVarPairFlag) UnmarshalFlag(value string) error {
+	vs := strings.SplitN(value, "=", 2)
+	if len(vs) != 2 {
+		return fmt.Errorf("invalid input pair '%s' (must be name=value)", value)
+	}
+
+	pair.Name = vs[0]
+
+	err := yaml.Unmarshal([]byte(vs[1]), &pair.Value)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func PipelineRef(pipeline PipelineFlag, instanceVars []InstanceVarPairFlag) atc.PipelineRef {
+	ref := atc.PipelineRef{Name: string(pipeline)}
+
+	if len(instanceVars) != 0 {
+		ref.InstanceVars = atc.InstanceVars{}
+		for _, pair := range instanceVars {
+			ref.InstanceVars[pair.Name] = pair.Value
+		}
+	}
+
+	return ref
+}
@@ -0,0 +1,51 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceVarPairFlag", func() {
+	It("parses the value as YAML", func() {
+		pair := &InstanceVarPairFlag{}
+
+		err := pair.UnmarshalFlag("version=1.13")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pair.Name).To(Equal("version"))
+		Expect(pair.Value).To(Equal(1.13))
+	})
+
+	It("parses nested maps so they can be encoded as JSON", func() {
+		pair := &InstanceVarPairFlag{}
+
+		err := pair.UnmarshalFlag("release={major: 1, minor: 2}")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pair.Value).To(Equal(map[string]interface{}{"major": float64(1), "minor": float64(2)}))
+	})
+
+	It("errors when there is no value", func() {
+		pair := &InstanceVarPairFlag{}
+
+		err := pair.UnmarshalFlag("version")
+		Expect(err).To(MatchError("invalid input pair 'version' (must be name=value)"))
+	})
+})
+
+var _ = Describe("PipelineRef", func() {
+	It("does not set instance vars when none are given", func() {
+		Expect(PipelineRef("some-pipeline", nil)).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+	})
+
+	It("collects the instance vars", func() {
+		ref := PipelineRef("some-pipeline", []InstanceVarPairFlag{
+			{Name: "branch", Value: "feature"},
+		})
+		Expect(ref).To(Equal(atc.PipelineRef{
+			Name:         "some-pipeline",
+			InstanceVars: atc.InstanceVars{"branch": "feature"},
+		}))
+	})
+})
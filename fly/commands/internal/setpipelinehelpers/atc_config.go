@@ -1,12 +1,14 @@
 package setpipelinehelpers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
-	"sigs.k8s.io/yaml"
+	"sort"
 
 	"github.com/vito/go-interact/interact"
+	"sigs.k8s.io/yaml"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -17,7 +19,7 @@ import (
 )
 
 type ATCConfig struct {
-	PipelineName     string
+	PipelineRef      atc.PipelineRef
 	Team             concourse.Team
 	TargetName       rc.TargetName
 	Target           string
@@ -45,7 +47,7 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
-	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineName)
+	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
 	if err != nil {
 		return err
 	}
@@ -69,7 +71,7 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	}
 
 	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfig(
-		atcConfig.PipelineName,
+		atcConfig.PipelineRef,
 		existingConfigVersion,
 		evaluatedTemplate,
 		atcConfig.CheckCredentials,
@@ -83,11 +85,39 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	}
 
 	atcConfig.showPipelineUpdateResult(created, updated)
+
+	// an archived pipeline has no config, and setting it un-archives it but
+	// leaves it paused
+	if updated && len(existingConfig.Jobs) == 0 && len(existingConfig.Resources) == 0 {
+		pipeline, found, err := atcConfig.Team.Pipeline(atcConfig.PipelineRef)
+		if err != nil {
+			return err
+		}
+
+		if found && pipeline.Paused {
+			fmt.Println("")
+			atcConfig.showPausedPipelineHint()
+		}
+	}
+
 	return nil
 }
 
 func (atcConfig ATCConfig) UnpausePipelineCommand() string {
-	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineName)
+	command := fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineRef.Name)
+
+	names := make([]string, 0, len(atcConfig.PipelineRef.InstanceVars))
+	for name := range atcConfig.PipelineRef.InstanceVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value, _ := json.Marshal(atcConfig.PipelineRef.InstanceVars[name])
+		command += fmt.Sprintf(" -i '%s=%s'", name, value)
+	}
+
+	return command
 }
 
 func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool) {
@@ -100,23 +130,29 @@ func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool)
 			fmt.Println("Could not parse targetURL")
 		}
 
-		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineName)
+		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineRef.Name)
 		if err != nil {
 			fmt.Println("Could not parse pipelineURL")
 		}
 
+		pipelineURL.RawQuery = atcConfig.PipelineRef.QueryParams().Encode()
+
 		fmt.Println("pipeline created!")
 		fmt.Printf("you can view your pipeline here: %s\n", targetURL.ResolveReference(pipelineURL))
 		fmt.Println("")
-		fmt.Println("the pipeline is currently paused. to unpause, either:")
-		fmt.Println("  - run the unpause-pipeline command:")
-		fmt.Println("    " + atcConfig.UnpausePipelineCommand())
-		fmt.Println("  - click play next to the pipeline in the web ui")
+		atcConfig.showPausedPipelineHint()
 	} else {
 		panic("Something really went wrong!")
 	}
 }
 
+func (atcConfig ATCConfig) showPausedPipelineHint() {
+	fmt.Println("the pipeline is currently paused. to unpause, either:")
+	fmt.Println("  - run the unpause-pipeline command:")
+	fmt.Println("    " + atcConfig.UnpausePipelineCommand())
+	fmt.Println("  - click play next to the pipeline in the web ui")
+}
+
 func diff(existingConfig atc.Config, newConfig atc.Config) bool {
 	stdout, _ := ui.ForTTY(os.Stdout)
 	return existingConfig.Diff(stdout, newConfig)
@@ -29,6 +29,7 @@ type LoginCommand struct {
 	TeamName    string       `short:"n" long:"team-name" description:"Team to authenticate with"`
 	CACert      atc.PathFlag `long:"ca-cert" description:"Path to Concourse PEM-encoded CA certificate file."`
 	OpenBrowser bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
+	APIToken    string       `long:"api-token" description:"API token to authenticate with, as created by create-token"`
 
 	BrowserOnly bool
 }
@@ -90,6 +91,10 @@ func (command *LoginCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.APIToken != "" {
+		return command.apiTokenLogin(target)
+	}
+
 	var tokenType string
 	var tokenValue string
 
@@ -156,6 +161,39 @@ func (command *LoginCommand) Execute(args []string) error {
 	)
 }
 
+// apiTokenLogin saves the API token after checking that it grants access to
+// the team.
+func (command *LoginCommand) apiTokenLogin(target rc.Target) error {
+	token := &rc.TargetToken{
+		Type:  "Bearer",
+		Value: command.APIToken,
+	}
+
+	authenticated, err := rc.NewAuthenticatedTarget(
+		Fly.Target,
+		target.URL(),
+		command.TeamName,
+		command.Insecure,
+		token,
+		target.CACert(),
+		Fly.Verbose,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = authenticated.Team().APITokens()
+	if err != nil {
+		if err == concourse.ErrUnauthorized || err == concourse.ErrForbidden {
+			return fmt.Errorf("api token does not grant access to team '%s'", command.TeamName)
+		}
+
+		return err
+	}
+
+	return command.saveTarget(target.URL(), token, target.CACert())
+}
+
 func (command *LoginCommand) passwordGrant(client concourse.Client, username, password string) (string, string, error) {
 
 	oauth2Config := oauth2.Config{
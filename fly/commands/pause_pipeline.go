@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"github.com/concourse/concourse/atc"
 
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
@@ -9,8 +10,9 @@ import (
 )
 
 type PausePipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline" description:"Pipeline to pause"`
-	All      bool                     `short:"a"  long:"all"      description:"Pause all pipelines"`
+	Pipeline     flaghelpers.PipelineFlag          `short:"p"  long:"pipeline" description:"Pipeline to pause"`
+	InstanceVars []flaghelpers.InstanceVarPairFlag `short:"i"  long:"instance-var" value-name:"[NAME=YAML]" description:"Instance var identifying an instance of the pipeline"`
+	All          bool                              `short:"a"  long:"all"      description:"Pause all pipelines"`
 }
 
 func (command *PausePipelineCommand) Validate() error {
@@ -41,9 +43,9 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	var pipelineNames []string
+	var pipelineRefs []atc.PipelineRef
 	if string(command.Pipeline) != "" {
-		pipelineNames = []string{string(command.Pipeline)}
+		pipelineRefs = []atc.PipelineRef{flaghelpers.PipelineRef(command.Pipeline, command.InstanceVars)}
 	}
 
 	if command.All {
@@ -53,20 +55,20 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		}
 
 		for _, pipeline := range pipelines {
-			pipelineNames = append(pipelineNames, pipeline.Name)
+			pipelineRefs = append(pipelineRefs, pipeline.Ref())
 		}
 	}
 
-	for _, pipelineName := range pipelineNames {
-		found, err := target.Team().PausePipeline(pipelineName)
+	for _, pipelineRef := range pipelineRefs {
+		found, err := target.Team().PausePipeline(pipelineRef)
 		if err != nil {
 			return err
 		}
 
 		if found {
-			fmt.Printf("paused '%s'\n", pipelineName)
+			fmt.Printf("paused '%s'\n", pipelineRef.String())
 		} else {
-			displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+			displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
 		}
 	}
 
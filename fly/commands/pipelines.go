@@ -11,8 +11,9 @@ import (
 )
 
 type PipelinesCommand struct {
-	All  bool `short:"a"  long:"all" description:"Show all pipelines"`
-	Json bool `long:"json" description:"Print command result as JSON"`
+	All             bool `short:"a"  long:"all" description:"Show all pipelines"`
+	IncludeArchived bool `long:"include-archived" description:"Show archived pipelines"`
+	Json            bool `long:"json" description:"Print command result as JSON"`
 }
 
 func (command *PipelinesCommand) Execute([]string) error {
@@ -40,6 +41,14 @@ func (command *PipelinesCommand) Execute([]string) error {
 		return err
 	}
 
+	if command.IncludeArchived {
+		headers = append(headers, "archived")
+	} else {
+		pipelines = unarchivedPipelines(pipelines)
+	}
+
+	pipelines = groupInstances(pipelines)
+
 	if command.Json {
 		err = displayhelpers.JsonPrint(pipelines)
 		if err != nil {
@@ -71,15 +80,64 @@ func (command *PipelinesCommand) Execute([]string) error {
 		}
 
 		row := ui.TableRow{}
-		row = append(row, ui.TableCell{Contents: p.Name})
+		row = append(row, ui.TableCell{Contents: p.Ref().String()})
 		if command.All {
 			row = append(row, ui.TableCell{Contents: p.TeamName})
 		}
 		row = append(row, pausedColumn)
 		row = append(row, publicColumn)
+		if command.IncludeArchived {
+			var archivedColumn ui.TableCell
+			if p.Archived {
+				archivedColumn.Contents = "yes"
+				archivedColumn.Color = ui.OnColor
+			} else {
+				archivedColumn.Contents = "no"
+			}
+
+			row = append(row, archivedColumn)
+		}
 
 		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+func unarchivedPipelines(pipelines []atc.Pipeline) []atc.Pipeline {
+	unarchived := []atc.Pipeline{}
+	for _, p := range pipelines {
+		if !p.Archived {
+			unarchived = append(unarchived, p)
+		}
+	}
+
+	return unarchived
+}
+
+// groupInstances moves the instances of a pipeline up next to the first of
+// them, keeping the order of everything else.
+func groupInstances(pipelines []atc.Pipeline) []atc.Pipeline {
+	type pipelineName struct {
+		team string
+		name string
+	}
+
+	var names []pipelineName
+	instances := map[pipelineName][]atc.Pipeline{}
+	for _, p := range pipelines {
+		name := pipelineName{p.TeamName, p.Name}
+		if _, seen := instances[name]; !seen {
+			names = append(names, name)
+		}
+
+		instances[name] = append(instances[name], p)
+	}
+
+	grouped := make([]atc.Pipeline, 0, len(pipelines))
+	for _, name := range names {
+		grouped = append(grouped, instances[name]...)
+	}
+
+	return grouped
+}
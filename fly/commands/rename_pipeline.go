@@ -9,8 +9,9 @@ import (
 )
 
 type RenamePipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"o"  long:"old-name" required:"true"  description:"Pipeline to rename"`
-	Name     flaghelpers.PipelineFlag `short:"n"  long:"new-name" required:"true"  description:"Name to set as pipeline name"`
+	Pipeline     flaghelpers.PipelineFlag          `short:"o"  long:"old-name" required:"true"  description:"Pipeline to rename"`
+	InstanceVars []flaghelpers.InstanceVarPairFlag `short:"i"  long:"instance-var" value-name:"[NAME=YAML]" description:"Instance var identifying an instance of the pipeline"`
+	Name         flaghelpers.PipelineFlag          `short:"n"  long:"new-name" required:"true"  description:"Name to set as pipeline name"`
 }
 
 func (command *RenamePipelineCommand) Validate() error {
@@ -38,16 +39,16 @@ func (command *RenamePipelineCommand) Execute([]string) error {
 		return err
 	}
 
-	oldName := string(command.Pipeline)
+	pipelineRef := flaghelpers.PipelineRef(command.Pipeline, command.InstanceVars)
 	newName := string(command.Name)
 
-	found, err := target.Team().RenamePipeline(oldName, newName)
+	found, err := target.Team().RenamePipeline(pipelineRef, newName)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		displayhelpers.Failf("pipeline '%s' not found\n", oldName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
 		return nil
 	}
 
@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ResolveVarCommand struct {
+	Pipeline     flaghelpers.PipelineFlag          `short:"p" long:"pipeline" required:"true" description:"Pipeline whose var to resolve"`
+	InstanceVars []flaghelpers.InstanceVarPairFlag `short:"i" long:"instance-var" value-name:"[NAME=YAML]" description:"Instance var identifying an instance of the pipeline"`
+	Var          string                            `short:"v" long:"var" required:"true" value-name:"[SOURCE:]NAME" description:"Name of the var to resolve, optionally prefixed with the name of a var source"`
+	Reveal       bool                              `long:"reveal" description:"Show the value of the var. Only allowed for team owners"`
+	JSON         bool                              `short:"j" long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ResolveVarCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *ResolveVarCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := flaghelpers.PipelineRef(command.Pipeline, command.InstanceVars)
+
+	trace, found, err := target.Team().ResolveVar(pipelineRef, command.Var, command.Reveal)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	if command.JSON {
+		return displayhelpers.JsonPrint(trace)
+	}
+
+	if trace.Local {
+		fmt.Printf("%s is a local var, which is only set within a build, e.g. by a load_var step\n", trace.Name)
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "source", Color: color.New(color.Bold)},
+			{Contents: "path", Color: color.New(color.Bold)},
+			{Contents: "found", Color: color.New(color.Bold)},
+			{Contents: "chosen", Color: color.New(color.Bold)},
+		},
+	}
+
+	var chosen *atc.VarLookup
+	for i, lookup := range trace.Lookups {
+		sourceCell := ui.TableCell{Contents: lookup.Source}
+		if lookup.Source == "" {
+			sourceCell.Contents = "credential manager"
+			sourceCell.Color = color.New(color.Faint)
+		}
+
+		foundCell := boolCell(lookup.Found)
+		if lookup.Error != "" {
+			foundCell = ui.TableCell{Contents: "error: " + lookup.Error, Color: color.New(color.FgRed)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			sourceCell,
+			{Contents: lookup.Path},
+			foundCell,
+			boolCell(lookup.Chosen),
+		})
+
+		if lookup.Chosen {
+			chosen = &trace.Lookups[i]
+		}
+	}
+
+	err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+
+	if chosen == nil {
+		fmt.Printf("%s could not be resolved\n", trace.Name)
+		return nil
+	}
+
+	fmt.Printf("%s resolves from %s\n", trace.Name, chosen.Path)
+
+	if trace.Value != nil {
+		value, err := json.Marshal(trace.Value)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("value: %s\n", value)
+	}
+
+	return nil
+}
+
+func boolCell(value bool) ui.TableCell {
+	if value {
+		return ui.TableCell{Contents: "yes", Color: ui.OnColor}
+	}
+
+	return ui.TableCell{Contents: "no"}
+}
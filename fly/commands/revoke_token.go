@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type RevokeTokenCommand struct {
+	Name string `short:"n" long:"name" required:"true" description:"Name of the token to revoke"`
+	Team string `long:"team" description:"Name of the team to which the token belongs, if different from the target default"`
+}
+
+func (command *RevokeTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := GetTeam(target, command.Team)
+	found, err := team.RevokeAPIToken(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("token '%s' not found on team %s", command.Name, team.Name())
+	}
+
+	fmt.Printf("revoked token '%s'\n", command.Name)
+
+	return nil
+}
@@ -18,6 +18,8 @@ type SetPipelineCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to configure"`
 	Config   atc.PathFlag             `short:"c"  long:"config"    required:"true"  description:"Pipeline configuration file"`
 
+	InstanceVars []flaghelpers.InstanceVarPairFlag `short:"i"  long:"instance-var"  value-name:"[NAME=YAML]"  description:"Specify an instance var identifying an instance of the pipeline. Instance vars are also available as variables in the pipeline"`
+
 	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 
@@ -35,7 +37,7 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 	}
 	configPath := command.Config
 	templateVariablesFiles := command.VarsFrom
-	pipelineName := string(command.Pipeline)
+	pipelineRef := flaghelpers.PipelineRef(command.Pipeline, command.InstanceVars)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -51,13 +53,21 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 
 	atcConfig := setpipelinehelpers.ATCConfig{
 		Team:             target.Team(),
-		PipelineName:     pipelineName,
+		PipelineRef:      pipelineRef,
 		TargetName:       Fly.Target,
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
 	}
 
-	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar)
+	yamlVars := command.YAMLVar
+	for _, pair := range command.InstanceVars {
+		yamlVars = append(yamlVars, flaghelpers.YAMLVariablePairFlag{
+			Name:  pair.Name,
+			Value: pair.Value,
+		})
+	}
+
+	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, yamlVars)
 	return atcConfig.Set(yamlTemplateWithParams)
 }
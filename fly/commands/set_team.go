@@ -1,7 +1,9 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"sort"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/concourse/concourse/skymarshal/skycmd"
 	"github.com/jessevdk/go-flags"
 	"github.com/vito/go-interact/interact"
+	"sigs.k8s.io/yaml"
 )
 
 func WireTeamConnectors(command *flags.Command) {
@@ -25,9 +28,17 @@ func WireTeamConnectors(command *flags.Command) {
 }
 
 type SetTeamCommand struct {
-	Team            flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
-	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
-	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+	Team                 flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
+	SkipInteractive      bool                 `long:"non-interactive" description:"Force apply configuration"`
+	DefaultBuildPriority int                  `long:"default-build-priority" description:"Priority of the builds of the team's jobs which do not configure their own"`
+	MaxRunningBuilds     int                  `long:"max-running-builds" description:"Maximum number of builds of the team which may run at the same time (0 means unlimited)"`
+	VarSources           []atc.PathFlag       `long:"var-source" value-name:"PATH" description:"Path to a YAML file declaring a var source shared by the team's pipelines. Can be specified multiple times."`
+	RemoveVarSources     bool                 `long:"remove-var-sources" description:"Remove the var sources shared by the team's pipelines"`
+	PipelineAuth         atc.PathFlag         `long:"pipeline-auth" value-name:"PATH" description:"Path to a YAML file binding roles in individual pipelines of the team to users and groups, in addition to their roles in the team"`
+	RemovePipelineAuth   bool                 `long:"remove-pipeline-auth" description:"Remove the roles bound in individual pipelines of the team"`
+	SyslogDrains         []string             `long:"syslog-drain" value-name:"URL" description:"Destination to send the events of the team's builds to, e.g. tls://logs.example.com:6514 or https://logs.example.com/ingest. Can be specified multiple times."`
+	RemoveSyslogDrains   bool                 `long:"remove-syslog-drains" description:"Stop sending the events of the team's builds to its syslog drains"`
+	AuthFlags            skycmd.AuthTeamFlags `group:"Authentication"`
 }
 
 func (command *SetTeamCommand) Execute([]string) error {
@@ -47,6 +58,21 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	varSources, err := command.loadVarSources()
+	if err != nil {
+		return err
+	}
+
+	pipelineAuth, err := command.loadPipelineAuth()
+	if err != nil {
+		return err
+	}
+
+	syslogDrains, err := command.syslogDrains()
+	if err != nil {
+		return err
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -57,28 +83,63 @@ func (command *SetTeamCommand) Execute([]string) error {
 	fmt.Println("setting team:", ui.Embolden("%s", teamName))
 
 	for _, role := range roles {
-		authUsers := authRoles[role]["users"]
-		authGroups := authRoles[role]["groups"]
+		printRoleAuth(fmt.Sprintf("role %s", ui.Embolden(role)), authRoles[role])
+	}
 
+	if pipelineAuth != nil && len(*pipelineAuth) == 0 {
 		fmt.Println()
-		fmt.Printf("role %s:\n", ui.Embolden(role))
-		fmt.Printf("  users:\n")
-		if len(authUsers) > 0 {
-			for _, user := range authUsers {
-				fmt.Printf("  - %s\n", user)
+		fmt.Printf("pipeline auth: %s\n", ui.OffColor.Sprint("none (will be removed)"))
+	} else if pipelineAuth != nil {
+		pipelines := []string{}
+		for pipeline := range *pipelineAuth {
+			pipelines = append(pipelines, pipeline)
+		}
+		sort.Strings(pipelines)
+
+		for _, pipeline := range pipelines {
+			pipelineRoles := []string{}
+			for role := range (*pipelineAuth)[pipeline] {
+				pipelineRoles = append(pipelineRoles, role)
+			}
+			sort.Strings(pipelineRoles)
+
+			for _, role := range pipelineRoles {
+				printRoleAuth(fmt.Sprintf("role %s in pipeline %s", ui.Embolden(role), ui.Embolden(pipeline)), (*pipelineAuth)[pipeline][role])
 			}
-		} else {
-			fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
 		}
+	}
 
+	if command.DefaultBuildPriority != 0 {
 		fmt.Println()
-		fmt.Printf("  groups:\n")
-		if len(authGroups) > 0 {
-			for _, group := range authGroups {
-				fmt.Printf("  - %s\n", group)
+		fmt.Printf("default build priority: %d\n", command.DefaultBuildPriority)
+	}
+
+	if command.MaxRunningBuilds != 0 {
+		fmt.Println()
+		fmt.Printf("max running builds: %d\n", command.MaxRunningBuilds)
+	}
+
+	if varSources != nil {
+		fmt.Println()
+		if len(*varSources) == 0 {
+			fmt.Printf("var sources: %s\n", ui.OffColor.Sprint("none (will be removed)"))
+		} else {
+			fmt.Printf("var sources:\n")
+			for _, vs := range *varSources {
+				fmt.Printf("- %s (%s)\n", vs.Name, vs.Type)
 			}
+		}
+	}
+
+	if syslogDrains != nil {
+		fmt.Println()
+		if len(*syslogDrains) == 0 {
+			fmt.Printf("syslog drains: %s\n", ui.OffColor.Sprint("none (will be removed)"))
 		} else {
-			fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
+			fmt.Printf("syslog drains:\n")
+			for _, drain := range *syslogDrains {
+				fmt.Printf("- %s\n", drain.URL)
+			}
 		}
 	}
 
@@ -95,7 +156,14 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: atc.TeamAuth(authRoles)}
+	team := atc.Team{
+		Auth:                 atc.TeamAuth(authRoles),
+		PipelineAuth:         pipelineAuth,
+		DefaultBuildPriority: command.DefaultBuildPriority,
+		MaxRunningBuilds:     command.MaxRunningBuilds,
+		VarSources:           varSources,
+		SyslogDrains:         syslogDrains,
+	}
 
 	_, created, updated, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
@@ -111,6 +179,131 @@ func (command *SetTeamCommand) Execute([]string) error {
 	return nil
 }
 
+func printRoleAuth(title string, auth map[string][]string) {
+	authUsers := auth["users"]
+	authGroups := auth["groups"]
+
+	fmt.Println()
+	fmt.Printf("%s:\n", title)
+	fmt.Printf("  users:\n")
+	if len(authUsers) > 0 {
+		for _, user := range authUsers {
+			fmt.Printf("  - %s\n", user)
+		}
+	} else {
+		fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
+	}
+
+	fmt.Println()
+	fmt.Printf("  groups:\n")
+	if len(authGroups) > 0 {
+		for _, group := range authGroups {
+			fmt.Printf("  - %s\n", group)
+		}
+	} else {
+		fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
+	}
+}
+
+// syslogDrains returns nil when no drains are given, leaving the team's
+// drains as they are, and an empty list when they are removed.
+func (command *SetTeamCommand) syslogDrains() (*[]atc.SyslogDrainConfig, error) {
+	if command.RemoveSyslogDrains {
+		if len(command.SyslogDrains) > 0 {
+			return nil, errors.New("--syslog-drain cannot be given along with --remove-syslog-drains")
+		}
+
+		return &[]atc.SyslogDrainConfig{}, nil
+	}
+
+	if len(command.SyslogDrains) == 0 {
+		return nil, nil
+	}
+
+	drains := []atc.SyslogDrainConfig{}
+	for _, url := range command.SyslogDrains {
+		drains = append(drains, atc.SyslogDrainConfig{URL: url})
+	}
+
+	return &drains, nil
+}
+
+// loadVarSources returns nil when no var sources are given, leaving the
+// team's var sources as they are, and an empty list when they are removed.
+func (command *SetTeamCommand) loadVarSources() (*atc.VarSourceConfigs, error) {
+	if command.RemoveVarSources {
+		if len(command.VarSources) > 0 {
+			return nil, errors.New("--var-source cannot be given along with --remove-var-sources")
+		}
+
+		return &atc.VarSourceConfigs{}, nil
+	}
+
+	if len(command.VarSources) == 0 {
+		return nil, nil
+	}
+
+	varSources := atc.VarSourceConfigs{}
+	for _, path := range command.VarSources {
+		content, err := ioutil.ReadFile(string(path))
+		if err != nil {
+			return nil, err
+		}
+
+		var varSource atc.VarSourceConfig
+		err = yaml.Unmarshal(content, &varSource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse var source %s: %s", path, err)
+		}
+
+		varSources = append(varSources, varSource)
+	}
+
+	return &varSources, nil
+}
+
+// loadPipelineAuth reads the roles to bind in pipelines, keyed by pipeline
+// name and then by role, e.g.:
+//
+//	deploy-prod:
+//	  member:
+//	    users: ["github:some-user"]
+//	    groups: ["github:some-org:some-team"]
+//
+// It returns nil when no path is given, leaving the roles bound in the team's
+// pipelines as they are, and an empty map when they are removed.
+func (command *SetTeamCommand) loadPipelineAuth() (*atc.PipelineAuth, error) {
+	path := string(command.PipelineAuth)
+	if command.RemovePipelineAuth {
+		if path != "" {
+			return nil, errors.New("--pipeline-auth cannot be given along with --remove-pipeline-auth")
+		}
+
+		return &atc.PipelineAuth{}, nil
+	}
+
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var pipelineAuth atc.PipelineAuth
+	err = yaml.Unmarshal(content, &pipelineAuth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pipeline auth %s: %s", path, err)
+	}
+
+	if pipelineAuth == nil {
+		pipelineAuth = atc.PipelineAuth{}
+	}
+
+	return &pipelineAuth, nil
+}
+
 func (command *SetTeamCommand) ErrorAuthNotConfigured(err error) {
 	switch err {
 	case skycmd.ErrAuthNotConfiguredFromFile:
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type SetWebhookCommand struct {
+	Provider string `short:"p" long:"provider" required:"true" description:"Provider sending the push events (github, gitlab or bitbucket)"`
+	Secret   string `short:"s" long:"secret" required:"true" description:"Secret the push events are signed with"`
+	Team     string `long:"team" description:"Name of the team to receive the push events, if different from the target default"`
+}
+
+func (command *SetWebhookCommand) Execute([]string) error {
+	if !validWebhookProvider(command.Provider) {
+		return fmt.Errorf("unknown provider '%s', must be one of: %s", command.Provider, strings.Join(atc.WebhookProviders, ", "))
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := GetTeam(target, command.Team)
+	err = team.SetWebhook(command.Provider, command.Secret)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("webhook set, configure %s to send push events to:\n\n", command.Provider)
+	fmt.Printf("  %s/api/v1/teams/%s/webhooks/%s\n", target.URL(), team.Name(), command.Provider)
+
+	return nil
+}
+
+func validWebhookProvider(provider string) bool {
+	for _, p := range atc.WebhookProviders {
+		if p == provider {
+			return true
+		}
+	}
+
+	return false
+}
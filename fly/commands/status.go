@@ -2,7 +2,9 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	jwt "github.com/dgrijalva/jwt-go"
@@ -23,7 +25,15 @@ func (c *StatusCommand) Execute([]string) error {
 		return nil
 	}
 
-	if tToken != nil {
+	if strings.HasPrefix(tToken.Value, atc.APITokenPrefix) {
+		// api tokens are opaque and not known to skymarshal, so check them
+		// against the api instead
+		_, err = target.Team().APITokens()
+		if err != nil {
+			displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
+			return nil
+		}
+	} else if tToken != nil {
 		_, err := jwt.Parse(tToken.Value, func(token *jwt.Token) (interface{}, error) {
 			return nil, token.Claims.Valid()
 		})
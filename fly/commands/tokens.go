@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type TokensCommand struct {
+	Team string `long:"team" description:"Name of the team to list the tokens of, if different from the target default"`
+	Json bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *TokensCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	apiTokens, err := GetTeam(target, command.Team).APITokens()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(apiTokens)
+	}
+
+	headers := ui.TableRow{
+		{Contents: "name", Color: color.New(color.Bold)},
+		{Contents: "role", Color: color.New(color.Bold)},
+		{Contents: "acts as", Color: color.New(color.Bold)},
+		{Contents: "created", Color: color.New(color.Bold)},
+		{Contents: "expires", Color: color.New(color.Bold)},
+		{Contents: "last used", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, apiToken := range apiTokens {
+		actsAsCell := ui.TableCell{Contents: apiToken.CreatedBy}
+		if apiToken.ServiceAccount {
+			actsAsCell = ui.TableCell{Contents: "service account", Color: color.New(color.Faint)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: apiToken.Name},
+			{Contents: apiToken.Role},
+			actsAsCell,
+			{Contents: time.Unix(apiToken.CreatedAt, 0).Format(timeDateLayout)},
+			tokenTimeCell(apiToken.ExpiresAt, "never"),
+			tokenTimeCell(apiToken.LastUsedAt, "never"),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func tokenTimeCell(unix int64, never string) ui.TableCell {
+	if unix == 0 {
+		return ui.TableCell{Contents: never, Color: color.New(color.Faint)}
+	}
+
+	return ui.TableCell{Contents: time.Unix(unix, 0).Format(timeDateLayout)}
+}
@@ -54,6 +54,11 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 
 		signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
 
+		err = printQueuePosition(target.Client(), build)
+		if err != nil {
+			return err
+		}
+
 		fmt.Println("")
 		eventSource, err := target.Client().BuildEvents(fmt.Sprintf("%d", build.ID))
 		if err != nil {
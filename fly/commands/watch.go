@@ -6,9 +6,11 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type WatchCommand struct {
@@ -84,6 +86,11 @@ func (command *WatchCommand) Execute(args []string) error {
 			return err
 		}
 		buildId = build.ID
+
+		err = printQueuePosition(client, build)
+		if err != nil {
+			return err
+		}
 	} else if command.Build != "" {
 		buildId, err = strconv.Atoi(command.Build)
 
@@ -113,3 +120,22 @@ func (command *WatchCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// printQueuePosition shows where a pending build is in the queue of builds
+// waiting to be started, so that it's clear why it hasn't started yet.
+func printQueuePosition(client concourse.Client, build atc.Build) error {
+	if build.Status != string(atc.StatusPending) {
+		return nil
+	}
+
+	position, found, err := client.BuildQueuePosition(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	if found {
+		fmt.Printf("waiting to start at position %d in the build queue (priority %d)\n", position.Position, position.Priority)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("audit-log", func() {
+		var (
+			flyCmd *exec.Cmd
+			query  string
+		)
+
+		events := []atc.AuditEvent{
+			{
+				ID:        2,
+				Time:      1585603825,
+				UserName:  "some-user",
+				TeamName:  "main",
+				Action:    atc.SaveConfig,
+				Target:    "/api/v1/teams/main/pipelines/some-pipeline/config",
+				RequestID: "some-request-id",
+				SourceIP:  "1.2.3.4",
+				Status:    http.StatusForbidden,
+			},
+			{
+				ID:        1,
+				Time:      1585600225,
+				UserName:  "admin",
+				Action:    atc.SetWall,
+				Target:    "/api/v1/wall",
+				RequestID: "other-request-id",
+				SourceIP:  "5.6.7.8",
+				Status:    http.StatusOK,
+			},
+		}
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "audit-log")
+			query = "limit=50"
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/audit_events", query),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, events),
+				),
+			)
+		})
+
+		It("prints the audited requests", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "time", Color: color.New(color.Bold)},
+					{Contents: "user", Color: color.New(color.Bold)},
+					{Contents: "team", Color: color.New(color.Bold)},
+					{Contents: "action", Color: color.New(color.Bold)},
+					{Contents: "target", Color: color.New(color.Bold)},
+					{Contents: "status", Color: color.New(color.Bold)},
+					{Contents: "source ip", Color: color.New(color.Bold)},
+					{Contents: "request id", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: time.Unix(1585603825, 0).Local().Format(timeDateLayout)},
+						{Contents: "some-user"},
+						{Contents: "main"},
+						{Contents: "SaveConfig"},
+						{Contents: "/api/v1/teams/main/pipelines/some-pipeline/config"},
+						{Contents: "403", Color: color.New(color.FgRed)},
+						{Contents: "1.2.3.4"},
+						{Contents: "some-request-id"},
+					},
+					{
+						{Contents: time.Unix(1585600225, 0).Local().Format(timeDateLayout)},
+						{Contents: "admin"},
+						{Contents: "none", Color: color.New(color.Faint)},
+						{Contents: "SetWall"},
+						{Contents: "/api/v1/wall"},
+						{Contents: "200"},
+						{Contents: "5.6.7.8"},
+						{Contents: "other-request-id"},
+					},
+				},
+			}))
+		})
+
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				since := time.Date(2020, 3, 30, 10, 0, 0, 0, time.Local)
+				until := time.Date(2020, 3, 31, 10, 0, 0, 0, time.Local)
+
+				flyCmd.Args = append(flyCmd.Args,
+					"--user", "some-user",
+					"--team", "main",
+					"--action", "SaveConfig",
+					"--since", "2020-03-30 10:00:00",
+					"--until", "2020-03-31 10:00:00",
+					"--count", "10",
+				)
+
+				query = "action=SaveConfig&from=" + strconv.FormatInt(since.Unix(), 10) + "&limit=10&team=main&to=" + strconv.FormatInt(until.Unix(), 10) + "&user=some-user"
+			})
+
+			It("asks for the matching requests", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(4))
+			})
+		})
+
+		Context("when --since is after --until", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args,
+					"--since", "2020-03-31 10:00:00",
+					"--until", "2020-03-30 10:00:00",
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Cannot have --since after --until"))
+			})
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints the requests as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{
+						"id": 2,
+						"time": 1585603825,
+						"user_name": "some-user",
+						"team_name": "main",
+						"action": "SaveConfig",
+						"target": "/api/v1/teams/main/pipelines/some-pipeline/config",
+						"request_id": "some-request-id",
+						"source_ip": "1.2.3.4",
+						"status": 403
+					},
+					{
+						"id": 1,
+						"time": 1585600225,
+						"user_name": "admin",
+						"action": "SetWall",
+						"target": "/api/v1/wall",
+						"request_id": "other-request-id",
+						"source_ip": "5.6.7.8",
+						"status": 200
+					}
+				]`))
+			})
+		})
+	})
+})
@@ -68,6 +68,7 @@ var _ = Describe("Fly CLI", func() {
 				{Contents: "end", Color: color.New(color.Bold)},
 				{Contents: "duration", Color: color.New(color.Bold)},
 				{Contents: "team", Color: color.New(color.Bold)},
+				{Contents: "comment", Color: color.New(color.Bold)},
 			}
 		})
 
@@ -101,6 +102,7 @@ var _ = Describe("Fly CLI", func() {
 						StartTime:    runningBuildStartTime.Unix(),
 						EndTime:      0,
 						TeamName:     "team1",
+						Comment:      "flaky",
 					},
 					{
 						ID:           3,
@@ -161,7 +163,8 @@ var _ = Describe("Fly CLI", func() {
                 "job_name": "some-job",
                 "api_url": "",
                 "pipeline_name": "some-pipeline",
-                "start_time": 1448101815
+                "start_time": 1448101815,
+                "comment": "flaky"
               },
               {
                 "id": 3,
@@ -223,6 +226,7 @@ var _ = Describe("Fly CLI", func() {
 								}.String(),
 							},
 							{Contents: "team1"},
+							{Contents: "flaky"},
 						},
 						{
 							{Contents: "3"},
@@ -233,6 +237,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: pendingBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: ""},
 						},
 						{
 							{Contents: "1000001"},
@@ -243,6 +248,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: erroredBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "2h45m0s"},
 							{Contents: "team1"},
+							{Contents: ""},
 						},
 						{
 							{Contents: "1002"},
@@ -253,6 +259,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: abortedBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "n/a"},
 							{Contents: "team1"},
+							{Contents: ""},
 						},
 						{
 							{Contents: "39"},
@@ -263,6 +270,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "n/a"},
 							{Contents: "n/a"},
 							{Contents: "team1"},
+							{Contents: ""},
 						},
 					},
 				}))
@@ -624,6 +632,7 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team1"},
+								{Contents: ""},
 							},
 						},
 					}))
@@ -692,6 +701,7 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team1"},
+								{Contents: ""},
 							},
 
 							{
@@ -703,6 +713,7 @@ var _ = Describe("Fly CLI", func() {
 								{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 								{Contents: "1h15m0s"},
 								{Contents: "team2"},
+								{Contents: ""},
 							},
 						},
 					}))
@@ -791,6 +802,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: ""},
 						},
 						{
 							{Contents: "4"},
@@ -801,6 +813,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team2"},
+							{Contents: ""},
 						},
 					},
 				}))
@@ -852,6 +865,7 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
 							{Contents: "1h15m0s"},
 							{Contents: "team1"},
+							{Contents: ""},
 						},
 					},
 				}))
@@ -898,6 +912,19 @@ var _ = Describe("Fly CLI", func() {
 				Eventually(session).Should(gexec.Exit(0))
 			})
 
+			Context("when passing instance vars", func() {
+				BeforeEach(func() {
+					cmdArgs = append(cmdArgs, "-i", "branch=feature")
+
+					queryParams = "instance_vars=%7B%22branch%22%3A%22feature%22%7D&limit=50"
+				})
+
+				It("returns the builds of the pipeline instance", func() {
+					Eventually(session.Out).Should(gbytes.Say("some-pipeline/some-job"))
+					Eventually(session).Should(gexec.Exit(0))
+				})
+			})
+
 			Context("when the api returns an error", func() {
 				BeforeEach(func() {
 					returnedStatusCode = http.StatusInternalServerError
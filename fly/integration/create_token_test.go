@@ -0,0 +1,141 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("CreateToken", func() {
+	var (
+		flyCmd *exec.Cmd
+	)
+
+	Context("when the token is created", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "create-token", "-n", "some-bot", "-r", "pipeline-operator", "--service-account")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+					ghttp.VerifyJSONRepresenting(atc.CreateAPITokenRequestBody{
+						Name:           "some-bot",
+						Role:           "pipeline-operator",
+						ServiceAccount: true,
+					}),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+						Name:           "some-bot",
+						TeamName:       "main",
+						Role:           "pipeline-operator",
+						ServiceAccount: true,
+						CreatedBy:      "some-user",
+						Token:          "ct_some-secret",
+					}),
+				),
+			)
+		})
+
+		It("prints the token", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say("created token 'some-bot' granting the pipeline-operator role in team 'main'"))
+			Expect(sess.Out).To(gbytes.Say("ct_some-secret"))
+		})
+	})
+
+	Context("when the token expires", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "create-token", "-n", "some-token", "--expires-in", "1h")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+					func(w http.ResponseWriter, r *http.Request) {
+						var body atc.CreateAPITokenRequestBody
+						Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+						Expect(body.Role).To(Equal("member"))
+						Expect(time.Unix(body.ExpiresAt, 0)).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+					},
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+						Name:     "some-token",
+						TeamName: "main",
+						Role:     "member",
+						Token:    "ct_some-secret",
+					}),
+				),
+			)
+		})
+
+		It("sends the expiry", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+		})
+	})
+
+	Context("when the role is custom", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "create-token", "-n", "some-token", "-r", "release-manager")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+					ghttp.VerifyJSONRepresenting(atc.CreateAPITokenRequestBody{
+						Name: "some-token",
+						Role: "release-manager",
+					}),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+						Name:      "some-token",
+						TeamName:  "main",
+						Role:      "release-manager",
+						CreatedBy: "some-user",
+						Token:     "ct_some-secret",
+					}),
+				),
+			)
+		})
+
+		It("requests the custom role", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("granting the release-manager role in team 'main'"))
+		})
+	})
+
+	Context("when the role is unknown", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "create-token", "-n", "some-token", "-r", "superuser")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+					ghttp.RespondWith(http.StatusBadRequest, "unknown role 'superuser'"),
+				),
+			)
+		})
+
+		It("errors with the reason given by the server", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("unknown role 'superuser'"))
+		})
+	})
+})
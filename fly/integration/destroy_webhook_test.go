@@ -0,0 +1,62 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("DestroyWebhook", func() {
+	var (
+		flyCmd *exec.Cmd
+	)
+
+	BeforeEach(func() {
+		flyCmd = exec.Command(flyPath, "-t", targetName, "destroy-webhook", "-p", "github")
+	})
+
+	Context("when the webhook exists", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/webhooks/github"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("destroys the webhook", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say("destroyed github webhook"))
+		})
+	})
+
+	Context("when the webhook does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/webhooks/github"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("github webhook not found on team main"))
+		})
+	})
+})
@@ -0,0 +1,131 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("encryption-status", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "encryption-status")
+		})
+
+		Context("when a rotation is running", func() {
+			var rotation atc.EncryptionKeyRotation
+
+			BeforeEach(func() {
+				rotation = atc.EncryptionKeyRotation{
+					State:     atc.EncryptionKeyRotationRunning,
+					StartedAt: 1584827015,
+					LastError: "rotate builds.private_plan of row 42: row encrypted with neither old nor new key",
+					Columns: []atc.EncryptionKeyRotationColumn{
+						{Table: "teams", Column: "legacy_auth", RowsTotal: 2, RowsProcessed: 2, RowsRotated: 1, Done: true},
+						{Table: "builds", Column: "private_plan", RowsTotal: 1000, RowsProcessed: 500, RowsRotated: 500},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/info/encryption"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, rotation),
+					),
+				)
+			})
+
+			It("prints the progress of each column", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("state: running"))
+				Expect(sess.Out).To(gbytes.Say("rows remaining: 500"))
+				Expect(sess.Out).To(gbytes.Say("last error: rotate builds.private_plan of row 42"))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "table", Color: color.New(color.Bold)},
+						{Contents: "column", Color: color.New(color.Bold)},
+						{Contents: "processed", Color: color.New(color.Bold)},
+						{Contents: "rotated", Color: color.New(color.Bold)},
+						{Contents: "done", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "teams"}, {Contents: "legacy_auth"}, {Contents: "2/2"}, {Contents: "1"}, {Contents: "yes"}},
+						{{Contents: "builds"}, {Contents: "private_plan"}, {Contents: "500/1000"}, {Contents: "500"}, {Contents: "no"}},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the rotation as JSON", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"state": "running",
+						"started_at": 1584827015,
+						"last_error": "rotate builds.private_plan of row 42: row encrypted with neither old nor new key",
+						"columns": [
+							{"table": "teams", "column": "legacy_auth", "rows_total": 2, "rows_processed": 2, "rows_rotated": 1, "done": true},
+							{"table": "builds", "column": "private_plan", "rows_total": 1000, "rows_processed": 500, "rows_rotated": 500, "done": false}
+						]
+					}`))
+				})
+			})
+		})
+
+		Context("when the key has never been rotated", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/info/encryption"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.EncryptionKeyRotation{}),
+					),
+				)
+			})
+
+			It("says so", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("the encryption key has never been rotated"))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/info/encryption"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
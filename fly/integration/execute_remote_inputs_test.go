@@ -0,0 +1,369 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var buildDir string
+	var repoDir string
+
+	var streaming chan struct{}
+	var events chan atc.Event
+
+	var expectedPlan atc.Plan
+
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", repoDir}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=some-author",
+			"GIT_AUTHOR_EMAIL=author@example.com",
+			"GIT_COMMITTER_NAME=some-committer",
+			"GIT_COMMITTER_EMAIL=committer@example.com",
+		)
+
+		output, err := cmd.Output()
+		Expect(err).NotTo(HaveOccurred())
+
+		return strings.TrimSpace(string(output))
+	}
+
+	planFor := func(gets ...atc.GetPlan) atc.Plan {
+		planFactory := atc.NewPlanFactory(0)
+
+		getPlans := atc.AggregatePlan{}
+		taskInputs := []atc.TaskInputConfig{}
+		for _, get := range gets {
+			getPlans = append(getPlans, planFactory.NewPlan(get))
+			taskInputs = append(taskInputs, atc.TaskInputConfig{Name: get.Name})
+		}
+
+		return planFactory.NewPlan(atc.DoPlan{
+			planFactory.NewPlan(getPlans),
+			planFactory.NewPlan(atc.TaskPlan{
+				Name: "one-off",
+				Config: &atc.TaskConfig{
+					Platform: "some-platform",
+					ImageResource: &atc.ImageResource{
+						Type: "registry-image",
+						Source: atc.Source{
+							"repository": "ubuntu",
+						},
+					},
+					Inputs: taskInputs,
+					Run: atc.TaskRunConfig{
+						Path: "find",
+						Args: []string{"."},
+					},
+				},
+			}),
+		})
+	}
+
+	writeTaskConfig := func(name string, inputs ...string) string {
+		config := `---
+platform: some-platform
+
+image_resource:
+  type: registry-image
+  source:
+    repository: ubuntu
+
+inputs:
+`
+		for _, input := range inputs {
+			config += "- name: " + input + "\n"
+		}
+
+		config += `
+run:
+  path: find
+  args: [.]
+`
+
+		path := filepath.Join(buildDir, name)
+
+		err := ioutil.WriteFile(path, []byte(config), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		return path
+	}
+
+	otherInput := atc.GetPlan{
+		Name:     "some-other-input",
+		Resource: "some-resource",
+		Version:  &atc.Version{"ref": "abcd"},
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		buildDir, err = ioutil.TempDir("", "fly-build-dir")
+		Expect(err).NotTo(HaveOccurred())
+
+		repoDir, err = ioutil.TempDir("", "fly-git-repo")
+		Expect(err).NotTo(HaveOccurred())
+
+		git("init", "-q")
+		git("commit", "-q", "--allow-empty", "-m", "first")
+		git("tag", "-a", "some-tag", "-m", "some-tag")
+		git("checkout", "-q", "-b", "some-branch")
+		git("commit", "-q", "--allow-empty", "-m", "second")
+
+		writeTaskConfig("task.yml", "some-input", "some-other-input")
+
+		streaming = make(chan struct{})
+		events = make(chan atc.Event)
+
+		atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds",
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds"),
+				func(w http.ResponseWriter, r *http.Request) {
+					VerifyPlan(expectedPlan)(w, r)
+				},
+				ghttp.RespondWith(201, `{"id":128}`),
+			),
+		)
+		atcServer.RouteToHandler("GET", "/api/v1/builds/128/events",
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/v1/builds/128/events"),
+				func(w http.ResponseWriter, r *http.Request) {
+					flusher := w.(http.Flusher)
+
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+					w.Header().Add("Connection", "keep-alive")
+
+					w.WriteHeader(http.StatusOK)
+
+					flusher.Flush()
+
+					close(streaming)
+
+					id := 0
+
+					for e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						event := sse.Event{
+							ID:   fmt.Sprintf("%d", id),
+							Name: "event",
+							Data: payload,
+						}
+
+						err = event.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+
+						flusher.Flush()
+
+						id++
+					}
+
+					err := sse.Event{
+						Name: "end",
+					}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			),
+		)
+		atcServer.RouteToHandler("GET", "/api/v1/builds/128/artifacts",
+			ghttp.RespondWithJSONEncoded(200, []atc.WorkerArtifact{}),
+		)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(buildDir)
+		os.RemoveAll(repoDir)
+	})
+
+	runBuild := func(args ...string) {
+		flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "e"}, args...)...)
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(streaming).Should(BeClosed())
+
+		events <- event.Log{Payload: "sup"}
+		close(events)
+
+		Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+		<-sess.Exited
+		Expect(sess).To(gexec.Exit(0))
+	}
+
+	runAndFail := func(args ...string) *gexec.Session {
+		flyCmd := exec.Command(flyPath, append([]string{"-t", targetName, "e"}, args...)...)
+
+		sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+		Expect(err).NotTo(HaveOccurred())
+
+		<-sess.Exited
+		Expect(sess).To(gexec.Exit(1))
+
+		for _, req := range atcServer.ReceivedRequests() {
+			Expect(req.URL.Path).ToNot(HaveSuffix("/builds"))
+		}
+
+		return sess
+	}
+
+	It("fetches the remote inputs in a build of their pipeline without uploading anything", func() {
+		expectedPlan = planFor(
+			atc.GetPlan{
+				Name:    "some-input",
+				Type:    "git",
+				Source:  atc.Source{"uri": repoDir, "branch": "some-branch"},
+				Version: &atc.Version{"ref": git("rev-parse", "some-branch")},
+			},
+			otherInput,
+		)
+
+		runBuild(
+			"--input", "some-input=git:"+repoDir+"#some-branch",
+			"--input", "some-other-input=resource:some-pipeline/some-resource@ref:abcd",
+			"--config", filepath.Join(buildDir, "task.yml"),
+		)
+
+		for _, req := range atcServer.ReceivedRequests() {
+			Expect(req.URL.Path).ToNot(Equal("/api/v1/teams/main/artifacts"))
+		}
+	})
+
+	Context("when the git ref is an annotated tag", func() {
+		It("fetches the commit it tags", func() {
+			expectedPlan = planFor(
+				atc.GetPlan{
+					Name:    "some-input",
+					Type:    "git",
+					Source:  atc.Source{"uri": repoDir, "branch": "some-tag"},
+					Version: &atc.Version{"ref": git("rev-parse", "some-tag^{commit}")},
+				},
+				otherInput,
+			)
+
+			runBuild(
+				"--input", "some-input=git:"+repoDir+"#some-tag",
+				"--input", "some-other-input=resource:some-pipeline/some-resource@ref:abcd",
+				"--config", filepath.Join(buildDir, "task.yml"),
+			)
+		})
+	})
+
+	Context("when the git ref is a commit", func() {
+		It("fetches the commit", func() {
+			commit := git("rev-parse", "some-branch~1")
+
+			expectedPlan = planFor(
+				atc.GetPlan{
+					Name:    "some-input",
+					Type:    "git",
+					Source:  atc.Source{"uri": repoDir},
+					Version: &atc.Version{"ref": commit},
+				},
+				otherInput,
+			)
+
+			runBuild(
+				"--input", "some-input=git:"+repoDir+"#"+commit,
+				"--input", "some-other-input=resource:some-pipeline/some-resource@ref:abcd",
+				"--config", filepath.Join(buildDir, "task.yml"),
+			)
+		})
+	})
+
+	Context("when the git ref can't be resolved", func() {
+		It("errors without creating a build", func() {
+			sess := runAndFail(
+				"--input", "some-input=git:"+repoDir+"#bogus-branch",
+				"--input", "some-other-input=resource:some-pipeline/some-resource@ref:abcd",
+				"--config", filepath.Join(buildDir, "task.yml"),
+			)
+
+			Expect(sess.Err).To(gbytes.Say("`bogus-branch` is neither a branch nor a tag of " + repoDir))
+		})
+	})
+
+	Context("when the pipeline is given for a git input", func() {
+		It("fetches the input in a build of the pipeline", func() {
+			expectedPlan = planFor(
+				atc.GetPlan{
+					Name:    "some-input",
+					Type:    "git",
+					Source:  atc.Source{"uri": repoDir, "branch": "some-branch"},
+					Version: &atc.Version{"ref": git("rev-parse", "some-branch")},
+				},
+			)
+
+			runBuild(
+				"--pipeline", "some-pipeline",
+				"--input", "some-input=git:"+repoDir+"#some-branch",
+				"--config", writeTaskConfig("git-task.yml", "some-input"),
+			)
+		})
+	})
+
+	Context("when no pipeline is given for a git input", func() {
+		It("errors without creating a build", func() {
+			sess := runAndFail(
+				"--input", "some-input=git:"+repoDir+"#some-branch",
+				"--config", writeTaskConfig("git-task.yml", "some-input"),
+			)
+
+			Expect(sess.Err).To(gbytes.Say("git input `some-input` is authenticated as a resource of the build's pipeline, which must be given with --pipeline"))
+		})
+	})
+
+	Context("when the inputs come from different pipelines", func() {
+		It("errors without creating a build", func() {
+			sess := runAndFail(
+				"--inputs-from", "other-pipeline/some-job",
+				"--input", "some-other-input=resource:some-pipeline/some-resource@ref:abcd",
+				"--config", filepath.Join(buildDir, "task.yml"),
+			)
+
+			Expect(sess.Err).To(gbytes.Say("inputs can only come from a single pipeline, but `some-other-input` comes from `some-pipeline` rather than `other-pipeline`"))
+		})
+
+		Context("when they're from a different pipeline than the one given", func() {
+			It("errors without creating a build", func() {
+				sess := runAndFail(
+					"--pipeline", "other-pipeline",
+					"--input", "some-other-input=resource:some-pipeline/some-resource@ref:abcd",
+					"--config", filepath.Join(buildDir, "task.yml"),
+				)
+
+				Expect(sess.Err).To(gbytes.Say("inputs can only come from a single pipeline, but `some-other-input` comes from `some-pipeline` rather than `other-pipeline`"))
+			})
+		})
+	})
+
+	Context("when a git input has no ref", func() {
+		It("errors", func() {
+			sess := runAndFail(
+				"--input", "some-input=git:"+repoDir,
+				"--config", filepath.Join(buildDir, "task.yml"),
+			)
+
+			Expect(sess.Err).To(gbytes.Say("invalid git input 'some-input'"))
+		})
+	})
+})
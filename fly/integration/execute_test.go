@@ -287,6 +287,64 @@ run:
 
 	})
 
+	Context("when the pipeline job is in a pipeline instance", func() {
+		BeforeEach(func() {
+			instanceVarsQuery := "instance_vars=%7B%22branch%22%3A%22feature%22%7D"
+
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds",
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds", instanceVarsQuery),
+					ghttp.RespondWith(201, `{"id":128}`),
+				),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/inputs",
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/inputs", instanceVarsQuery),
+					ghttp.RespondWithJSONEncoded(200, []atc.BuildInput{atc.BuildInput{Name: "fixture"}}),
+				),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/resource-types",
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resource-types", instanceVarsQuery),
+					ghttp.RespondWithJSONEncoded(200, atc.VersionedResourceTypes{}),
+				),
+			)
+		})
+
+		It("creates the build in the pipeline instance", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "e", "-c", taskConfigPath, "-j", "some-pipeline/some-job", "--instance-var", "branch=feature")
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			buildURL, _ := url.Parse(atcServer.URL())
+			buildURL.Path = path.Join(buildURL.Path, "builds/128")
+			Eventually(sess.Out).Should(gbytes.Say("executing build 128 at %s", buildURL.String()))
+
+			close(events)
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+	})
+
+	Context("when instance vars are given without a pipeline", func() {
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "e", "-c", taskConfigPath, "--instance-var", "branch=feature")
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("instance vars can only be given when the inputs come from a pipeline"))
+		})
+	})
+
 	Context("when the build config is invalid", func() {
 		BeforeEach(func() {
 			// missing platform and run path
@@ -676,6 +676,76 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		Context("with an api token", func() {
+			BeforeEach(func() {
+				loginATCServer.AppendHandlers(
+					infoHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/tokens"),
+						ghttp.VerifyHeaderKV("Authorization", "Bearer ct_some-token"),
+						ghttp.RespondWithJSONEncoded(200, []atc.APIToken{}),
+					),
+				)
+			})
+
+			It("saves the token without going through skymarshal", func() {
+				flyCmd = exec.Command(flyPath, "-t", "some-target", "login", "-c", loginATCServer.URL(), "--api-token", "ct_some-token")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("target saved"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				loginATCServer.AppendHandlers(
+					infoHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+						ghttp.VerifyHeaderKV("Authorization", "Bearer ct_some-token"),
+						ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+							{Name: "pipeline-1"},
+						}),
+					),
+				)
+
+				otherCmd := exec.Command(flyPath, "-t", "some-target", "pipelines")
+
+				sess, err = gexec.Start(otherCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+
+				Expect(sess).To(gbytes.Say("pipeline-1"))
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("with an api token which does not grant access to the team", func() {
+			BeforeEach(func() {
+				loginATCServer.AppendHandlers(
+					infoHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/tokens"),
+						ghttp.RespondWith(http.StatusUnauthorized, ""),
+					),
+				)
+			})
+
+			It("does not save the target", func() {
+				flyCmd = exec.Command(flyPath, "-t", "some-target", "login", "-c", loginATCServer.URL(), "--api-token", "ct_bogus")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("api token does not grant access to team 'main'"))
+
+				flyRcContents, _ := ioutil.ReadFile(tmpDir + "/.flyrc")
+				Expect(string(flyRcContents)).ToNot(ContainSubstring("some-target"))
+			})
+		})
+
 		Context("when fly and atc differ in major versions", func() {
 			var flyVersion string
 
@@ -92,6 +92,98 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when there are instances of a pipeline", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines")
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "pipeline-1", InstanceVars: atc.InstanceVars{"branch": "feature"}},
+								{Name: "pipeline-2"},
+								{Name: "pipeline-1", InstanceVars: atc.InstanceVars{"branch": "master"}},
+							}),
+						),
+					)
+				})
+
+				It("groups the instances together", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "public", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "pipeline-1/branch:feature"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-1/branch:master"}, {Contents: "no"}, {Contents: "no"}},
+							{{Contents: "pipeline-2"}, {Contents: "no"}, {Contents: "no"}},
+						},
+					}))
+				})
+			})
+
+			Context("when some pipelines are archived", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines")
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "pipeline-1", Paused: false, Public: false},
+								{Name: "archived-pipeline", Paused: true, Public: false, Archived: true},
+							}),
+						),
+					)
+				})
+
+				It("does not show the archived pipelines", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "public", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "pipeline-1"}, {Contents: "no"}, {Contents: "no"}},
+						},
+					}))
+				})
+
+				Context("when --include-archived is given", func() {
+					BeforeEach(func() {
+						flyCmd.Args = append(flyCmd.Args, "--include-archived")
+					})
+
+					It("shows the archived pipelines with an archived column", func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+						Eventually(sess).Should(gexec.Exit(0))
+
+						Expect(sess.Out).To(PrintTable(ui.Table{
+							Headers: ui.TableRow{
+								{Contents: "name", Color: color.New(color.Bold)},
+								{Contents: "paused", Color: color.New(color.Bold)},
+								{Contents: "public", Color: color.New(color.Bold)},
+								{Contents: "archived", Color: color.New(color.Bold)},
+							},
+							Data: []ui.TableRow{
+								{{Contents: "pipeline-1"}, {Contents: "no"}, {Contents: "no"}, {Contents: "no"}},
+								{{Contents: "archived-pipeline"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "no"}, {Contents: "yes", Color: color.New(color.FgCyan)}},
+							},
+						}))
+					})
+				})
+			})
+
 			Context("when --all is specified", func() {
 				BeforeEach(func() {
 					flyCmd = exec.Command(flyPath, "-t", targetName, "pipelines", "--all")
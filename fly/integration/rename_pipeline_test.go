@@ -80,6 +80,27 @@ var _ = Describe("RenamePipeline", func() {
 			Expect(sess.Out).To(gbytes.Say(fmt.Sprintf("pipeline successfully renamed to %s", newName)))
 		})
 
+		Context("when the pipeline is an instance", func() {
+			BeforeEach(func() {
+				atcServer.SetHandler(3, ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/rename", "instance_vars=%7B%22branch%22%3A%22feature%22%7D"),
+					ghttp.VerifyJSON(fmt.Sprintf(`{"name":%q}`, newName)),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				))
+			})
+
+			It("renames the instance identified by the instance vars", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rename-pipeline", "-o", "some-pipeline", "-i", "branch=feature", "-n", newName)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(4))
+				Expect(sess.Out).To(gbytes.Say(fmt.Sprintf("pipeline successfully renamed to %s", newName)))
+			})
+		})
+
 		Context("when the pipeline is not found", func() {
 			BeforeEach(func() {
 				atcServer.SetHandler(3, ghttp.RespondWith(http.StatusNotFound, ""))
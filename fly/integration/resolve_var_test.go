@@ -0,0 +1,199 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("resolve-var", func() {
+		var (
+			path  string
+			trace atc.VarTrace
+		)
+
+		BeforeEach(func() {
+			var err error
+			path, err = atc.Routes.CreatePathForRoute(atc.ResolveVar, rata.Params{"pipeline_name": "some-pipeline", "team_name": "main"})
+			Expect(err).NotTo(HaveOccurred())
+
+			trace = atc.VarTrace{
+				Name: "some-var",
+				Lookups: []atc.VarLookup{
+					{Path: "/concourse/main/some-pipeline/some-var"},
+					{Path: "/concourse/main/some-var", Found: true, Chosen: true},
+					{Source: "some-source", Path: "some-var", Error: "disaster"},
+				},
+			}
+		})
+
+		Context("when the var flag is not specified", func() {
+			It("asks the user to specify a var", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "resolve-var", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("v", "var") + "' was not specified"))
+			})
+		})
+
+		Context("when the var is traced", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path, "name=some-var"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, trace),
+					),
+				)
+			})
+
+			It("prints every lookup and which one was chosen", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "resolve-var", "-p", "some-pipeline", "-v", "some-var")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "source", Color: color.New(color.Bold)},
+						{Contents: "path", Color: color.New(color.Bold)},
+						{Contents: "found", Color: color.New(color.Bold)},
+						{Contents: "chosen", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "credential manager"}, {Contents: "/concourse/main/some-pipeline/some-var"}, {Contents: "no"}, {Contents: "no"}},
+						{{Contents: "credential manager"}, {Contents: "/concourse/main/some-var"}, {Contents: "yes"}, {Contents: "yes"}},
+						{{Contents: "some-source"}, {Contents: "some-var"}, {Contents: "error: disaster"}, {Contents: "no"}},
+					},
+				}))
+
+				Expect(sess.Out).To(gbytes.Say("some-var resolves from /concourse/main/some-var"))
+				Expect(sess.Out).ToNot(gbytes.Say("value:"))
+			})
+
+			It("prints the trace as JSON", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "resolve-var", "-p", "some-pipeline", "-v", "some-var", "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				expected, err := json.Marshal(trace)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sess.Out.Contents()).To(MatchJSON(expected))
+			})
+		})
+
+		Context("when revealing the value", func() {
+			BeforeEach(func() {
+				trace.Value = map[string]interface{}{"username": "some-user"}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path, "name=some-var&reveal=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, trace),
+					),
+				)
+			})
+
+			It("prints the value", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "resolve-var", "-p", "some-pipeline", "-v", "some-var", "--reveal")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`value: {"username":"some-user"}`))
+			})
+		})
+
+		Context("when the user is not an owner", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path, "name=some-var&reveal=true"),
+						ghttp.RespondWith(http.StatusForbidden, "only team owners can reveal the value of a var"),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "resolve-var", "-p", "some-pipeline", "-v", "some-var", "--reveal")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+
+		Context("when the var is a local var", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path, "name=.:some-var"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.VarTrace{Name: ".:some-var", Local: true}),
+					),
+				)
+			})
+
+			It("explains that it can only be resolved within a build", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "resolve-var", "-p", "some-pipeline", "-v", ".:some-var")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`.:some-var is a local var, which is only set within a build`))
+			})
+		})
+
+		Context("when the pipeline is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "resolve-var", "-p", "some-pipeline", "-v", "some-var")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: pipeline not found"))
+			})
+		})
+	})
+})
@@ -827,6 +827,48 @@ this is super secure
 				})
 			})
 
+			Context("when setting an archived pipeline", func() {
+				BeforeEach(func() {
+					path, err := atc.Routes.CreatePathForRoute(atc.GetConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("GET", path,
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: atc.Config{}}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+					)
+
+					path, err = atc.Routes.CreatePathForRoute(atc.SaveConfig, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("PUT", path,
+						ghttp.CombineHandlers(
+							ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+							ghttp.RespondWith(http.StatusOK, "{}"),
+						),
+					)
+
+					path, err = atc.Routes.CreatePathForRoute(atc.GetPipeline, rata.Params{"pipeline_name": "awesome-pipeline", "team_name": "main"})
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.RouteToHandler("GET", path,
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Pipeline{Name: "awesome-pipeline", Paused: true, TeamName: "main"}),
+					)
+				})
+
+				It("un-archives it and says it is still paused", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline", "-n", "-p", "awesome-pipeline", "-c", configFile.Name())
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say("configuration updated"))
+					Eventually(sess).Should(gbytes.Say("the pipeline is currently paused. to unpause, either:"))
+					Eventually(sess).Should(gbytes.Say("unpause-pipeline -p awesome-pipeline"))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
+
 			Context("when configuring succeeds", func() {
 				BeforeEach(func() {
 					newGroup := changedConfig.Groups[1]
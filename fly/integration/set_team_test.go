@@ -3,8 +3,11 @@ package integration_test
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"os/exec"
+	"path/filepath"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/onsi/ginkgo"
@@ -705,6 +708,492 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("sending a default build priority", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--default-build-priority", "10",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"default_build_priority": 10
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows and sends the default build priority", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("default build priority: 10"))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team created"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Describe("sending a max number of running builds", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--max-running-builds", "3",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"max_running_builds": 3
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows and sends the max number of running builds", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("max running builds: 3"))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team created"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Describe("sending var sources", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "fly-test")
+				Expect(err).NotTo(HaveOccurred())
+
+				varSourcePath := filepath.Join(tmpDir, "vault.yml")
+				err = ioutil.WriteFile(varSourcePath, []byte(`
+name: vault
+type: vault
+config:
+  url: https://vault.example.com
+  client_token: some-token
+`), 0644)
+				Expect(err).NotTo(HaveOccurred())
+
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--var-source", varSourcePath,
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"var_sources": [
+								{
+									"name": "vault",
+									"type": "vault",
+									"config": {
+										"url": "https://vault.example.com",
+										"client_token": "some-token"
+									}
+								}
+							]
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(tmpDir)
+			})
+
+			It("shows and sends the var sources", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("var sources:"))
+				Eventually(sess.Out).Should(gbytes.Say(`- vault \(vault\)`))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team created"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Describe("removing var sources", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--remove-var-sources",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"var_sources": []
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows that the var sources will be removed and sends an empty list", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say(`var sources: none \(will be removed\)`))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when var sources are given as well", func() {
+				BeforeEach(func() {
+					cmdParams = append(cmdParams, "--var-source", "fixtures/team_config_mixed.yml")
+				})
+
+				It("errors without setting the team", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("--var-source cannot be given along with --remove-var-sources"))
+					Eventually(sess).Should(gexec.Exit(1))
+
+					for _, req := range atcServer.ReceivedRequests() {
+						Expect(req.Method).ToNot(Equal("PUT"))
+					}
+				})
+			})
+		})
+
+		Describe("sending pipeline auth", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "fly-test")
+				Expect(err).NotTo(HaveOccurred())
+
+				pipelineAuthPath := filepath.Join(tmpDir, "pipeline-auth.yml")
+				err = ioutil.WriteFile(pipelineAuthPath, []byte(`
+deploy-prod:
+  member:
+    users: ["local:dean-venture"]
+    groups: ["github:venture-industries"]
+`), 0644)
+				Expect(err).NotTo(HaveOccurred())
+
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--pipeline-auth", pipelineAuthPath,
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"pipeline_auth": {
+								"deploy-prod": {
+									"member": {
+										"users": ["local:dean-venture"],
+										"groups": ["github:venture-industries"]
+									}
+								}
+							}
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(tmpDir)
+			})
+
+			It("shows and sends the roles bound in pipelines", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("role member in pipeline deploy-prod:"))
+				Eventually(sess.Out).Should(gbytes.Say("- local:dean-venture"))
+				Eventually(sess.Out).Should(gbytes.Say("- github:venture-industries"))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team created"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Describe("removing pipeline auth", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--remove-pipeline-auth",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"pipeline_auth": {}
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows that the roles bound in pipelines will be removed and sends an empty map", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say(`pipeline auth: none \(will be removed\)`))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when pipeline auth is given as well", func() {
+				BeforeEach(func() {
+					cmdParams = append(cmdParams, "--pipeline-auth", "fixtures/team_config_mixed.yml")
+				})
+
+				It("errors without setting the team", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("--pipeline-auth cannot be given along with --remove-pipeline-auth"))
+					Eventually(sess).Should(gexec.Exit(1))
+
+					for _, req := range atcServer.ReceivedRequests() {
+						Expect(req.Method).ToNot(Equal("PUT"))
+					}
+				})
+			})
+		})
+
+		Describe("sending syslog drains", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--syslog-drain", "tls://logs.example.com:6514",
+					"--syslog-drain", "https://logs.example.com/ingest",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"syslog_drains": [
+								{"url": "tls://logs.example.com:6514"},
+								{"url": "https://logs.example.com/ingest"}
+							]
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows and sends the syslog drains", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("syslog drains:"))
+				Eventually(sess.Out).Should(gbytes.Say(`- tls://logs.example.com:6514`))
+				Eventually(sess.Out).Should(gbytes.Say(`- https://logs.example.com/ingest`))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team created"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Describe("removing syslog drains", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--remove-syslog-drains",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"syslog_drains": []
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows that the syslog drains will be removed and sends an empty list", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say(`syslog drains: none \(will be removed\)`))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+
+			Context("when syslog drains are given as well", func() {
+				BeforeEach(func() {
+					cmdParams = append(cmdParams, "--syslog-drain", "tls://logs.example.com:6514")
+				})
+
+				It("errors without setting the team", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("--syslog-drain cannot be given along with --remove-syslog-drains"))
+					Eventually(sess).Should(gexec.Exit(1))
+
+					for _, req := range atcServer.ReceivedRequests() {
+						Expect(req.Method).ToNot(Equal("PUT"))
+					}
+				})
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"--local-user", "brock-obama"}
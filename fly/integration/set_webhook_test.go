@@ -0,0 +1,61 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("SetWebhook", func() {
+	var (
+		flyCmd *exec.Cmd
+	)
+
+	Context("when the provider is known", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "set-webhook", "-p", "github", "-s", "some-secret")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/webhooks/github"),
+					ghttp.VerifyJSONRepresenting(atc.SetTeamWebhookRequestBody{
+						Secret: "some-secret",
+					}),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("sets the webhook and prints its url", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say("webhook set"))
+			Expect(sess.Out).To(gbytes.Say(atcServer.URL() + "/api/v1/teams/main/webhooks/github"))
+		})
+	})
+
+	Context("when the provider is unknown", func() {
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "set-webhook", "-p", "svn", "-s", "some-secret")
+		})
+
+		It("errors", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("unknown provider 'svn', must be one of: github, gitlab, bitbucket"))
+		})
+	})
+})
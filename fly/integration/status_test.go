@@ -41,6 +41,12 @@ targets:
     token:
       type: Bearer
       value: bad-token
+  api-token-test:
+    api: ` + atcServer.URL() + `
+    team: test
+    token:
+      type: Bearer
+      value: ct_some-token
   loggedout-test:
     api: https://example.com/loggedout-test
     team: test
@@ -134,6 +140,30 @@ targets:
 			})
 		})
 
+		Context("when target is saved with an api token", func() {
+			BeforeEach(func() {
+				atcServer.Reset()
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/test/tokens"),
+						ghttp.VerifyHeaderKV("Authorization", "Bearer ct_some-token"),
+						ghttp.RespondWithJSONEncoded(200, []interface{}{}),
+					),
+				)
+			})
+
+			It("checks the token against the api", func() {
+				flyCmd = exec.Command(flyPath, "-t", "api-token-test", "status")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				Expect(sess.Out).To(gbytes.Say(`logged in successfully`))
+			})
+		})
+
 		Context("when target is saved with invalid token", func() {
 			It("command exist with 1", func() {
 				flyCmd = exec.Command(flyPath, "-t", "bad-test", "status")
@@ -0,0 +1,117 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("tokens", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "tokens")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.APIToken{
+						{
+							Name:       "some-token",
+							TeamName:   "main",
+							Role:       "member",
+							CreatedBy:  "some-user",
+							CreatedAt:  1585431025,
+							LastUsedAt: 1585434625,
+						},
+						{
+							Name:           "some-bot",
+							TeamName:       "main",
+							Role:           "viewer",
+							ServiceAccount: true,
+							CreatedBy:      "some-user",
+							CreatedAt:      1585431025,
+							ExpiresAt:      1588023025,
+						},
+					}),
+				),
+			)
+		})
+
+		It("prints the tokens", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "role", Color: color.New(color.Bold)},
+					{Contents: "acts as", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+					{Contents: "last used", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "some-token"},
+						{Contents: "member"},
+						{Contents: "some-user"},
+						{Contents: time.Unix(1585431025, 0).Local().Format(timeDateLayout)},
+						{Contents: "never", Color: color.New(color.Faint)},
+						{Contents: time.Unix(1585434625, 0).Local().Format(timeDateLayout)},
+					},
+					{
+						{Contents: "some-bot"},
+						{Contents: "viewer"},
+						{Contents: "service account", Color: color.New(color.Faint)},
+						{Contents: time.Unix(1585431025, 0).Local().Format(timeDateLayout)},
+						{Contents: time.Unix(1588023025, 0).Local().Format(timeDateLayout)},
+						{Contents: "never", Color: color.New(color.Faint)},
+					},
+				},
+			}))
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints the tokens as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{
+						"name": "some-token",
+						"team_name": "main",
+						"role": "member",
+						"created_by": "some-user",
+						"created_at": 1585431025,
+						"last_used_at": 1585434625
+					},
+					{
+						"name": "some-bot",
+						"team_name": "main",
+						"role": "viewer",
+						"service_account": true,
+						"created_by": "some-user",
+						"created_at": 1585431025,
+						"expires_at": 1588023025
+					}
+				]`))
+			})
+		})
+	})
+})
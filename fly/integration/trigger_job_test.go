@@ -129,14 +129,18 @@ var _ = Describe("Fly CLI", func() {
 				Context("when -w option is provided", func() {
 					var streaming chan struct{}
 					var events chan atc.Event
+					var triggeredBuild atc.Build
 
 					BeforeEach(func() {
 						streaming = make(chan struct{})
 						events = make(chan atc.Event)
+						triggeredBuild = atc.Build{ID: 57, Name: "42"}
 						loginATCServer.AppendHandlers(
 							ghttp.CombineHandlers(
 								ghttp.VerifyRequest("POST", mainPath),
-								ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42"}),
+								func(w http.ResponseWriter, r *http.Request) {
+									ghttp.RespondWithJSONEncoded(http.StatusOK, triggeredBuild)(w, r)
+								},
 							),
 							ghttp.CombineHandlers(
 								ghttp.VerifyRequest("GET", "/api/v1/builds/57/events"),
@@ -200,6 +204,38 @@ var _ = Describe("Fly CLI", func() {
 						<-sess.Exited
 						Expect(sess.ExitCode()).To(Equal(0))
 					})
+
+					Context("when the build is waiting to be started", func() {
+						BeforeEach(func() {
+							triggeredBuild.Status = "pending"
+
+							loginATCServer.RouteToHandler("GET", "/api/v1/builds/57/queue",
+								ghttp.RespondWithJSONEncoded(http.StatusOK, atc.BuildQueuePosition{
+									BuildID:   57,
+									BuildName: "42",
+									JobName:   "awesome-job",
+									Priority:  0,
+									Position:  3,
+								}),
+							)
+						})
+
+						It("shows the build's position in the queue", func() {
+							flyCmd := exec.Command(flyPath, "-t", "some-target", "trigger-job", "-j", "awesome-pipeline/awesome-job", "-w")
+
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #42`))
+							Eventually(sess).Should(gbytes.Say(`waiting to start at position 3 in the build queue \(priority 0\)`))
+							Eventually(streaming).Should(BeClosed())
+
+							close(events)
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(0))
+						})
+					})
 				})
 			})
 
@@ -152,6 +152,7 @@ var _ = Describe("Fly CLI", func() {
 					ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
 						{Name: "awesome-pipeline", Paused: false, Public: false},
 						{Name: "more-awesome-pipeline", Paused: true, Public: false},
+						{Name: "archived-pipeline", Paused: true, Public: false, Archived: true},
 					}),
 				),
 				ghttp.CombineHandlers(
@@ -165,7 +166,7 @@ var _ = Describe("Fly CLI", func() {
 			)
 		})
 
-		It("unpauses every pipeline", func() {
+		It("unpauses every pipeline that is not archived", func() {
 			Expect(func() {
 				flyCmd := exec.Command(flyPath, "-t", targetName, "unpause-pipeline", "--all")
 
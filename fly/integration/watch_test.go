@@ -186,6 +186,55 @@ var _ = Describe("Watching", func() {
 			})
 		})
 
+		Context("when the job's next build is waiting to be started", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job"),
+						ghttp.RespondWithJSONEncoded(200, atc.Job{
+							NextBuild: &atc.Build{
+								ID:      3,
+								Name:    "3",
+								Status:  "pending",
+								JobName: "some-job",
+							},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/3/queue"),
+						ghttp.RespondWithJSONEncoded(200, atc.BuildQueuePosition{
+							BuildID:   3,
+							BuildName: "3",
+							JobName:   "some-job",
+							Priority:  10,
+							Position:  2,
+						}),
+					),
+					eventsHandler(),
+				)
+			})
+
+			It("shows the build's position in the queue and watches it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--job", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say(`waiting to start at position 2 in the build queue \(priority 10\)`))
+
+				Eventually(streaming).Should(BeClosed())
+
+				events <- event.Log{Payload: "sup"}
+
+				Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+				close(events)
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
 		Context("when the job only has a finished build", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
@@ -0,0 +1,71 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) CreateAPIToken(request atc.CreateAPITokenRequestBody) (atc.APIToken, error) {
+	params := rata.Params{
+		"team_name": team.name,
+	}
+
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	var apiToken atc.APIToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateAPIToken,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &apiToken,
+	})
+
+	return apiToken, err
+}
+
+func (team *team) APITokens() ([]atc.APIToken, error) {
+	params := rata.Params{
+		"team_name": team.name,
+	}
+
+	var apiTokens []atc.APIToken
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListAPITokens,
+		Params:      params,
+	}, &internal.Response{
+		Result: &apiTokens,
+	})
+
+	return apiTokens, err
+}
+
+func (team *team) RevokeAPIToken(name string) (bool, error) {
+	params := rata.Params{
+		"team_name":  team.name,
+		"token_name": name,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RevokeAPIToken,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,114 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler API Tokens", func() {
+	var expectedURL = "/api/v1/teams/some-team/tokens"
+
+	Describe("CreateAPIToken", func() {
+		var request = atc.CreateAPITokenRequestBody{
+			Name:           "some-bot",
+			Role:           "pipeline-operator",
+			ServiceAccount: true,
+			ExpiresAt:      100,
+		}
+
+		var expectedToken = atc.APIToken{
+			Name:           "some-bot",
+			TeamName:       "some-team",
+			Role:           "pipeline-operator",
+			ServiceAccount: true,
+			CreatedBy:      "some-user",
+			CreatedAt:      50,
+			ExpiresAt:      100,
+			Token:          "ct_some-secret",
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSONRepresenting(request),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedToken),
+				),
+			)
+		})
+
+		It("returns the created token", func() {
+			apiToken, err := team.CreateAPIToken(request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiToken).To(Equal(expectedToken))
+		})
+	})
+
+	Describe("APITokens", func() {
+		var expectedTokens = []atc.APIToken{
+			{
+				Name:       "some-token",
+				TeamName:   "some-team",
+				Role:       "member",
+				CreatedBy:  "some-user",
+				CreatedAt:  50,
+				LastUsedAt: 100,
+			},
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTokens),
+				),
+			)
+		})
+
+		It("returns the team's tokens", func() {
+			apiTokens, err := team.APITokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiTokens).To(Equal(expectedTokens))
+		})
+	})
+
+	Describe("RevokeAPIToken", func() {
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL+"/some-token"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("returns true and no error", func() {
+				found, err := team.RevokeAPIToken("some-token")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL+"/some-token"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				found, err := team.RevokeAPIToken("some-token")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package concourse
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+// AuditEventFilter narrows down the audit events to list. Empty fields match
+// every event.
+type AuditEventFilter struct {
+	UserName string
+	TeamName string
+	Action   string
+
+	From time.Time
+	To   time.Time
+}
+
+func (client *client) AuditEvents(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error) {
+	var events []atc.AuditEvent
+
+	query := page.QueryParams()
+
+	if filter.UserName != "" {
+		query.Add("user", filter.UserName)
+	}
+
+	if filter.TeamName != "" {
+		query.Add("team", filter.TeamName)
+	}
+
+	if filter.Action != "" {
+		query.Add("action", filter.Action)
+	}
+
+	if !filter.From.IsZero() {
+		query.Add(atc.PaginationQueryFrom, strconv.FormatInt(filter.From.Unix(), 10))
+	}
+
+	if !filter.To.IsZero() {
+		query.Add(atc.PaginationQueryTo, strconv.FormatInt(filter.To.Unix(), 10))
+	}
+
+	headers := http.Header{}
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListAuditEvents,
+		Query:       query,
+	}, &internal.Response{
+		Result:  &events,
+		Headers: &headers,
+	})
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	pagination, err := paginationFromHeaders(headers)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return events, pagination, nil
+}
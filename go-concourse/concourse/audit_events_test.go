@@ -0,0 +1,95 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Audit Events", func() {
+	Describe("AuditEvents", func() {
+		var (
+			filter concourse.AuditEventFilter
+			page   concourse.Page
+
+			events     []atc.AuditEvent
+			pagination concourse.Pagination
+			clientErr  error
+		)
+
+		expectedEvents := []atc.AuditEvent{
+			{
+				ID:        42,
+				Time:      10,
+				UserName:  "some-user",
+				TeamName:  "some-team",
+				Action:    atc.SetTeam,
+				Target:    "/api/v1/teams/some-team",
+				RequestID: "some-request-id",
+				SourceIP:  "1.2.3.4",
+				Status:    http.StatusCreated,
+			},
+		}
+
+		BeforeEach(func() {
+			filter = concourse.AuditEventFilter{}
+			page = concourse.Page{}
+		})
+
+		JustBeforeEach(func() {
+			events, pagination, clientErr = client.AuditEvents(filter, page)
+		})
+
+		Context("when the events are listed", func() {
+			BeforeEach(func() {
+				filter = concourse.AuditEventFilter{
+					UserName: "some-user",
+					TeamName: "some-team",
+					Action:   atc.SetTeam,
+					From:     time.Unix(10, 0),
+					To:       time.Unix(20, 0),
+				}
+				page = concourse.Page{Since: 50, Limit: 2}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit_events", "action=SetTeam&from=10&limit=2&since=50&team=some-team&to=20&user=some-user"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents, http.Header{
+							"Link": []string{
+								`<http://some-url.com/api/v1/audit_events?until=42&limit=2>; rel="previous"`,
+								`<http://some-url.com/api/v1/audit_events?since=42&limit=2>; rel="next"`,
+							},
+						}),
+					),
+				)
+			})
+
+			It("returns the events and pagination", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(events).To(Equal(expectedEvents))
+				Expect(pagination.Previous).To(Equal(&concourse.Page{Until: 42, Limit: 2}))
+				Expect(pagination.Next).To(Equal(&concourse.Page{Since: 42, Limit: 2}))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit_events"),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				Expect(clientErr).To(HaveOccurred())
+			})
+		})
+	})
+})
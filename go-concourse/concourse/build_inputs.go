@@ -8,9 +8,9 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) BuildInputsForJob(pipelineName string, jobName string) ([]atc.BuildInput, bool, error) {
+func (team *team) BuildInputsForJob(pipelineRef atc.PipelineRef, jobName string) ([]atc.BuildInput, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -19,6 +19,7 @@ func (team *team) BuildInputsForJob(pipelineName string, jobName string) ([]atc.
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListJobInputs,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &buildInputs,
 	})
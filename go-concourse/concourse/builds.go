@@ -121,6 +121,29 @@ func (client *client) Build(buildID string) (atc.Build, bool, error) {
 	}
 }
 
+func (client *client) BuildQueuePosition(buildID string) (atc.BuildQueuePosition, bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	var position atc.BuildQueuePosition
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetBuildQueue,
+		Params:      params,
+	}, &internal.Response{
+		Result: &position,
+	})
+
+	switch err.(type) {
+	case nil:
+		return position, true, nil
+	case internal.ResourceNotFoundError:
+		return position, false, nil
+	default:
+		return position, false, err
+	}
+}
+
 func (client *client) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
@@ -157,6 +180,38 @@ func (client *client) AbortBuild(buildID string) error {
 	}, nil)
 }
 
+func (client *client) SetBuildComment(buildID string, comment string) (bool, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(atc.SetBuildCommentRequestBody{
+		Comment: comment,
+	})
+	if err != nil {
+		return false, fmt.Errorf("Unable to marshal comment: %s", err)
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.SetBuildComment,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+		Params: params,
+		Body:   buffer,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (team *team) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
@@ -235,6 +235,51 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("BuildQueuePosition", func() {
+		Context("when the build is waiting to be started", func() {
+			expectedPosition := atc.BuildQueuePosition{
+				BuildID:   123,
+				BuildName: "mybuild",
+				JobName:   "myjob",
+				Priority:  10,
+				Position:  2,
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/123/queue"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedPosition),
+					),
+				)
+			})
+
+			It("returns its position in the queue", func() {
+				position, found, err := client.BuildQueuePosition("123")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(position).To(Equal(expectedPosition))
+			})
+		})
+
+		Context("when the build is not in the queue", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/123/queue"),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.BuildQueuePosition("123")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("client.Builds", func() {
 		expectedURL := "/api/v1/builds"
 
@@ -460,6 +505,63 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("SetBuildComment", func() {
+		expectedURL := "/api/v1/builds/123/comment"
+
+		Context("when the build exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSONRepresenting(atc.SetBuildCommentRequestBody{
+							Comment: "flaky, see infra ticket",
+						}),
+						ghttp.RespondWith(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("sets the comment and returns true", func() {
+				found, err := client.SetBuildComment("123", "flaky, see infra ticket")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := client.SetBuildComment("123", "flaky, see infra ticket")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the request is forbidden", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := client.SetBuildComment("123", "flaky, see infra ticket")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
@@ -16,22 +16,26 @@ type Client interface {
 	HTTPClient() *http.Client
 	Builds(Page) ([]atc.Build, Pagination, error)
 	Build(buildID string) (atc.Build, bool, error)
+	BuildQueuePosition(buildID string) (atc.BuildQueuePosition, bool, error)
 	BuildEvents(buildID string) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
+	SetBuildComment(buildID string, comment string) (bool, error)
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
 	GetInfo() (atc.Info, error)
+	GetEncryptionKeyRotation() (atc.EncryptionKeyRotation, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
 	ListTeams() ([]atc.Team, error)
 	Team(teamName string) Team
 	UserInfo() (map[string]interface{}, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	AuditEvents(AuditEventFilter, Page) ([]atc.AuditEvent, Pagination, error)
 	Check(checkID string) (atc.Check, bool, error)
 }
 
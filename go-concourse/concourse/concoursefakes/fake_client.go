@@ -23,6 +23,22 @@ type FakeClient struct {
 	abortBuildReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuditEventsStub        func(concourse.AuditEventFilter, concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error)
+	auditEventsMutex       sync.RWMutex
+	auditEventsArgsForCall []struct {
+		arg1 concourse.AuditEventFilter
+		arg2 concourse.Page
+	}
+	auditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}
+	auditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}
 	BuildStub        func(string) (atc.Build, bool, error)
 	buildMutex       sync.RWMutex
 	buildArgsForCall []struct {
@@ -66,6 +82,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildQueuePositionStub        func(string) (atc.BuildQueuePosition, bool, error)
+	buildQueuePositionMutex       sync.RWMutex
+	buildQueuePositionArgsForCall []struct {
+		arg1 string
+	}
+	buildQueuePositionReturns struct {
+		result1 atc.BuildQueuePosition
+		result2 bool
+		result3 error
+	}
+	buildQueuePositionReturnsOnCall map[int]struct {
+		result1 atc.BuildQueuePosition
+		result2 bool
+		result3 error
+	}
 	BuildResourcesStub        func(int) (atc.BuildInputsOutputs, bool, error)
 	buildResourcesMutex       sync.RWMutex
 	buildResourcesArgsForCall []struct {
@@ -127,6 +158,18 @@ type FakeClient struct {
 		result2 http.Header
 		result3 error
 	}
+	GetEncryptionKeyRotationStub        func() (atc.EncryptionKeyRotation, error)
+	getEncryptionKeyRotationMutex       sync.RWMutex
+	getEncryptionKeyRotationArgsForCall []struct {
+	}
+	getEncryptionKeyRotationReturns struct {
+		result1 atc.EncryptionKeyRotation
+		result2 error
+	}
+	getEncryptionKeyRotationReturnsOnCall map[int]struct {
+		result1 atc.EncryptionKeyRotation
+		result2 error
+	}
 	GetInfoStub        func() (atc.Info, error)
 	getInfoMutex       sync.RWMutex
 	getInfoArgsForCall []struct {
@@ -247,6 +290,20 @@ type FakeClient struct {
 		result1 *atc.Worker
 		result2 error
 	}
+	SetBuildCommentStub        func(string, string) (bool, error)
+	setBuildCommentMutex       sync.RWMutex
+	setBuildCommentArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setBuildCommentReturns struct {
+		result1 bool
+		result2 error
+	}
+	setBuildCommentReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	TeamStub        func(string) concourse.Team
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -344,6 +401,73 @@ func (fake *FakeClient) AbortBuildReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) AuditEvents(arg1 concourse.AuditEventFilter, arg2 concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error) {
+	fake.auditEventsMutex.Lock()
+	ret, specificReturn := fake.auditEventsReturnsOnCall[len(fake.auditEventsArgsForCall)]
+	fake.auditEventsArgsForCall = append(fake.auditEventsArgsForCall, struct {
+		arg1 concourse.AuditEventFilter
+		arg2 concourse.Page
+	}{arg1, arg2})
+	fake.recordInvocation("AuditEvents", []interface{}{arg1, arg2})
+	fake.auditEventsMutex.Unlock()
+	if fake.AuditEventsStub != nil {
+		return fake.AuditEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.auditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) AuditEventsCallCount() int {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	return len(fake.auditEventsArgsForCall)
+}
+
+func (fake *FakeClient) AuditEventsCalls(stub func(concourse.AuditEventFilter, concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error)) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = stub
+}
+
+func (fake *FakeClient) AuditEventsArgsForCall(i int) (concourse.AuditEventFilter, concourse.Page) {
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
+	argsForCall := fake.auditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) AuditEventsReturns(result1 []atc.AuditEvent, result2 concourse.Pagination, result3 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	fake.auditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) AuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 concourse.Pagination, result3 error) {
+	fake.auditEventsMutex.Lock()
+	defer fake.auditEventsMutex.Unlock()
+	fake.AuditEventsStub = nil
+	if fake.auditEventsReturnsOnCall == nil {
+		fake.auditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 concourse.Pagination
+			result3 error
+		})
+	}
+	fake.auditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Build(arg1 string) (atc.Build, bool, error) {
 	fake.buildMutex.Lock()
 	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
@@ -539,6 +663,72 @@ func (fake *FakeClient) BuildPlanReturnsOnCall(i int, result1 atc.PublicBuildPla
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildQueuePosition(arg1 string) (atc.BuildQueuePosition, bool, error) {
+	fake.buildQueuePositionMutex.Lock()
+	ret, specificReturn := fake.buildQueuePositionReturnsOnCall[len(fake.buildQueuePositionArgsForCall)]
+	fake.buildQueuePositionArgsForCall = append(fake.buildQueuePositionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("BuildQueuePosition", []interface{}{arg1})
+	fake.buildQueuePositionMutex.Unlock()
+	if fake.BuildQueuePositionStub != nil {
+		return fake.BuildQueuePositionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildQueuePositionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildQueuePositionCallCount() int {
+	fake.buildQueuePositionMutex.RLock()
+	defer fake.buildQueuePositionMutex.RUnlock()
+	return len(fake.buildQueuePositionArgsForCall)
+}
+
+func (fake *FakeClient) BuildQueuePositionCalls(stub func(string) (atc.BuildQueuePosition, bool, error)) {
+	fake.buildQueuePositionMutex.Lock()
+	defer fake.buildQueuePositionMutex.Unlock()
+	fake.BuildQueuePositionStub = stub
+}
+
+func (fake *FakeClient) BuildQueuePositionArgsForCall(i int) string {
+	fake.buildQueuePositionMutex.RLock()
+	defer fake.buildQueuePositionMutex.RUnlock()
+	argsForCall := fake.buildQueuePositionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildQueuePositionReturns(result1 atc.BuildQueuePosition, result2 bool, result3 error) {
+	fake.buildQueuePositionMutex.Lock()
+	defer fake.buildQueuePositionMutex.Unlock()
+	fake.BuildQueuePositionStub = nil
+	fake.buildQueuePositionReturns = struct {
+		result1 atc.BuildQueuePosition
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildQueuePositionReturnsOnCall(i int, result1 atc.BuildQueuePosition, result2 bool, result3 error) {
+	fake.buildQueuePositionMutex.Lock()
+	defer fake.buildQueuePositionMutex.Unlock()
+	fake.BuildQueuePositionStub = nil
+	if fake.buildQueuePositionReturnsOnCall == nil {
+		fake.buildQueuePositionReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildQueuePosition
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildQueuePositionReturnsOnCall[i] = struct {
+		result1 atc.BuildQueuePosition
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) BuildResources(arg1 int) (atc.BuildInputsOutputs, bool, error) {
 	fake.buildResourcesMutex.Lock()
 	ret, specificReturn := fake.buildResourcesReturnsOnCall[len(fake.buildResourcesArgsForCall)]
@@ -804,6 +994,61 @@ func (fake *FakeClient) GetCLIReaderReturnsOnCall(i int, result1 io.ReadCloser,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) GetEncryptionKeyRotation() (atc.EncryptionKeyRotation, error) {
+	fake.getEncryptionKeyRotationMutex.Lock()
+	ret, specificReturn := fake.getEncryptionKeyRotationReturnsOnCall[len(fake.getEncryptionKeyRotationArgsForCall)]
+	fake.getEncryptionKeyRotationArgsForCall = append(fake.getEncryptionKeyRotationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetEncryptionKeyRotation", []interface{}{})
+	fake.getEncryptionKeyRotationMutex.Unlock()
+	if fake.GetEncryptionKeyRotationStub != nil {
+		return fake.GetEncryptionKeyRotationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getEncryptionKeyRotationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetEncryptionKeyRotationCallCount() int {
+	fake.getEncryptionKeyRotationMutex.RLock()
+	defer fake.getEncryptionKeyRotationMutex.RUnlock()
+	return len(fake.getEncryptionKeyRotationArgsForCall)
+}
+
+func (fake *FakeClient) GetEncryptionKeyRotationCalls(stub func() (atc.EncryptionKeyRotation, error)) {
+	fake.getEncryptionKeyRotationMutex.Lock()
+	defer fake.getEncryptionKeyRotationMutex.Unlock()
+	fake.GetEncryptionKeyRotationStub = stub
+}
+
+func (fake *FakeClient) GetEncryptionKeyRotationReturns(result1 atc.EncryptionKeyRotation, result2 error) {
+	fake.getEncryptionKeyRotationMutex.Lock()
+	defer fake.getEncryptionKeyRotationMutex.Unlock()
+	fake.GetEncryptionKeyRotationStub = nil
+	fake.getEncryptionKeyRotationReturns = struct {
+		result1 atc.EncryptionKeyRotation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetEncryptionKeyRotationReturnsOnCall(i int, result1 atc.EncryptionKeyRotation, result2 error) {
+	fake.getEncryptionKeyRotationMutex.Lock()
+	defer fake.getEncryptionKeyRotationMutex.Unlock()
+	fake.GetEncryptionKeyRotationStub = nil
+	if fake.getEncryptionKeyRotationReturnsOnCall == nil {
+		fake.getEncryptionKeyRotationReturnsOnCall = make(map[int]struct {
+			result1 atc.EncryptionKeyRotation
+			result2 error
+		})
+	}
+	fake.getEncryptionKeyRotationReturnsOnCall[i] = struct {
+		result1 atc.EncryptionKeyRotation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) GetInfo() (atc.Info, error) {
 	fake.getInfoMutex.Lock()
 	ret, specificReturn := fake.getInfoReturnsOnCall[len(fake.getInfoArgsForCall)]
@@ -1386,6 +1631,70 @@ func (fake *FakeClient) SaveWorkerReturnsOnCall(i int, result1 *atc.Worker, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SetBuildComment(arg1 string, arg2 string) (bool, error) {
+	fake.setBuildCommentMutex.Lock()
+	ret, specificReturn := fake.setBuildCommentReturnsOnCall[len(fake.setBuildCommentArgsForCall)]
+	fake.setBuildCommentArgsForCall = append(fake.setBuildCommentArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetBuildComment", []interface{}{arg1, arg2})
+	fake.setBuildCommentMutex.Unlock()
+	if fake.SetBuildCommentStub != nil {
+		return fake.SetBuildCommentStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setBuildCommentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SetBuildCommentCallCount() int {
+	fake.setBuildCommentMutex.RLock()
+	defer fake.setBuildCommentMutex.RUnlock()
+	return len(fake.setBuildCommentArgsForCall)
+}
+
+func (fake *FakeClient) SetBuildCommentCalls(stub func(string, string) (bool, error)) {
+	fake.setBuildCommentMutex.Lock()
+	defer fake.setBuildCommentMutex.Unlock()
+	fake.SetBuildCommentStub = stub
+}
+
+func (fake *FakeClient) SetBuildCommentArgsForCall(i int) (string, string) {
+	fake.setBuildCommentMutex.RLock()
+	defer fake.setBuildCommentMutex.RUnlock()
+	argsForCall := fake.setBuildCommentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) SetBuildCommentReturns(result1 bool, result2 error) {
+	fake.setBuildCommentMutex.Lock()
+	defer fake.setBuildCommentMutex.Unlock()
+	fake.SetBuildCommentStub = nil
+	fake.setBuildCommentReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SetBuildCommentReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.setBuildCommentMutex.Lock()
+	defer fake.setBuildCommentMutex.Unlock()
+	fake.SetBuildCommentStub = nil
+	if fake.setBuildCommentReturnsOnCall == nil {
+		fake.setBuildCommentReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.setBuildCommentReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Team(arg1 string) concourse.Team {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -1558,12 +1867,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortBuildMutex.RLock()
 	defer fake.abortBuildMutex.RUnlock()
+	fake.auditEventsMutex.RLock()
+	defer fake.auditEventsMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
 	defer fake.buildEventsMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
+	fake.buildQueuePositionMutex.RLock()
+	defer fake.buildQueuePositionMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
@@ -1572,6 +1885,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.checkMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
+	fake.getEncryptionKeyRotationMutex.RLock()
+	defer fake.getEncryptionKeyRotationMutex.RUnlock()
 	fake.getInfoMutex.RLock()
 	defer fake.getInfoMutex.RUnlock()
 	fake.hTTPClientMutex.RLock()
@@ -1592,6 +1907,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.pruneWorkerMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setBuildCommentMutex.RLock()
+	defer fake.setBuildCommentMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
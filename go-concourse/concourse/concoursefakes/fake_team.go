@@ -10,10 +10,35 @@ import (
 )
 
 type FakeTeam struct {
-	BuildInputsForJobStub        func(string, string) ([]atc.BuildInput, bool, error)
+	APITokensStub        func() ([]atc.APIToken, error)
+	aPITokensMutex       sync.RWMutex
+	aPITokensArgsForCall []struct {
+	}
+	aPITokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	aPITokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	ArchivePipelineStub        func(atc.PipelineRef) (bool, error)
+	archivePipelineMutex       sync.RWMutex
+	archivePipelineArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	archivePipelineReturns struct {
+		result1 bool
+		result2 error
+	}
+	archivePipelineReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	BuildInputsForJobStub        func(atc.PipelineRef, string) ([]atc.BuildInput, bool, error)
 	buildInputsForJobMutex       sync.RWMutex
 	buildInputsForJobArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	buildInputsForJobReturns struct {
@@ -125,6 +150,19 @@ type FakeTeam struct {
 		result1 int64
 		result2 error
 	}
+	CreateAPITokenStub        func(atc.CreateAPITokenRequestBody) (atc.APIToken, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 atc.CreateAPITokenRequestBody
+	}
+	createAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
 	CreateArtifactStub        func(io.Reader, string) (atc.WorkerArtifact, error)
 	createArtifactMutex       sync.RWMutex
 	createArtifactArgsForCall []struct {
@@ -183,10 +221,10 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	CreateOrUpdatePipelineConfigStub        func(string, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)
+	CreateOrUpdatePipelineConfigStub        func(atc.PipelineRef, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)
 	createOrUpdatePipelineConfigMutex       sync.RWMutex
 	createOrUpdatePipelineConfigArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 []byte
 		arg4 bool
@@ -203,10 +241,10 @@ type FakeTeam struct {
 		result3 []concourse.ConfigWarning
 		result4 error
 	}
-	CreatePipelineBuildStub        func(string, atc.Plan) (atc.Build, error)
+	CreatePipelineBuildStub        func(atc.PipelineRef, atc.Plan) (atc.Build, error)
 	createPipelineBuildMutex       sync.RWMutex
 	createPipelineBuildArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Plan
 	}
 	createPipelineBuildReturns struct {
@@ -217,10 +255,10 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
-	DeletePipelineStub        func(string) (bool, error)
+	DeletePipelineStub        func(atc.PipelineRef) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	deletePipelineReturns struct {
 		result1 bool
@@ -241,6 +279,19 @@ type FakeTeam struct {
 	destroyTeamReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DestroyWebhookStub        func(string) (bool, error)
+	destroyWebhookMutex       sync.RWMutex
+	destroyWebhookArgsForCall []struct {
+		arg1 string
+	}
+	destroyWebhookReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyWebhookReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DisableResourceVersionStub        func(string, string, int) (bool, error)
 	disableResourceVersionMutex       sync.RWMutex
 	disableResourceVersionArgsForCall []struct {
@@ -271,10 +322,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	ExposePipelineStub        func(string) (bool, error)
+	ExposePipelineStub        func(atc.PipelineRef) (bool, error)
 	exposePipelineMutex       sync.RWMutex
 	exposePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	exposePipelineReturns struct {
 		result1 bool
@@ -310,10 +361,10 @@ type FakeTeam struct {
 		result1 atc.Container
 		result2 error
 	}
-	HidePipelineStub        func(string) (bool, error)
+	HidePipelineStub        func(atc.PipelineRef) (bool, error)
 	hidePipelineMutex       sync.RWMutex
 	hidePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	hidePipelineReturns struct {
 		result1 bool
@@ -473,10 +524,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	PausePipelineStub        func(string) (bool, error)
+	PausePipelineStub        func(atc.PipelineRef) (bool, error)
 	pausePipelineMutex       sync.RWMutex
 	pausePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pausePipelineReturns struct {
 		result1 bool
@@ -501,10 +552,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	PipelineStub        func(string) (atc.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (atc.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 atc.Pipeline
@@ -516,10 +567,10 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	PipelineBuildsStub        func(string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
+	PipelineBuildsStub        func(atc.PipelineRef, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
 	pipelineBuildsMutex       sync.RWMutex
 	pipelineBuildsArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 concourse.Page
 	}
 	pipelineBuildsReturns struct {
@@ -534,10 +585,10 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	PipelineConfigStub        func(string) (atc.Config, string, bool, error)
+	PipelineConfigStub        func(atc.PipelineRef) (atc.Config, string, bool, error)
 	pipelineConfigMutex       sync.RWMutex
 	pipelineConfigArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineConfigReturns struct {
 		result1 atc.Config
@@ -551,10 +602,10 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	RenamePipelineStub        func(string, string) (bool, error)
+	RenamePipelineStub        func(atc.PipelineRef, string) (bool, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	renamePipelineReturns struct {
@@ -594,6 +645,23 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	ResolveVarStub        func(atc.PipelineRef, string, bool) (atc.VarTrace, bool, error)
+	resolveVarMutex       sync.RWMutex
+	resolveVarArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 bool
+	}
+	resolveVarReturns struct {
+		result1 atc.VarTrace
+		result2 bool
+		result3 error
+	}
+	resolveVarReturnsOnCall map[int]struct {
+		result1 atc.VarTrace
+		result2 bool
+		result3 error
+	}
 	ResourceStub        func(string, string) (atc.Resource, bool, error)
 	resourceMutex       sync.RWMutex
 	resourceArgsForCall []struct {
@@ -630,6 +698,19 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	RevokeAPITokenStub        func(string) (bool, error)
+	revokeAPITokenMutex       sync.RWMutex
+	revokeAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	revokeAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ScheduleJobStub        func(string, string) (bool, error)
 	scheduleJobMutex       sync.RWMutex
 	scheduleJobArgsForCall []struct {
@@ -659,6 +740,18 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetWebhookStub        func(string, string) error
+	setWebhookMutex       sync.RWMutex
+	setWebhookArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	setWebhookReturns struct {
+		result1 error
+	}
+	setWebhookReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TeamStub        func(string) (atc.Team, bool, error)
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -688,10 +781,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	UnpausePipelineStub        func(string) (bool, error)
+	UnpausePipelineStub        func(atc.PipelineRef) (bool, error)
 	unpausePipelineMutex       sync.RWMutex
 	unpausePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	unpausePipelineReturns struct {
 		result1 bool
@@ -715,10 +808,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	VersionedResourceTypesStub        func(string) (atc.VersionedResourceTypes, bool, error)
+	VersionedResourceTypesStub        func(atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)
 	versionedResourceTypesMutex       sync.RWMutex
 	versionedResourceTypesArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	versionedResourceTypesReturns struct {
 		result1 atc.VersionedResourceTypes
@@ -734,11 +827,129 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeTeam) BuildInputsForJob(arg1 string, arg2 string) ([]atc.BuildInput, bool, error) {
+func (fake *FakeTeam) APITokens() ([]atc.APIToken, error) {
+	fake.aPITokensMutex.Lock()
+	ret, specificReturn := fake.aPITokensReturnsOnCall[len(fake.aPITokensArgsForCall)]
+	fake.aPITokensArgsForCall = append(fake.aPITokensArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APITokens", []interface{}{})
+	fake.aPITokensMutex.Unlock()
+	if fake.APITokensStub != nil {
+		return fake.APITokensStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.aPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) APITokensCallCount() int {
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
+	return len(fake.aPITokensArgsForCall)
+}
+
+func (fake *FakeTeam) APITokensCalls(stub func() ([]atc.APIToken, error)) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = stub
+}
+
+func (fake *FakeTeam) APITokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	fake.aPITokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) APITokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	if fake.aPITokensReturnsOnCall == nil {
+		fake.aPITokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.aPITokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ArchivePipeline(arg1 atc.PipelineRef) (bool, error) {
+	fake.archivePipelineMutex.Lock()
+	ret, specificReturn := fake.archivePipelineReturnsOnCall[len(fake.archivePipelineArgsForCall)]
+	fake.archivePipelineArgsForCall = append(fake.archivePipelineArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	fake.recordInvocation("ArchivePipeline", []interface{}{arg1})
+	fake.archivePipelineMutex.Unlock()
+	if fake.ArchivePipelineStub != nil {
+		return fake.ArchivePipelineStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.archivePipelineReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ArchivePipelineCallCount() int {
+	fake.archivePipelineMutex.RLock()
+	defer fake.archivePipelineMutex.RUnlock()
+	return len(fake.archivePipelineArgsForCall)
+}
+
+func (fake *FakeTeam) ArchivePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
+	fake.archivePipelineMutex.Lock()
+	defer fake.archivePipelineMutex.Unlock()
+	fake.ArchivePipelineStub = stub
+}
+
+func (fake *FakeTeam) ArchivePipelineArgsForCall(i int) atc.PipelineRef {
+	fake.archivePipelineMutex.RLock()
+	defer fake.archivePipelineMutex.RUnlock()
+	argsForCall := fake.archivePipelineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) ArchivePipelineReturns(result1 bool, result2 error) {
+	fake.archivePipelineMutex.Lock()
+	defer fake.archivePipelineMutex.Unlock()
+	fake.ArchivePipelineStub = nil
+	fake.archivePipelineReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ArchivePipelineReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.archivePipelineMutex.Lock()
+	defer fake.archivePipelineMutex.Unlock()
+	fake.ArchivePipelineStub = nil
+	if fake.archivePipelineReturnsOnCall == nil {
+		fake.archivePipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.archivePipelineReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) BuildInputsForJob(arg1 atc.PipelineRef, arg2 string) ([]atc.BuildInput, bool, error) {
 	fake.buildInputsForJobMutex.Lock()
 	ret, specificReturn := fake.buildInputsForJobReturnsOnCall[len(fake.buildInputsForJobArgsForCall)]
 	fake.buildInputsForJobArgsForCall = append(fake.buildInputsForJobArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("BuildInputsForJob", []interface{}{arg1, arg2})
@@ -759,13 +970,13 @@ func (fake *FakeTeam) BuildInputsForJobCallCount() int {
 	return len(fake.buildInputsForJobArgsForCall)
 }
 
-func (fake *FakeTeam) BuildInputsForJobCalls(stub func(string, string) ([]atc.BuildInput, bool, error)) {
+func (fake *FakeTeam) BuildInputsForJobCalls(stub func(atc.PipelineRef, string) ([]atc.BuildInput, bool, error)) {
 	fake.buildInputsForJobMutex.Lock()
 	defer fake.buildInputsForJobMutex.Unlock()
 	fake.BuildInputsForJobStub = stub
 }
 
-func (fake *FakeTeam) BuildInputsForJobArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) BuildInputsForJobArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
 	argsForCall := fake.buildInputsForJobArgsForCall[i]
@@ -1205,6 +1416,69 @@ func (fake *FakeTeam) ClearTaskCacheReturnsOnCall(i int, result1 int64, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateAPIToken(arg1 atc.CreateAPITokenRequestBody) (atc.APIToken, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 atc.CreateAPITokenRequestBody
+	}{arg1})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateAPITokenCalls(stub func(atc.CreateAPITokenRequestBody) (atc.APIToken, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeTeam) CreateAPITokenArgsForCall(i int) atc.CreateAPITokenRequestBody {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateAPITokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateArtifact(arg1 io.Reader, arg2 string) (atc.WorkerArtifact, error) {
 	fake.createArtifactMutex.Lock()
 	ret, specificReturn := fake.createArtifactReturnsOnCall[len(fake.createArtifactArgsForCall)]
@@ -1465,7 +1739,7 @@ func (fake *FakeTeam) CreateOrUpdateReturnsOnCall(i int, result1 atc.Team, resul
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 string, arg2 string, arg3 []byte, arg4 bool) (bool, bool, []concourse.ConfigWarning, error) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 atc.PipelineRef, arg2 string, arg3 []byte, arg4 bool) (bool, bool, []concourse.ConfigWarning, error) {
 	var arg3Copy []byte
 	if arg3 != nil {
 		arg3Copy = make([]byte, len(arg3))
@@ -1474,7 +1748,7 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 string, arg2 string, arg
 	fake.createOrUpdatePipelineConfigMutex.Lock()
 	ret, specificReturn := fake.createOrUpdatePipelineConfigReturnsOnCall[len(fake.createOrUpdatePipelineConfigArgsForCall)]
 	fake.createOrUpdatePipelineConfigArgsForCall = append(fake.createOrUpdatePipelineConfigArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 []byte
 		arg4 bool
@@ -1497,13 +1771,13 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfigCallCount() int {
 	return len(fake.createOrUpdatePipelineConfigArgsForCall)
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfigCalls(stub func(string, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigCalls(stub func(atc.PipelineRef, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)) {
 	fake.createOrUpdatePipelineConfigMutex.Lock()
 	defer fake.createOrUpdatePipelineConfigMutex.Unlock()
 	fake.CreateOrUpdatePipelineConfigStub = stub
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfigArgsForCall(i int) (string, string, []byte, bool) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigArgsForCall(i int) (atc.PipelineRef, string, []byte, bool) {
 	fake.createOrUpdatePipelineConfigMutex.RLock()
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
 	argsForCall := fake.createOrUpdatePipelineConfigArgsForCall[i]
@@ -1542,11 +1816,11 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfigReturnsOnCall(i int, result1 b
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) CreatePipelineBuild(arg1 string, arg2 atc.Plan) (atc.Build, error) {
+func (fake *FakeTeam) CreatePipelineBuild(arg1 atc.PipelineRef, arg2 atc.Plan) (atc.Build, error) {
 	fake.createPipelineBuildMutex.Lock()
 	ret, specificReturn := fake.createPipelineBuildReturnsOnCall[len(fake.createPipelineBuildArgsForCall)]
 	fake.createPipelineBuildArgsForCall = append(fake.createPipelineBuildArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Plan
 	}{arg1, arg2})
 	fake.recordInvocation("CreatePipelineBuild", []interface{}{arg1, arg2})
@@ -1567,13 +1841,13 @@ func (fake *FakeTeam) CreatePipelineBuildCallCount() int {
 	return len(fake.createPipelineBuildArgsForCall)
 }
 
-func (fake *FakeTeam) CreatePipelineBuildCalls(stub func(string, atc.Plan) (atc.Build, error)) {
+func (fake *FakeTeam) CreatePipelineBuildCalls(stub func(atc.PipelineRef, atc.Plan) (atc.Build, error)) {
 	fake.createPipelineBuildMutex.Lock()
 	defer fake.createPipelineBuildMutex.Unlock()
 	fake.CreatePipelineBuildStub = stub
 }
 
-func (fake *FakeTeam) CreatePipelineBuildArgsForCall(i int) (string, atc.Plan) {
+func (fake *FakeTeam) CreatePipelineBuildArgsForCall(i int) (atc.PipelineRef, atc.Plan) {
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
 	argsForCall := fake.createPipelineBuildArgsForCall[i]
@@ -1606,11 +1880,11 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) DeletePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) DeletePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
 	fake.deletePipelineArgsForCall = append(fake.deletePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("DeletePipeline", []interface{}{arg1})
 	fake.deletePipelineMutex.Unlock()
@@ -1630,13 +1904,13 @@ func (fake *FakeTeam) DeletePipelineCallCount() int {
 	return len(fake.deletePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) DeletePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) DeletePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.deletePipelineMutex.Lock()
 	defer fake.deletePipelineMutex.Unlock()
 	fake.DeletePipelineStub = stub
 }
 
-func (fake *FakeTeam) DeletePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) DeletePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
 	argsForCall := fake.deletePipelineArgsForCall[i]
@@ -1729,6 +2003,69 @@ func (fake *FakeTeam) DestroyTeamReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DestroyWebhook(arg1 string) (bool, error) {
+	fake.destroyWebhookMutex.Lock()
+	ret, specificReturn := fake.destroyWebhookReturnsOnCall[len(fake.destroyWebhookArgsForCall)]
+	fake.destroyWebhookArgsForCall = append(fake.destroyWebhookArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DestroyWebhook", []interface{}{arg1})
+	fake.destroyWebhookMutex.Unlock()
+	if fake.DestroyWebhookStub != nil {
+		return fake.DestroyWebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.destroyWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyWebhookCallCount() int {
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
+	return len(fake.destroyWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyWebhookCalls(stub func(string) (bool, error)) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = stub
+}
+
+func (fake *FakeTeam) DestroyWebhookArgsForCall(i int) string {
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
+	argsForCall := fake.destroyWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyWebhookReturns(result1 bool, result2 error) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = nil
+	fake.destroyWebhookReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyWebhookReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyWebhookMutex.Lock()
+	defer fake.destroyWebhookMutex.Unlock()
+	fake.DestroyWebhookStub = nil
+	if fake.destroyWebhookReturnsOnCall == nil {
+		fake.destroyWebhookReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyWebhookReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DisableResourceVersion(arg1 string, arg2 string, arg3 int) (bool, error) {
 	fake.disableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.disableResourceVersionReturnsOnCall[len(fake.disableResourceVersionArgsForCall)]
@@ -1859,11 +2196,11 @@ func (fake *FakeTeam) EnableResourceVersionReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) ExposePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) ExposePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.exposePipelineMutex.Lock()
 	ret, specificReturn := fake.exposePipelineReturnsOnCall[len(fake.exposePipelineArgsForCall)]
 	fake.exposePipelineArgsForCall = append(fake.exposePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("ExposePipeline", []interface{}{arg1})
 	fake.exposePipelineMutex.Unlock()
@@ -1883,13 +2220,13 @@ func (fake *FakeTeam) ExposePipelineCallCount() int {
 	return len(fake.exposePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) ExposePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) ExposePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.exposePipelineMutex.Lock()
 	defer fake.exposePipelineMutex.Unlock()
 	fake.ExposePipelineStub = stub
 }
 
-func (fake *FakeTeam) ExposePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) ExposePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
 	argsForCall := fake.exposePipelineArgsForCall[i]
@@ -2048,11 +2385,11 @@ func (fake *FakeTeam) GetContainerReturnsOnCall(i int, result1 atc.Container, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) HidePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) HidePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.hidePipelineMutex.Lock()
 	ret, specificReturn := fake.hidePipelineReturnsOnCall[len(fake.hidePipelineArgsForCall)]
 	fake.hidePipelineArgsForCall = append(fake.hidePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("HidePipeline", []interface{}{arg1})
 	fake.hidePipelineMutex.Unlock()
@@ -2072,13 +2409,13 @@ func (fake *FakeTeam) HidePipelineCallCount() int {
 	return len(fake.hidePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) HidePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) HidePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.hidePipelineMutex.Lock()
 	defer fake.hidePipelineMutex.Unlock()
 	fake.HidePipelineStub = stub
 }
 
-func (fake *FakeTeam) HidePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) HidePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.hidePipelineMutex.RLock()
 	defer fake.hidePipelineMutex.RUnlock()
 	argsForCall := fake.hidePipelineArgsForCall[i]
@@ -2797,11 +3134,11 @@ func (fake *FakeTeam) PauseJobReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) PausePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) PausePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.pausePipelineMutex.Lock()
 	ret, specificReturn := fake.pausePipelineReturnsOnCall[len(fake.pausePipelineArgsForCall)]
 	fake.pausePipelineArgsForCall = append(fake.pausePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("PausePipeline", []interface{}{arg1})
 	fake.pausePipelineMutex.Unlock()
@@ -2821,13 +3158,13 @@ func (fake *FakeTeam) PausePipelineCallCount() int {
 	return len(fake.pausePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PausePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) PausePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.pausePipelineMutex.Lock()
 	defer fake.pausePipelineMutex.Unlock()
 	fake.PausePipelineStub = stub
 }
 
-func (fake *FakeTeam) PausePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PausePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pausePipelineMutex.RLock()
 	defer fake.pausePipelineMutex.RUnlock()
 	argsForCall := fake.pausePipelineArgsForCall[i]
@@ -2925,11 +3262,11 @@ func (fake *FakeTeam) PinResourceVersionReturnsOnCall(i int, result1 bool, resul
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (atc.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (atc.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -2949,13 +3286,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (atc.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (atc.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -2991,11 +3328,11 @@ func (fake *FakeTeam) PipelineReturnsOnCall(i int, result1 atc.Pipeline, result2
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) PipelineBuilds(arg1 string, arg2 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
+func (fake *FakeTeam) PipelineBuilds(arg1 atc.PipelineRef, arg2 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
 	fake.pipelineBuildsMutex.Lock()
 	ret, specificReturn := fake.pipelineBuildsReturnsOnCall[len(fake.pipelineBuildsArgsForCall)]
 	fake.pipelineBuildsArgsForCall = append(fake.pipelineBuildsArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 concourse.Page
 	}{arg1, arg2})
 	fake.recordInvocation("PipelineBuilds", []interface{}{arg1, arg2})
@@ -3016,13 +3353,13 @@ func (fake *FakeTeam) PipelineBuildsCallCount() int {
 	return len(fake.pipelineBuildsArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineBuildsCalls(stub func(string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)) {
+func (fake *FakeTeam) PipelineBuildsCalls(stub func(atc.PipelineRef, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)) {
 	fake.pipelineBuildsMutex.Lock()
 	defer fake.pipelineBuildsMutex.Unlock()
 	fake.PipelineBuildsStub = stub
 }
 
-func (fake *FakeTeam) PipelineBuildsArgsForCall(i int) (string, concourse.Page) {
+func (fake *FakeTeam) PipelineBuildsArgsForCall(i int) (atc.PipelineRef, concourse.Page) {
 	fake.pipelineBuildsMutex.RLock()
 	defer fake.pipelineBuildsMutex.RUnlock()
 	argsForCall := fake.pipelineBuildsArgsForCall[i]
@@ -3061,11 +3398,11 @@ func (fake *FakeTeam) PipelineBuildsReturnsOnCall(i int, result1 []atc.Build, re
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) PipelineConfig(arg1 string) (atc.Config, string, bool, error) {
+func (fake *FakeTeam) PipelineConfig(arg1 atc.PipelineRef) (atc.Config, string, bool, error) {
 	fake.pipelineConfigMutex.Lock()
 	ret, specificReturn := fake.pipelineConfigReturnsOnCall[len(fake.pipelineConfigArgsForCall)]
 	fake.pipelineConfigArgsForCall = append(fake.pipelineConfigArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("PipelineConfig", []interface{}{arg1})
 	fake.pipelineConfigMutex.Unlock()
@@ -3085,13 +3422,13 @@ func (fake *FakeTeam) PipelineConfigCallCount() int {
 	return len(fake.pipelineConfigArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineConfigCalls(stub func(string) (atc.Config, string, bool, error)) {
+func (fake *FakeTeam) PipelineConfigCalls(stub func(atc.PipelineRef) (atc.Config, string, bool, error)) {
 	fake.pipelineConfigMutex.Lock()
 	defer fake.pipelineConfigMutex.Unlock()
 	fake.PipelineConfigStub = stub
 }
 
-func (fake *FakeTeam) PipelineConfigArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineConfigArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
 	argsForCall := fake.pipelineConfigArgsForCall[i]
@@ -3130,11 +3467,11 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, error) {
+func (fake *FakeTeam) RenamePipeline(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
 	fake.renamePipelineArgsForCall = append(fake.renamePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("RenamePipeline", []interface{}{arg1, arg2})
@@ -3155,13 +3492,13 @@ func (fake *FakeTeam) RenamePipelineCallCount() int {
 	return len(fake.renamePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) RenamePipelineCalls(stub func(string, string) (bool, error)) {
+func (fake *FakeTeam) RenamePipelineCalls(stub func(atc.PipelineRef, string) (bool, error)) {
 	fake.renamePipelineMutex.Lock()
 	defer fake.renamePipelineMutex.Unlock()
 	fake.RenamePipelineStub = stub
 }
 
-func (fake *FakeTeam) RenamePipelineArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) RenamePipelineArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	argsForCall := fake.renamePipelineArgsForCall[i]
@@ -3323,6 +3660,74 @@ func (fake *FakeTeam) RerunJobBuildReturnsOnCall(i int, result1 atc.Build, resul
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ResolveVar(arg1 atc.PipelineRef, arg2 string, arg3 bool) (atc.VarTrace, bool, error) {
+	fake.resolveVarMutex.Lock()
+	ret, specificReturn := fake.resolveVarReturnsOnCall[len(fake.resolveVarArgsForCall)]
+	fake.resolveVarArgsForCall = append(fake.resolveVarArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ResolveVar", []interface{}{arg1, arg2, arg3})
+	fake.resolveVarMutex.Unlock()
+	if fake.ResolveVarStub != nil {
+		return fake.ResolveVarStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.resolveVarReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) ResolveVarCallCount() int {
+	fake.resolveVarMutex.RLock()
+	defer fake.resolveVarMutex.RUnlock()
+	return len(fake.resolveVarArgsForCall)
+}
+
+func (fake *FakeTeam) ResolveVarCalls(stub func(atc.PipelineRef, string, bool) (atc.VarTrace, bool, error)) {
+	fake.resolveVarMutex.Lock()
+	defer fake.resolveVarMutex.Unlock()
+	fake.ResolveVarStub = stub
+}
+
+func (fake *FakeTeam) ResolveVarArgsForCall(i int) (atc.PipelineRef, string, bool) {
+	fake.resolveVarMutex.RLock()
+	defer fake.resolveVarMutex.RUnlock()
+	argsForCall := fake.resolveVarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) ResolveVarReturns(result1 atc.VarTrace, result2 bool, result3 error) {
+	fake.resolveVarMutex.Lock()
+	defer fake.resolveVarMutex.Unlock()
+	fake.ResolveVarStub = nil
+	fake.resolveVarReturns = struct {
+		result1 atc.VarTrace
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ResolveVarReturnsOnCall(i int, result1 atc.VarTrace, result2 bool, result3 error) {
+	fake.resolveVarMutex.Lock()
+	defer fake.resolveVarMutex.Unlock()
+	fake.ResolveVarStub = nil
+	if fake.resolveVarReturnsOnCall == nil {
+		fake.resolveVarReturnsOnCall = make(map[int]struct {
+			result1 atc.VarTrace
+			result2 bool
+			result3 error
+		})
+	}
+	fake.resolveVarReturnsOnCall[i] = struct {
+		result1 atc.VarTrace
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) Resource(arg1 string, arg2 string) (atc.Resource, bool, error) {
 	fake.resourceMutex.Lock()
 	ret, specificReturn := fake.resourceReturnsOnCall[len(fake.resourceArgsForCall)]
@@ -3462,6 +3867,69 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) RevokeAPIToken(arg1 string) (bool, error) {
+	fake.revokeAPITokenMutex.Lock()
+	ret, specificReturn := fake.revokeAPITokenReturnsOnCall[len(fake.revokeAPITokenArgsForCall)]
+	fake.revokeAPITokenArgsForCall = append(fake.revokeAPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RevokeAPIToken", []interface{}{arg1})
+	fake.revokeAPITokenMutex.Unlock()
+	if fake.RevokeAPITokenStub != nil {
+		return fake.RevokeAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeAPITokenCallCount() int {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	return len(fake.revokeAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeAPITokenCalls(stub func(string) (bool, error)) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeAPITokenArgsForCall(i int) string {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	argsForCall := fake.revokeAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) RevokeAPITokenReturns(result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	fake.revokeAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	if fake.revokeAPITokenReturnsOnCall == nil {
+		fake.revokeAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ScheduleJob(arg1 string, arg2 string) (bool, error) {
 	fake.scheduleJobMutex.Lock()
 	ret, specificReturn := fake.scheduleJobReturnsOnCall[len(fake.scheduleJobArgsForCall)]
@@ -3591,6 +4059,67 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetWebhook(arg1 string, arg2 string) error {
+	fake.setWebhookMutex.Lock()
+	ret, specificReturn := fake.setWebhookReturnsOnCall[len(fake.setWebhookArgsForCall)]
+	fake.setWebhookArgsForCall = append(fake.setWebhookArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetWebhook", []interface{}{arg1, arg2})
+	fake.setWebhookMutex.Unlock()
+	if fake.SetWebhookStub != nil {
+		return fake.SetWebhookStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setWebhookReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetWebhookCallCount() int {
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	return len(fake.setWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) SetWebhookCalls(stub func(string, string) error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = stub
+}
+
+func (fake *FakeTeam) SetWebhookArgsForCall(i int) (string, string) {
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
+	argsForCall := fake.setWebhookArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) SetWebhookReturns(result1 error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = nil
+	fake.setWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetWebhookReturnsOnCall(i int, result1 error) {
+	fake.setWebhookMutex.Lock()
+	defer fake.setWebhookMutex.Unlock()
+	fake.SetWebhookStub = nil
+	if fake.setWebhookReturnsOnCall == nil {
+		fake.setWebhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setWebhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) Team(arg1 string) (atc.Team, bool, error) {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -3721,11 +4250,11 @@ func (fake *FakeTeam) UnpauseJobReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) UnpausePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) UnpausePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.unpausePipelineMutex.Lock()
 	ret, specificReturn := fake.unpausePipelineReturnsOnCall[len(fake.unpausePipelineArgsForCall)]
 	fake.unpausePipelineArgsForCall = append(fake.unpausePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("UnpausePipeline", []interface{}{arg1})
 	fake.unpausePipelineMutex.Unlock()
@@ -3745,13 +4274,13 @@ func (fake *FakeTeam) UnpausePipelineCallCount() int {
 	return len(fake.unpausePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) UnpausePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) UnpausePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.unpausePipelineMutex.Lock()
 	defer fake.unpausePipelineMutex.Unlock()
 	fake.UnpausePipelineStub = stub
 }
 
-func (fake *FakeTeam) UnpausePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) UnpausePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.unpausePipelineMutex.RLock()
 	defer fake.unpausePipelineMutex.RUnlock()
 	argsForCall := fake.unpausePipelineArgsForCall[i]
@@ -3848,11 +4377,11 @@ func (fake *FakeTeam) UnpinResourceReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) VersionedResourceTypes(arg1 string) (atc.VersionedResourceTypes, bool, error) {
+func (fake *FakeTeam) VersionedResourceTypes(arg1 atc.PipelineRef) (atc.VersionedResourceTypes, bool, error) {
 	fake.versionedResourceTypesMutex.Lock()
 	ret, specificReturn := fake.versionedResourceTypesReturnsOnCall[len(fake.versionedResourceTypesArgsForCall)]
 	fake.versionedResourceTypesArgsForCall = append(fake.versionedResourceTypesArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("VersionedResourceTypes", []interface{}{arg1})
 	fake.versionedResourceTypesMutex.Unlock()
@@ -3872,13 +4401,13 @@ func (fake *FakeTeam) VersionedResourceTypesCallCount() int {
 	return len(fake.versionedResourceTypesArgsForCall)
 }
 
-func (fake *FakeTeam) VersionedResourceTypesCalls(stub func(string) (atc.VersionedResourceTypes, bool, error)) {
+func (fake *FakeTeam) VersionedResourceTypesCalls(stub func(atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)) {
 	fake.versionedResourceTypesMutex.Lock()
 	defer fake.versionedResourceTypesMutex.Unlock()
 	fake.VersionedResourceTypesStub = stub
 }
 
-func (fake *FakeTeam) VersionedResourceTypesArgsForCall(i int) string {
+func (fake *FakeTeam) VersionedResourceTypesArgsForCall(i int) atc.PipelineRef {
 	fake.versionedResourceTypesMutex.RLock()
 	defer fake.versionedResourceTypesMutex.RUnlock()
 	argsForCall := fake.versionedResourceTypesArgsForCall[i]
@@ -3917,6 +4446,10 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
+	fake.archivePipelineMutex.RLock()
+	defer fake.archivePipelineMutex.RUnlock()
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
 	fake.buildsMutex.RLock()
@@ -3931,6 +4464,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.checkResourceTypeMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
 	fake.createArtifactMutex.RLock()
 	defer fake.createArtifactMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -3947,6 +4482,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.deletePipelineMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
+	fake.destroyWebhookMutex.RLock()
+	defer fake.destroyWebhookMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
@@ -3997,14 +4534,20 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.renameTeamMutex.RUnlock()
 	fake.rerunJobBuildMutex.RLock()
 	defer fake.rerunJobBuildMutex.RUnlock()
+	fake.resolveVarMutex.RLock()
+	defer fake.resolveVarMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.setWebhookMutex.RLock()
+	defer fake.setWebhookMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
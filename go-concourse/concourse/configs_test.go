@@ -84,7 +84,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns the given config and version for that pipeline", func() {
-				pipelineConfig, version, found, err := team.PipelineConfig("mypipeline")
+				pipelineConfig, version, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(pipelineConfig).To(Equal(expectedConfig))
 				Expect(version).To(Equal(expectedVersion))
@@ -103,7 +103,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.PipelineConfig("mypipeline")
+				_, _, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -120,7 +120,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns the error", func() {
-				_, _, _, err := team.PipelineConfig("mypipeline")
+				_, _, _, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -136,7 +136,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns an error", func() {
-				_, _, _, err := team.PipelineConfig("mypipeline")
+				_, _, _, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -195,7 +195,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns true for created and false for updated", func() {
-				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(created).To(BeTrue())
 				Expect(updated).To(BeFalse())
@@ -217,7 +217,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -230,7 +230,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				It("submits with check_creds query param set", func() {
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(0))
 
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
@@ -249,7 +249,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns false for created and true for updated", func() {
-				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(created).To(BeFalse())
 				Expect(updated).To(BeTrue())
@@ -271,7 +271,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -284,7 +284,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				It("submits with check_creds query param set", func() {
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(0))
 
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
@@ -300,7 +300,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns config validation error", func() {
-				_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("invalid pipeline config:\n"))
 				Expect(err.Error()).To(ContainSubstring("fake-error1\nfake-error2"))
@@ -312,7 +312,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
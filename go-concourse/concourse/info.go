@@ -16,3 +16,15 @@ func (client *client) GetInfo() (atc.Info, error) {
 
 	return info, err
 }
+
+func (client *client) GetEncryptionKeyRotation() (atc.EncryptionKeyRotation, error) {
+	var rotation atc.EncryptionKeyRotation
+
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetEncryptionKeyRotation,
+	}, &internal.Response{
+		Result: &rotation,
+	})
+
+	return rotation, err
+}
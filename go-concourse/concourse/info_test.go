@@ -31,4 +31,32 @@ var _ = Describe("ATC Info", func() {
 			Expect(info.Version).To(Equal("12.3.4"))
 		})
 	})
+
+	Describe("GetEncryptionKeyRotation", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/info/encryption"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.EncryptionKeyRotation{
+						State: atc.EncryptionKeyRotationRunning,
+						Columns: []atc.EncryptionKeyRotationColumn{
+							{Table: "teams", Column: "legacy_auth", RowsTotal: 2, RowsProcessed: 1},
+						},
+					}),
+				),
+			)
+		})
+
+		It("returns the progress of the rotation", func() {
+			rotation, err := client.GetEncryptionKeyRotation()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(rotation).To(Equal(atc.EncryptionKeyRotation{
+				State: atc.EncryptionKeyRotationRunning,
+				Columns: []atc.EncryptionKeyRotationColumn{
+					{Table: "teams", Column: "legacy_auth", RowsTotal: 2, RowsProcessed: 1},
+				},
+			}))
+		})
+	})
 })
@@ -11,9 +11,9 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) Pipeline(pipelineName string) (atc.Pipeline, bool, error) {
+func (team *team) Pipeline(pipelineRef atc.PipelineRef) (atc.Pipeline, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -21,6 +21,7 @@ func (team *team) Pipeline(pipelineName string) (atc.Pipeline, bool, error) {
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.GetPipeline,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &pipeline,
 	})
@@ -83,7 +84,7 @@ func (client *client) ListPipelines() ([]atc.Pipeline, error) {
 	return pipelines, err
 }
 
-func (team *team) CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error) {
+func (team *team) CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error) {
 	var build atc.Build
 
 	buffer := &bytes.Buffer{}
@@ -97,8 +98,9 @@ func (team *team) CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.B
 		Body:        buffer,
 		Params: rata.Params{
 			"team_name":     team.name,
-			"pipeline_name": pipelineName,
+			"pipeline_name": pipelineRef.Name,
 		},
+		Query: pipelineRef.QueryParams(),
 		Header: http.Header{
 			"Content-Type": {"application/json"},
 		},
@@ -108,35 +110,40 @@ func (team *team) CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.B
 
 	return build, err
 }
-func (team *team) DeletePipeline(pipelineName string) (bool, error) {
-	return team.managePipeline(pipelineName, atc.DeletePipeline)
+func (team *team) DeletePipeline(pipelineRef atc.PipelineRef) (bool, error) {
+	return team.managePipeline(pipelineRef, atc.DeletePipeline)
 }
 
-func (team *team) PausePipeline(pipelineName string) (bool, error) {
+func (team *team) PausePipeline(pipelineRef atc.PipelineRef) (bool, error) {
 
-	return team.managePipeline(pipelineName, atc.PausePipeline)
+	return team.managePipeline(pipelineRef, atc.PausePipeline)
 }
 
-func (team *team) UnpausePipeline(pipelineName string) (bool, error) {
-	return team.managePipeline(pipelineName, atc.UnpausePipeline)
+func (team *team) UnpausePipeline(pipelineRef atc.PipelineRef) (bool, error) {
+	return team.managePipeline(pipelineRef, atc.UnpausePipeline)
 }
 
-func (team *team) ExposePipeline(pipelineName string) (bool, error) {
-	return team.managePipeline(pipelineName, atc.ExposePipeline)
+func (team *team) ArchivePipeline(pipelineRef atc.PipelineRef) (bool, error) {
+	return team.managePipeline(pipelineRef, atc.ArchivePipeline)
 }
 
-func (team *team) HidePipeline(pipelineName string) (bool, error) {
-	return team.managePipeline(pipelineName, atc.HidePipeline)
+func (team *team) ExposePipeline(pipelineRef atc.PipelineRef) (bool, error) {
+	return team.managePipeline(pipelineRef, atc.ExposePipeline)
 }
 
-func (team *team) managePipeline(pipelineName string, endpoint string) (bool, error) {
+func (team *team) HidePipeline(pipelineRef atc.PipelineRef) (bool, error) {
+	return team.managePipeline(pipelineRef, atc.HidePipeline)
+}
+
+func (team *team) managePipeline(pipelineRef atc.PipelineRef, endpoint string) (bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 	err := team.connection.Send(internal.Request{
 		RequestName: endpoint,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, nil)
 
 	switch err.(type) {
@@ -149,9 +156,9 @@ func (team *team) managePipeline(pipelineName string, endpoint string) (bool, er
 	}
 }
 
-func (team *team) RenamePipeline(pipelineName, name string) (bool, error) {
+func (team *team) RenamePipeline(pipelineRef atc.PipelineRef, name string) (bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -163,6 +170,7 @@ func (team *team) RenamePipeline(pipelineName, name string) (bool, error) {
 	err = team.connection.Send(internal.Request{
 		RequestName: atc.RenamePipeline,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 		Body:        bytes.NewBuffer(jsonBytes),
 		Header:      http.Header{"Content-Type": []string{"application/json"}},
 	}, nil)
@@ -176,19 +184,24 @@ func (team *team) RenamePipeline(pipelineName, name string) (bool, error) {
 	}
 }
 
-func (team *team) PipelineBuilds(pipelineName string, page Page) ([]atc.Build, Pagination, bool, error) {
+func (team *team) PipelineBuilds(pipelineRef atc.PipelineRef, page Page) ([]atc.Build, Pagination, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
+	queryParams := page.QueryParams()
+	for k, v := range pipelineRef.QueryParams() {
+		queryParams[k] = v
+	}
+
 	var builds []atc.Build
 
 	headers := http.Header{}
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListPipelineBuilds,
 		Params:      params,
-		Query:       page.QueryParams(),
+		Query:       queryParams,
 	}, &internal.Response{
 		Result:  &builds,
 		Headers: &headers,
@@ -25,7 +25,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.PausePipeline("mypipeline")
+				found, err := team.PausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -42,7 +42,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.PausePipeline("mypipeline")
+				found, err := team.PausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -62,7 +62,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.UnpausePipeline("mypipeline")
+				found, err := team.UnpausePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -79,7 +79,44 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.UnpausePipeline("mypipeline")
+				found, err := team.UnpausePipeline(atc.PipelineRef{Name: "mypipeline"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("ArchivePipeline", func() {
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/archive"
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, ""),
+					),
+				)
+			})
+
+			It("return true and no error", func() {
+				found, err := team.ArchivePipeline(atc.PipelineRef{Name: "mypipeline"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the pipeline doesn't exist", func() {
+			BeforeEach(func() {
+				expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/archive"
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, ""),
+					),
+				)
+			})
+			It("returns false and no error", func() {
+				found, err := team.ArchivePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -99,7 +136,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.ExposePipeline("mypipeline")
+				found, err := team.ExposePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -116,7 +153,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.ExposePipeline("mypipeline")
+				found, err := team.ExposePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -136,7 +173,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("return true and no error", func() {
-				found, err := team.HidePipeline("mypipeline")
+				found, err := team.HidePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 			})
@@ -153,7 +190,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				)
 			})
 			It("returns false and no error", func() {
-				found, err := team.HidePipeline("mypipeline")
+				found, err := team.HidePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -241,7 +278,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns the requested pipeline", func() {
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline).To(Equal(expectedPipeline))
@@ -259,11 +296,34 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false", func() {
-				_, found, err := team.Pipeline(pipelineName)
+				_, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
 		})
+
+		Context("when the pipeline is an instance", func() {
+			BeforeEach(func() {
+				expectedPipeline.InstanceVars = atc.InstanceVars{"branch": "feature"}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, `instance_vars=%7B%22branch%22%3A%22feature%22%7D`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedPipeline),
+					),
+				)
+			})
+
+			It("passes the instance vars as a query param", func() {
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{
+					Name:         pipelineName,
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline).To(Equal(expectedPipeline))
+			})
+		})
 	})
 
 	Describe("team.ListPipelines", func() {
@@ -373,7 +433,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 
 			It("deletes the pipeline when called", func() {
 				Expect(func() {
-					found, err := team.DeletePipeline("mypipeline")
+					found, err := team.DeletePipeline(atc.PipelineRef{Name: "mypipeline"})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 				}).To(Change(func() int {
@@ -393,7 +453,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				found, err := team.DeletePipeline("mypipeline")
+				found, err := team.DeletePipeline(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -415,7 +475,28 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("renames the pipeline when called", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(renamed).To(BeTrue())
+			})
+		})
+
+		Context("when the pipeline is an instance", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL, `instance_vars=%7B%22branch%22%3A%22feature%22%7D`),
+						ghttp.VerifyJSON(`{"name":"newpipelinename"}`),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("passes the instance vars as a query param", func() {
+				renamed, err := team.RenamePipeline(atc.PipelineRef{
+					Name:         "mypipeline",
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				}, "newpipelinename")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(renamed).To(BeTrue())
 			})
@@ -429,7 +510,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(renamed).To(BeFalse())
 			})
@@ -443,7 +524,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns an error", func() {
-				renamed, err := team.RenamePipeline("mypipeline", "newpipelinename")
+				renamed, err := team.RenamePipeline(atc.PipelineRef{Name: "mypipeline"}, "newpipelinename")
 				Expect(err).To(MatchError(ContainSubstring("418 I'm a teapot")))
 				Expect(renamed).To(BeFalse())
 			})
@@ -491,7 +572,34 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns the build and no error", func() {
-				build, err := team.CreatePipelineBuild("mypipeline", plan)
+				build, err := team.CreatePipelineBuild(atc.PipelineRef{Name: "mypipeline"}, plan)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build).To(Equal(expectedBuild))
+			})
+		})
+
+		Context("when the pipeline is an instance", func() {
+			BeforeEach(func() {
+				expectedBuild = atc.Build{
+					ID:           123,
+					Name:         "mybuild",
+					Status:       "pending",
+					PipelineName: "mypipeline",
+					APIURL:       "/api/v1/builds/123",
+				}
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", expectedURL, `instance_vars=%7B%22branch%22%3A%22feature%22%7D`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+					),
+				)
+			})
+
+			It("passes the instance vars as a query param", func() {
+				build, err := team.CreatePipelineBuild(atc.PipelineRef{
+					Name:         "mypipeline",
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				}, atc.Plan{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(build).To(Equal(expectedBuild))
 			})
@@ -532,7 +640,23 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(builds).To(Equal(expectedBuilds))
+			})
+		})
+
+		Context("when the pipeline is an instance", func() {
+			BeforeEach(func() {
+				expectedQuery = `instance_vars=%7B%22branch%22%3A%22feature%22%7D&limit=5&since=24`
+			})
+
+			It("passes the instance vars along with the page", func() {
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{
+					Name:         "mypipeline",
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				}, concourse.Page{Since: 24, Limit: 5})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -545,7 +669,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds since that id", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -557,7 +681,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24, Limit: 5})
+					builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24, Limit: 5})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -571,7 +695,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("calls to get all builds until that id", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Until: 26})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -583,7 +707,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Until: 26, Limit: 15})
+					builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Until: 26, Limit: 15})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -597,7 +721,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("sends both since and until", func() {
-				builds, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{Since: 24, Until: 26})
+				builds, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{Since: 24, Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -615,7 +739,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and an error", func() {
-				_, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).To(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -632,7 +756,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, _, found, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -655,7 +779,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 				})
 
 				It("returns the pagination data from the header", func() {
-					_, pagination, _, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+					_, pagination, _, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(pagination.Previous).To(Equal(&concourse.Page{Since: 452, Limit: 123}))
@@ -675,7 +799,7 @@ var _ = Describe("ATC Handler Pipelines", func() {
 			})
 
 			It("returns pagination data with nil pages", func() {
-				_, pagination, _, err := team.PipelineBuilds("mypipeline", concourse.Page{})
+				_, pagination, _, err := team.PipelineBuilds(atc.PipelineRef{Name: "mypipeline"}, concourse.Page{})
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pagination.Previous).To(BeNil())
@@ -17,21 +17,23 @@ type Team interface {
 	RenameTeam(teamName, name string) (bool, error)
 	DestroyTeam(teamName string) error
 
-	Pipeline(name string) (atc.Pipeline, bool, error)
-	PipelineBuilds(pipelineName string, page Page) ([]atc.Build, Pagination, bool, error)
-	DeletePipeline(pipelineName string) (bool, error)
-	PausePipeline(pipelineName string) (bool, error)
-	UnpausePipeline(pipelineName string) (bool, error)
-	ExposePipeline(pipelineName string) (bool, error)
-	HidePipeline(pipelineName string) (bool, error)
-	RenamePipeline(pipelineName, name string) (bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (atc.Pipeline, bool, error)
+	PipelineBuilds(pipelineRef atc.PipelineRef, page Page) ([]atc.Build, Pagination, bool, error)
+	DeletePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	PausePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	UnpausePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	ArchivePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	ExposePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	HidePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	RenamePipeline(pipelineRef atc.PipelineRef, name string) (bool, error)
 	ListPipelines() ([]atc.Pipeline, error)
-	PipelineConfig(pipelineName string) (atc.Config, string, bool, error)
-	CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error)
+	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	ResolveVar(pipelineRef atc.PipelineRef, varName string, reveal bool) (atc.VarTrace, bool, error)
 
-	CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error)
+	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
 
-	BuildInputsForJob(pipelineName string, jobName string) ([]atc.BuildInput, bool, error)
+	BuildInputsForJob(pipelineRef atc.PipelineRef, jobName string) ([]atc.BuildInput, bool, error)
 
 	Job(pipelineName, jobName string) (atc.Job, bool, error)
 	JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error)
@@ -48,7 +50,7 @@ type Team interface {
 
 	Resource(pipelineName string, resourceName string) (atc.Resource, bool, error)
 	ListResources(pipelineName string) ([]atc.Resource, error)
-	VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error)
+	VersionedResourceTypes(pipelineRef atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)
 	ResourceVersions(pipelineName string, resourceName string, page Page, filter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
 	CheckResource(pipelineName string, resourceName string, version atc.Version) (atc.Check, bool, error)
 	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (atc.Check, bool, error)
@@ -71,6 +73,13 @@ type Team interface {
 
 	CreateArtifact(io.Reader, string) (atc.WorkerArtifact, error)
 	GetArtifact(int) (io.ReadCloser, error)
+
+	SetWebhook(provider string, secret string) error
+	DestroyWebhook(provider string) (bool, error)
+
+	CreateAPIToken(request atc.CreateAPITokenRequestBody) (atc.APIToken, error)
+	APITokens() ([]atc.APIToken, error)
+	RevokeAPIToken(name string) (bool, error)
 }
 
 type team struct {
@@ -0,0 +1,44 @@
+package concourse
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ResolveVar(pipelineRef atc.PipelineRef, varName string, reveal bool) (atc.VarTrace, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{}
+	for k, v := range pipelineRef.QueryParams() {
+		query[k] = v
+	}
+
+	query.Set(atc.ResolveVarQueryName, varName)
+	if reveal {
+		query.Set(atc.ResolveVarQueryReveal, "true")
+	}
+
+	var trace atc.VarTrace
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ResolveVar,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &trace,
+	})
+
+	switch err.(type) {
+	case nil:
+		return trace, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.VarTrace{}, false, nil
+	default:
+		return atc.VarTrace{}, false, err
+	}
+}
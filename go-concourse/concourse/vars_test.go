@@ -0,0 +1,92 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Vars", func() {
+	Describe("ResolveVar", func() {
+		var expectedURL = "/api/v1/teams/some-team/pipelines/some-pipeline/vars/resolve"
+
+		var (
+			pipelineRef atc.PipelineRef
+			reveal      bool
+		)
+
+		BeforeEach(func() {
+			pipelineRef = atc.PipelineRef{Name: "some-pipeline"}
+			reveal = false
+		})
+
+		Context("when the pipeline exists", func() {
+			var expectedTrace atc.VarTrace
+
+			BeforeEach(func() {
+				expectedTrace = atc.VarTrace{
+					Name: "some-var",
+					Lookups: []atc.VarLookup{
+						{Path: "/concourse/some-team/some-pipeline/some-var"},
+						{Path: "/concourse/some-team/some-var", Found: true, Chosen: true},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "name=some-var"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTrace),
+					),
+				)
+			})
+
+			It("returns the trace of the var", func() {
+				trace, found, err := team.ResolveVar(pipelineRef, "some-var", reveal)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(trace).To(Equal(expectedTrace))
+			})
+		})
+
+		Context("when revealing the value of an instanced pipeline's var", func() {
+			BeforeEach(func() {
+				pipelineRef = atc.PipelineRef{Name: "some-pipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+				reveal = true
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, `instance_vars=%7B%22branch%22%3A%22master%22%7D&name=some-var&reveal=true`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.VarTrace{Name: "some-var", Value: "some-value"}),
+					),
+				)
+			})
+
+			It("sends the instance vars and asks for the value", func() {
+				trace, found, err := team.ResolveVar(pipelineRef, "some-var", reveal)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(trace.Value).To(Equal("some-value"))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.ResolveVar(pipelineRef, "some-var", reveal)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
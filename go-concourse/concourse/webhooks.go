@@ -0,0 +1,51 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) SetWebhook(provider string, secret string) error {
+	params := rata.Params{
+		"team_name": team.name,
+		"provider":  provider,
+	}
+
+	jsonBytes, err := json.Marshal(atc.SetTeamWebhookRequestBody{Secret: secret})
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamWebhook,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+}
+
+func (team *team) DestroyWebhook(provider string) (bool, error) {
+	params := rata.Params{
+		"team_name": team.name,
+		"provider":  provider,
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyTeamWebhook,
+		Params:      params,
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
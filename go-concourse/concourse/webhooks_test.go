@@ -0,0 +1,68 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Webhooks", func() {
+	var expectedURL = "/api/v1/teams/some-team/webhooks/github"
+
+	Describe("SetWebhook", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.VerifyJSONRepresenting(atc.SetTeamWebhookRequestBody{Secret: "some-secret"}),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("sets the secret of the webhook", func() {
+			err := team.SetWebhook("github", "some-secret")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("DestroyWebhook", func() {
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("returns true and no error", func() {
+				found, err := team.DestroyWebhook("github")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				found, err := team.DestroyWebhook("github")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
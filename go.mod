@@ -84,6 +84,7 @@ require (
 	github.com/pkg/term v0.0.0-20190109203006-aa71e9d9e942
 	github.com/pquerna/cachecontrol v0.0.0-20180517163645-1555304b9b35 // indirect
 	github.com/prometheus/client_golang v0.9.3
+	github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4
 	github.com/racksec/srslog v0.0.0-20180709174129-a4725f04ec91
 	github.com/sclevine/spec v1.3.0 // indirect
 	github.com/sirupsen/logrus v1.4.2
@@ -47,11 +47,10 @@ func (i *issuer) Issue(verifiedClaims *VerifiedClaims) (*oauth2.Token, error) {
 	name := verifiedClaims.Name
 	userID := verifiedClaims.UserID
 	userName := verifiedClaims.UserName
-	connectorID := verifiedClaims.ConnectorID
-	claimGroups := verifiedClaims.Groups
 
 	isAdmin := false
 	teamSet := map[string]map[string]bool{}
+	pipelineSet := map[string]map[string]map[string]bool{}
 
 	for _, team := range dbTeams {
 		teamSet[team.Name()] = map[string]bool{}
@@ -61,33 +60,29 @@ func (i *issuer) Issue(verifiedClaims *VerifiedClaims) (*oauth2.Token, error) {
 			groupAuth := auth["groups"]
 
 			// backwards compatibility for allow-all-users
-			if len(userAuth) == 0 && len(groupAuth) == 0 {
+			if (len(userAuth) == 0 && len(groupAuth) == 0) || matchesAuth(auth, verifiedClaims) {
 				teamSet[team.Name()][role] = true
 				isAdmin = isAdmin || (team.Admin() && role == "owner")
 			}
+		}
 
-			for _, user := range userAuth {
-				if strings.EqualFold(user, connectorID+":"+userID) {
-					teamSet[team.Name()][role] = true
-					isAdmin = isAdmin || (team.Admin() && role == "owner")
+		// roles bound in a pipeline are never granted to all users, as they
+		// would then be no different from roles in the team
+		for pipeline, pipelineAuth := range team.PipelineAuth() {
+			for role, auth := range pipelineAuth {
+				if !matchesAuth(auth, verifiedClaims) {
+					continue
 				}
-				if userName != "" {
-					if strings.EqualFold(user, connectorID+":"+userName) {
-						teamSet[team.Name()][role] = true
-						isAdmin = isAdmin || (team.Admin() && role == "owner")
-					}
+
+				if pipelineSet[team.Name()] == nil {
+					pipelineSet[team.Name()] = map[string]map[string]bool{}
 				}
-			}
 
-			for _, group := range groupAuth {
-				for _, claimGroup := range claimGroups {
-					if claimGroup != "" {
-						if strings.EqualFold(group, connectorID+":"+claimGroup) {
-							teamSet[team.Name()][role] = true
-							isAdmin = isAdmin || (team.Admin() && role == "owner")
-						}
-					}
+				if pipelineSet[team.Name()][pipeline] == nil {
+					pipelineSet[team.Name()][pipeline] = map[string]bool{}
 				}
+
+				pipelineSet[team.Name()][pipeline][role] = true
 			}
 		}
 	}
@@ -99,7 +94,17 @@ func (i *issuer) Issue(verifiedClaims *VerifiedClaims) (*oauth2.Token, error) {
 		}
 	}
 
-	if len(teams) == 0 {
+	pipelines := map[string]map[string][]string{}
+	for team, teamPipelines := range pipelineSet {
+		pipelines[team] = map[string][]string{}
+		for pipeline, roles := range teamPipelines {
+			for role := range roles {
+				pipelines[team][pipeline] = append(pipelines[team][pipeline], role)
+			}
+		}
+	}
+
+	if len(teams) == 0 && len(pipelines) == 0 {
 		return nil, errors.New("user doesn't belong to any team")
 	}
 
@@ -110,8 +115,38 @@ func (i *issuer) Issue(verifiedClaims *VerifiedClaims) (*oauth2.Token, error) {
 		"user_id":   userID,
 		"user_name": userName,
 		"teams":     teams,
+		"pipelines": pipelines,
 		"is_admin":  isAdmin,
 		"exp":       time.Now().Add(i.Duration).Unix(),
 		"csrf":      RandomString(),
 	})
 }
+
+// matchesAuth returns whether the verified user is one of the users or is in
+// one of the groups of the auth config.
+func matchesAuth(auth map[string][]string, verifiedClaims *VerifiedClaims) bool {
+	connectorID := verifiedClaims.ConnectorID
+
+	for _, user := range auth["users"] {
+		if strings.EqualFold(user, connectorID+":"+verifiedClaims.UserID) {
+			return true
+		}
+		if verifiedClaims.UserName != "" {
+			if strings.EqualFold(user, connectorID+":"+verifiedClaims.UserName) {
+				return true
+			}
+		}
+	}
+
+	for _, group := range auth["groups"] {
+		for _, claimGroup := range verifiedClaims.Groups {
+			if claimGroup != "" {
+				if strings.EqualFold(group, connectorID+":"+claimGroup) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
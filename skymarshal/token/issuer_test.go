@@ -440,6 +440,68 @@ var _ = Describe("Token Issuer", func() {
 					})
 				})
 
+				Context("when a team binds roles in its pipelines", func() {
+					BeforeEach(func() {
+						fakeTeam1.AuthReturns(atc.TeamAuth{
+							"viewer": {"users": []string{"connector-id:user-id"}},
+						})
+						fakeTeam1.PipelineAuthReturns(atc.PipelineAuth{
+							"deploy-prod": {
+								"member": {"users": []string{"connector-id:user-name"}},
+							},
+							"some-pipeline": {
+								"pipeline-operator": {"groups": []string{"connector-id:some-group"}},
+							},
+							"other-pipeline": {
+								"member": {"users": []string{"connector-id:someone-else"}},
+							},
+						})
+					})
+
+					It("calls generate with the roles of the matching pipelines", func() {
+						AssertIssueToken()
+						claims := fakeGenerator.GenerateArgsForCall(0)
+						Expect(claims["teams"]).To(HaveKeyWithValue("fake-team-1", ConsistOf("viewer")))
+						Expect(claims["pipelines"]).To(Equal(map[string]map[string][]string{
+							"fake-team-1": {
+								"deploy-prod":   {"member"},
+								"some-pipeline": {"pipeline-operator"},
+							},
+						}))
+					})
+
+					AssertTokenClaims()
+
+					Context("when the user only has roles in pipelines", func() {
+						BeforeEach(func() {
+							fakeTeam1.AuthReturns(atc.TeamAuth{})
+						})
+
+						It("issues a token with the pipeline roles", func() {
+							AssertIssueToken()
+							claims := fakeGenerator.GenerateArgsForCall(0)
+							Expect(claims["teams"]).To(BeEmpty())
+							Expect(claims["pipelines"]).To(HaveKeyWithValue("fake-team-1", HaveKeyWithValue("deploy-prod", ConsistOf("member"))))
+						})
+					})
+
+					Context("when a pipeline binding has no users or groups", func() {
+						BeforeEach(func() {
+							fakeTeam1.PipelineAuthReturns(atc.PipelineAuth{
+								"deploy-prod": {
+									"member": {"users": []string{}, "groups": []string{}},
+								},
+							})
+						})
+
+						It("does not grant the role to all users", func() {
+							AssertIssueToken()
+							claims := fakeGenerator.GenerateArgsForCall(0)
+							Expect(claims["pipelines"]).To(BeEmpty())
+						})
+					})
+				})
+
 				Context("when the verified claims has no username", func() {
 					BeforeEach(func() {
 						verifiedClaims.UserName = ""
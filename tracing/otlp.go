@@ -0,0 +1,237 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/api/core"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"google.golang.org/grpc/codes"
+)
+
+type OTLP struct {
+	Address string            `long:"otlp-address" description:"otlp http collector endpoint, e.g. http://127.0.0.1:55681/v1/traces"`
+	Headers map[string]string `long:"otlp-header"  description:"headers to attach to every request sent to the collector"`
+	Service string            `long:"otlp-service" description:"service name reported to the collector" default:"web"`
+}
+
+func (o OTLP) IsConfigured() bool {
+	return o.Address != ""
+}
+
+func (o OTLP) Exporter() (export.SpanBatcher, error) {
+	req, err := http.NewRequest("POST", o.Address, nil)
+	if err != nil {
+		err = fmt.Errorf("failed to create otlp exporter: %w", err)
+		return nil, err
+	}
+
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return nil, fmt.Errorf("failed to create otlp exporter: unsupported scheme %q", req.URL.Scheme)
+	}
+
+	return &otlpExporter{
+		address: o.Address,
+		headers: o.Headers,
+		service: o.Service,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// otlpExporter sends batches of spans to an OpenTelemetry collector using the
+// OTLP HTTP transport with JSON encoding, one request per batch.
+type otlpExporter struct {
+	address string
+	headers map[string]string
+	service string
+	client  *http.Client
+}
+
+func (e *otlpExporter) ExportSpans(ctx context.Context, batch []*export.SpanData) {
+	if len(batch) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(e.request(batch))
+	if err != nil {
+		return
+	}
+
+	req, err := http.NewRequest("POST", e.address, bytes.NewReader(payload))
+	if err != nil {
+		return
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range e.headers {
+		req.Header.Set(name, value)
+	}
+
+	// the spans have already ended, and are exported in the background;
+	// exporting them is best-effort and failures are not reported back to the
+	// code that created them.
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return
+	}
+
+	resp.Body.Close()
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource                    otlpResource                      `json:"resource"`
+	InstrumentationLibrarySpans []otlpInstrumentationLibrarySpans `json:"instrumentationLibrarySpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpInstrumentationLibrarySpans struct {
+	InstrumentationLibrary otlpInstrumentationLibrary `json:"instrumentationLibrary"`
+	Spans                  []otlpSpan                 `json:"spans"`
+}
+
+type otlpInstrumentationLibrary struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+const (
+	otlpStatusUnset = 0
+	otlpStatusError = 2
+)
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func (e *otlpExporter) request(batch []*export.SpanData) otlpRequest {
+	spans := make([]otlpSpan, len(batch))
+	for i, data := range batch {
+		spans[i] = otlpSpanFromData(data)
+	}
+
+	service := e.service
+	return otlpRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpKeyValue{
+						{Key: "service.name", Value: otlpValue{StringValue: &service}},
+					},
+				},
+				InstrumentationLibrarySpans: []otlpInstrumentationLibrarySpans{
+					{
+						InstrumentationLibrary: otlpInstrumentationLibrary{Name: "concourse"},
+						Spans:                  spans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otlpSpanFromData(data *export.SpanData) otlpSpan {
+	span := otlpSpan{
+		TraceID:           data.SpanContext.TraceIDString(),
+		SpanID:            data.SpanContext.SpanIDString(),
+		Name:              data.Name,
+		Kind:              int(data.SpanKind),
+		StartTimeUnixNano: unixNano(data.StartTime),
+		EndTimeUnixNano:   unixNano(data.EndTime),
+		Attributes:        otlpAttributes(data.Attributes),
+		Status:            otlpStatus{Code: otlpStatusUnset},
+	}
+
+	if data.ParentSpanID.IsValid() {
+		span.ParentSpanID = core.SpanContext{SpanID: data.ParentSpanID}.SpanIDString()
+	}
+
+	if data.Status != codes.OK {
+		span.Status = otlpStatus{
+			Code:    otlpStatusError,
+			Message: data.Status.String(),
+		}
+	}
+
+	for _, event := range data.MessageEvents {
+		span.Events = append(span.Events, otlpEvent{
+			TimeUnixNano: unixNano(event.Time),
+			Name:         event.Name,
+			Attributes:   otlpAttributes(event.Attributes),
+		})
+	}
+
+	return span
+}
+
+func otlpAttributes(kvs []core.KeyValue) []otlpKeyValue {
+	var attrs []otlpKeyValue
+	for _, kv := range kvs {
+		var value otlpValue
+
+		switch kv.Value.Type() {
+		case core.BOOL:
+			v := kv.Value.AsBool()
+			value.BoolValue = &v
+		case core.INT32, core.INT64, core.UINT32, core.UINT64:
+			v := kv.Value.Emit()
+			value.IntValue = &v
+		case core.FLOAT32, core.FLOAT64:
+			v, _ := strconv.ParseFloat(kv.Value.Emit(), 64)
+			value.DoubleValue = &v
+		default:
+			v := kv.Value.Emit()
+			value.StringValue = &v
+		}
+
+		attrs = append(attrs, otlpKeyValue{Key: string(kv.Key), Value: value})
+	}
+
+	return attrs
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
@@ -0,0 +1,138 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/tracing"
+	"go.opentelemetry.io/otel/api/core"
+	"go.opentelemetry.io/otel/api/key"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"google.golang.org/grpc/codes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("OTLP", func() {
+	var (
+		collector *ghttp.Server
+		otlp      tracing.OTLP
+	)
+
+	BeforeEach(func() {
+		collector = ghttp.NewServer()
+
+		otlp = tracing.OTLP{
+			Address: collector.URL() + "/v1/traces",
+			Headers: map[string]string{"Authorization": "Bearer some-token"},
+			Service: "some-service",
+		}
+	})
+
+	AfterEach(func() {
+		collector.Close()
+	})
+
+	It("is configured by its address", func() {
+		Expect(otlp.IsConfigured()).To(BeTrue())
+		Expect(tracing.OTLP{}.IsConfigured()).To(BeFalse())
+	})
+
+	It("rejects addresses which are not http", func() {
+		otlp.Address = "grpc://127.0.0.1:55680"
+
+		_, err := otlp.Exporter()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("exports a batch of spans as OTLP JSON in one request", func() {
+		traceID, err := core.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		Expect(err).ToNot(HaveOccurred())
+
+		spanID, err := core.SpanIDFromHex("00f067aa0ba902b7")
+		Expect(err).ToNot(HaveOccurred())
+
+		parentID, err := core.SpanIDFromHex("53995c3f42cd8ad8")
+		Expect(err).ToNot(HaveOccurred())
+
+		collector.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/traces"),
+				ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+				ghttp.VerifyJSON(`{
+					"resourceSpans": [{
+						"resource": {
+							"attributes": [{"key": "service.name", "value": {"stringValue": "some-service"}}]
+						},
+						"instrumentationLibrarySpans": [{
+							"instrumentationLibrary": {"name": "concourse"},
+							"spans": [{
+								"traceId": "4bf92f3577b34da6a3ce929d0e0e4736",
+								"spanId": "00f067aa0ba902b7",
+								"parentSpanId": "53995c3f42cd8ad8",
+								"name": "check",
+								"kind": 1,
+								"startTimeUnixNano": "1000000000",
+								"endTimeUnixNano": "2000000000",
+								"attributes": [
+									{"key": "resource", "value": {"stringValue": "some-resource"}},
+									{"key": "rows", "value": {"intValue": "42"}}
+								],
+								"status": {"code": 2, "message": "Internal"}
+							}, {
+								"traceId": "4bf92f3577b34da6a3ce929d0e0e4736",
+								"spanId": "53995c3f42cd8ad8",
+								"name": "scheduler",
+								"kind": 0,
+								"startTimeUnixNano": "1000000000",
+								"endTimeUnixNano": "3000000000",
+								"status": {"code": 0}
+							}]
+						}]
+					}]
+				}`),
+				ghttp.RespondWith(http.StatusOK, "{}"),
+			),
+		)
+
+		exporter, err := otlp.Exporter()
+		Expect(err).ToNot(HaveOccurred())
+
+		exporter.ExportSpans(context.Background(), []*export.SpanData{
+			{
+				SpanContext:  core.SpanContext{TraceID: traceID, SpanID: spanID},
+				ParentSpanID: parentID,
+				SpanKind:     1,
+				Name:         "check",
+				StartTime:    time.Unix(1, 0),
+				EndTime:      time.Unix(2, 0),
+				Attributes: []core.KeyValue{
+					key.New("resource").String("some-resource"),
+					key.New("rows").Int64(42),
+				},
+				Status: codes.Internal,
+			},
+			{
+				SpanContext: core.SpanContext{TraceID: traceID, SpanID: parentID},
+				Name:        "scheduler",
+				StartTime:   time.Unix(1, 0),
+				EndTime:     time.Unix(3, 0),
+			},
+		})
+
+		Expect(collector.ReceivedRequests()).To(HaveLen(1))
+	})
+
+	It("does not send empty batches", func() {
+		exporter, err := otlp.Exporter()
+		Expect(err).ToNot(HaveOccurred())
+
+		exporter.ExportSpans(context.Background(), nil)
+
+		Expect(collector.ReceivedRequests()).To(BeEmpty())
+	})
+})
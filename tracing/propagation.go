@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/api/propagators"
+)
+
+// Inject propagates the span in the given context, in the W3C trace context
+// format, into the carrier the supplier writes to, e.g. the headers of an
+// outgoing HTTP request.
+//
+func Inject(ctx context.Context, supplier propagators.Supplier) {
+	if !Configured {
+		return
+	}
+
+	propagators.TraceContext{}.Inject(ctx, supplier)
+}
+
+// Environment returns the W3C trace context of the span in the given context
+// as environment variables (e.g. `TRACEPARENT=...`), so that processes run in
+// containers can continue the trace.
+//
+func Environment(ctx context.Context) []string {
+	env := envSupplier{}
+	Inject(ctx, env)
+
+	var vars []string
+	for name, value := range env {
+		vars = append(vars, name+"="+value)
+	}
+
+	sort.Strings(vars)
+
+	return vars
+}
+
+type envSupplier map[string]string
+
+func (e envSupplier) Get(key string) string {
+	return e[envName(key)]
+}
+
+func (e envSupplier) Set(key string, value string) {
+	e[envName(key)] = value
+}
+
+func envName(key string) string {
+	return strings.ToUpper(strings.Replace(key, "-", "_", -1))
+}
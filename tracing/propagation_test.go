@@ -0,0 +1,72 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/tracing/tracingfakes"
+	"go.opentelemetry.io/otel/api/core"
+	"go.opentelemetry.io/otel/api/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Propagation", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		traceID, err := core.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+		Expect(err).ToNot(HaveOccurred())
+
+		spanID, err := core.SpanIDFromHex("00f067aa0ba902b7")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeSpan := new(tracingfakes.FakeSpan)
+		fakeSpan.SpanContextReturns(core.SpanContext{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: core.TraceFlagsSampled,
+		})
+
+		ctx = trace.ContextWithSpan(context.Background(), fakeSpan)
+		tracing.Configured = true
+	})
+
+	Describe("Inject", func() {
+		It("sets the traceparent header", func() {
+			header := http.Header{}
+			tracing.Inject(ctx, header)
+
+			Expect(header.Get("traceparent")).To(Equal("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+		})
+
+		Context("when tracing is not configured", func() {
+			BeforeEach(func() {
+				tracing.Configured = false
+			})
+
+			It("does nothing", func() {
+				header := http.Header{}
+				tracing.Inject(ctx, header)
+
+				Expect(header).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("Environment", func() {
+		It("returns the trace context as env vars", func() {
+			Expect(tracing.Environment(ctx)).To(Equal([]string{
+				"TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			}))
+		})
+
+		Context("when there is no span in the context", func() {
+			It("returns nothing", func() {
+				Expect(tracing.Environment(context.Background())).To(BeEmpty())
+			})
+		})
+	})
+})
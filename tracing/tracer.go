@@ -93,17 +93,63 @@ func End(span trace.Span, err error) {
 	span.End()
 }
 
+// TraceID returns the ID of the trace that the span in the given context
+// belongs to, e.g. so that a metric observed within the span can refer back to
+// it.
+//
+// An empty string is returned when tracing hasn't been configured or the
+// context carries no span.
+//
+func TraceID(ctx context.Context) string {
+	if !Configured {
+		return ""
+	}
+
+	spanContext := trace.SpanFromContext(ctx).SpanContext()
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+
+	return spanContext.TraceIDString()
+}
+
 // ConfigureTracer configures the sdk to use a given exporter.
 //
 // By default, a noop tracer is registered, thus, it's safe to call StartSpan
 // and other related methods even before `ConfigureTracer` it called.
 //
+// The exporter is called synchronously as each span ends, so it has to buffer
+// the spans itself rather than send them right away.
+//
 func ConfigureTracer(exporter export.SpanSyncer) error {
+	return configureProvider(sdktrace.WithSyncer(exporter))
+}
+
+// ConfigureBatchTracer configures the sdk to use a given exporter, which is
+// handed batches of spans in the background.
+//
+// The spans are queued up to a bound, beyond which they're dropped, so that
+// an unavailable collector never holds up the code creating them.
+//
+func ConfigureBatchTracer(exporter export.SpanBatcher) error {
+	return configureProvider(sdktrace.WithBatcher(
+		exporter,
+		sdktrace.WithMaxQueueSize(batchQueueSize),
+		sdktrace.WithMaxExportBatchSize(batchSize),
+	))
+}
+
+const (
+	batchQueueSize = 2048
+	batchSize      = 512
+)
+
+func configureProvider(exportOption sdktrace.ProviderOption) error {
 	tp, err := sdktrace.NewProvider(sdktrace.WithConfig(
 		sdktrace.Config{
 			DefaultSampler: sdktrace.AlwaysSample(),
 		}),
-		sdktrace.WithSyncer(exporter),
+		exportOption,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to configure trace provider: %w", err)
@@ -76,4 +76,43 @@ var _ = Describe("Tracer", func() {
 
 	})
 
+	Describe("TraceID", func() {
+
+		var (
+			ctx         context.Context
+			spanContext core.SpanContext
+		)
+
+		BeforeEach(func() {
+			spanContext = core.SpanContext{
+				TraceID: core.TraceID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+				SpanID:  core.SpanID{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+			}
+
+			fakeSpan.SpanContextReturns(spanContext)
+			ctx = trace.ContextWithSpan(context.Background(), fakeSpan)
+		})
+
+		It("returns the id of the trace of the span in the context", func() {
+			Expect(tracing.TraceID(ctx)).To(Equal("0102030405060708090a0b0c0d0e0f10"))
+		})
+
+		Context("without a span in the context", func() {
+			It("returns an empty string", func() {
+				Expect(tracing.TraceID(context.Background())).To(BeEmpty())
+			})
+		})
+
+		Context("when tracing is not configured", func() {
+			BeforeEach(func() {
+				tracing.Configured = false
+			})
+
+			It("returns an empty string", func() {
+				Expect(tracing.TraceID(ctx)).To(BeEmpty())
+			})
+		})
+
+	})
+
 })
@@ -53,9 +53,11 @@ var _ = Describe("Register", func() {
 
 		atcServer.RouteToHandler("POST", "/api/v1/workers", func(w http.ResponseWriter, r *http.Request) {
 			var worker atc.Worker
-			Expect(accessFactory.Create(r, "some-action").IsAuthenticated()).To(BeTrue())
+			accessor, err := accessFactory.Create(r, "some-action")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(accessor.IsAuthenticated()).To(BeTrue())
 
-			err := json.NewDecoder(r.Body).Decode(&worker)
+			err = json.NewDecoder(r.Body).Decode(&worker)
 			Expect(err).NotTo(HaveOccurred())
 
 			ttl, err := time.ParseDuration(r.URL.Query().Get("ttl"))
@@ -66,9 +68,11 @@ var _ = Describe("Register", func() {
 
 		atcServer.RouteToHandler("PUT", "/api/v1/workers/some-worker/heartbeat", func(w http.ResponseWriter, r *http.Request) {
 			var worker atc.Worker
-			Expect(accessFactory.Create(r, "some-action").IsAuthenticated()).To(BeTrue())
+			accessor, err := accessFactory.Create(r, "some-action")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(accessor.IsAuthenticated()).To(BeTrue())
 
-			err := json.NewDecoder(r.Body).Decode(&worker)
+			err = json.NewDecoder(r.Body).Decode(&worker)
 			Expect(err).NotTo(HaveOccurred())
 
 			ttl, err := time.ParseDuration(r.URL.Query().Get("ttl"))
@@ -477,7 +481,9 @@ var _ = Describe("Register", func() {
 		Context("when the ATC returns a 404 for the heartbeat", func() {
 			BeforeEach(func() {
 				atcServer.RouteToHandler("PUT", "/api/v1/workers/some-worker/heartbeat", func(w http.ResponseWriter, r *http.Request) {
-					Expect(accessFactory.Create(r, "some-action").IsAuthenticated()).To(BeTrue())
+					accessor, err := accessFactory.Create(r, "some-action")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(accessor.IsAuthenticated()).To(BeTrue())
 					w.WriteHeader(404)
 				})
 			})
@@ -34,7 +34,8 @@ var _ = Describe("ReportContainers", func() {
 						ghttp.VerifyRequest("PUT", "/api/v1/containers/report", "worker_name=some-worker"),
 						ghttp.VerifyJSONRepresenting([]string{"a", "b"}),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.ListDestroyingContainers)
+							accessor, err := accessFactory.Create(r, atc.ListDestroyingContainers)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -75,7 +76,8 @@ var _ = Describe("ReportContainers", func() {
 						ghttp.VerifyRequest("PUT", "/api/v1/containers/report", "worker_name=some-worker"),
 						ghttp.VerifyJSONRepresenting([]string{"a", "b"}),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.ListDestroyingContainers)
+							accessor, err := accessFactory.Create(r, atc.ListDestroyingContainers)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -107,7 +109,8 @@ var _ = Describe("ReportContainers", func() {
 						ghttp.VerifyRequest("PUT", "/api/v1/containers/report", "worker_name=some-worker"),
 						ghttp.VerifyJSONRepresenting([]string{"a", "b"}),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.ListDestroyingContainers)
+							accessor, err := accessFactory.Create(r, atc.ListDestroyingContainers)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -133,7 +136,8 @@ var _ = Describe("ReportContainers", func() {
 						ghttp.VerifyRequest("PUT", "/api/v1/containers/report", "worker_name=some-worker"),
 						ghttp.VerifyJSONRepresenting([]string{"a", "b"}),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.ListDestroyingContainers)
+							accessor, err := accessFactory.Create(r, atc.ListDestroyingContainers)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -159,7 +163,8 @@ var _ = Describe("ReportContainers", func() {
 						ghttp.VerifyRequest("PUT", "/api/v1/containers/report", "worker_name=some-worker"),
 						ghttp.VerifyJSONRepresenting([]string{"a", "b"}),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.ListDestroyingContainers)
+							accessor, err := accessFactory.Create(r, atc.ListDestroyingContainers)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -33,7 +33,8 @@ var _ = Describe("Retire", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/retire"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.RetireWorker)
+							accessor, err := accessFactory.Create(r, atc.RetireWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 						}),
 						ghttp.RespondWith(200, nil, nil),
@@ -87,7 +88,8 @@ var _ = Describe("Retire", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/retire"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.RetireWorker)
+							accessor, err := accessFactory.Create(r, atc.RetireWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsAuthorized("some-team")).To(BeTrue())
 							Expect(accessor.IsAuthorized("some-other-team")).To(BeFalse())
@@ -119,7 +121,8 @@ var _ = Describe("Retire", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/retire"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.RetireWorker)
+							accessor, err := accessFactory.Create(r, atc.RetireWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsAuthorized("some-team")).To(BeTrue())
 							Expect(accessor.IsAuthorized("some-other-team")).To(BeFalse())
@@ -145,7 +148,8 @@ var _ = Describe("Retire", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/retire"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.RetireWorker)
+							accessor, err := accessFactory.Create(r, atc.RetireWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsAuthorized("some-team")).To(BeTrue())
 							Expect(accessor.IsAuthorized("some-other-team")).To(BeFalse())
@@ -171,7 +175,8 @@ var _ = Describe("Retire", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/retire"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.RetireWorker)
+							accessor, err := accessFactory.Create(r, atc.RetireWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsAuthorized("some-team")).To(BeTrue())
 							Expect(accessor.IsAuthorized("some-other-team")).To(BeFalse())
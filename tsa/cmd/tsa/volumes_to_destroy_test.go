@@ -34,7 +34,8 @@ var _ = Describe("VolumesToDestroy", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("GET", "/api/v1/volumes/destroying", "worker_name=some-worker"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.ListDestroyingVolumes)
+							accessor, err := accessFactory.Create(r, atc.ListDestroyingVolumes)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -78,7 +79,8 @@ var _ = Describe("VolumesToDestroy", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("GET", "/api/v1/volumes/destroying", "worker_name=some-worker"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.LandWorker)
+							accessor, err := accessFactory.Create(r, atc.LandWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -109,7 +111,8 @@ var _ = Describe("VolumesToDestroy", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("GET", "/api/v1/volumes/destroying", "worker_name=some-worker"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.LandWorker)
+							accessor, err := accessFactory.Create(r, atc.LandWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -138,7 +141,8 @@ var _ = Describe("VolumesToDestroy", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("GET", "/api/v1/volumes/destroying", "worker_name=some-worker"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.LandWorker)
+							accessor, err := accessFactory.Create(r, atc.LandWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
@@ -163,7 +167,8 @@ var _ = Describe("VolumesToDestroy", func() {
 					atcServer.AppendHandlers(ghttp.CombineHandlers(
 						ghttp.VerifyRequest("GET", "/api/v1/volumes/destroying", "worker_name=some-worker"),
 						http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
-							accessor := accessFactory.Create(r, atc.LandWorker)
+							accessor, err := accessFactory.Create(r, atc.LandWorker)
+							Expect(err).NotTo(HaveOccurred())
 							Expect(accessor.IsAuthenticated()).To(BeTrue())
 							Expect(accessor.IsSystem()).To(BeTrue())
 						}),
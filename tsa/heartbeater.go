@@ -36,6 +36,9 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	lastCPUUsage   map[string]uint64
+	lastLoadSample time.Time
 }
 
 func NewHeartbeater(
@@ -298,9 +301,70 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	cpuLoad, memoryLoad, err := heartbeater.load(containers)
+	if err != nil {
+		logger.Error("failed-to-measure-load", err)
+	} else {
+		registration.CPULoad = cpuLoad
+		registration.MemoryLoad = memoryLoad
+	}
+
 	return registration, true
 }
 
+// load measures the CPU and memory used by the worker's containers. CPU usage
+// is reported by Garden as cumulative time, so the CPU load is measured
+// against the previous sample and is zero for the first one.
+func (heartbeater *Heartbeater) load(containers []garden.Container) (float64, float64, error) {
+	handles := make([]string, len(containers))
+	for i, container := range containers {
+		handles[i] = container.Handle()
+	}
+
+	capacity, err := heartbeater.gardenClient.Capacity()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	metrics, err := heartbeater.gardenClient.BulkMetrics(handles)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	now := heartbeater.clock.Now()
+
+	var cpuTime, memory uint64
+	cpuUsage := map[string]uint64{}
+	for handle, entry := range metrics {
+		if entry.Err != nil {
+			continue
+		}
+
+		usage := entry.Metrics.CPUStat.Usage
+		if usage >= heartbeater.lastCPUUsage[handle] {
+			cpuTime += usage - heartbeater.lastCPUUsage[handle]
+		}
+
+		cpuUsage[handle] = usage
+		memory += entry.Metrics.MemoryStat.TotalUsageTowardLimit
+	}
+
+	var cpuLoad float64
+	if elapsed := now.Sub(heartbeater.lastLoadSample); !heartbeater.lastLoadSample.IsZero() && elapsed > 0 {
+		cpuLoad = float64(cpuTime) / float64(elapsed)
+	}
+
+	heartbeater.lastCPUUsage = cpuUsage
+	heartbeater.lastLoadSample = now
+
+	var memoryLoad float64
+	if capacity.MemoryInBytes > 0 {
+		memoryLoad = float64(memory) / float64(capacity.MemoryInBytes)
+	}
+
+	return cpuLoad, memoryLoad, nil
+}
+
 func (heartbeater *Heartbeater) ttl() time.Duration {
 	return heartbeater.interval * 2
 }
@@ -270,6 +270,56 @@ var _ = Describe("Heartbeater", func() {
 					Eventually(clientWriter).Should(gbytes.Say(`{"event":"heartbeated"}`))
 				})
 			})
+
+			Context("when Garden reports the containers' metrics", func() {
+				BeforeEach(func() {
+					fakeATC1.AppendHandlers(verifyRegister)
+					fakeATC2.AppendHandlers(verifyHeartbeat)
+
+					fakeGardenClient.CapacityReturns(garden.Capacity{MemoryInBytes: 1024}, nil)
+
+					metrics := make(chan map[string]garden.ContainerMetricsEntry, 2)
+					metrics <- map[string]garden.ContainerMetricsEntry{
+						"some-handle": {Metrics: garden.Metrics{
+							CPUStat:    garden.ContainerCPUStat{Usage: uint64(time.Second)},
+							MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 256},
+						}},
+					}
+					metrics <- map[string]garden.ContainerMetricsEntry{
+						"some-handle": {Metrics: garden.Metrics{
+							CPUStat:    garden.ContainerCPUStat{Usage: uint64(1500 * time.Millisecond)},
+							MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 256},
+						}},
+						"some-other-handle": {Metrics: garden.Metrics{
+							CPUStat:    garden.ContainerCPUStat{Usage: uint64(time.Second)},
+							MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 256},
+						}},
+					}
+					close(metrics)
+
+					fakeGardenClient.BulkMetricsStub = func([]string) (map[string]garden.ContainerMetricsEntry, error) {
+						return <-metrics, nil
+					}
+				})
+
+				It("registers with the memory load", func() {
+					expectedWorker.ActiveContainers = 2
+					expectedWorker.ActiveVolumes = 3
+					expectedWorker.MemoryLoad = 0.25
+					Eventually(registrations).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+				})
+
+				It("heartbeats with the CPU used since the last heartbeat", func() {
+					Eventually(registrations).Should(Receive())
+
+					fakeClock.WaitForWatcherAndIncrement(interval)
+					expectedWorker.ActiveContainers = 5
+					expectedWorker.ActiveVolumes = 2
+					expectedWorker.CPULoad = 1.5
+					expectedWorker.MemoryLoad = 0.5
+					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+				})
+			})
 		})
 
 		Context("when heartbeat returns worker is landed", func() {
@@ -1,5 +1,7 @@
 package vars
 
+import "time"
+
 type MultiVars struct {
 	varss []Variables
 }
@@ -8,17 +10,22 @@ func NewMultiVars(varss []Variables) MultiVars {
 	return MultiVars{varss}
 }
 
-var _ Variables = MultiVars{}
+var _ ExpiringVariables = MultiVars{}
 
 func (m MultiVars) Get(varDef VariableDefinition) (interface{}, bool, error) {
+	val, _, found, err := m.GetWithExpiration(varDef)
+	return val, found, err
+}
+
+func (m MultiVars) GetWithExpiration(varDef VariableDefinition) (interface{}, *time.Time, bool, error) {
 	for _, vars := range m.varss {
-		val, found, err := vars.Get(varDef)
+		val, expiration, found, err := getWithExpiration(vars, varDef)
 		if found || err != nil {
-			return val, found, err
+			return val, expiration, found, err
 		}
 	}
 
-	return nil, false, nil
+	return nil, nil, false, nil
 }
 
 func (m MultiVars) List() ([]VariableDefinition, error) {
@@ -35,3 +42,20 @@ func (m MultiVars) List() ([]VariableDefinition, error) {
 
 	return allDefs, nil
 }
+
+var _ TraceableVariables = MultiVars{}
+
+func (m MultiVars) Trace(varDef VariableDefinition) ([]VariableLookup, error) {
+	var allLookups []VariableLookup
+
+	for _, vars := range m.varss {
+		lookups, err := Trace(vars, varDef)
+		if err != nil {
+			return nil, err
+		}
+
+		allLookups = append(allLookups, lookups...)
+	}
+
+	return allLookups, nil
+}
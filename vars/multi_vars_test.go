@@ -83,4 +83,32 @@ var _ = Describe("MultiVariables", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	Describe("Trace", func() {
+		It("returns the lookups of every source, including those after the var is found", func() {
+			vars1 := StaticVariables{"key1": "val1"}
+			vars2 := &FakeVariables{GetErr: errors.New("fake-err")}
+			vars3 := StaticVariables{"key1": "val3"}
+			vars := NewMultiVars([]Variables{vars1, vars2, vars3})
+
+			lookups, err := vars.Trace(VariableDefinition{Name: "key1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookups).To(Equal([]VariableLookup{
+				{Path: "key1", Found: true, Value: "val1"},
+				{Path: "key1", Err: errors.New("fake-err")},
+				{Path: "key1", Found: true, Value: "val3"},
+			}))
+
+			Expect(ChosenLookup(lookups)).To(Equal(0))
+		})
+
+		It("chooses no lookup if the var is not found", func() {
+			vars := NewMultiVars([]Variables{StaticVariables{}, StaticVariables{}})
+
+			lookups, err := vars.Trace(VariableDefinition{Name: "key1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lookups).To(HaveLen(2))
+			Expect(ChosenLookup(lookups)).To(Equal(-1))
+		})
+	})
 })
@@ -3,6 +3,7 @@ package vars
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type NamedVariables map[string]Variables
@@ -12,23 +13,55 @@ type NamedVariables map[string]Variables
 // the var_source name, and "foo" is the real var name that should be forwarded
 // to the underlying secret manager.
 func (m NamedVariables) Get(varDef VariableDefinition) (interface{}, bool, error) {
-	var sourceName, varName string
-	parts := strings.Split(varDef.Name, ":")
-	if len(parts) == 1 {
+	val, _, found, err := m.GetWithExpiration(varDef)
+	return val, found, err
+}
+
+func (m NamedVariables) GetWithExpiration(varDef VariableDefinition) (interface{}, *time.Time, bool, error) {
+	sourceName, varName, err := splitSourceName(varDef.Name)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if sourceName == "" {
 		// No source name, then no need to query named vars.
-		return nil, false, nil
-	} else if len(parts) == 2 {
-		sourceName = parts[0]
-		varName = parts[1]
-	} else {
-		return nil, false, fmt.Errorf("invalid var: %s", varDef.Name)
+		return nil, nil, false, nil
 	}
 
 	if vars, ok := m[sourceName]; ok {
-		return vars.Get(VariableDefinition{Name: varName})
+		return getWithExpiration(vars, VariableDefinition{Name: varName})
 	}
 
-	return nil, false, fmt.Errorf("unknown var source: %s", sourceName)
+	return nil, nil, false, fmt.Errorf("unknown var source: %s", sourceName)
+}
+
+// Trace reports the lookups made in the var source named by the var. Vars
+// without a source name are not looked up in named vars.
+func (m NamedVariables) Trace(varDef VariableDefinition) ([]VariableLookup, error) {
+	sourceName, varName, err := splitSourceName(varDef.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceName == "" {
+		return nil, nil
+	}
+
+	vars, ok := m[sourceName]
+	if !ok {
+		return nil, fmt.Errorf("unknown var source: %s", sourceName)
+	}
+
+	lookups, err := Trace(vars, VariableDefinition{Name: varName})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range lookups {
+		lookups[i].Source = sourceName
+	}
+
+	return lookups, nil
 }
 
 func (m NamedVariables) List() ([]VariableDefinition, error) {
@@ -45,3 +78,15 @@ func (m NamedVariables) List() ([]VariableDefinition, error) {
 
 	return allDefs, nil
 }
+
+func splitSourceName(name string) (string, string, error) {
+	parts := strings.Split(name, ":")
+	switch len(parts) {
+	case 1:
+		return "", name, nil
+	case 2:
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("invalid var: %s", name)
+	}
+}
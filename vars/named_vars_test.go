@@ -85,4 +85,30 @@ var _ = Describe("NamedVariables", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	Describe("Trace", func() {
+		It("returns no lookups for a var without a source name", func() {
+			vars := NamedVariables{"s1": StaticVariables{"key1": "val"}}
+
+			lookups, err := vars.Trace(VariableDefinition{Name: "key1"})
+			Expect(lookups).To(BeEmpty())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the lookups made in the named source", func() {
+			vars := NamedVariables{
+				"s1": StaticVariables{"key1": "val1"},
+				"s2": StaticVariables{"key1": "val2"},
+			}
+
+			lookups, err := vars.Trace(VariableDefinition{Name: "s2:key1"})
+			Expect(lookups).To(Equal([]VariableLookup{{Source: "s2", Path: "key1", Found: true, Value: "val2"}}))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an error if the source is unknown", func() {
+			_, err := NamedVariables{}.Trace(VariableDefinition{Name: "s1:key1"})
+			Expect(err).To(MatchError("unknown var source: s1"))
+		})
+	})
 })
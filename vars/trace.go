@@ -0,0 +1,54 @@
+package vars
+
+// A VariableLookup is a single attempt made to find the value of a var, e.g.
+// a secret path tried by a credential manager.
+type VariableLookup struct {
+	// Source is the name of the var source the lookup was made in. It is
+	// empty for lookups which are not made in a named var source.
+	Source string
+
+	Path  string
+	Found bool
+	Value interface{}
+	Err   error
+}
+
+// TraceableVariables are Variables which can report every lookup they would
+// make to find the value of a var.
+type TraceableVariables interface {
+	Variables
+
+	// Trace returns the lookups made for the var in the order Get would make
+	// them. Unlike Get, it does not stop at the first lookup which finds the
+	// var or fails, so that every candidate is reported.
+	Trace(VariableDefinition) ([]VariableLookup, error)
+}
+
+// Trace returns the lookups made to find the value of a var. Variables which
+// are not TraceableVariables are reported as a single lookup of the var name.
+func Trace(vars Variables, varDef VariableDefinition) ([]VariableLookup, error) {
+	if traceable, ok := vars.(TraceableVariables); ok {
+		return traceable.Trace(varDef)
+	}
+
+	val, found, err := vars.Get(varDef)
+	return []VariableLookup{{
+		Path:  varDef.Name,
+		Found: found,
+		Value: val,
+		Err:   err,
+	}}, nil
+}
+
+// ChosenLookup returns the index of the lookup whose result Get would have
+// returned, i.e. the first one which found the var or failed, or -1 if the
+// var was not found.
+func ChosenLookup(lookups []VariableLookup) int {
+	for i, lookup := range lookups {
+		if lookup.Found || lookup.Err != nil {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -1,10 +1,31 @@
 package vars
 
+import "time"
+
 type Variables interface {
 	Get(VariableDefinition) (interface{}, bool, error)
 	List() ([]VariableDefinition, error)
 }
 
+// ExpiringVariables are Variables whose values are only valid until they
+// expire, e.g. secrets with a lease.
+type ExpiringVariables interface {
+	Variables
+
+	// GetWithExpiration is like Get, but also returns when the value expires.
+	// A nil expiration means the value does not expire.
+	GetWithExpiration(VariableDefinition) (interface{}, *time.Time, bool, error)
+}
+
+func getWithExpiration(vars Variables, varDef VariableDefinition) (interface{}, *time.Time, bool, error) {
+	if expiring, ok := vars.(ExpiringVariables); ok {
+		return expiring.GetWithExpiration(varDef)
+	}
+
+	val, found, err := vars.Get(varDef)
+	return val, nil, found, err
+}
+
 type VariableDefinition struct {
 	Name    string
 	Type    string
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 )
 
 // CredVarsTracker implements the interface Variables. It wraps a secret manager and
@@ -22,6 +23,10 @@ type CredVarsTracker interface {
 	Enabled() bool
 
 	AddLocalVar(string, interface{}, bool)
+
+	// NewLocalScope returns a tracker whose local vars shadow this tracker's,
+	// e.g. for the vars set by each iteration of an across step.
+	NewLocalScope() CredVarsTracker
 }
 
 func NewCredVarsTracker(credVars Variables, on bool) CredVarsTracker {
@@ -31,20 +36,34 @@ func NewCredVarsTracker(credVars Variables, on bool) CredVarsTracker {
 		enabled:           on,
 		interpolatedCreds: map[string]string{},
 		noRedactVarNames:  map[string]bool{},
+		expiringCreds:     map[string]expiringCred{},
 		lock:              sync.RWMutex{},
 	}
 }
 
+// An expiringCred is a cred var whose value is reused until it expires, so
+// that the steps of a build use the same lease of a secret. Steps which start
+// after it expired resolve the var again.
+type expiringCred struct {
+	value      interface{}
+	expiration time.Time
+}
+
 type credVarsTracker struct {
 	credVars  Variables
 	localVars StaticVariables
 
+	// the tracker this local scope was created from, if any
+	parent *credVarsTracker
+
 	enabled bool
 
 	interpolatedCreds map[string]string
 
 	noRedactVarNames map[string]bool
 
+	expiringCreds map[string]expiringCred
+
 	// Considering in-parallel steps, a lock is need.
 	lock sync.RWMutex
 }
@@ -59,16 +78,24 @@ func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, err
 	redact := true
 	parts := strings.Split(varDef.Name, ":")
 	if len(parts) == 2 && parts[0] == "." {
-		varDef.Name = parts[1]
-		val, found, err = t.localVars.Get(varDef)
+		t.lock.RLock()
+		localVarDef := varDef
+		localVarDef.Name = parts[1]
+		val, found, err = t.localVars.Get(localVarDef)
 		if found {
+			varDef = localVarDef
 			parts = strings.Split(varDef.Name, ".")
 			if _, ok := t.noRedactVarNames[parts[0]]; ok {
 				redact = false
 			}
 		}
+		t.lock.RUnlock()
+
+		if !found && err == nil && t.parent != nil {
+			return t.parent.Get(varDef)
+		}
 	} else {
-		val, found, err = t.credVars.Get(varDef)
+		val, found, err = t.getCred(varDef)
 	}
 
 	if t.enabled && found && redact {
@@ -80,6 +107,40 @@ func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, err
 	return val, found, err
 }
 
+func (t *credVarsTracker) getCred(varDef VariableDefinition) (interface{}, bool, error) {
+	if t.parent != nil {
+		return t.parent.getCred(varDef)
+	}
+
+	expiringVars, ok := t.credVars.(ExpiringVariables)
+	if !ok {
+		return t.credVars.Get(varDef)
+	}
+
+	t.lock.RLock()
+	cred, cached := t.expiringCreds[varDef.Name]
+	t.lock.RUnlock()
+
+	if cached && time.Now().Before(cred.expiration) {
+		return cred.value, true, nil
+	}
+
+	val, expiration, found, err := expiringVars.GetWithExpiration(varDef)
+	if err != nil || !found {
+		return val, found, err
+	}
+
+	t.lock.Lock()
+	if expiration != nil && time.Now().Before(*expiration) {
+		t.expiringCreds[varDef.Name] = expiringCred{value: val, expiration: *expiration}
+	} else {
+		delete(t.expiringCreds, varDef.Name)
+	}
+	t.lock.Unlock()
+
+	return val, true, nil
+}
+
 func (t *credVarsTracker) track(name string, val interface{}) {
 	switch v := val.(type) {
 	case map[interface{}]interface{}:
@@ -109,6 +170,10 @@ func (t *credVarsTracker) IterateInterpolatedCreds(iter CredVarsTrackerIterator)
 		iter.YieldCred(k, v)
 	}
 	t.lock.RUnlock()
+
+	if t.parent != nil {
+		t.parent.IterateInterpolatedCreds(iter)
+	}
 }
 
 func (t *credVarsTracker) Enabled() bool {
@@ -116,10 +181,25 @@ func (t *credVarsTracker) Enabled() bool {
 }
 
 func (t *credVarsTracker) AddLocalVar(name string, value interface{}, redact bool) {
+	t.lock.Lock()
 	t.localVars[name] = value
 	if !redact {
 		t.noRedactVarNames[name] = true
 	}
+	t.lock.Unlock()
+}
+
+func (t *credVarsTracker) NewLocalScope() CredVarsTracker {
+	return &credVarsTracker{
+		parent:            t,
+		localVars:         StaticVariables{},
+		credVars:          t.credVars,
+		enabled:           t.enabled,
+		interpolatedCreds: map[string]string{},
+		noRedactVarNames:  map[string]bool{},
+		expiringCreds:     map[string]expiringCred{},
+		lock:              sync.RWMutex{},
+	}
 }
 
 // MapCredVarsTrackerIterator implements a simple CredVarsTrackerIterator which just
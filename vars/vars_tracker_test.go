@@ -1,6 +1,9 @@
 package vars_test
 
 import (
+	"fmt"
+	"time"
+
 	. "github.com/concourse/concourse/vars"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -99,6 +102,102 @@ var _ = Describe("vars_tracker", func() {
 				Expect(mapit.Data["foo"]).To(BeNil())
 			})
 		})
+
+		Describe("NewLocalScope", func() {
+			var scope CredVarsTracker
+
+			BeforeEach(func() {
+				tracker.AddLocalVar("foo", "bar", true)
+				tracker.AddLocalVar("baz", "qux", true)
+
+				scope = tracker.NewLocalScope()
+				scope.AddLocalVar("foo", "scoped-bar", true)
+			})
+
+			It("shadows the parent's local vars", func() {
+				val, found, err := scope.Get(VariableDefinition{Name: ".:foo"})
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+				Expect(val).To(Equal("scoped-bar"))
+
+				val, found, err = tracker.Get(VariableDefinition{Name: ".:foo"})
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+				Expect(val).To(Equal("bar"))
+			})
+
+			It("falls back to the parent's local vars", func() {
+				val, found, err := scope.Get(VariableDefinition{Name: ".:baz"})
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+				Expect(val).To(Equal("qux"))
+			})
+
+			It("gets the parent's cred vars", func() {
+				val, found, err := scope.Get(VariableDefinition{Name: "k1"})
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+				Expect(val).To(Equal("v1"))
+			})
+
+			It("iterates the vars tracked by itself and its parent", func() {
+				scope.Get(VariableDefinition{Name: ".:foo"})
+				scope.Get(VariableDefinition{Name: ".:baz"})
+
+				mapit := NewMapCredVarsTrackerIterator()
+				scope.IterateInterpolatedCreds(mapit)
+				Expect(mapit.Data["foo"]).To(Equal("scoped-bar"))
+				Expect(mapit.Data["baz"]).To(Equal("qux"))
+			})
+		})
+	})
+
+	Describe("expiring cred vars", func() {
+		var leased *leasedVariables
+
+		BeforeEach(func() {
+			leased = &leasedVariables{ttl: time.Hour}
+			tracker = NewCredVarsTracker(leased, true)
+		})
+
+		It("reuses the value until it expires", func() {
+			val, found, err := tracker.Get(VariableDefinition{Name: "creds"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("creds-1"))
+
+			val, _, _ = tracker.Get(VariableDefinition{Name: "creds"})
+			Expect(val).To(Equal("creds-1"))
+			Expect(leased.reads).To(Equal(1))
+		})
+
+		It("reuses the value in local scopes", func() {
+			tracker.Get(VariableDefinition{Name: "creds"})
+
+			val, _, _ := tracker.NewLocalScope().Get(VariableDefinition{Name: "creds"})
+			Expect(val).To(Equal("creds-1"))
+			Expect(leased.reads).To(Equal(1))
+		})
+
+		Context("when the value has expired", func() {
+			BeforeEach(func() {
+				leased.ttl = time.Millisecond
+			})
+
+			It("resolves the var again", func() {
+				tracker.Get(VariableDefinition{Name: "creds"})
+
+				time.Sleep(2 * time.Millisecond)
+
+				val, _, _ := tracker.Get(VariableDefinition{Name: "creds"})
+				Expect(val).To(Equal("creds-2"))
+				Expect(leased.reads).To(Equal(2))
+
+				mapit := NewMapCredVarsTrackerIterator()
+				tracker.IterateInterpolatedCreds(mapit)
+				Expect(mapit.Data["creds"]).To(Equal("creds-2"))
+			})
+		})
 	})
 
 	Describe("turn off track", func() {
@@ -140,3 +239,25 @@ var _ = Describe("vars_tracker", func() {
 		})
 	})
 })
+
+// leasedVariables returns a new value every time a var is read, like the
+// dynamic secrets of a secret manager.
+type leasedVariables struct {
+	ttl   time.Duration
+	reads int
+}
+
+func (v *leasedVariables) Get(varDef VariableDefinition) (interface{}, bool, error) {
+	val, _, found, err := v.GetWithExpiration(varDef)
+	return val, found, err
+}
+
+func (v *leasedVariables) GetWithExpiration(varDef VariableDefinition) (interface{}, *time.Time, bool, error) {
+	v.reads++
+	expiration := time.Now().Add(v.ttl)
+	return fmt.Sprintf("%s-%d", varDef.Name, v.reads), &expiration, true, nil
+}
+
+func (v *leasedVariables) List() ([]VariableDefinition, error) {
+	return nil, nil
+}
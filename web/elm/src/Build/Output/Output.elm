@@ -207,6 +207,11 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        AcrossSubsteps origin substeps ->
+            ( { model | steps = Maybe.map (Build.StepTree.StepTree.expandAcross origin.id substeps) model.steps }
+            , effects
+            )
+
         BuildStatus status _ ->
             let
                 newSt =
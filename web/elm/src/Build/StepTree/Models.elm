@@ -34,6 +34,7 @@ type alias StepTreeModel =
     { tree : StepTree
     , foci : Dict StepID StepFocus
     , highlight : Highlight
+    , resources : Concourse.BuildResources
     }
 
 
@@ -47,6 +48,7 @@ type StepTree
     | Put Step
     | Aggregate (Array StepTree)
     | InParallel (Array StepTree)
+    | Across (List String) (List (List String)) (Array StepTree)
     | Do (Array StepTree)
     | OnSuccess HookedStep
     | OnFailure HookedStep
@@ -136,6 +138,7 @@ type BuildEvent
     | FinishPut Origin Int Concourse.Version Concourse.Metadata (Maybe Time.Posix)
     | Log Origin String (Maybe Time.Posix)
     | Error Origin String Time.Posix
+    | AcrossSubsteps Origin (List Concourse.AcrossSubstep)
     | End
     | Opened
     | NetworkError
@@ -273,6 +276,9 @@ getMultiStepIndex idx tree =
                 InParallel trees ->
                     trees
 
+                Across _ _ trees ->
+                    trees
+
                 Do trees ->
                     trees
 
@@ -301,6 +307,9 @@ setMultiStepIndex idx update tree =
         InParallel trees ->
             InParallel (Array.set idx (update (getMultiStepIndex idx tree)) trees)
 
+        Across vars values trees ->
+            Across vars values (Array.set idx (update (getMultiStepIndex idx tree)) trees)
+
         Do trees ->
             Do (Array.set idx (update (getMultiStepIndex idx tree)) trees)
 
@@ -351,6 +360,9 @@ finishTree root =
         InParallel trees ->
             InParallel (Array.map finishTree trees)
 
+        Across vars values trees ->
+            Across vars values (Array.map finishTree trees)
+
         Do trees ->
             Do (Array.map finishTree trees)
 
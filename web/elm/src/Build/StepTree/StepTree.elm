@@ -1,5 +1,6 @@
 module Build.StepTree.StepTree exposing
-    ( extendHighlight
+    ( expandAcross
+    , extendHighlight
     , finished
     , init
     , setHighlight
@@ -59,10 +60,10 @@ init :
 init hl resources buildPlan =
     case buildPlan.step of
         Concourse.BuildStepTask name ->
-            initBottom hl Task buildPlan.id name
+            initBottom hl resources Task buildPlan.id name
 
         Concourse.BuildStepArtifactInput name ->
-            initBottom hl
+            initBottom hl resources
                 (\s ->
                     ArtifactInput { s | state = StepStateSucceeded }
                 )
@@ -70,22 +71,22 @@ init hl resources buildPlan =
                 name
 
         Concourse.BuildStepGet name version ->
-            initBottom hl
+            initBottom hl resources
                 (Get << setupGetStep resources name version)
                 buildPlan.id
                 name
 
         Concourse.BuildStepArtifactOutput name ->
-            initBottom hl ArtifactOutput buildPlan.id name
+            initBottom hl resources ArtifactOutput buildPlan.id name
 
         Concourse.BuildStepPut name ->
-            initBottom hl Put buildPlan.id name
+            initBottom hl resources Put buildPlan.id name
 
         Concourse.BuildStepSetPipeline name ->
-            initBottom hl SetPipeline buildPlan.id name
+            initBottom hl resources SetPipeline buildPlan.id name
 
         Concourse.BuildStepLoadVar name ->
-            initBottom hl LoadVar buildPlan.id name
+            initBottom hl resources LoadVar buildPlan.id name
 
         Concourse.BuildStepAggregate plans ->
             initMultiStep hl resources buildPlan.id Aggregate plans
@@ -93,6 +94,13 @@ init hl resources buildPlan =
         Concourse.BuildStepInParallel plans ->
             initMultiStep hl resources buildPlan.id InParallel plans
 
+        Concourse.BuildStepAcross plan ->
+            initMultiStep hl
+                resources
+                buildPlan.id
+                (Across plan.vars (List.map .values plan.steps))
+                (Array.fromList (List.map .step plan.steps))
+
         Concourse.BuildStepDo plans ->
             initMultiStep hl resources buildPlan.id Do plans
 
@@ -146,16 +154,18 @@ initMultiStep hl resources planId constructor plans =
             |> Array.indexedMap wrapMultiStep
             |> Array.foldr Dict.union selfFoci
     , highlight = hl
+    , resources = resources
     }
 
 
 initBottom :
     Highlight
+    -> Concourse.BuildResources
     -> (Step -> StepTree)
     -> StepID
     -> StepName
     -> StepTreeModel
-initBottom hl create id name =
+initBottom hl resources create id name =
     let
         step =
             { id = id
@@ -193,6 +203,7 @@ initBottom hl create id name =
     { tree = create step
     , foci = Dict.singleton id identity
     , highlight = hl
+    , resources = resources
     }
 
 
@@ -210,6 +221,7 @@ initWrappedStep hl resources create plan =
     { tree = create tree
     , foci = Dict.map (always wrapStep) foci
     , highlight = hl
+    , resources = resources
     }
 
 
@@ -233,9 +245,45 @@ initHookedStep hl resources create hookedPlan =
             (Dict.map (always wrapStep) stepModel.foci)
             (Dict.map (always wrapHook) hookModel.foci)
     , highlight = hl
+    , resources = resources
     }
 
 
+expandAcross : StepID -> List Concourse.AcrossSubstep -> StepTreeModel -> StepTreeModel
+expandAcross id substeps root =
+    case Dict.get id root.foci of
+        Nothing ->
+            root
+
+        Just focus ->
+            let
+                inited =
+                    List.map (init root.highlight root.resources << .step) substeps
+
+                setSubsteps tree =
+                    case tree of
+                        Across vars _ _ ->
+                            Across vars
+                                (List.map .values substeps)
+                                (Array.fromList (List.map .tree inited))
+
+                        _ ->
+                            -- impossible (substeps for a non-across step)
+                            tree
+
+                substepFoci =
+                    inited
+                        |> List.map .foci
+                        |> List.indexedMap wrapMultiStep
+                        |> List.foldr Dict.union Dict.empty
+                        |> Dict.map (\_ subFocus -> subFocus >> focus)
+            in
+            { root
+                | tree = focus setSubsteps root.tree
+                , foci = Dict.union substepFoci root.foci
+            }
+
+
 treeIsActive : StepTree -> Bool
 treeIsActive stepTree =
     case stepTree of
@@ -245,6 +293,9 @@ treeIsActive stepTree =
         InParallel trees ->
             List.any treeIsActive (Array.toList trees)
 
+        Across _ _ trees ->
+            List.any treeIsActive (Array.toList trees)
+
         Do trees ->
             List.any treeIsActive (Array.toList trees)
 
@@ -444,6 +495,10 @@ viewTree session model tree =
             Html.div [ class "parallel" ]
                 (Array.toList <| Array.map (viewSeq session model) steps)
 
+        Across vars values steps ->
+            Html.div [ class "across" ]
+                (List.map2 (viewAcrossIteration session model vars) values (Array.toList steps))
+
         Do steps ->
             Html.div [ class "do" ]
                 (Array.toList <| Array.map (viewSeq session model) steps)
@@ -499,6 +554,19 @@ viewSeq session model tree =
     Html.div [ class "seq" ] [ viewTree session model tree ]
 
 
+viewAcrossIteration : { timeZone : Time.Zone, hovered : HoverState.HoverState } -> StepTreeModel -> List String -> List String -> StepTree -> Html Message
+viewAcrossIteration session model vars values tree =
+    Html.div [ class "seq" ]
+        [ Html.div (class "across-values" :: Styles.acrossValues)
+            (List.map2
+                (\var value -> Html.span Styles.acrossValue [ Html.text (var ++ ": " ++ value) ])
+                vars
+                values
+            )
+        , viewTree session model tree
+        ]
+
+
 viewHooked : { timeZone : Time.Zone, hovered : HoverState.HoverState } -> String -> StepTreeModel -> StepTree -> StepTree -> Html Message
 viewHooked session name model step hook =
     Html.div [ class "hooked" ]
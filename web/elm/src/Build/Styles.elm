@@ -1,6 +1,8 @@
 module Build.Styles exposing
     ( MetadataCellType(..)
     , abortButton
+    , acrossValue
+    , acrossValues
     , body
     , buttonTooltip
     , buttonTooltipArrow
@@ -333,6 +335,21 @@ type MetadataCellType
     | Value
 
 
+acrossValues : List (Html.Attribute msg)
+acrossValues =
+    [ style "display" "flex"
+    , style "flex-wrap" "wrap"
+    , style "line-height" "28px"
+    , style "color" Colors.pending
+    ]
+
+
+acrossValue : List (Html.Attribute msg)
+acrossValue =
+    [ style "padding" "0 6px"
+    ]
+
+
 metadataTable : List (Html.Attribute msg)
 metadataTable =
     [ style "border-collapse" "collapse"
@@ -1,5 +1,7 @@
 module Concourse exposing
-    ( AuthSession
+    ( AcrossPlan
+    , AcrossSubstep
+    , AuthSession
     , AuthToken
     , Build
     , BuildDuration
@@ -19,6 +21,7 @@ module Concourse exposing
     , CheckStatus(..)
     , ClusterInfo
     , HookedPlan
+    , InstanceVars
     , Job
     , JobBuildIdentifier
     , JobIdentifier
@@ -41,6 +44,7 @@ module Concourse exposing
     , VersionedResourceIdentifier
     , csrfTokenHeaderName
     , customDecoder
+    , decodeAcrossSubstep
     , decodeAuthToken
     , decodeBuild
     , decodeBuildPlan
@@ -54,6 +58,7 @@ module Concourse exposing
     , decodePipeline
     , decodeResource
     , decodeTeam
+    , decodeUnarchivedPipelines
     , decodeUser
     , decodeVersion
     , decodeVersionedResource
@@ -307,6 +312,7 @@ type BuildStep
     | BuildStepPut StepName
     | BuildStepAggregate (Array BuildPlan)
     | BuildStepInParallel (Array BuildPlan)
+    | BuildStepAcross AcrossPlan
     | BuildStepDo (Array BuildPlan)
     | BuildStepOnSuccess HookedPlan
     | BuildStepOnFailure HookedPlan
@@ -324,6 +330,18 @@ type alias HookedPlan =
     }
 
 
+type alias AcrossPlan =
+    { vars : List String
+    , steps : List AcrossSubstep
+    }
+
+
+type alias AcrossSubstep =
+    { values : List String
+    , step : BuildPlan
+    }
+
+
 decodeBuildPlan : Json.Decode.Decoder BuildPlan
 decodeBuildPlan =
     Json.Decode.at [ "plan" ] <|
@@ -353,6 +371,8 @@ decodeBuildPlan_ =
                     lazy (\_ -> decodeBuildStepAggregate)
                 , Json.Decode.field "in_parallel" <|
                     lazy (\_ -> decodeBuildStepInParallel)
+                , Json.Decode.field "across" <|
+                    lazy (\_ -> decodeBuildStepAcross)
                 , Json.Decode.field "do" <|
                     lazy (\_ -> decodeBuildStepDo)
                 , Json.Decode.field "on_success" <|
@@ -422,6 +442,32 @@ decodeBuildStepInParallel =
         |> andMap (Json.Decode.field "steps" <| Json.Decode.array (lazy (\_ -> decodeBuildPlan_)))
 
 
+decodeBuildStepAcross : Json.Decode.Decoder BuildStep
+decodeBuildStepAcross =
+    -- the substeps are only known up front when every var has static values;
+    -- otherwise they arrive later in an across-substeps build event
+    Json.Decode.map BuildStepAcross
+        (Json.Decode.succeed AcrossPlan
+            |> andMap (Json.Decode.field "vars" <| Json.Decode.list (Json.Decode.field "name" Json.Decode.string))
+            |> andMap (defaultTo [] <| Json.Decode.field "steps" <| Json.Decode.list (lazy (\_ -> decodeAcrossSubstep)))
+        )
+
+
+decodeAcrossSubstep : Json.Decode.Decoder AcrossSubstep
+decodeAcrossSubstep =
+    Json.Decode.succeed AcrossSubstep
+        |> andMap (defaultTo [] <| Json.Decode.field "values" <| Json.Decode.list decodeAcrossValue)
+        |> andMap (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
+
+
+decodeAcrossValue : Json.Decode.Decoder String
+decodeAcrossValue =
+    Json.Decode.oneOf
+        [ Json.Decode.string
+        , Json.Decode.map (Json.Encode.encode 0) Json.Decode.value
+        ]
+
+
 decodeBuildStepDo : Json.Decode.Decoder BuildStep
 decodeBuildStepDo =
     Json.Decode.succeed BuildStepDo
@@ -611,6 +657,7 @@ type alias PipelineIdentifier =
 type alias Pipeline =
     { id : Int
     , name : PipelineName
+    , instanceVars : InstanceVars
     , paused : Bool
     , public : Bool
     , teamName : TeamName
@@ -618,6 +665,14 @@ type alias Pipeline =
     }
 
 
+-- the vars distinguishing an instance of a pipeline from the other pipelines
+-- sharing its name, each value kept as its JSON encoding
+
+
+type alias InstanceVars =
+    Dict String String
+
+
 type alias PipelineGroup =
     { name : String
     , jobs : List String
@@ -630,12 +685,27 @@ decodePipeline =
     Json.Decode.succeed Pipeline
         |> andMap (Json.Decode.field "id" Json.Decode.int)
         |> andMap (Json.Decode.field "name" Json.Decode.string)
+        |> andMap (defaultTo Dict.empty <| Json.Decode.field "instance_vars" decodeInstanceVars)
         |> andMap (Json.Decode.field "paused" Json.Decode.bool)
         |> andMap (Json.Decode.field "public" Json.Decode.bool)
         |> andMap (Json.Decode.field "team_name" Json.Decode.string)
         |> andMap (defaultTo [] <| Json.Decode.field "groups" (Json.Decode.list decodePipelineGroup))
 
 
+decodeInstanceVars : Json.Decode.Decoder InstanceVars
+decodeInstanceVars =
+    Json.Decode.dict (Json.Decode.map (Json.Encode.encode 0) Json.Decode.value)
+
+
+decodeUnarchivedPipelines : Json.Decode.Decoder (List Pipeline)
+decodeUnarchivedPipelines =
+    Json.Decode.map2 Tuple.pair
+        (defaultTo False <| Json.Decode.field "archived" Json.Decode.bool)
+        decodePipeline
+        |> Json.Decode.list
+        |> Json.Decode.map (List.filter (not << Tuple.first) >> List.map Tuple.second)
+
+
 decodePipelineGroup : Json.Decode.Decoder PipelineGroup
 decodePipelineGroup =
     Json.Decode.succeed PipelineGroup
@@ -89,6 +89,14 @@ decodeBuildEvent =
                     "error" ->
                         Json.Decode.field "data" decodeErrorEvent
 
+                    "across-substeps" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map2 AcrossSubsteps
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "substeps" <| Json.Decode.list Concourse.decodeAcrossSubstep)
+                            )
+
                     "initialize-task" ->
                         Json.Decode.field
                             "data"
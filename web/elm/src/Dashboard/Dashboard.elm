@@ -210,6 +210,7 @@ handleCallback callback ( model, effects ) =
                                 { id = p.id
                                 , ordering = idx
                                 , name = p.name
+                                , instanceVars = p.instanceVars
                                 , teamName = p.teamName
                                 , public = p.public
                                 , isToggleLoading = False
@@ -11,6 +11,7 @@ import Concourse.PipelineStatus
 import Dashboard.Group.Models exposing (Group, Pipeline)
 import Dashboard.Pipeline as Pipeline
 import Dict
+import List.Extra
 import Parser
     exposing
         ( (|.)
@@ -57,11 +58,21 @@ filterGroups existingJobs query teams pipelines =
                         |> Dict.fromList
                     )
                 |> Dict.toList
-                |> List.map (\( k, v ) -> { teamName = k, pipelines = v })
+                |> List.map (\( k, v ) -> { teamName = k, pipelines = groupInstances v })
     in
     parseFilters query |> List.foldr (runFilter existingJobs) groupsToFilter
 
 
+groupInstances : List Pipeline -> List Pipeline
+groupInstances pipelines =
+    -- instances of a pipeline share its name, so keep them together where
+    -- the first of them is ordered
+    pipelines
+        |> List.map .name
+        |> List.Extra.unique
+        |> List.concatMap (\name -> List.filter (.name >> (==) name) pipelines)
+
+
 runFilter : List Concourse.Job -> Filter -> List Group -> List Group
 runFilter existingJobs f =
     let
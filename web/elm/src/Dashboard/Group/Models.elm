@@ -1,5 +1,7 @@
 module Dashboard.Group.Models exposing (Group, Pipeline)
 
+import Concourse
+
 
 type alias Group =
     { pipelines : List Pipeline
@@ -11,6 +13,7 @@ type alias Pipeline =
     { id : Int
     , ordering : Int
     , name : String
+    , instanceVars : Concourse.InstanceVars
     , teamName : String
     , public : Bool
     , isToggleLoading : Bool
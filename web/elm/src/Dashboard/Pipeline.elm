@@ -11,6 +11,7 @@ import Concourse.PipelineStatus as PipelineStatus
 import Dashboard.DashboardPreview as DashboardPreview
 import Dashboard.Group.Models exposing (Pipeline)
 import Dashboard.Styles as Styles
+import Dict
 import Duration
 import HoverState
 import Html exposing (Html)
@@ -68,7 +69,7 @@ hdPipelineView { pipeline, pipelineRunningKeyframes, resourceError, existingJobs
             []
         , Html.div
             (class "dashboardhd-pipeline-name" :: Styles.pipelineCardBodyHd)
-            [ Html.text pipeline.name ]
+            [ Html.text <| instanceName pipeline ]
         ]
             ++ (if resourceError then
                     [ Html.div Styles.resourceErrorTriangle [] ]
@@ -210,19 +211,59 @@ headerView pipeline resourceError =
              ]
                 ++ Styles.pipelineCardHeader
             )
-            [ Html.div
+            ([ Html.div
                 (class "dashboard-pipeline-name" :: Styles.pipelineName)
                 [ Html.text pipeline.name ]
-            , Html.div
-                [ classList
-                    [ ( "dashboard-resource-error", resourceError )
-                    ]
-                ]
-                []
-            ]
+             ]
+                ++ instanceVarsView pipeline
+                ++ [ Html.div
+                        [ classList
+                            [ ( "dashboard-resource-error", resourceError )
+                            ]
+                        ]
+                        []
+                   ]
+            )
+        ]
+
+
+instanceVarsView : Pipeline -> List (Html Message)
+instanceVarsView pipeline =
+    if Dict.isEmpty pipeline.instanceVars then
+        []
+
+    else
+        [ Html.div
+            (class "dashboard-pipeline-instance-vars" :: Styles.pipelineInstanceVars)
+            [ Html.text <| instanceVarsLabel pipeline.instanceVars ]
         ]
 
 
+instanceName : Pipeline -> String
+instanceName pipeline =
+    if Dict.isEmpty pipeline.instanceVars then
+        pipeline.name
+
+    else
+        pipeline.name ++ "/" ++ instanceVarsLabel pipeline.instanceVars
+
+
+instanceVarsLabel : Concourse.InstanceVars -> String
+instanceVarsLabel =
+    Dict.toList
+        >> List.map (\( name, value ) -> name ++ ":" ++ unquote value)
+        >> String.join ","
+
+
+unquote : String -> String
+unquote value =
+    if String.length value >= 2 && String.startsWith "\"" value && String.endsWith "\"" value then
+        value |> String.dropLeft 1 |> String.dropRight 1
+
+    else
+        value
+
+
 bodyView : HoverState.HoverState -> List Concourse.Job -> Html Message
 bodyView hovered existingJobs =
     Html.div
@@ -30,6 +30,7 @@ module Dashboard.Styles exposing
     , pipelineCardHd
     , pipelineCardHeader
     , pipelineCardTransitionAge
+    , pipelineInstanceVars
     , pipelineName
     , previewPlaceholder
     , resourceErrorTriangle
@@ -164,6 +165,17 @@ pipelineName =
     ]
 
 
+pipelineInstanceVars : List (Html.Attribute msg)
+pipelineInstanceVars =
+    [ style "width" "245px"
+    , style "white-space" "nowrap"
+    , style "overflow" "hidden"
+    , style "text-overflow" "ellipsis"
+    , style "font-size" "0.6em"
+    , style "color" Colors.bottomBarText
+    ]
+
+
 cardBody : List (Html.Attribute msg)
 cardBody =
     [ style "width" "200px"
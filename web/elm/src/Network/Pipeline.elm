@@ -42,7 +42,7 @@ fetchPipelines =
     Http.toTask <|
         Http.get
             "/api/v1/pipelines"
-            (Json.Decode.list Concourse.decodePipeline)
+            Concourse.decodeUnarchivedPipelines
 
 
 fetchPipelinesForTeam : String -> Task Http.Error (List Concourse.Pipeline)
@@ -9,6 +9,7 @@ import Char
 import Colors
 import Common exposing (defineHoverBehaviour, isColorWithStripes)
 import Concourse exposing (BuildPrepStatus(..))
+import Concourse.BuildEvents
 import Concourse.BuildStatus exposing (BuildStatus(..))
 import Concourse.Pagination exposing (Direction(..))
 import DashboardTests exposing (iconSelector, middleGrey)
@@ -17,6 +18,7 @@ import Dict
 import Expect
 import Html.Attributes as Attr
 import Http
+import Json.Decode
 import Json.Encode as Encode
 import Keyboard
 import Message.Callback as Callback
@@ -3641,6 +3643,154 @@ all =
                                 , attribute <| Attr.href invalidURLText
                                 ]
                 ]
+            , describe "across step" <|
+                let
+                    acrossPlan =
+                        { id = "across-id"
+                        , step =
+                            Concourse.BuildStepAcross
+                                { vars = [ "var1", "var2" ]
+                                , steps = []
+                                }
+                        }
+
+                    substeps =
+                        [ { values = [ "a1", "b1" ]
+                          , step = { id = "task-a-id", step = Concourse.BuildStepTask "task-a" }
+                          }
+                        , { values = [ "a2", "{\"k\":1}" ]
+                          , step = { id = "task-b-id", step = Concourse.BuildStepTask "task-b" }
+                          }
+                        ]
+
+                    acrossView events =
+                        Common.init "/teams/t/pipelines/p/jobs/j/builds/1"
+                            |> fetchBuildWithStatus BuildStatusStarted
+                            |> Application.handleCallback
+                                (Callback.PlanAndResourcesFetched 1 <|
+                                    Ok ( acrossPlan, { inputs = [], outputs = [] } )
+                                )
+                            |> Tuple.first
+                            |> Application.update
+                                (Msgs.DeliveryReceived <|
+                                    EventsReceived <|
+                                        Ok <|
+                                            List.map (\event -> { url = eventsUrl, data = event }) events
+                                )
+                            |> Tuple.first
+                            |> Common.queryView
+                in
+                [ test "has no substeps until they are expanded" <|
+                    \_ ->
+                        acrossView []
+                            |> Query.find [ class "across" ]
+                            |> Query.findAll [ class "build-step" ]
+                            |> Query.count (Expect.equal 0)
+                , test "shows the expanded substeps" <|
+                    \_ ->
+                        acrossView
+                            [ STModels.AcrossSubsteps
+                                { source = "", id = "across-id" }
+                                substeps
+                            ]
+                            |> Query.find [ class "across" ]
+                            |> Query.findAll [ class "build-step" ]
+                            |> Expect.all
+                                [ Query.count (Expect.equal 2)
+                                , Query.index 0 >> Query.has [ attribute <| Attr.attribute "data-step-name" "task-a" ]
+                                , Query.index 1 >> Query.has [ attribute <| Attr.attribute "data-step-name" "task-b" ]
+                                ]
+                , test "labels each iteration with its var values" <|
+                    \_ ->
+                        acrossView
+                            [ STModels.AcrossSubsteps
+                                { source = "", id = "across-id" }
+                                substeps
+                            ]
+                            |> Query.findAll [ class "across-values" ]
+                            |> Expect.all
+                                [ Query.count (Expect.equal 2)
+                                , Query.index 0
+                                    >> Query.has
+                                        [ containing [ text "var1: a1" ]
+                                        , containing [ text "var2: b1" ]
+                                        ]
+                                , Query.index 1
+                                    >> Query.has
+                                        [ containing [ text "var1: a2" ]
+                                        , containing [ text "var2: {\"k\":1}" ]
+                                        ]
+                                ]
+                , test "routes events to the expanded substeps" <|
+                    \_ ->
+                        acrossView
+                            [ STModels.AcrossSubsteps
+                                { source = "", id = "across-id" }
+                                substeps
+                            , STModels.FinishTask
+                                { source = "", id = "task-b-id" }
+                                0
+                                (Time.millisToPosix 0)
+                            ]
+                            |> Query.find
+                                [ class "build-step"
+                                , attribute <| Attr.attribute "data-step-name" "task-b"
+                                ]
+                            |> Query.has [ attribute <| Attr.attribute "data-step-state" "succeeded" ]
+                , test "decodes the across-substeps event" <|
+                    \_ ->
+                        """
+                        {
+                          "event": "across-substeps",
+                          "version": "1.0",
+                          "data": {
+                            "origin": { "id": "across-id" },
+                            "time": 1,
+                            "substeps": [
+                              { "step": { "id": "task-a-id", "task": { "name": "task-a" } }, "values": [ "a1", "b1" ] },
+                              { "step": { "id": "task-b-id", "task": { "name": "task-b" } }, "values": [ "a2", { "k": 1 } ] }
+                            ]
+                          }
+                        }
+                        """
+                            |> Json.Decode.decodeString Concourse.BuildEvents.decodeBuildEvent
+                            |> Expect.equal
+                                (Ok <|
+                                    STModels.AcrossSubsteps
+                                        { source = "", id = "across-id" }
+                                        substeps
+                                )
+                , test "decodes the substeps of an across plan with static values" <|
+                    \_ ->
+                        """
+                        {
+                          "plan": {
+                            "id": "across-id",
+                            "across": {
+                              "vars": [ { "name": "var1", "values": [ "a1" ] } ],
+                              "steps": [
+                                { "step": { "id": "task-a-id", "task": { "name": "task-a" } }, "values": [ "a1" ] }
+                              ]
+                            }
+                          }
+                        }
+                        """
+                            |> Json.Decode.decodeString Concourse.decodeBuildPlan
+                            |> Expect.equal
+                                (Ok
+                                    { id = "across-id"
+                                    , step =
+                                        Concourse.BuildStepAcross
+                                            { vars = [ "var1" ]
+                                            , steps =
+                                                [ { values = [ "a1" ]
+                                                  , step = { id = "task-a-id", step = Concourse.BuildStepTask "task-a" }
+                                                  }
+                                                ]
+                                            }
+                                    }
+                                )
+                ]
             ]
         ]
 
@@ -6,6 +6,7 @@ import Common exposing (defineHoverBehaviour, isColorWithStripes, queryView)
 import Concourse
 import Concourse.BuildStatus exposing (BuildStatus(..))
 import Dashboard.DashboardPreview as DP
+import Dict
 import Expect
 import Message.Callback as Callback
 import Message.Message exposing (DomID(..))
@@ -199,6 +200,7 @@ dashboardWithJob j =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 1
@@ -206,6 +208,7 @@ dashboardWithJob j =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 2
@@ -213,6 +216,7 @@ dashboardWithJob j =
                       , paused = False
                       , public = True
                       , teamName = "banana"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     ]
@@ -4,6 +4,7 @@ import Application.Application as Application
 import Common exposing (queryView)
 import Concourse
 import Concourse.BuildStatus exposing (BuildStatus(..))
+import Dict
 import Expect exposing (Expectation)
 import Message.Callback as Callback
 import Message.Message
@@ -91,6 +92,7 @@ all =
                           , paused = False
                           , public = True
                           , teamName = "team1"
+                          , instanceVars = Dict.empty
                           , groups = []
                           }
                         , { id = 1
@@ -98,6 +100,7 @@ all =
                           , paused = False
                           , public = True
                           , teamName = "team1"
+                          , instanceVars = Dict.empty
                           , groups = []
                           }
                         ]
@@ -195,6 +198,7 @@ all =
                               , paused = False
                               , public = True
                               , teamName = "team"
+                              , instanceVars = Dict.empty
                               , groups = []
                               }
                             ]
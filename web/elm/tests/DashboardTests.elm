@@ -359,6 +359,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -385,6 +386,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -411,6 +413,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -435,6 +438,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -459,6 +463,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -486,6 +491,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -513,6 +519,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -543,6 +550,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -566,6 +574,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -603,6 +612,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -625,6 +635,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -645,6 +656,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -702,6 +714,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -757,6 +770,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -796,6 +810,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -883,6 +898,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -909,6 +925,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -931,6 +948,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -953,6 +971,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -975,6 +994,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -997,6 +1017,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1019,6 +1040,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1045,6 +1067,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1079,6 +1102,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "owner-team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1106,6 +1130,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1134,6 +1159,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1158,6 +1184,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1182,6 +1209,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1206,6 +1234,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1226,6 +1255,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1255,6 +1285,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1279,6 +1310,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1306,6 +1338,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1333,6 +1366,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1354,6 +1388,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1408,6 +1443,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1432,6 +1468,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1456,6 +1493,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1486,6 +1524,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1512,6 +1551,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1541,6 +1581,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1565,6 +1606,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1616,6 +1658,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1687,6 +1730,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -1717,6 +1761,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -1739,6 +1784,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -1766,6 +1812,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1872,6 +1919,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -1918,6 +1966,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -1968,6 +2017,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2024,6 +2074,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -2045,6 +2096,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -2070,6 +2122,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -2266,6 +2319,7 @@ onePipeline teamName =
     , paused = False
     , public = True
     , teamName = teamName
+    , instanceVars = Dict.empty
     , groups = []
     }
 
@@ -2277,6 +2331,7 @@ onePipelinePaused teamName =
     , paused = True
     , public = True
     , teamName = teamName
+    , instanceVars = Dict.empty
     , groups = []
     }
 
@@ -204,6 +204,7 @@ myBrowserFetchedOnePipeline =
                   , paused = False
                   , public = True
                   , teamName = "team"
+                  , instanceVars = Dict.empty
                   , groups = []
                   }
                 ]
@@ -219,6 +220,7 @@ myBrowserFetchedTwoPipelines =
                   , paused = False
                   , public = True
                   , teamName = "team"
+                  , instanceVars = Dict.empty
                   , groups = []
                   }
                 , { id = 1
@@ -226,6 +228,7 @@ myBrowserFetchedTwoPipelines =
                   , paused = False
                   , public = True
                   , teamName = "team"
+                  , instanceVars = Dict.empty
                   , groups = []
                   }
                 ]
@@ -241,6 +244,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                   , paused = False
                   , public = True
                   , teamName = "team"
+                  , instanceVars = Dict.empty
                   , groups = []
                   }
                 , { id = 1
@@ -248,6 +252,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                   , paused = False
                   , public = True
                   , teamName = "team"
+                  , instanceVars = Dict.empty
                   , groups = []
                   }
                 , { id = 2
@@ -255,6 +260,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                   , paused = False
                   , public = True
                   , teamName = "other-team"
+                  , instanceVars = Dict.empty
                   , groups = []
                   }
                 ]
@@ -468,6 +474,7 @@ dashboardRefreshPipelines =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 1
@@ -475,6 +482,7 @@ dashboardRefreshPipelines =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     ]
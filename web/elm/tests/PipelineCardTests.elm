@@ -2,6 +2,7 @@ module PipelineCardTests exposing (all)
 
 import Application.Application as Application
 import Common exposing (defineHoverBehaviour, isColorWithStripes)
+import Concourse
 import Concourse.BuildStatus exposing (BuildStatus(..))
 import DashboardTests exposing (afterSeconds, amber, apiData, blue, brown, circularJobs, darkGrey, fadedGreen, givenDataAndUser, givenDataUnauthenticated, green, iconSelector, job, jobWithNameTransitionedAt, lightGrey, middleGrey, orange, otherJob, red, running, userWithRoles, whenOnDashboard, white)
 import Dict
@@ -63,6 +64,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -93,6 +95,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "other-team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -192,6 +195,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -216,6 +220,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -243,6 +248,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -280,6 +286,61 @@ all =
                         , style "text-overflow" "ellipsis"
                         ]
             ]
+        , describe "instances" <|
+            let
+                instance : Int -> String -> Maybe String -> Concourse.Pipeline
+                instance id name branch =
+                    { id = id
+                    , name = name
+                    , paused = False
+                    , public = True
+                    , teamName = "team"
+                    , instanceVars =
+                        branch
+                            |> Maybe.map (\b -> Dict.fromList [ ( "branch", "\"" ++ b ++ "\"" ) ])
+                            |> Maybe.withDefault Dict.empty
+                    , groups = []
+                    }
+
+                setup : Bool -> () -> Query.Single ApplicationMsgs.TopLevelMessage
+                setup highDensity _ =
+                    whenOnDashboard { highDensity = highDensity }
+                        |> givenDataUnauthenticated
+                            (apiData [ ( "team", [] ) ])
+                        |> Tuple.first
+                        |> Application.handleCallback
+                            (Callback.AllPipelinesFetched <|
+                                Ok
+                                    [ instance 0 "pipeline" (Just "feature")
+                                    , instance 1 "other-pipeline" Nothing
+                                    , instance 2 "pipeline" (Just "main")
+                                    ]
+                            )
+                        |> Tuple.first
+                        |> Common.queryView
+            in
+            [ test "are grouped together" <|
+                setup False
+                    >> Query.findAll [ class "dashboard-pipeline-name" ]
+                    >> Expect.all
+                        [ Query.index 0 >> Query.has [ text "pipeline" ]
+                        , Query.index 1 >> Query.has [ text "pipeline" ]
+                        , Query.index 2 >> Query.has [ text "other-pipeline" ]
+                        ]
+            , test "show their instance vars under the pipeline name" <|
+                setup False
+                    >> Query.findAll [ class "dashboard-pipeline-instance-vars" ]
+                    >> Expect.all
+                        [ Query.count (Expect.equal 2)
+                        , Query.index 0 >> Query.has [ text "branch:feature" ]
+                        , Query.index 1 >> Query.has [ text "branch:main" ]
+                        ]
+            , test "show their instance vars after the pipeline name on HD view" <|
+                setup True
+                    >> Query.findAll [ class "dashboardhd-pipeline-name" ]
+                    >> Query.index 0
+                    >> Query.has [ text "pipeline/branch:feature" ]
+            ]
         , describe "colored banner" <|
             let
                 findBanner =
@@ -309,6 +370,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -330,6 +392,7 @@ all =
                                           , paused = True
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -368,6 +431,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -458,6 +522,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -509,6 +574,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -532,6 +598,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -553,6 +620,7 @@ all =
                                               , paused = True
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -591,6 +659,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -681,6 +750,7 @@ all =
                                                   , paused = False
                                                   , public = True
                                                   , teamName = "team"
+                                                  , instanceVars = Dict.empty
                                                   , groups = []
                                                   }
                                                 ]
@@ -736,6 +806,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -761,6 +832,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "other-team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -900,6 +972,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -988,6 +1061,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1014,6 +1088,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1044,6 +1119,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1077,6 +1153,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1105,6 +1182,7 @@ all =
                                       , paused = False
                                       , public = True
                                       , teamName = "team"
+                                      , instanceVars = Dict.empty
                                       , groups = []
                                       }
                                     ]
@@ -1145,6 +1223,7 @@ all =
                                               , paused = True
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -1286,6 +1365,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -1813,6 +1893,7 @@ all =
                                                   , paused = False
                                                   , public = True
                                                   , teamName = "team"
+                                                  , instanceVars = Dict.empty
                                                   , groups = []
                                                   }
                                                 ]
@@ -1833,6 +1914,7 @@ all =
                                                   , paused = False
                                                   , public = False
                                                   , teamName = "team"
+                                                  , instanceVars = Dict.empty
                                                   , groups = []
                                                   }
                                                 ]
@@ -1860,6 +1942,7 @@ all =
                                                   , paused = False
                                                   , public = True
                                                   , teamName = "team"
+                                                  , instanceVars = Dict.empty
                                                   , groups = []
                                                   }
                                                 ]
@@ -1880,6 +1963,7 @@ all =
                                                   , paused = False
                                                   , public = False
                                                   , teamName = "team"
+                                                  , instanceVars = Dict.empty
                                                   , groups = []
                                                   }
                                                 ]
@@ -1905,6 +1989,7 @@ all =
                                                   , paused = False
                                                   , public = True
                                                   , teamName = "team"
+                                                  , instanceVars = Dict.empty
                                                   , groups = []
                                                   }
                                                 ]
@@ -1929,6 +2014,7 @@ all =
                                           , paused = False
                                           , public = True
                                           , teamName = "team"
+                                          , instanceVars = Dict.empty
                                           , groups = []
                                           }
                                         ]
@@ -1959,6 +2045,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -1991,6 +2078,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2020,6 +2108,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2050,6 +2139,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2106,6 +2196,7 @@ all =
                                               , paused = True
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2161,6 +2252,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2197,6 +2289,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2229,6 +2322,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2265,6 +2359,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -2302,6 +2397,7 @@ all =
                                               , paused = False
                                               , public = True
                                               , teamName = "team"
+                                              , instanceVars = Dict.empty
                                               , groups = []
                                               }
                                             ]
@@ -3,6 +3,7 @@ module PipelineTests exposing (all)
 import Application.Application as Application
 import Char
 import Common exposing (defineHoverBehaviour)
+import Dict
 import Expect exposing (..)
 import Html.Attributes as Attr
 import Json.Encode
@@ -92,6 +93,7 @@ all =
                                     , paused = False
                                     , public = True
                                     , teamName = "team"
+                                    , instanceVars = Dict.empty
                                     , groups = groups
                                     }
                                 )
@@ -544,6 +546,7 @@ all =
                                     , paused = True
                                     , public = True
                                     , teamName = "team"
+                                    , instanceVars = Dict.empty
                                     , groups = []
                                     }
                                 )
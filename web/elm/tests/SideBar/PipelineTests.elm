@@ -2,6 +2,7 @@ module SideBar.PipelineTests exposing (all)
 
 import Colors
 import Common
+import Dict
 import Expect
 import HoverState exposing (TooltipPosition(..))
 import Html exposing (Html)
@@ -117,6 +118,7 @@ singlePipeline =
     , paused = False
     , public = True
     , teamName = "team"
+    , instanceVars = Dict.empty
     , groups = []
     }
 
@@ -1,6 +1,7 @@
 module SideBar.TeamTests exposing (all)
 
 import Common
+import Dict
 import Expect
 import HoverState exposing (TooltipPosition(..))
 import Html exposing (Html)
@@ -305,6 +306,7 @@ team { active, expanded, hovered } =
               , paused = False
               , public = True
               , teamName = "team"
+              , instanceVars = Dict.empty
               , groups = []
               }
             ]
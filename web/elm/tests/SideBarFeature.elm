@@ -760,6 +760,7 @@ apiDataLoads =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 1
@@ -767,6 +768,7 @@ apiDataLoads =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     ]
@@ -784,6 +786,7 @@ dataRefreshes =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 1
@@ -791,6 +794,7 @@ dataRefreshes =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     ]
@@ -1237,6 +1241,7 @@ thePipelineIsPaused =
                     , paused = True
                     , public = True
                     , teamName = "team"
+                    , instanceVars = Dict.empty
                     , groups = []
                     }
                 )
@@ -1300,6 +1305,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 1
@@ -1307,6 +1313,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 2
@@ -1314,6 +1321,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 3
@@ -1321,6 +1329,7 @@ myBrowserFetchedPipelinesFromMultipleTeams =
                       , paused = False
                       , public = True
                       , teamName = "other-team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     ]
@@ -1337,6 +1346,7 @@ myBrowserFetchedPipelines =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     , { id = 1
@@ -1344,6 +1354,7 @@ myBrowserFetchedPipelines =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     ]
@@ -1644,6 +1655,7 @@ iAmLookingAtAOneOffBuildPageOnANonPhoneScreen =
                       , paused = False
                       , public = True
                       , teamName = "team"
+                      , instanceVars = Dict.empty
                       , groups = []
                       }
                     ]
@@ -2,6 +2,7 @@ module SideBarTests exposing (all)
 
 import Browser.Dom
 import Common
+import Dict
 import Expect
 import HoverState
 import Message.Callback as Callback exposing (TooltipPolicy(..))
@@ -45,6 +46,7 @@ model =
               , paused = False
               , public = True
               , teamName = "team"
+              , instanceVars = Dict.empty
               , groups = []
               }
             ]
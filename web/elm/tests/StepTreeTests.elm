@@ -1,5 +1,7 @@
 module StepTreeTests exposing
     ( all
+    , expandAcross
+    , initAcross
     , initAggregate
     , initAggregateNested
     , initEnsure
@@ -37,6 +39,8 @@ all =
         , initAggregateNested
         , initInParallel
         , initInParallelNested
+        , initAcross
+        , expandAcross
         , initOnSuccess
         , initOnFailure
         , initEnsure
@@ -423,6 +427,111 @@ initInParallelNested =
         ]
 
 
+initAcross : Test
+initAcross =
+    let
+        { tree, foci } =
+            StepTree.init Routes.HighlightNothing
+                emptyResources
+                { id = "across-id"
+                , step =
+                    BuildStepAcross
+                        { vars = [ "var" ]
+                        , steps =
+                            [ { values = [ "a" ], step = { id = "task-a-id", step = BuildStepTask "task-a" } }
+                            , { values = [ "b" ], step = { id = "task-b-id", step = BuildStepTask "task-b" } }
+                            ]
+                        }
+                }
+    in
+    describe "init with Across"
+        [ test "the tree" <|
+            \_ ->
+                Expect.equal
+                    (Models.Across [ "var" ] [ [ "a" ], [ "b" ] ]
+                        << Array.fromList
+                     <|
+                        [ Models.Task (someStep "task-a-id" "task-a" Models.StepStatePending)
+                        , Models.Task (someStep "task-b-id" "task-b" Models.StepStatePending)
+                        ]
+                    )
+                    tree
+        , test "using the focus" <|
+            \_ ->
+                assertFocus "task-b-id"
+                    foci
+                    tree
+                    (\s -> { s | state = Models.StepStateSucceeded })
+                    (Models.Across [ "var" ] [ [ "a" ], [ "b" ] ]
+                        << Array.fromList
+                     <|
+                        [ Models.Task (someStep "task-a-id" "task-a" Models.StepStatePending)
+                        , Models.Task (someStep "task-b-id" "task-b" Models.StepStateSucceeded)
+                        ]
+                    )
+        ]
+
+
+expandAcross : Test
+expandAcross =
+    let
+        { tree, foci } =
+            StepTree.init Routes.HighlightNothing
+                emptyResources
+                { id = "do-id"
+                , step =
+                    BuildStepDo
+                        << Array.fromList
+                    <|
+                        [ { id = "task-a-id", step = BuildStepTask "task-a" }
+                        , { id = "across-id"
+                          , step = BuildStepAcross { vars = [ "var" ], steps = [] }
+                          }
+                        ]
+                }
+                |> StepTree.expandAcross "across-id"
+                    [ { values = [ "b" ], step = { id = "task-b-id", step = BuildStepTask "task-b" } }
+                    , { values = [ "c" ], step = { id = "task-c-id", step = BuildStepTask "task-c" } }
+                    ]
+    in
+    describe "expanding the substeps of an Across"
+        [ test "the tree" <|
+            \_ ->
+                Expect.equal
+                    (Models.Do
+                        << Array.fromList
+                     <|
+                        [ Models.Task (someStep "task-a-id" "task-a" Models.StepStatePending)
+                        , Models.Across [ "var" ] [ [ "b" ], [ "c" ] ]
+                            << Array.fromList
+                          <|
+                            [ Models.Task (someStep "task-b-id" "task-b" Models.StepStatePending)
+                            , Models.Task (someStep "task-c-id" "task-c" Models.StepStatePending)
+                            ]
+                        ]
+                    )
+                    tree
+        , test "using the focus of a substep" <|
+            \_ ->
+                assertFocus "task-c-id"
+                    foci
+                    tree
+                    (\s -> { s | state = Models.StepStateSucceeded })
+                    (Models.Do
+                        << Array.fromList
+                     <|
+                        [ Models.Task (someStep "task-a-id" "task-a" Models.StepStatePending)
+                        , Models.Across [ "var" ] [ [ "b" ], [ "c" ] ]
+                            << Array.fromList
+                          <|
+                            [ Models.Task (someStep "task-b-id" "task-b" Models.StepStatePending)
+                            , Models.Task (someStep "task-c-id" "task-c" Models.StepStateSucceeded)
+                            ]
+                        ]
+                    )
+        ]
+
+
 initOnSuccess : Test
 initOnSuccess =
     let
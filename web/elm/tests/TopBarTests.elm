@@ -327,6 +327,7 @@ all =
                             , paused = True
                             , public = True
                             , teamName = "t"
+                            , instanceVars = Dict.empty
                             , groups = []
                             }
                     )
@@ -579,6 +580,7 @@ all =
                               , paused = False
                               , public = True
                               , teamName = "team1"
+                              , instanceVars = Dict.empty
                               , groups = []
                               }
                             ]
@@ -630,6 +632,7 @@ all =
                               , paused = False
                               , public = True
                               , teamName = "team1"
+                              , instanceVars = Dict.empty
                               , groups = []
                               }
                             ]
@@ -906,6 +909,7 @@ all =
                               , paused = False
                               , public = True
                               , teamName = "team1"
+                              , instanceVars = Dict.empty
                               , groups = []
                               }
                             ]
@@ -983,6 +987,7 @@ all =
                               , paused = False
                               , public = True
                               , teamName = "team1"
+                              , instanceVars = Dict.empty
                               , groups = []
                               }
                             ]
@@ -1034,6 +1039,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team1"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -1076,6 +1082,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = "team1"
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]
@@ -1105,6 +1112,7 @@ all =
                               , paused = False
                               , public = True
                               , teamName = "team"
+                              , instanceVars = Dict.empty
                               , groups = []
                               }
                             ]
@@ -1336,6 +1344,7 @@ all =
                                     , paused = True
                                     , public = True
                                     , teamName = "t"
+                                    , instanceVars = Dict.empty
                                     , groups = []
                                     }
                             )
@@ -1654,6 +1663,7 @@ onePipeline teamName =
     , paused = False
     , public = True
     , teamName = teamName
+    , instanceVars = Dict.empty
     , groups = []
     }
 
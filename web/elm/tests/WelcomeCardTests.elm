@@ -4,6 +4,7 @@ import Application.Application as Application
 import Common exposing (defineHoverBehaviour)
 import Concourse.Cli as Cli
 import DashboardTests exposing (apiData, darkGrey, givenDataAndUser, givenDataUnauthenticated, iconSelector, userWithRoles, whenOnDashboard)
+import Dict
 import Expect
 import Html.Attributes as Attr
 import Message.Callback as Callback
@@ -93,6 +94,7 @@ all =
                                   , paused = False
                                   , public = True
                                   , teamName = ""
+                                  , instanceVars = Dict.empty
                                   , groups = []
                                   }
                                 ]